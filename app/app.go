@@ -98,6 +98,7 @@ import (
 	ibctestingtypes "github.com/cosmos/ibc-go/v6/testing/types"
 
 	"github.com/likecoin/likecoin-chain/v4/x/iscn"
+	iscnclient "github.com/likecoin/likecoin-chain/v4/x/iscn/client"
 	iscnkeeper "github.com/likecoin/likecoin-chain/v4/x/iscn/keeper"
 	iscntypes "github.com/likecoin/likecoin-chain/v4/x/iscn/types"
 
@@ -111,7 +112,7 @@ import (
 )
 
 const (
-	UpgradeName = "v4.2.0"
+	UpgradeName = "v4.3.0"
 )
 
 var (
@@ -138,6 +139,8 @@ var (
 				upgradeclient.LegacyCancelProposalHandler,
 				ibcclientclient.UpdateClientProposalHandler,
 				ibcclientclient.UpgradeProposalHandler,
+				iscnclient.AddContentSchemaProposalHandler,
+				iscnclient.RemoveContentSchemaProposalHandler,
 			},
 		),
 		params.AppModuleBasic{},
@@ -169,7 +172,8 @@ var (
 		govtypes.ModuleName:            {authtypes.Burner},
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		nft.ModuleName:                 nil,
-		likenfttypes.ModuleName:        nil,
+		iscntypes.ModuleName:           {authtypes.Burner},
+		likenfttypes.ModuleName:        {authtypes.Burner},
 	}
 )
 
@@ -345,10 +349,10 @@ func NewLikeApp(
 		app.BaseApp, authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	app.registerUpgradeHandlers()
-	app.IscnKeeper = iscnkeeper.NewKeeper(appCodec, keys[iscntypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.FeeGrantKeeper, iscnSubspace)
+	app.IscnKeeper = iscnkeeper.NewKeeper(appCodec, keys[iscntypes.StoreKey], app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.FeeGrantKeeper, app.GroupKeeper, iscnSubspace)
 
 	app.NftKeeper = nftkeeper.NewKeeper(keys[nftkeeper.StoreKey], appCodec, app.AccountKeeper, app.BankKeeper)
-	app.LikeNftKeeper = *likenftkeeper.NewKeeper(app.appCodec, keys[likenfttypes.StoreKey], app.memKeys[likenfttypes.MemStoreKey], likeNftSubspace, app.AccountKeeper, app.BankKeeper, app.IscnKeeper, app.NftKeeper, app.FeeGrantKeeper)
+	app.LikeNftKeeper = *likenftkeeper.NewKeeper(app.appCodec, keys[likenfttypes.StoreKey], app.memKeys[likenfttypes.MemStoreKey], likeNftSubspace, app.AccountKeeper, app.BankKeeper, app.IscnKeeper, app.NftKeeper, app.FeeGrantKeeper, app.DistrKeeper)
 
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
@@ -367,7 +371,8 @@ func NewLikeApp(
 		AddRoute(paramproposal.RouterKey, params.NewParamChangeProposalHandler(app.ParamsKeeper)).
 		AddRoute(distrtypes.RouterKey, distr.NewCommunityPoolSpendProposalHandler(app.DistrKeeper)).
 		AddRoute(upgradetypes.RouterKey, upgrade.NewSoftwareUpgradeProposalHandler(app.UpgradeKeeper)).
-		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper))
+		AddRoute(ibcclienttypes.RouterKey, ibcclient.NewClientProposalHandler(app.IBCKeeper.ClientKeeper)).
+		AddRoute(iscntypes.RouterKey, iscn.NewContentSchemaProposalHandler(app.IscnKeeper))
 	govConfig := govtypes.DefaultConfig()
 	app.GovKeeper = govkeeper.NewKeeper(
 		appCodec, keys[govtypes.StoreKey], govSubspace, app.AccountKeeper, app.BankKeeper,
@@ -540,7 +545,8 @@ func NewLikeApp(
 				SignModeHandler: encodingConfig.TxConfig.SignModeHandler(),
 				SigGasConsumer:  ante.DefaultSigVerificationGasConsumer,
 			},
-			IBCKeeper: app.IBCKeeper,
+			IBCKeeper:  app.IBCKeeper,
+			IscnKeeper: app.IscnKeeper,
 		},
 	)
 	if err != nil {
@@ -568,6 +574,11 @@ func NewLikeApp(
 // Upgrade Handler
 func (app *LikeApp) registerUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(UpgradeName, func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		// the existing likenft module account needs the burner permission for burning the routed per-byte fees
+		if acc, ok := app.AccountKeeper.GetModuleAccount(ctx, likenfttypes.ModuleName).(*authtypes.ModuleAccount); ok {
+			acc.Permissions = maccPerms[likenfttypes.ModuleName]
+			app.AccountKeeper.SetModuleAccount(ctx, acc)
+		}
 		return app.mm.RunMigrations(ctx, app.configurator, fromVM)
 	})
 }
@@ -628,6 +639,9 @@ func (app *LikeApp) GetIBCKeeper() *ibckeeper.Keeper {
 func (app *LikeApp) GetScopedIBCKeeper() capabilitykeeper.ScopedKeeper {
 	return app.ScopedIBCKeeper
 }
+func (app *LikeApp) GetKey(storeKey string) *storetypes.KVStoreKey {
+	return app.keys[storeKey]
+}
 func (app *LikeApp) GetScopedTransferKeeper() capabilitykeeper.ScopedKeeper {
 	return app.ScopedTransferKeeper
 }
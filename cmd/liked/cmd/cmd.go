@@ -211,6 +211,7 @@ func NewRootCmd() (*cobra.Command, app.EncodingConfig) {
 		simappcli.AddGenesisAccountCmd(app.DefaultNodeHome),
 		tmcli.NewCompletionCmd(rootCmd, true),
 		debugCmd,
+		IscnCommand(),
 	)
 
 	server.AddCommands(rootCmd, app.DefaultNodeHome, newApp, exportAppState, addStartFlags)
@@ -0,0 +1,199 @@
+package cmd
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	"github.com/likecoin/likecoin-chain/v4/app"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/car"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/proof"
+)
+
+const (
+	flagHeight                = "height"
+	flagCarVersion            = "car-version"
+	flagTrustedValidatorsHash = "trusted-validators-hash"
+)
+
+func IscnCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "iscn",
+		Short: "ISCN registry tools working on local data",
+	}
+	cmd.AddCommand(
+		ExportCarCommand(),
+		ImportCarCommand(),
+		VerifyCarCommand(),
+		VerifyProofCommand(),
+	)
+	return cmd
+}
+
+func ExportCarCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-car [output_file]",
+		Short: "Export all ISCN records in local database into a CAR file",
+		Long: `Export all ISCN records in local database into a CAR file, which can be imported into IPFS (e.g. by "ipfs dag import").
+
+Each record is stored as a DAG-JSON block with the same CID as the record IPLD on chain.
+The root of the CAR file is an index block containing the block height and links to index pages, which map ISCN IDs to the CIDs of the records.
+
+The node must not be running while exporting.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			carVersion, err := cmd.Flags().GetInt(flagCarVersion)
+			if err != nil {
+				return err
+			}
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			homeDir := serverCtx.Config.RootDir
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(homeDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			likeApp := app.NewLikeApp(
+				serverCtx.Logger, db, nil, height == 0, map[int64]bool{}, homeDir, uint(1), app.MakeEncodingConfig(), serverCtx.Viper,
+			)
+			if height != 0 {
+				err = likeApp.LoadHeight(height)
+				if err != nil {
+					return err
+				}
+			}
+			ctx := likeApp.NewContext(true, tmproto.Header{Height: likeApp.LastBlockHeight()})
+
+			f, err := os.Create(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			root, err := car.ExportRegistry(ctx, likeApp.IscnKeeper, f, carVersion)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("exported ISCN records at height %d, root: %s\n", ctx.BlockHeight(), root.String())
+			return nil
+		},
+	}
+	cmd.Flags().Int64(flagHeight, 0, "block height to export, 0 means the latest height")
+	cmd.Flags().Int(flagCarVersion, 1, "CAR format version, 1 or 2")
+	return cmd
+}
+
+func ImportCarCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "import-car [car_file] [output_dir]",
+		Short: "Import a CAR file exported by export-car into a local block store",
+		Long: `Import a CAR file exported by export-car into a local block store in the output directory, keyed by CID.
+
+The file is verified in the same way as verify-car while importing. The root of the registry is recorded only if the whole file is verified.
+The block store uses the same database backend as the application DB of the node.`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			db, err := dbm.NewDB("iscn-blocks", server.GetAppDBBackend(serverCtx.Viper), args[1])
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+			result, err := car.ImportRegistry(f, car.NewBlockStore(db))
+			if err != nil {
+				return err
+			}
+			fmt.Printf("imported %d blocks with %d ISCN records at height %d, root: %s\n",
+				result.BlockCount, result.RecordCount, result.Height, result.Root.String())
+			return nil
+		},
+	}
+	return cmd
+}
+
+func VerifyCarCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-car [car_file]",
+		Short: "Verify a CAR file exported by export-car",
+		Long: `Verify a CAR file exported by export-car before importing it elsewhere.
+
+The CID of every block is recomputed from its data, and the index is checked against the records in the file.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			result, err := car.VerifyRegistry(f)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("verified %d blocks with %d ISCN records at height %d, root: %s\n",
+				result.BlockCount, result.RecordCount, result.Height, result.Root.String())
+			return nil
+		},
+	}
+	return cmd
+}
+
+func VerifyProofCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "verify-proof [proof_file] --trusted-validators-hash [hash_hex]",
+		Short: "Verify an ISCN record proof built by \"query iscn prove\" offline",
+		Long: `Verify an ISCN record proof built by "query iscn prove" without connecting to any node.
+
+The validator set in the proof must have the trusted hash, e.g. the validators hash of a block header obtained from a trusted source.
+The block header must be signed by more than 2/3 of the voting power of the validator set, and the ISCN record entries must be proven against the app hash in the header.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			hashHex, err := cmd.Flags().GetString(flagTrustedValidatorsHash)
+			if err != nil {
+				return err
+			}
+			trustedValidatorsHash, err := hex.DecodeString(hashHex)
+			if err != nil {
+				return fmt.Errorf("invalid trusted validators hash: %w", err)
+			}
+			if len(trustedValidatorsHash) == 0 {
+				return fmt.Errorf("empty trusted validators hash")
+			}
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			recordProof, err := proof.ReadRecordProof(f)
+			if err != nil {
+				return err
+			}
+			result, err := recordProof.Verify(trustedValidatorsHash)
+			if err != nil {
+				return err
+			}
+			fmt.Printf("verified ISCN record %s (sequence %d, CID %s) on chain %s at height %d, block time %s\n",
+				result.IscnId.String(), result.Sequence, result.Cid.String(), result.ChainId, result.Height, result.BlockTime.UTC().Format(time.RFC3339))
+			return nil
+		},
+	}
+	cmd.Flags().String(flagTrustedValidatorsHash, "", "hex encoded hash of the trusted validator set")
+	_ = cmd.MarkFlagRequired(flagTrustedValidatorsHash)
+	return cmd
+}
@@ -47,6 +47,7 @@ func LikenftKeeper(t testing.TB) (*keeper.Keeper, sdk.Context) {
 		nil,
 		nil,
 		nil,
+		nil,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -63,6 +64,7 @@ type LikenftDependedKeepers struct {
 	IscnKeeper     types.IscnKeeper
 	NftKeeper      types.NftKeeper
 	FeegrantKeeper ante.FeegrantKeeper
+	DistrKeeper    types.DistributionKeeper
 }
 
 func LikenftKeeperOverrideDependedKeepers(t testing.TB, dependedKeepers LikenftDependedKeepers) (*keeper.Keeper, sdk.Context) {
@@ -94,6 +96,7 @@ func LikenftKeeperOverrideDependedKeepers(t testing.TB, dependedKeepers LikenftD
 		dependedKeepers.IscnKeeper,
 		dependedKeepers.NftKeeper,
 		dependedKeepers.FeegrantKeeper,
+		dependedKeepers.DistrKeeper,
 	)
 
 	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
@@ -0,0 +1,25 @@
+package iscn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+var _ sdk.AnteDecorator = RegistryNameContextDecorator{}
+
+// RegistryNameContextDecorator sets the registry name getter into the context for the ISCN authorizations, which
+// generate the ISCN IDs of new records without access to the keeper
+type RegistryNameContextDecorator struct {
+	keeper keeper.Keeper
+}
+
+func NewRegistryNameContextDecorator(keeper keeper.Keeper) RegistryNameContextDecorator {
+	return RegistryNameContextDecorator{keeper: keeper}
+}
+
+func (d RegistryNameContextDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	newCtx := types.WithRegistryNameGetter(ctx, d.keeper.RegistryName)
+	return next(newCtx, tx, simulate)
+}
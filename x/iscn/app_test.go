@@ -1,22 +1,32 @@
 package iscn_test
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math/rand"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	crisistypes "github.com/cosmos/cosmos-sdk/x/crisis/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 
+	"github.com/likecoin/likecoin-chain/v4/x/iscn"
 	"github.com/likecoin/likecoin-chain/v4/x/iscn/keeper"
 	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
 
@@ -335,6 +345,92 @@ func TestMultipleCreateInOneTx(t *testing.T) {
 	app.DeliverMsgsNoError(t, msgs, priv1)
 }
 
+func TestBatchCreate(t *testing.T) {
+	var msg sdk.Msg
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}})
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	records := []types.IscnRecord{}
+	for i := 0; i < 3; i++ {
+		records = append(records, types.IscnRecord{
+			RecordNotes:         fmt.Sprintf("batch record %d", i),
+			ContentFingerprints: []string{fingerprint1},
+			Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+			ContentMetadata:     contentMetadata1,
+		})
+	}
+	nonces := []uint64{0, 1, 2}
+
+	// duplicated records within the same batch should fail
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{records[0], records[0]}, []uint64{0, 0})
+	_, err, simErr, _ := app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnVersion))
+
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, nil, nil)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnRecord))
+
+	balanceBefore := app.BankKeeper.GetBalance(app.Context, addr1, "nanolike")
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, records, nonces)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	balanceAfter := app.BankKeeper.GetBalance(app.Context, addr1, "nanolike")
+
+	iscnIds := []types.IscnId{}
+	for _, e := range result.GetEvents() {
+		if e.Type != "iscn_record" {
+			continue
+		}
+		for _, attr := range e.Attributes {
+			if string(attr.Key) == "iscn_id" {
+				iscnId, err := types.ParseIscnId(string(attr.Value))
+				require.NoError(t, err)
+				iscnIds = append(iscnIds, iscnId)
+			}
+		}
+	}
+	require.Len(t, iscnIds, len(records))
+
+	ctx := app.SetForQuery()
+	params := app.IscnKeeper.GetParams(ctx)
+	totalBytes := 0
+	for i, iscnId := range iscnIds {
+		// ISCN ID should be the same as creating the record individually
+		seed := types.NewMsgCreateIscnRecord(addr1, &records[i], nonces[i]).GetSignBytes()
+		require.Equal(t, types.GenerateNewIscnIdWithSeed(params.RegistryName, seed), iscnId)
+		res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId, 0, 0))
+		require.NoError(t, err)
+		require.Equal(t, addr1.String(), res.Owner)
+		require.Len(t, res.Records, 1)
+		notes, ok := res.Records[0].Data.GetPath("recordNotes")
+		require.True(t, ok)
+		require.Equal(t, records[i].RecordNotes, notes)
+		totalBytes += len(res.Records[0].Data)
+	}
+	expectedFee := params.FeePerByte.Amount.MulInt64(int64(totalBytes)).Ceil().RoundInt()
+	require.Equal(t, expectedFee, balanceBefore.Amount.Sub(balanceAfter.Amount))
+
+	// batch with an existing record should fail as a whole
+	app.SetForTx()
+	newRecord := records[0]
+	newRecord.RecordNotes = "new batch record"
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{newRecord, records[1]}, []uint64{0, 1})
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnVersion))
+	ctx = app.SetForQuery()
+	seed := types.NewMsgCreateIscnRecord(addr1, &newRecord, 0).GetSignBytes()
+	newIscnId := types.GenerateNewIscnIdWithSeed(params.RegistryName, seed)
+	_, err = app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(newIscnId, 0, 0))
+	require.True(t, errors.Is(err, types.ErrRecordNotFound))
+
+	app.SetForTx()
+	msg = crisistypes.NewMsgVerifyInvariant(addr1, "iscn", "iscn-records")
+	app.DeliverMsgNoError(t, msg, priv1)
+}
+
 func TestOwnerQueryPagination(t *testing.T) {
 	var msg sdk.Msg
 	app := testutil.SetupTestApp([]testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}})
@@ -467,6 +563,328 @@ func TestFingerprintQueryPagination(t *testing.T) {
 	}
 }
 
+func TestStandardPagination(t *testing.T) {
+	var msg sdk.Msg
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}})
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	// 5 ISCN IDs with record notes 0 to 4, then the first one updated with record notes 5
+	stakeholderId1 := "did:cosmos:5sy29r37gfxvxz21rh4r0ktpuc46pzjrmz29g45"
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	var firstIscnId types.IscnId
+	for i := 0; i < 5; i++ {
+		record.RecordNotes = fmt.Sprintf("record %d", i)
+		msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+		result := app.DeliverMsgNoError(t, msg, priv1)
+		if i == 0 {
+			iscnIdStrBytes := testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("iscn_id"))
+			require.NotNil(t, iscnIdStrBytes)
+			iscnId, err := types.ParseIscnId(string(iscnIdStrBytes))
+			require.NoError(t, err)
+			firstIscnId = iscnId
+		}
+	}
+	record.RecordNotes = "record 5"
+	msg = types.NewMsgUpdateIscnRecord(addr1, firstIscnId, &record)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	ctx := app.SetForQuery()
+
+	requireNotes := func(records []types.QueryResponseRecord, expected ...int) {
+		require.Len(t, records, len(expected))
+		for i, queryRecord := range records {
+			notes, ok := queryRecord.Data.GetPath("recordNotes")
+			require.True(t, ok)
+			require.Equal(t, fmt.Sprintf("record %d", expected[i]), notes)
+		}
+	}
+
+	// fingerprint
+	fpQuery := types.NewQueryRecordsByFingerprintRequest(fingerprint1, 0)
+	fpQuery.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+	fpQueryRes, err := app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+	require.NoError(t, err)
+	requireNotes(fpQueryRes.Records, 0, 1)
+	require.Equal(t, uint64(6), fpQueryRes.Pagination.Total)
+	require.NotNil(t, fpQueryRes.Pagination.NextKey)
+	require.Zero(t, fpQueryRes.NextSequence)
+
+	fpQuery.Pagination = &query.PageRequest{Key: fpQueryRes.Pagination.NextKey, Limit: 2}
+	fpQueryRes, err = app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+	require.NoError(t, err)
+	requireNotes(fpQueryRes.Records, 2, 3)
+
+	fpQuery.Pagination = &query.PageRequest{Limit: 2, Reverse: true}
+	fpQueryRes, err = app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+	require.NoError(t, err)
+	requireNotes(fpQueryRes.Records, 5, 4)
+
+	fpQuery.Pagination = &query.PageRequest{Offset: 4, Limit: 10}
+	fpQueryRes, err = app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+	require.NoError(t, err)
+	requireNotes(fpQueryRes.Records, 4, 5)
+	require.Nil(t, fpQueryRes.Pagination.NextKey)
+
+	// legacy cursor still works
+	fpQuery = types.NewQueryRecordsByFingerprintRequest(fingerprint1, 0)
+	fpQueryRes, err = app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+	require.NoError(t, err)
+	requireNotes(fpQueryRes.Records, 0, 1, 2, 3, 4, 5)
+	require.Nil(t, fpQueryRes.Pagination)
+
+	// stakeholder
+	stakeholderQuery := types.NewQueryRecordsByStakeholderRequest(stakeholderId1, 0)
+	stakeholderQuery.Pagination = &query.PageRequest{Limit: 3, Reverse: true, CountTotal: true}
+	stakeholderQueryRes, err := app.IscnKeeper.RecordsByStakeholder(sdk.WrapSDKContext(ctx), stakeholderQuery)
+	require.NoError(t, err)
+	requireNotes(stakeholderQueryRes.Records, 5, 4, 3)
+	require.Equal(t, uint64(6), stakeholderQueryRes.Pagination.Total)
+
+	// owner, each item is an ISCN ID with all its versions
+	ownerQuery := types.NewQueryRecordsByOwnerRequest(addr1, 0)
+	ownerQuery.Pagination = &query.PageRequest{Limit: 2, CountTotal: true}
+	ownerQueryRes, err := app.IscnKeeper.RecordsByOwner(sdk.WrapSDKContext(ctx), ownerQuery)
+	require.NoError(t, err)
+	requireNotes(ownerQueryRes.Records, 0, 5, 1)
+	require.Equal(t, uint64(5), ownerQueryRes.Pagination.Total)
+	require.NotNil(t, ownerQueryRes.Pagination.NextKey)
+
+	ownerQuery.Pagination = &query.PageRequest{Limit: 1, Reverse: true}
+	ownerQueryRes, err = app.IscnKeeper.RecordsByOwner(sdk.WrapSDKContext(ctx), ownerQuery)
+	require.NoError(t, err)
+	requireNotes(ownerQueryRes.Records, 4)
+
+	// time range
+	timeQuery := types.NewQueryRecordsByTimeRangeRequest(1234567890, 1234567890, 0, 0, "", 0)
+	timeQuery.Pagination = &query.PageRequest{Limit: 4, Reverse: true, CountTotal: true}
+	timeQueryRes, err := app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), timeQuery)
+	require.NoError(t, err)
+	requireNotes(timeQueryRes.Records, 5, 4, 3, 2)
+	require.Equal(t, uint64(6), timeQueryRes.Pagination.Total)
+
+	timeQuery.Pagination = &query.PageRequest{Key: timeQueryRes.Pagination.NextKey, Limit: 4, Reverse: true}
+	timeQueryRes, err = app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), timeQuery)
+	require.NoError(t, err)
+	requireNotes(timeQueryRes.Records, 1, 0)
+	require.Nil(t, timeQueryRes.Pagination.NextKey)
+
+	timeQuery = types.NewQueryRecordsByTimeRangeRequest(0, 0, 0, 0, "other-registry", 0)
+	timeQuery.Pagination = &query.PageRequest{CountTotal: true}
+	timeQueryRes, err = app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), timeQuery)
+	require.NoError(t, err)
+	require.Len(t, timeQueryRes.Records, 0)
+	require.Zero(t, timeQueryRes.Pagination.Total)
+
+	timeQuery = types.NewQueryRecordsByTimeRangeRequest(1234567891, 0, 0, 0, "", 0)
+	timeQuery.Pagination = &query.PageRequest{}
+	timeQueryRes, err = app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), timeQuery)
+	require.NoError(t, err)
+	require.Len(t, timeQueryRes.Records, 0)
+	require.NotNil(t, timeQueryRes.Pagination)
+}
+
+func TestStakeholderQuery(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	stakeholderId1 := "did:cosmos:5sy29r37gfxvxz21rh4r0ktpuc46pzjrmz29g45"
+	stakeholderId2 := "did:cosmos:1ww3qews2y5jxe8apw2zt8stqqrcu2tptejfwaf"
+	stakeholder3 := types.IscnInput(fmt.Sprintf(`{"entity":{"@id":"%s","name":"Another Author"},"contributionType":"http://schema.org/author"}`, stakeholderId2))
+
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	for i := 0; i < 2*keeper.StakeholderRecordsPageLimit-1; i++ {
+		record.RecordNotes = fmt.Sprintf("record %010d", i)
+		msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+		app.DeliverMsgNoError(t, msg, priv1)
+	}
+	record = types.IscnRecord{
+		RecordNotes:         "record with both stakeholders",
+		ContentFingerprints: []string{fingerprint2},
+		Stakeholders:        []types.IscnInput{stakeholder3, stakeholder1, stakeholder3},
+		ContentMetadata:     contentMetadata2,
+	}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	verifyState := func(ctx sdk.Context) {
+		invariantMsg, broken := keeper.IscnStakeholdersInvariant(app.IscnKeeper)(ctx)
+		require.False(t, broken, invariantMsg)
+
+		query := types.NewQueryRecordsByStakeholderRequest(stakeholderId1, 0)
+		res, err := app.IscnKeeper.RecordsByStakeholder(sdk.WrapSDKContext(ctx), query)
+		require.NoError(t, err)
+		require.Len(t, res.Records, keeper.StakeholderRecordsPageLimit)
+		require.NotZero(t, res.NextSequence)
+		for i, queryRecord := range res.Records {
+			notes, ok := queryRecord.Data.GetPath("recordNotes")
+			require.True(t, ok)
+			require.Equal(t, fmt.Sprintf("record %010d", i), notes)
+		}
+
+		query = types.NewQueryRecordsByStakeholderRequest(stakeholderId1, res.NextSequence)
+		res, err = app.IscnKeeper.RecordsByStakeholder(sdk.WrapSDKContext(ctx), query)
+		require.NoError(t, err)
+		require.Len(t, res.Records, keeper.StakeholderRecordsPageLimit)
+		require.Zero(t, res.NextSequence)
+		notes, ok := res.Records[keeper.StakeholderRecordsPageLimit-1].Data.GetPath("recordNotes")
+		require.True(t, ok)
+		require.Equal(t, "record with both stakeholders", notes)
+
+		query = types.NewQueryRecordsByStakeholderRequest(stakeholderId2, 0)
+		res, err = app.IscnKeeper.RecordsByStakeholder(sdk.WrapSDKContext(ctx), query)
+		require.NoError(t, err)
+		require.Len(t, res.Records, 1)
+		require.Zero(t, res.NextSequence)
+
+		query = types.NewQueryRecordsByStakeholderRequest("did:cosmos:nobody", 0)
+		res, err = app.IscnKeeper.RecordsByStakeholder(sdk.WrapSDKContext(ctx), query)
+		require.NoError(t, err)
+		require.Len(t, res.Records, 0)
+
+		query = types.NewQueryRecordsByStakeholderRequest("", 0)
+		_, err = app.IscnKeeper.RecordsByStakeholder(sdk.WrapSDKContext(ctx), query)
+		require.Error(t, err)
+	}
+
+	ctx := app.SetForQuery()
+	verifyState(ctx)
+
+	// rebuilding the index should not change anything
+	app.IscnKeeper.RebuildStakeholderIndex(ctx)
+	verifyState(ctx)
+
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	ctx = app.SetForQuery()
+	verifyState(ctx)
+}
+
+func TestTimeRangeQuery(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	recordCount := 0
+	heights := []uint64{}
+	createRecords := func(n int) {
+		for i := 0; i < n; i++ {
+			record.RecordNotes = fmt.Sprintf("record %010d", recordCount)
+			msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+			app.DeliverMsgNoError(t, msg, priv1)
+			heights = append(heights, uint64(app.LastBlockHeight()))
+			recordCount++
+		}
+	}
+
+	app.NextHeader(1000000000)
+	app.SetForTx()
+	createRecords(2)
+	app.NextHeader(1000000100)
+	createRecords(3)
+	app.NextHeader(1000000200)
+	createRecords(1)
+	app.NextHeader(1000000300)
+	createRecords(keeper.TimeRangeRecordsPageLimit + 10)
+
+	requireRecords := func(res *types.QueryRecordsByTimeRangeResponse, from int, count int) {
+		require.Len(t, res.Records, count)
+		for i, queryRecord := range res.Records {
+			notes, ok := queryRecord.Data.GetPath("recordNotes")
+			require.True(t, ok)
+			require.Equal(t, fmt.Sprintf("record %010d", from+i), notes)
+		}
+	}
+
+	verifyState := func(ctx sdk.Context) {
+		query := func(fromTimestamp, toTimestamp int64, fromHeight, toHeight uint64, registryName string, fromSeq uint64) *types.QueryRecordsByTimeRangeResponse {
+			req := types.NewQueryRecordsByTimeRangeRequest(fromTimestamp, toTimestamp, fromHeight, toHeight, registryName, fromSeq)
+			res, err := app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), req)
+			require.NoError(t, err)
+			return res
+		}
+
+		res := query(1000000100, 1000000100, 0, 0, "", 0)
+		requireRecords(res, 2, 3)
+		require.Zero(t, res.NextSequence)
+
+		res = query(1000000000, 1000000250, 0, 0, "likecoin-chain", 0)
+		requireRecords(res, 0, 6)
+		require.Zero(t, res.NextSequence)
+
+		res = query(1000000001, 1000000299, 0, 0, "", 0)
+		requireRecords(res, 2, 4)
+
+		res = query(0, 999999999, 0, 0, "", 0)
+		requireRecords(res, 0, 0)
+
+		res = query(1000000400, 0, 0, 0, "", 0)
+		requireRecords(res, 0, 0)
+
+		res = query(1000000000, 1000000200, 0, 0, "other-registry", 0)
+		requireRecords(res, 0, 0)
+
+		res = query(1000000300, 0, 0, 0, "", 0)
+		requireRecords(res, 6, keeper.TimeRangeRecordsPageLimit)
+		require.NotZero(t, res.NextSequence)
+		res = query(1000000300, 0, 0, 0, "", res.NextSequence)
+		requireRecords(res, 6+keeper.TimeRangeRecordsPageLimit, 10)
+		require.Zero(t, res.NextSequence)
+
+		res = query(0, 0, heights[1], heights[3], "", 0)
+		requireRecords(res, 1, 3)
+
+		res = query(0, 0, 0, heights[0], "", 0)
+		requireRecords(res, 0, 1)
+
+		res = query(0, 0, heights[len(heights)-1], 0, "", 0)
+		requireRecords(res, len(heights)-1, 1)
+
+		res = query(0, 0, heights[len(heights)-1]+1, 0, "", 0)
+		requireRecords(res, 0, 0)
+
+		req := types.NewQueryRecordsByTimeRangeRequest(1000000000, 0, heights[0], 0, "", 0)
+		_, err := app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), req)
+		require.Error(t, err)
+
+		req = types.NewQueryRecordsByTimeRangeRequest(1000000100, 1000000000, 0, 0, "", 0)
+		_, err = app.IscnKeeper.RecordsByTimeRange(sdk.WrapSDKContext(ctx), req)
+		require.Error(t, err)
+	}
+
+	ctx := app.SetForQuery()
+	verifyState(ctx)
+
+	// rebuilding the index should not change anything
+	app.IscnKeeper.RebuildTimeIndex(ctx)
+	verifyState(ctx)
+
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	ctx = app.SetForQuery()
+	verifyState(ctx)
+}
+
 func TestFailureCases(t *testing.T) {
 	var msg sdk.Msg
 	var record types.IscnRecord
@@ -928,3 +1346,1476 @@ func TestUpdateAuthorization(t *testing.T) {
 	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
 	require.ErrorContains(t, simErr, "ISCN ID prefix mismatch")
 }
+
+func TestCreateAndChangeOwnershipAuthorization(t *testing.T) {
+	var msg sdk.Msg
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+		{addr3.String(), "1000000000000000000nanolike"},
+	})
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	expiration := time.Unix(2000000000, 0)
+	msg, err := authz.NewMsgGrant(addr1, addr2, types.NewCreateAuthorization(0, 1), &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	record := types.IscnRecord{
+		RecordNotes:         "some notes",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	createMsg := types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	msgExec := authz.NewMsgExec(addr2, []sdk.Msg{createMsg})
+	msg = &msgExec
+	result := app.DeliverMsgNoError(t, msg, priv2)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+	owner := testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("owner"))
+	require.Equal(t, addr1.String(), string(owner))
+
+	// grant is used up after creating the only allowed record
+	record.RecordNotes = "another record"
+	createMsg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{createMsg})
+	msg = &msgExec
+	_, _, simErr, _ := app.DeliverMsg(msg, priv2)
+	require.ErrorContains(t, simErr, "authorization not found")
+
+	msg, err = authz.NewMsgGrant(addr1, addr2, types.NewCreateAuthorization(1, 0), &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{createMsg})
+	msg = &msgExec
+	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
+	require.ErrorContains(t, simErr, "exceeds remaining byte quota")
+
+	// byte quota is charged by the length of the stored record, with the ISCN ID in the registry of the chain
+	ctx := app.SetForQuery()
+	params := app.IscnKeeper.GetParams(ctx)
+	params.RegistryName = "another-registry-with-a-longer-name"
+	require.NotEqual(t, len(types.DefaultRegistryName), len(params.RegistryName))
+	app.IscnKeeper.SetParams(ctx, params)
+	app.SetForTx()
+	byteQuota := uint64(1000000)
+	msg, err = authz.NewMsgGrant(addr1, addr2, types.NewCreateAuthorization(byteQuota, 0), &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{createMsg})
+	msg = &msgExec
+	result = app.DeliverMsgNoError(t, msg, priv2)
+	quotaIscnId := testutil.GetIscnIdFromResult(t, result)
+	require.Equal(t, params.RegistryName, quotaIscnId.Prefix.RegistryName)
+	ctx = app.SetForQuery()
+	recordsRes, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(quotaIscnId, 0, 0))
+	require.NoError(t, err)
+	grant, _ := app.AuthzKeeper.GetAuthorization(ctx, addr2, addr1, sdk.MsgTypeURL(createMsg))
+	require.Equal(t, types.NewCreateAuthorization(byteQuota-uint64(len(recordsRes.Records[0].Data)), 0), grant)
+	app.SetForTx()
+
+	// batch create is granted separately, with quota charged per record
+	msg, err = authz.NewMsgGrant(addr1, addr2, types.NewBatchCreateAuthorization(0, 3), &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+	record.RecordNotes = "batch record 1"
+	record2 := record
+	record2.RecordNotes = "batch record 2"
+	batchMsg := types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{record, record2}, nil)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{batchMsg})
+	msg = &msgExec
+	app.DeliverMsgNoError(t, msg, priv2)
+	ctx = app.SetForQuery()
+	grant, _ = app.AuthzKeeper.GetAuthorization(ctx, addr2, addr1, sdk.MsgTypeURL(batchMsg))
+	require.Equal(t, types.NewBatchCreateAuthorization(0, 1), grant)
+	app.SetForTx()
+	record.RecordNotes = "batch record 3"
+	record2.RecordNotes = "batch record 4"
+	batchMsg = types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{record, record2}, nil)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{batchMsg})
+	msg = &msgExec
+	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
+	require.ErrorContains(t, simErr, "exceed remaining record quota")
+
+	// two-step transfers are granted separately with the same recipient limit
+	msg, err = authz.NewMsgGrant(addr1, addr2, types.NewProposeOwnershipTransferAuthorization([]sdk.AccAddress{addr2}), &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+	transferExpiration := time.Unix(1234567890, 0).Add(time.Hour)
+	proposeMsg := types.NewMsgProposeIscnOwnershipTransfer(addr1, quotaIscnId, addr3, transferExpiration)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{proposeMsg})
+	msg = &msgExec
+	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
+	require.ErrorContains(t, simErr, "is not an allowed recipient")
+	proposeMsg = types.NewMsgProposeIscnOwnershipTransfer(addr1, quotaIscnId, addr2, transferExpiration)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{proposeMsg})
+	msg = &msgExec
+	app.DeliverMsgNoError(t, msg, priv2)
+
+	msg, err = authz.NewMsgGrant(addr1, addr2, types.NewChangeOwnershipAuthorization([]sdk.AccAddress{addr2}), &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	changeMsg := types.NewMsgChangeIscnRecordOwnership(addr1, iscnId, addr3)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{changeMsg})
+	msg = &msgExec
+	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
+	require.ErrorContains(t, simErr, "is not an allowed recipient")
+
+	changeMsg = types.NewMsgChangeIscnRecordOwnership(addr1, iscnId, addr2)
+	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{changeMsg})
+	msg = &msgExec
+	app.DeliverMsgNoError(t, msg, priv2)
+
+	ctx = app.SetForQuery()
+	res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId, 0, 0))
+	require.NoError(t, err)
+	require.Equal(t, addr2.String(), res.Owner)
+}
+
+func TestRecordStatus(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	record := types.IscnRecord{
+		RecordNotes:         "some notes",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	createIscn := func(nonce uint64) types.IscnId {
+		msg := types.NewMsgCreateIscnRecord(addr1, &record, nonce)
+		result := app.DeliverMsgNoError(t, msg, priv1)
+		iscnId, err := types.ParseIscnId(string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("iscn_id"))))
+		require.NoError(t, err)
+		return iscnId
+	}
+	iscnId1 := createIscn(1)
+	iscnId2 := createIscn(2)
+	iscnId3 := createIscn(3)
+	iscnId4 := createIscn(4)
+
+	queryStatus := func(iscnId types.IscnId) *types.QueryRecordsByIdResponse {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId.PrefixId(), 0, 0))
+		require.NoError(t, err)
+		app.SetForTx()
+		return res
+	}
+
+	res := queryStatus(iscnId1)
+	require.Equal(t, types.ISCN_RECORD_STATUS_ACTIVE, res.Status)
+	require.Equal(t, "", res.SupersededBy)
+
+	// only owner can change status
+	msg = types.NewMsgFreezeIscnRecord(addr2, iscnId1)
+	_, err, simErr, _ := app.DeliverMsg(msg, priv2)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, sdkerrors.ErrUnauthorized))
+
+	// freeze
+	msg = types.NewMsgFreezeIscnRecord(addr1, iscnId1)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	status := testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("status"))
+	require.Equal(t, types.ISCN_RECORD_STATUS_FROZEN.String(), string(status))
+	res = queryStatus(iscnId1)
+	require.Equal(t, types.ISCN_RECORD_STATUS_FROZEN, res.Status)
+
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId1, &record)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+
+	msg = types.NewMsgFreezeIscnRecord(addr1, iscnId1)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnStatus))
+
+	// ownership cannot be changed for frozen records
+	msg = types.NewMsgChangeIscnRecordOwnership(addr1, iscnId1, addr2)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId1, addr2, time.Unix(1234567890, 0).Add(time.Hour))
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+	res = queryStatus(iscnId1)
+	require.Equal(t, addr1.String(), res.Owner)
+	require.Equal(t, types.ISCN_RECORD_STATUS_FROZEN, res.Status)
+
+	// deprecate frozen record
+	msg = types.NewMsgDeprecateIscnRecord(addr1, iscnId1)
+	app.DeliverMsgNoError(t, msg, priv1)
+	res = queryStatus(iscnId1)
+	require.Equal(t, types.ISCN_RECORD_STATUS_DEPRECATED, res.Status)
+
+	msg = types.NewMsgDeprecateIscnRecord(addr1, iscnId1)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnStatus))
+
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId1, &record)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+
+	// ownership cannot be changed for deprecated records either
+	msg = types.NewMsgChangeIscnRecordOwnership(addr1, iscnId1, addr2)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+
+	// deprecate active record
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId2, addr2, time.Unix(1234567890, 0).Add(time.Hour))
+	app.DeliverMsgNoError(t, msg, priv1)
+	msg = types.NewMsgDeprecateIscnRecord(addr1, iscnId2)
+	app.DeliverMsgNoError(t, msg, priv1)
+	res = queryStatus(iscnId2)
+	require.Equal(t, types.ISCN_RECORD_STATUS_DEPRECATED, res.Status)
+
+	// transfer proposed before the record is locked cannot be accepted
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr2, iscnId2)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv2)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+
+	// supersede
+	msg = types.NewMsgSupersedeIscnRecord(addr1, iscnId3, iscnId3.Prefix)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnId))
+
+	notExistingIscnId := types.NewIscnId(iscnId3.Prefix.RegistryName, "not-exist", 1)
+	msg = types.NewMsgSupersedeIscnRecord(addr1, iscnId3, notExistingIscnId.Prefix)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotFound))
+
+	// the version in the superseding ISCN ID is dropped
+	msg = &types.MsgSupersedeIscnRecord{
+		From:         addr1.String(),
+		IscnId:       iscnId3.String(),
+		SupersededBy: iscnId4.String(),
+	}
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	supersededBy := testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("superseded_by"))
+	require.Equal(t, iscnId4.Prefix.String(), string(supersededBy))
+	res = queryStatus(iscnId3)
+	require.Equal(t, types.ISCN_RECORD_STATUS_SUPERSEDED, res.Status)
+	require.Equal(t, iscnId4.Prefix.String(), res.SupersededBy)
+
+	msg = types.NewMsgSupersedeIscnRecord(addr1, iscnId3, iscnId1.Prefix)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidIscnStatus))
+
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId3, &record)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrRecordNotActive))
+
+	// active record can still be updated
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId4, &record)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	msg = crisistypes.NewMsgVerifyInvariant(addr1, "iscn", "iscn-records")
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	// status should survive genesis export and import
+	ctx := app.SetForQuery()
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	app.SetForTx()
+	res = queryStatus(iscnId1)
+	require.Equal(t, types.ISCN_RECORD_STATUS_DEPRECATED, res.Status)
+	require.Equal(t, addr1.String(), res.Owner)
+	res = queryStatus(iscnId2)
+	require.Equal(t, types.ISCN_RECORD_STATUS_DEPRECATED, res.Status)
+	res = queryStatus(iscnId3)
+	require.Equal(t, types.ISCN_RECORD_STATUS_SUPERSEDED, res.Status)
+	require.Equal(t, iscnId4.Prefix.String(), res.SupersededBy)
+	res = queryStatus(iscnId4)
+	require.Equal(t, types.ISCN_RECORD_STATUS_ACTIVE, res.Status)
+	require.Equal(t, uint64(2), res.LatestVersion)
+}
+
+func TestContentSchema(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	schema := types.ContentSchema{
+		Type:   "CreativeWorks",
+		Schema: `{"type":"object","required":["title"],"properties":{"title":{"type":"string"},"version":{"type":"integer"}}}`,
+	}
+	invalidMetadata := types.IscnInput(`{"@type":"CreativeWorks","version":1}`)
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1},
+		ContentMetadata:     invalidMetadata,
+	}
+
+	// no schema, anything goes
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+
+	ctx := app.SetForQuery()
+	handler := iscn.NewContentSchemaProposalHandler(app.IscnKeeper)
+	err := handler(ctx, types.NewAddContentSchemaProposal("title", "description", schema))
+	require.NoError(t, err)
+	err = handler(ctx, types.NewAddContentSchemaProposal("title", "description", types.ContentSchema{
+		Type:   "Book",
+		Schema: `{"$ref":"https://example.com/schema.json"}`,
+	}))
+	require.Error(t, err, "should not accept schema referencing external resources")
+	err = handler(ctx, types.NewRemoveContentSchemaProposal("title", "description", "Book"))
+	require.True(t, errors.Is(err, types.ErrContentSchemaNotFound))
+	res, err := app.IscnKeeper.ContentSchemas(sdk.WrapSDKContext(ctx), types.NewQueryContentSchemasRequest(""))
+	require.NoError(t, err)
+	require.Equal(t, []types.ContentSchema{schema}, res.ContentSchemas)
+	app.SetForTx()
+
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 1)
+	_, err, simErr, _ := app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidContentMetadata))
+
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidContentMetadata))
+
+	validRecord := record
+	validRecord.ContentMetadata = contentMetadata1
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{validRecord, record}, []uint64{0, 0})
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidContentMetadata))
+
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &validRecord)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	// metadata of other types is not affected
+	otherRecord := record
+	otherRecord.ContentMetadata = types.IscnInput(`{"@type":"Photograph","version":"1"}`)
+	msg = types.NewMsgCreateIscnRecord(addr1, &otherRecord, 0)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	// schemas should survive genesis export and import
+	ctx = app.SetForQuery()
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	app.SetForTx()
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 2)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidContentMetadata))
+
+	ctx = app.SetForQuery()
+	handler = iscn.NewContentSchemaProposalHandler(app.IscnKeeper)
+	err = handler(ctx, types.NewRemoveContentSchemaProposal("title", "description", schema.Type))
+	require.NoError(t, err)
+	require.Empty(t, app.IscnKeeper.GetContentSchemas(ctx))
+	app.SetForTx()
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 2)
+	app.DeliverMsgNoError(t, msg, priv1)
+}
+
+func TestRecordDiff(t *testing.T) {
+	genesisBalances := []testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	record := types.IscnRecord{
+		RecordNotes:         "some notes",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1},
+		ContentMetadata:     contentMetadata1,
+	}
+	msg := types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+
+	record.ContentFingerprints = []string{fingerprint1, fingerprint2}
+	updateMsg := types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	app.DeliverMsgNoError(t, updateMsg, priv1)
+
+	iscnId.Version = 2
+	record.RecordNotes = "new notes"
+	record.Stakeholders = []types.IscnInput{stakeholder1, stakeholder2}
+	record.ContentMetadata = contentMetadata2
+	updateMsg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	app.DeliverMsgNoError(t, updateMsg, priv1)
+
+	ctx := app.SetForQuery()
+	queryDiff := func(fromVersion, toVersion uint64) (*types.QueryRecordDiffResponse, error) {
+		req := types.NewQueryRecordDiffRequest(iscnId, fromVersion, toVersion)
+		return app.IscnKeeper.RecordDiff(sdk.WrapSDKContext(ctx), req)
+	}
+
+	res, err := queryDiff(1, 2)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.FromVersion)
+	require.Equal(t, uint64(2), res.ToVersion)
+	require.Len(t, res.Operations, 1)
+	require.Equal(t, "add", res.Operations[0].Op)
+	require.Equal(t, "/contentFingerprints/1", res.Operations[0].Path)
+	require.Equal(t, `"`+fingerprint2+`"`, string(res.Operations[0].Value))
+
+	// default to the latest version and the version before it
+	res, err = queryDiff(0, 0)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.FromVersion)
+	require.Equal(t, uint64(3), res.ToVersion)
+	paths := []string{}
+	for _, op := range res.Operations {
+		paths = append(paths, op.Path)
+	}
+	require.Equal(t, []string{
+		"/contentMetadata/author",
+		"/contentMetadata/datePublished",
+		"/contentMetadata/description",
+		"/contentMetadata/keywords",
+		"/contentMetadata/title",
+		"/contentMetadata/url",
+		"/contentMetadata/usageInfo",
+		"/contentMetadata/version",
+		"/recordNotes",
+		"/stakeholders/1",
+	}, paths)
+
+	res, err = queryDiff(3, 1)
+	require.NoError(t, err)
+	require.Len(t, res.Operations, 11)
+
+	res, err = queryDiff(2, 2)
+	require.NoError(t, err)
+	require.Empty(t, res.Operations)
+
+	_, err = queryDiff(1, 4)
+	require.Error(t, err)
+
+	_, err = queryDiff(0, 1)
+	require.Error(t, err)
+
+	req := types.NewQueryRecordDiffRequest(types.NewIscnId("likecoin-chain", "not-exist", 1), 1, 2)
+	_, err = app.IscnKeeper.RecordDiff(sdk.WrapSDKContext(ctx), req)
+	require.True(t, errors.Is(err, types.ErrRecordNotFound))
+}
+
+func TestOwnershipTransfer(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+		{addr3.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	blockTime := int64(1234567890)
+	app.NextHeader(blockTime)
+	app.SetForTx()
+	record := types.IscnRecord{
+		RecordNotes:         "some notes",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	createIscn := func(nonce uint64) types.IscnId {
+		msg := types.NewMsgCreateIscnRecord(addr1, &record, nonce)
+		result := app.DeliverMsgNoError(t, msg, priv1)
+		iscnId, err := types.ParseIscnId(string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("iscn_id"))))
+		require.NoError(t, err)
+		return iscnId
+	}
+	iscnId1 := createIscn(1)
+	iscnId2 := createIscn(2)
+	expiration := time.Unix(blockTime, 0).Add(24 * time.Hour)
+
+	queryOwner := func(iscnId types.IscnId) string {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId.PrefixId(), 0, 0))
+		require.NoError(t, err)
+		app.SetForTx()
+		return res.Owner
+	}
+	queryTransfer := func(iscnId types.IscnId) *types.PendingOwnershipTransfer {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.PendingOwnershipTransfer(sdk.WrapSDKContext(ctx), types.NewQueryPendingOwnershipTransferRequest(iscnId))
+		app.SetForTx()
+		if err != nil {
+			require.True(t, errors.Is(err, types.ErrOwnershipTransferNotFound))
+			return nil
+		}
+		return &res.PendingOwnershipTransfer
+	}
+	queryTransfersByNewOwner := func(newOwner sdk.AccAddress) []types.PendingOwnershipTransfer {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.PendingOwnershipTransfersByNewOwner(sdk.WrapSDKContext(ctx), types.NewQueryPendingOwnershipTransfersByNewOwnerRequest(newOwner.String(), nil))
+		require.NoError(t, err)
+		app.SetForTx()
+		return res.PendingOwnershipTransfers
+	}
+
+	// only owner can propose
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr2, iscnId1, addr3, expiration)
+	_, err, simErr, _ := app.DeliverMsg(msg, priv2)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, sdkerrors.ErrUnauthorized))
+
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId1, addr2, time.Unix(blockTime, 0))
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidOwnershipTransfer))
+
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId1, addr2, time.Unix(blockTime, 0).Add(types.MaxOwnershipTransferDuration+time.Second))
+	_, err, simErr, _ = app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrInvalidOwnershipTransfer))
+
+	// propose, then replace by another proposal
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId1, addr3, expiration)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	action := testutil.GetEventAttribute(result.GetEvents(), "iscn_ownership_transfer", []byte("action"))
+	require.Equal(t, "proposed", string(action))
+	require.Len(t, queryTransfersByNewOwner(addr3), 1)
+
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId1, addr2, expiration)
+	app.DeliverMsgNoError(t, msg, priv1)
+	transfer := queryTransfer(iscnId1)
+	require.NotNil(t, transfer)
+	require.Equal(t, iscnId1.Prefix.String(), transfer.IscnIdPrefix)
+	require.Equal(t, addr1.String(), transfer.Owner)
+	require.Equal(t, addr2.String(), transfer.NewOwner)
+	require.True(t, expiration.Equal(transfer.Expiration))
+	require.Len(t, queryTransfersByNewOwner(addr3), 0)
+	require.Len(t, queryTransfersByNewOwner(addr2), 1)
+	require.Equal(t, addr1.String(), queryOwner(iscnId1))
+
+	// only the new owner can accept
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr3, iscnId1)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv3)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, sdkerrors.ErrUnauthorized))
+
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr2, iscnId1.PrefixId())
+	result = app.DeliverMsgNoError(t, msg, priv2)
+	action = testutil.GetEventAttribute(result.GetEvents(), "iscn_ownership_transfer", []byte("action"))
+	require.Equal(t, "accepted", string(action))
+	require.Equal(t, addr2.String(), queryOwner(iscnId1))
+	require.Nil(t, queryTransfer(iscnId1))
+	require.Len(t, queryTransfersByNewOwner(addr2), 0)
+
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr2, iscnId1)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv2)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrOwnershipTransferNotFound))
+
+	// cancel
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr2, iscnId1, addr3, expiration)
+	app.DeliverMsgNoError(t, msg, priv2)
+	msg = types.NewMsgCancelIscnOwnershipTransfer(addr3, iscnId1)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv3)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, sdkerrors.ErrUnauthorized))
+	msg = types.NewMsgCancelIscnOwnershipTransfer(addr2, iscnId1)
+	app.DeliverMsgNoError(t, msg, priv2)
+	require.Nil(t, queryTransfer(iscnId1))
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr3, iscnId1)
+	_, err, simErr, _ = app.DeliverMsg(msg, priv3)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, types.ErrOwnershipTransferNotFound))
+
+	// one-step ownership change removes the pending transfer proposed by the previous owner
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr2, iscnId1, addr3, expiration)
+	app.DeliverMsgNoError(t, msg, priv2)
+	msg = types.NewMsgChangeIscnRecordOwnership(addr2, iscnId1, addr1)
+	app.DeliverMsgNoError(t, msg, priv2)
+	require.Nil(t, queryTransfer(iscnId1))
+	require.Len(t, queryTransfersByNewOwner(addr3), 0)
+
+	// pending transfer survives genesis export and import
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId1, addr3, expiration)
+	app.DeliverMsgNoError(t, msg, priv1)
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr1, iscnId2, addr3, expiration.Add(time.Hour))
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	ctx := app.SetForQuery()
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	require.Len(t, iscnGenesis.PendingOwnershipTransfers, 2)
+	require.NoError(t, iscnGenesis.Validate())
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	app.NextHeader(blockTime)
+	app.SetForTx()
+	require.Len(t, queryTransfersByNewOwner(addr3), 2)
+
+	// expired transfers cannot be accepted, and are removed in end block
+	app.NextHeader(expiration.Unix())
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr3, iscnId1)
+	// simulation runs on the previous block time, so the error only comes from delivering
+	_, err, simErr, deliverErr := app.DeliverMsg(msg, priv3)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+	require.True(t, errors.Is(deliverErr, types.ErrInvalidOwnershipTransfer))
+	app.SetForQuery()
+	app.SetForTx()
+	require.Nil(t, queryTransfer(iscnId1))
+	require.NotNil(t, queryTransfer(iscnId2))
+	require.Len(t, queryTransfersByNewOwner(addr3), 1)
+
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr3, iscnId2)
+	app.DeliverMsgNoError(t, msg, priv3)
+	require.Equal(t, addr3.String(), queryOwner(iscnId2))
+	require.Equal(t, addr1.String(), queryOwner(iscnId1))
+
+	app.NextHeader(expiration.Add(2 * time.Hour).Unix())
+	app.SetForQuery()
+	app.SetForTx()
+	require.Len(t, queryTransfersByNewOwner(addr3), 0)
+
+	msg = crisistypes.NewMsgVerifyInvariant(addr1, "iscn", "iscn-records")
+	app.DeliverMsgNoError(t, msg, priv1)
+}
+
+func TestGroupOwnedRecord(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+		{addr3.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	// editorial board of 3 members, requiring 2 approvals
+	members := []group.MemberRequest{
+		{Address: addr1.String(), Weight: "1"},
+		{Address: addr2.String(), Weight: "1"},
+		{Address: addr3.String(), Weight: "1"},
+	}
+	msg, err := group.NewMsgCreateGroupWithPolicy(addr1.String(), members, "", "", false, group.NewThresholdDecisionPolicy("2", time.Hour, 0))
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msg, priv1)
+	ctx := app.SetForQuery()
+	policiesRes, err := app.GroupKeeper.GroupPoliciesByGroup(sdk.WrapSDKContext(ctx), &group.QueryGroupPoliciesByGroupRequest{GroupId: 1})
+	require.NoError(t, err)
+	app.SetForTx()
+	require.Len(t, policiesRes.GroupPolicies, 1)
+	policyAddr, err := sdk.AccAddressFromBech32(policiesRes.GroupPolicies[0].Address)
+	require.NoError(t, err)
+
+	// the group policy account pays the ISCN fee
+	msg = banktypes.NewMsgSend(addr1, policyAddr, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 1000000000000)))
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	record := types.IscnRecord{
+		RecordNotes:         "some notes",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	queryRecord := func(iscnId types.IscnId) *types.QueryRecordsByIdResponse {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId.PrefixId(), 0, 0))
+		require.NoError(t, err)
+		app.SetForTx()
+		return res
+	}
+	queryOwnerRecordCount := func(owner sdk.AccAddress) int {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.RecordsByOwner(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByOwnerRequest(owner, 0))
+		require.NoError(t, err)
+		app.SetForTx()
+		return len(res.Records)
+	}
+	proposalId := uint64(0)
+	submitProposal := func(msgs ...sdk.Msg) *sdk.Result {
+		msg, err := group.NewMsgSubmitProposal(policyAddr.String(), []string{addr1.String()}, msgs, "", group.Exec_EXEC_TRY)
+		require.NoError(t, err)
+		result := app.DeliverMsgNoError(t, msg, priv1)
+		proposalId++
+		return result
+	}
+	approve := func() *sdk.Result {
+		msg := &group.MsgVote{
+			ProposalId: proposalId,
+			Voter:      addr2.String(),
+			Option:     group.VOTE_OPTION_YES,
+			Exec:       group.Exec_EXEC_TRY,
+		}
+		return app.DeliverMsgNoError(t, msg, priv2)
+	}
+
+	// record created by a member and transferred to the group
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 1)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId1 := testutil.GetIscnIdFromResult(t, result)
+	require.Nil(t, testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("owner_group_id")))
+	require.Equal(t, uint64(0), queryRecord(iscnId1).OwnerGroupId)
+
+	msg = types.NewMsgChangeIscnRecordOwnership(addr1, iscnId1, policyAddr)
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	require.Equal(t, "1", string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("owner_group_id"))))
+	res := queryRecord(iscnId1)
+	require.Equal(t, policyAddr.String(), res.Owner)
+	require.Equal(t, uint64(1), res.OwnerGroupId)
+	require.Equal(t, 0, queryOwnerRecordCount(addr1))
+	require.Equal(t, 1, queryOwnerRecordCount(policyAddr))
+
+	// members cannot update the record directly
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId1, &record)
+	_, err, simErr, _ := app.DeliverMsg(msg, priv1)
+	require.NoError(t, err)
+	require.True(t, errors.Is(simErr, sdkerrors.ErrUnauthorized))
+
+	// update through group proposal, executed after reaching the threshold
+	record.RecordNotes = "updated by editorial board"
+	submitProposal(types.NewMsgUpdateIscnRecord(policyAddr, iscnId1, &record))
+	require.Equal(t, uint64(1), queryRecord(iscnId1).LatestVersion)
+	result = approve()
+	iscnId1v2 := testutil.GetIscnIdFromResult(t, result)
+	require.Equal(t, uint64(2), iscnId1v2.Version)
+	require.Equal(t, policyAddr.String(), string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("owner"))))
+	require.Equal(t, "1", string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("owner_group_id"))))
+	res = queryRecord(iscnId1)
+	require.Equal(t, uint64(2), res.LatestVersion)
+	require.Equal(t, policyAddr.String(), res.Owner)
+
+	// record created by the group directly
+	record.RecordNotes = "created by editorial board"
+	submitProposal(types.NewMsgCreateIscnRecord(policyAddr, &record, 2))
+	result = approve()
+	iscnId2 := testutil.GetIscnIdFromResult(t, result)
+	res = queryRecord(iscnId2)
+	require.Equal(t, policyAddr.String(), res.Owner)
+	require.Equal(t, uint64(1), res.OwnerGroupId)
+	// 2 versions of the first record and the new record
+	require.Equal(t, 3, queryOwnerRecordCount(policyAddr))
+	require.Equal(t, 0, queryOwnerRecordCount(addr1))
+
+	// ownership change back to a member also needs approval
+	submitProposal(types.NewMsgChangeIscnRecordOwnership(policyAddr, iscnId2, addr3))
+	require.Equal(t, policyAddr.String(), queryRecord(iscnId2).Owner)
+	result = approve()
+	require.Nil(t, testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("owner_group_id")))
+	res = queryRecord(iscnId2)
+	require.Equal(t, addr3.String(), res.Owner)
+	require.Equal(t, uint64(0), res.OwnerGroupId)
+	require.Equal(t, 1, queryOwnerRecordCount(addr3))
+	require.Equal(t, 2, queryOwnerRecordCount(policyAddr))
+
+	msg = crisistypes.NewMsgVerifyInvariant(addr1, "iscn", "iscn-owner-seqeunce")
+	app.DeliverMsgNoError(t, msg, priv1)
+}
+
+func TestFingerprintCanonicalization(t *testing.T) {
+	var msg sdk.Msg
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}})
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	sha256Upper := "hash://sha256/9564B85669D5E96AC969DD0161B8475BBCED9E5999C6EC598DA718A3045D6F2E"
+	cidV1 := "ipfs://bafybeiahwesqupoyvqxxuiuo2hf4hhevr2fmj2s7zh3gifue2242t2weg4"
+
+	record := types.IscnRecord{
+		ContentFingerprints: []string{sha256Upper, fingerprint2},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	record.ContentFingerprints = []string{"ipfs://not-a-cid"}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 1)
+	app.DeliverMsgSimError(t, msg, priv1, "invalid CID")
+
+	record.ContentFingerprints = []string{fingerprint1, sha256Upper}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 1)
+	app.DeliverMsgSimError(t, msg, priv1, "repeated fingerprint entry")
+
+	ctx := app.SetForQuery()
+
+	verifyLookup := func(ctx sdk.Context) {
+		for _, fingerprint := range []string{fingerprint1, sha256Upper, fingerprint2, cidV1} {
+			fpQuery := types.NewQueryRecordsByFingerprintRequest(fingerprint, 0)
+			fpQueryRes, err := app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+			require.NoError(t, err)
+			require.Len(t, fpQueryRes.Records, 1, fingerprint)
+		}
+		_, broken := keeper.IscnFingerprintsInvariant(app.IscnKeeper)(ctx)
+		require.False(t, broken)
+	}
+	verifyLookup(ctx)
+
+	// simulate the index written before canonicalization, then migrate
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+	fpStore := prefix.NewStore(store, types.FingerprintSequencePrefix)
+	fpStore.Delete(types.GetFingerprintSequenceKey(fingerprint1, 1))
+	fpStore.Delete(types.GetFingerprintSequenceKey(cidV1, 1))
+	fpStore.Set(types.GetFingerprintSequenceKey(sha256Upper, 1), []byte{0x01})
+	fpStore.Set(types.GetFingerprintSequenceKey(fingerprint2, 1), []byte{0x01})
+
+	fpQuery := types.NewQueryRecordsByFingerprintRequest(fingerprint1, 0)
+	fpQueryRes, err := app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), fpQuery)
+	require.NoError(t, err)
+	require.Len(t, fpQueryRes.Records, 0)
+
+	app.IscnKeeper.CanonicalizeFingerprintIndex(ctx)
+	verifyLookup(ctx)
+	require.False(t, fpStore.Has(types.GetFingerprintSequenceKey(sha256Upper, 1)))
+	require.False(t, fpStore.Has(types.GetFingerprintSequenceKey(fingerprint2, 1)))
+}
+
+func TestCommitReveal(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	commitTime := int64(1234567890)
+	app.NextHeader(commitTime)
+	app.SetForTx()
+
+	record := types.IscnRecord{
+		RecordNotes:         "some notes",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	salt := []byte("some random salt")
+	commitment, err := types.ComputeIscnCommitment(salt, addr1, &record)
+	require.NoError(t, err)
+
+	queryCommitment := func(commitment []byte) *types.IscnCommitment {
+		ctx := app.SetForQuery()
+		res, err := app.IscnKeeper.Commitment(sdk.WrapSDKContext(ctx), types.NewQueryCommitmentRequest(hex.EncodeToString(commitment)))
+		app.SetForTx()
+		if err != nil {
+			require.True(t, errors.Is(err, types.ErrCommitmentNotFound))
+			return nil
+		}
+		return &res.Commitment
+	}
+
+	msg = types.NewMsgCommitIscn(addr1, commitment[:16])
+	app.DeliverMsgSimError(t, msg, priv1, "invalid commitment")
+
+	msg = types.NewMsgCommitIscn(addr1, commitment)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	require.Equal(t, hex.EncodeToString(commitment), string(testutil.GetEventAttribute(result.GetEvents(), "iscn_commitment", []byte("commitment"))))
+	require.Equal(t, "committed", string(testutil.GetEventAttribute(result.GetEvents(), "iscn_commitment", []byte("action"))))
+	app.DeliverMsgSimError(t, msg, priv1, "commitment already exists")
+
+	c := queryCommitment(commitment)
+	require.NotNil(t, c)
+	require.Equal(t, addr1.String(), c.Committer)
+	require.Equal(t, time.Unix(commitTime, 0).UTC(), c.CommitTime.UTC())
+	commitHeight := c.CommitHeight
+	require.Equal(t, time.Unix(commitTime, 0).Add(time.Duration(types.DefaultCommitmentExpiryDays)*24*time.Hour).UTC(), c.Expiration.UTC())
+	require.Empty(t, c.RevealedIscnId)
+
+	revealTime := commitTime + 86400
+	app.NextHeader(revealTime)
+
+	// wrong salt, wrong sender or modified record does not match the commitment
+	msg = types.NewMsgRevealIscn(addr1, &record, []byte("wrong salt"), 0)
+	app.DeliverMsgSimError(t, msg, priv1, "no commitment matching")
+	msg = types.NewMsgRevealIscn(addr2, &record, salt, 0)
+	app.DeliverMsgSimError(t, msg, priv2, "no commitment matching")
+	modifiedRecord := record
+	modifiedRecord.RecordNotes = "modified notes"
+	msg = types.NewMsgRevealIscn(addr1, &modifiedRecord, salt, 0)
+	app.DeliverMsgSimError(t, msg, priv1, "no commitment matching")
+
+	msg = types.NewMsgRevealIscn(addr1, &record, salt, 0)
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+	require.Equal(t, "revealed", string(testutil.GetEventAttribute(result.GetEvents(), "iscn_commitment", []byte("action"))))
+	require.Equal(t, iscnId.String(), string(testutil.GetEventAttribute(result.GetEvents(), "iscn_commitment", []byte("iscn_id"))))
+
+	ctx := app.SetForQuery()
+	res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId.PrefixId(), 0, 0))
+	require.NoError(t, err)
+	require.Equal(t, addr1.String(), res.Owner)
+	require.Len(t, res.Records, 1)
+	data := res.Records[0].Data
+	v, ok := data.GetPath("recordCommitment")
+	require.True(t, ok)
+	require.Equal(t, hex.EncodeToString(commitment), v)
+	v, ok = data.GetPath("recordCommitTimestamp")
+	require.True(t, ok)
+	require.Equal(t, time.Unix(commitTime, 0).UTC().Format(types.RecordTimestampFormat), v)
+	v, ok = data.GetPath("recordCommitHeight")
+	require.True(t, ok)
+	require.EqualValues(t, commitHeight, v)
+	v, ok = data.GetPath("recordTimestamp")
+	require.True(t, ok)
+	require.Equal(t, time.Unix(revealTime, 0).UTC().Format(types.RecordTimestampFormat), v)
+	fpRes, err := app.IscnKeeper.RecordsByFingerprint(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByFingerprintRequest(fingerprint1, 0))
+	require.NoError(t, err)
+	require.Len(t, fpRes.Records, 1)
+	app.SetForTx()
+
+	c = queryCommitment(commitment)
+	require.NotNil(t, c)
+	require.Equal(t, iscnId.String(), c.RevealedIscnId)
+
+	// cannot reveal twice, even with a different nonce
+	msg = types.NewMsgRevealIscn(addr1, &record, salt, 1)
+	app.DeliverMsgSimError(t, msg, priv1, "already revealed")
+
+	// another commitment survives genesis export and import, then expires
+	salt2 := []byte("another salt")
+	commitment2, err := types.ComputeIscnCommitment(salt2, addr2, &record)
+	require.NoError(t, err)
+	msg = types.NewMsgCommitIscn(addr2, commitment2)
+	app.DeliverMsgNoError(t, msg, priv2)
+	c2 := queryCommitment(commitment2)
+	require.NotNil(t, c2)
+
+	ctx = app.SetForQuery()
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	require.Len(t, iscnGenesis.Commitments, 2)
+	require.NoError(t, iscnGenesis.Validate())
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	app.NextHeader(revealTime)
+	app.SetForTx()
+	require.NotNil(t, queryCommitment(commitment))
+	require.NotNil(t, queryCommitment(commitment2))
+
+	app.NextHeader(c2.Expiration.Unix())
+	msg = types.NewMsgRevealIscn(addr2, &record, salt2, 0)
+	// simulation runs on the previous block time, so the error only comes from delivering
+	_, err, simErr, deliverErr := app.DeliverMsg(msg, priv2)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+	require.True(t, errors.Is(deliverErr, types.ErrInvalidCommitment))
+	app.SetForQuery()
+	app.SetForTx()
+	require.Nil(t, queryCommitment(commitment2))
+	// revealed commitments are kept as the proof of the commit time
+	require.NotNil(t, queryCommitment(commitment))
+
+	msg = crisistypes.NewMsgVerifyInvariant(addr1, "iscn", "iscn-records")
+	app.DeliverMsgNoError(t, msg, priv1)
+}
+
+func TestAttestation(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+		{addr3.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	attestTime := int64(1234567890)
+	app.NextHeader(attestTime)
+	app.SetForTx()
+
+	stakeholderAddr2 := types.IscnInput(fmt.Sprintf(`{"entity":{"@id":"did:cosmos:%s","name":"Attester"},"contributionType":"http://schema.org/author"}`, addr2.String()))
+	stakeholderAddr3 := types.IscnInput(fmt.Sprintf(`{"entity":{"@id":"%s","name":"Another Attester"},"contributionType":"http://schema.org/author"}`, addr3.String()))
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholderAddr2},
+		ContentMetadata:     contentMetadata1,
+	}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+
+	msg = types.NewMsgAttestIscnRecord(addr3, iscnId)
+	app.DeliverMsgSimError(t, msg, priv3, "not named as a stakeholder")
+	msg = types.NewMsgAttestIscnRecord(addr1, iscnId)
+	app.DeliverMsgSimError(t, msg, priv1, "not named as a stakeholder")
+	msg = types.NewMsgAttestIscnRecord(addr2, iscnId.PrefixId())
+	app.DeliverMsgSimError(t, msg, priv2, "version")
+	notExisting := iscnId
+	notExisting.Version = 2
+	msg = types.NewMsgAttestIscnRecord(addr2, notExisting)
+	app.DeliverMsgSimError(t, msg, priv2, "not found")
+
+	msg = types.NewMsgAttestIscnRecord(addr2, iscnId)
+	result = app.DeliverMsgNoError(t, msg, priv2)
+	require.Equal(t, iscnId.String(), string(testutil.GetEventAttribute(result.GetEvents(), "iscn_attestation", []byte("iscn_id"))))
+	require.Equal(t, addr2.String(), string(testutil.GetEventAttribute(result.GetEvents(), "iscn_attestation", []byte("attester"))))
+	require.Equal(t, "attested", string(testutil.GetEventAttribute(result.GetEvents(), "iscn_attestation", []byte("action"))))
+	app.DeliverMsgSimError(t, msg, priv2, "already attested")
+
+	// attestations are bound to the version, so the new version needs to be attested again
+	record.Stakeholders = []types.IscnInput{stakeholderAddr2, stakeholderAddr3}
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	app.DeliverMsgNoError(t, msg, priv1)
+	iscnIdV2 := iscnId
+	iscnIdV2.Version = 2
+	msg = types.NewMsgAttestIscnRecord(addr3, iscnIdV2)
+	app.DeliverMsgNoError(t, msg, priv3)
+	msg = types.NewMsgAttestIscnRecord(addr2, iscnIdV2)
+	app.DeliverMsgNoError(t, msg, priv2)
+
+	verifyState := func(ctx sdk.Context) {
+		res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId, 0, 0))
+		require.NoError(t, err)
+		require.Len(t, res.Attestations, 1)
+		require.Equal(t, iscnId.String(), res.Attestations[0].IscnId)
+		require.Equal(t, addr2.String(), res.Attestations[0].Attester)
+		require.Equal(t, time.Unix(attestTime, 0).UTC(), res.Attestations[0].AttestTime.UTC())
+		require.NotZero(t, res.Attestations[0].AttestHeight)
+
+		res, err = app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId.PrefixId(), 1, 2))
+		require.NoError(t, err)
+		require.Len(t, res.Records, 2)
+		require.Len(t, res.Attestations, 3)
+
+		attesterRes, err := app.IscnKeeper.AttestationsByAttester(sdk.WrapSDKContext(ctx), types.NewQueryAttestationsByAttesterRequest(addr2.String(), &query.PageRequest{Limit: 1, CountTotal: true}))
+		require.NoError(t, err)
+		require.Len(t, attesterRes.Attestations, 1)
+		require.Equal(t, iscnId.String(), attesterRes.Attestations[0].IscnId)
+		require.Equal(t, uint64(2), attesterRes.Pagination.Total)
+		require.NotEmpty(t, attesterRes.Pagination.NextKey)
+		attesterRes, err = app.IscnKeeper.AttestationsByAttester(sdk.WrapSDKContext(ctx), types.NewQueryAttestationsByAttesterRequest(addr2.String(), &query.PageRequest{Key: attesterRes.Pagination.NextKey}))
+		require.NoError(t, err)
+		require.Len(t, attesterRes.Attestations, 1)
+		require.Equal(t, iscnIdV2.String(), attesterRes.Attestations[0].IscnId)
+		require.Empty(t, attesterRes.Pagination.NextKey)
+
+		attesterRes, err = app.IscnKeeper.AttestationsByAttester(sdk.WrapSDKContext(ctx), types.NewQueryAttestationsByAttesterRequest(addr1.String(), nil))
+		require.NoError(t, err)
+		require.Empty(t, attesterRes.Attestations)
+
+		_, err = app.IscnKeeper.AttestationsByAttester(sdk.WrapSDKContext(ctx), types.NewQueryAttestationsByAttesterRequest("invalid_address", nil))
+		require.Error(t, err)
+	}
+
+	ctx := app.SetForQuery()
+	verifyState(ctx)
+
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	require.Len(t, iscnGenesis.Attestations, 3)
+	require.NoError(t, iscnGenesis.Validate())
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	ctx = app.SetForQuery()
+	verifyState(ctx)
+	app.SetForTx()
+
+	msg = types.NewMsgRevokeIscnRecordAttestation(addr1, iscnId)
+	app.DeliverMsgSimError(t, msg, priv1, "no attestation")
+	msg = types.NewMsgRevokeIscnRecordAttestation(addr2, iscnId)
+	result = app.DeliverMsgNoError(t, msg, priv2)
+	require.Equal(t, "revoked", string(testutil.GetEventAttribute(result.GetEvents(), "iscn_attestation", []byte("action"))))
+	app.DeliverMsgSimError(t, msg, priv2, "no attestation")
+
+	ctx = app.SetForQuery()
+	res, err := app.IscnKeeper.RecordsById(sdk.WrapSDKContext(ctx), types.NewQueryRecordsByIdRequest(iscnId, 0, 0))
+	require.NoError(t, err)
+	require.Empty(t, res.Attestations)
+	attesterRes, err := app.IscnKeeper.AttestationsByAttester(sdk.WrapSDKContext(ctx), types.NewQueryAttestationsByAttesterRequest(addr2.String(), nil))
+	require.NoError(t, err)
+	require.Len(t, attesterRes.Attestations, 1)
+	require.Equal(t, iscnIdV2.String(), attesterRes.Attestations[0].IscnId)
+	app.SetForTx()
+
+	msg = crisistypes.NewMsgVerifyInvariant(addr1, "iscn", "iscn-records")
+	app.DeliverMsgNoError(t, msg, priv1)
+}
+
+func TestTypedEvents(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	parseTypedEvent := func(events sdk.Events, eventType proto.Message) proto.Message {
+		typeName := proto.MessageName(eventType)
+		for _, event := range events {
+			if event.Type == typeName {
+				parsed, err := sdk.ParseTypedEvent(abci.Event(event))
+				require.NoError(t, err)
+				return parsed
+			}
+		}
+		require.FailNow(t, "typed event not found", typeName)
+		return nil
+	}
+
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1, fingerprint2},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+	createEvent := parseTypedEvent(result.GetEvents(), &types.EventCreateIscnRecord{}).(*types.EventCreateIscnRecord)
+	require.Equal(t, &types.EventCreateIscnRecord{
+		IscnId:              iscnId.String(),
+		IscnIdPrefix:        iscnId.Prefix.String(),
+		Owner:               addr1.String(),
+		Ipld:                string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("ipld"))),
+		Version:             1,
+		ContentFingerprints: []string{fingerprint1, fingerprint2},
+	}, createEvent)
+	// the legacy event is still emitted
+	require.Equal(t, iscnId.String(), string(testutil.GetEventAttribute(result.GetEvents(), "iscn_record", []byte("iscn_id"))))
+
+	record.ContentFingerprints = []string{fingerprint2}
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	iscnIdV2 := testutil.GetIscnIdFromResult(t, result)
+	updateEvent := parseTypedEvent(result.GetEvents(), &types.EventUpdateIscnRecord{}).(*types.EventUpdateIscnRecord)
+	require.Equal(t, iscnIdV2.String(), updateEvent.IscnId)
+	require.Equal(t, iscnId.Prefix.String(), updateEvent.IscnIdPrefix)
+	require.Equal(t, addr1.String(), updateEvent.Owner)
+	require.Equal(t, uint64(2), updateEvent.Version)
+	require.Equal(t, []string{fingerprint2}, updateEvent.ContentFingerprints)
+	require.NotEqual(t, createEvent.Ipld, updateEvent.Ipld)
+
+	msg = types.NewMsgChangeIscnRecordOwnership(addr1, iscnIdV2, addr2)
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	ownershipEvent := parseTypedEvent(result.GetEvents(), &types.EventChangeIscnRecordOwnership{}).(*types.EventChangeIscnRecordOwnership)
+	require.Equal(t, &types.EventChangeIscnRecordOwnership{
+		IscnId:        iscnIdV2.String(),
+		IscnIdPrefix:  iscnId.Prefix.String(),
+		PreviousOwner: addr1.String(),
+		NewOwner:      addr2.String(),
+		Version:       2,
+	}, ownershipEvent)
+
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr2, iscnIdV2, addr1, time.Unix(1234567890, 0).Add(24*time.Hour))
+	app.DeliverMsgNoError(t, msg, priv2)
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr1, iscnId.PrefixId())
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	ownershipEvent = parseTypedEvent(result.GetEvents(), &types.EventChangeIscnRecordOwnership{}).(*types.EventChangeIscnRecordOwnership)
+	require.Equal(t, &types.EventChangeIscnRecordOwnership{
+		IscnId:        iscnIdV2.String(),
+		IscnIdPrefix:  iscnId.Prefix.String(),
+		PreviousOwner: addr2.String(),
+		NewOwner:      addr1.String(),
+		Version:       2,
+	}, ownershipEvent)
+}
+
+func TestRecordLimits(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{{addr1.String(), "1000000000000000000nanolike"}}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	ctx := app.SetForQuery()
+	params := app.IscnKeeper.GetParams(ctx)
+	require.Equal(t, types.DefaultMaxRecordBytes, params.MaxRecordBytes)
+	require.Equal(t, types.DefaultMaxFingerprints, params.MaxFingerprints)
+	require.Equal(t, types.DefaultMaxStakeholders, params.MaxStakeholders)
+	require.Equal(t, types.DefaultMaxFingerprintLength, params.MaxFingerprintLength)
+	params.MaxRecordBytes = 2048
+	params.MaxFingerprints = 2
+	params.MaxStakeholders = 2
+	params.MaxFingerprintLength = 100
+	app.IscnKeeper.SetParams(ctx, params)
+	res, err := app.IscnKeeper.Params(sdk.WrapSDKContext(ctx), &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2048), res.Params.MaxRecordBytes)
+	require.Equal(t, uint64(2), res.Params.MaxFingerprints)
+	require.Equal(t, uint64(2), res.Params.MaxStakeholders)
+	require.Equal(t, uint64(100), res.Params.MaxFingerprintLength)
+	app.SetForTx()
+
+	goodRecord := func() types.IscnRecord {
+		return types.IscnRecord{
+			ContentFingerprints: []string{fingerprint1, fingerprint2},
+			Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+			ContentMetadata:     contentMetadata1,
+		}
+	}
+	record := goodRecord()
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+
+	record = goodRecord()
+	record.ContentFingerprints = append(record.ContentFingerprints, "ar://mMxNh2gFmXzzMgFNVGhZrGrRqJzXOKWWM_lOmgrPyr4")
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	app.DeliverMsgSimError(t, msg, priv1, "3 content fingerprints, exceeding the limit 2")
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	app.DeliverMsgSimError(t, msg, priv1, "3 content fingerprints, exceeding the limit 2")
+
+	record = goodRecord()
+	record.ContentFingerprints = []string{"https://example.com/" + strings.Repeat("a", 100)}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	app.DeliverMsgSimError(t, msg, priv1, "exceeding the limit 100")
+
+	record = goodRecord()
+	record.Stakeholders = append(record.Stakeholders, stakeholder1)
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	app.DeliverMsgSimError(t, msg, priv1, "3 stakeholders, exceeding the limit 2")
+
+	record = goodRecord()
+	record.RecordNotes = strings.Repeat("a", 2048)
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	app.DeliverMsgSimError(t, msg, priv1, "exceeding the limit 2048")
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{goodRecord(), record}, []uint64{1, 2})
+	app.DeliverMsgSimError(t, msg, priv1, "entry 1")
+}
+
+func TestOwnershipHistory(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+		{addr3.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	createTime := int64(1234567890)
+	app.NextHeader(createTime)
+	app.SetForTx()
+
+	record := types.IscnRecord{
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+	// updating the record does not change the owner
+	msg = types.NewMsgUpdateIscnRecord(addr1, iscnId, &record)
+	app.DeliverMsgNoError(t, msg, priv1)
+	iscnId.Version = 2
+
+	changeTime := createTime + 100
+	app.NextHeader(changeTime)
+	msg = types.NewMsgChangeIscnRecordOwnership(addr1, iscnId, addr2)
+	app.DeliverMsgNoError(t, msg, priv1)
+	msg = types.NewMsgProposeIscnOwnershipTransfer(addr2, iscnId, addr3, time.Unix(changeTime, 0).Add(24*time.Hour))
+	app.DeliverMsgNoError(t, msg, priv2)
+	msg = types.NewMsgAcceptIscnOwnershipTransfer(addr3, iscnId)
+	app.DeliverMsgNoError(t, msg, priv3)
+
+	msg = types.NewMsgBatchCreateIscnRecords(addr2, []types.IscnRecord{record}, []uint64{1})
+	result = app.DeliverMsgNoError(t, msg, priv2)
+	batchIscnId := testutil.GetIscnIdFromResult(t, result)
+
+	verifyState := func(ctx sdk.Context) {
+		res, err := app.IscnKeeper.OwnershipHistory(sdk.WrapSDKContext(ctx), types.NewQueryOwnershipHistoryRequest(iscnId, nil))
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 3)
+		expected := []struct {
+			owner      sdk.AccAddress
+			msgTypeUrl string
+			time       int64
+		}{
+			{addr1, "/likechain.iscn.MsgCreateIscnRecord", createTime},
+			{addr2, "/likechain.iscn.MsgChangeIscnRecordOwnership", changeTime},
+			{addr3, "/likechain.iscn.MsgAcceptIscnOwnershipTransfer", changeTime},
+		}
+		for i, entry := range res.Entries {
+			require.Equal(t, iscnId.Prefix.String(), entry.IscnIdPrefix)
+			require.Equal(t, expected[i].owner.String(), entry.Owner)
+			require.Equal(t, expected[i].msgTypeUrl, entry.MsgTypeUrl)
+			require.Equal(t, time.Unix(expected[i].time, 0).UTC(), entry.Time.UTC())
+			require.NotZero(t, entry.Height)
+		}
+		require.Less(t, res.Entries[0].Height, res.Entries[1].Height)
+
+		res, err = app.IscnKeeper.OwnershipHistory(sdk.WrapSDKContext(ctx), types.NewQueryOwnershipHistoryRequest(iscnId.PrefixId(), &query.PageRequest{Offset: 1, Limit: 1, CountTotal: true}))
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		require.Equal(t, addr2.String(), res.Entries[0].Owner)
+		require.Equal(t, uint64(3), res.Pagination.Total)
+
+		res, err = app.IscnKeeper.OwnershipHistory(sdk.WrapSDKContext(ctx), types.NewQueryOwnershipHistoryRequest(batchIscnId, nil))
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		require.Equal(t, addr2.String(), res.Entries[0].Owner)
+		require.Equal(t, "/likechain.iscn.MsgBatchCreateIscnRecords", res.Entries[0].MsgTypeUrl)
+
+		notExisting := iscnId
+		notExisting.Prefix.ContentId = "not-existing"
+		_, err = app.IscnKeeper.OwnershipHistory(sdk.WrapSDKContext(ctx), types.NewQueryOwnershipHistoryRequest(notExisting, nil))
+		require.True(t, errors.Is(err, types.ErrRecordNotFound))
+	}
+
+	ctx := app.SetForQuery()
+	verifyState(ctx)
+
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	require.Len(t, iscnGenesis.OwnershipHistory, 4)
+	require.NoError(t, iscnGenesis.Validate())
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	ctx = app.SetForQuery()
+	verifyState(ctx)
+
+	// records without history get their current owners recorded as the start of the history
+	iscnGenesis.OwnershipHistory = nil
+	iscnGenesisJson = app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	app.NextHeader(changeTime + 100)
+	ctx = app.SetForQuery()
+	app.IscnKeeper.InitOwnershipHistory(ctx)
+	res, err := app.IscnKeeper.OwnershipHistory(sdk.WrapSDKContext(ctx), types.NewQueryOwnershipHistoryRequest(iscnId, nil))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, addr3.String(), res.Entries[0].Owner)
+	require.Empty(t, res.Entries[0].MsgTypeUrl)
+	app.IscnKeeper.InitOwnershipHistory(ctx)
+	res, err = app.IscnKeeper.OwnershipHistory(sdk.WrapSDKContext(ctx), types.NewQueryOwnershipHistoryRequest(iscnId, nil))
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+}
+
+func TestRelatedRecords(t *testing.T) {
+	var msg sdk.Msg
+	genesisBalances := []testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+	}
+	app := testutil.SetupTestApp(genesisBalances)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	newRecord := func(relations ...*types.IscnRelation) *types.IscnRecord {
+		return &types.IscnRecord{
+			ContentFingerprints: []string{fingerprint1},
+			Stakeholders:        []types.IscnInput{stakeholder1},
+			ContentMetadata:     contentMetadata1,
+			Relations:           relations,
+		}
+	}
+	relation := func(relationType string, target types.IscnId) *types.IscnRelation {
+		return &types.IscnRelation{
+			Type:   relationType,
+			Target: target.Prefix.String(),
+		}
+	}
+
+	msg = types.NewMsgCreateIscnRecord(addr1, newRecord(), 0)
+	result := app.DeliverMsgNoError(t, msg, priv1)
+	seriesId := testutil.GetIscnIdFromResult(t, result)
+
+	msg = types.NewMsgCreateIscnRecord(addr1, newRecord(relation(types.RelationTypePartOf, seriesId)), 0)
+	result = app.DeliverMsgNoError(t, msg, priv1)
+	bookId := testutil.GetIscnIdFromResult(t, result)
+
+	// relations to records owned by others are allowed
+	msg = types.NewMsgCreateIscnRecord(addr2, newRecord(
+		relation(types.RelationTypeCitation, bookId),
+		relation(types.RelationTypeDerivedFrom, seriesId),
+	), 0)
+	result = app.DeliverMsgNoError(t, msg, priv2)
+	paperId := testutil.GetIscnIdFromResult(t, result)
+
+	notExistingId := seriesId
+	notExistingId.Prefix.ContentId = "not-existing"
+	msg = types.NewMsgCreateIscnRecord(addr1, newRecord(relation(types.RelationTypeCitation, notExistingId)), 1)
+	app.DeliverMsgSimError(t, msg, priv1, types.ErrRecordNotFound.Error())
+
+	msg = types.NewMsgBatchCreateIscnRecords(addr1, []types.IscnRecord{*newRecord(relation(types.RelationTypeCitation, notExistingId))}, []uint64{1})
+	app.DeliverMsgSimError(t, msg, priv1, types.ErrRecordNotFound.Error())
+
+	msg = types.NewMsgCreateIscnRecord(addr1, newRecord(&types.IscnRelation{Type: "cites", Target: seriesId.Prefix.String()}), 1)
+	app.DeliverMsgSimError(t, msg, priv1, types.ErrInvalidIscnRecord.Error())
+
+	msg = types.NewMsgUpdateIscnRecord(addr1, bookId, newRecord(relation(types.RelationTypeCitation, bookId)))
+	app.DeliverMsgSimError(t, msg, priv1, types.ErrInvalidRelation.Error())
+
+	// the second version of the book cites the paper in addition to being part of the series
+	msg = types.NewMsgUpdateIscnRecord(addr1, bookId, newRecord(
+		relation(types.RelationTypePartOf, seriesId),
+		relation(types.RelationTypeCitation, paperId),
+	))
+	app.DeliverMsgNoError(t, msg, priv1)
+	bookIdV2 := bookId
+	bookIdV2.Version = 2
+
+	queryRelated := func(ctx sdk.Context, iscnId types.IscnId, direction types.RelationDirection, relationType string, pagination *query.PageRequest) *types.QueryRelatedRecordsResponse {
+		res, err := app.IscnKeeper.RelatedRecords(sdk.WrapSDKContext(ctx), types.NewQueryRelatedRecordsRequest(iscnId, direction, relationType, pagination))
+		require.NoError(t, err)
+		return res
+	}
+	verifyState := func(ctx sdk.Context) {
+		// what the book is part of
+		res := queryRelated(ctx, bookId.PrefixId(), types.RELATION_DIRECTION_OUTGOING, types.RelationTypePartOf, nil)
+		require.Len(t, res.Relations, 1)
+		require.Equal(t, types.RelationTypePartOf, res.Relations[0].RelationType)
+		require.Equal(t, bookIdV2.String(), res.Relations[0].SourceIscnId)
+		require.Equal(t, seriesId.Prefix.String(), res.Relations[0].TargetIscnIdPrefix)
+		v, ok := res.Relations[0].Record.Data.GetPath("@id")
+		require.True(t, ok)
+		require.Equal(t, seriesId.String(), v)
+
+		res = queryRelated(ctx, bookId.PrefixId(), types.RELATION_DIRECTION_OUTGOING, "", nil)
+		require.Len(t, res.Relations, 2)
+
+		res = queryRelated(ctx, bookId, types.RELATION_DIRECTION_OUTGOING, "", nil)
+		require.Len(t, res.Relations, 1)
+		require.Equal(t, bookId.String(), res.Relations[0].SourceIscnId)
+
+		// what cites the book
+		res = queryRelated(ctx, bookId, types.RELATION_DIRECTION_INCOMING, types.RelationTypeCitation, nil)
+		require.Len(t, res.Relations, 1)
+		require.Equal(t, types.RelationTypeCitation, res.Relations[0].RelationType)
+		require.Equal(t, paperId.String(), res.Relations[0].SourceIscnId)
+		require.Equal(t, bookId.Prefix.String(), res.Relations[0].TargetIscnIdPrefix)
+		v, ok = res.Relations[0].Record.Data.GetPath("@id")
+		require.True(t, ok)
+		require.Equal(t, paperId.String(), v)
+
+		res = queryRelated(ctx, seriesId, types.RELATION_DIRECTION_INCOMING, types.RelationTypePartOf, nil)
+		require.Len(t, res.Relations, 2)
+		require.Equal(t, bookId.String(), res.Relations[0].SourceIscnId)
+		require.Equal(t, bookIdV2.String(), res.Relations[1].SourceIscnId)
+
+		res = queryRelated(ctx, seriesId, types.RELATION_DIRECTION_INCOMING, "", &query.PageRequest{Limit: 1, CountTotal: true})
+		require.Len(t, res.Relations, 1)
+		require.Equal(t, uint64(3), res.Pagination.Total)
+
+		res = queryRelated(ctx, paperId, types.RELATION_DIRECTION_INCOMING, types.RelationTypeDerivedFrom, nil)
+		require.Len(t, res.Relations, 0)
+
+		_, err := app.IscnKeeper.RelatedRecords(sdk.WrapSDKContext(ctx), types.NewQueryRelatedRecordsRequest(notExistingId, types.RELATION_DIRECTION_INCOMING, "", nil))
+		require.True(t, errors.Is(err, types.ErrRecordNotFound))
+
+		_, err = app.IscnKeeper.RelatedRecords(sdk.WrapSDKContext(ctx), types.NewQueryRelatedRecordsRequest(seriesId, types.RELATION_DIRECTION_INCOMING, "cites", nil))
+		require.True(t, errors.Is(err, sdkerrors.ErrInvalidRequest))
+	}
+
+	ctx := app.SetForQuery()
+	verifyState(ctx)
+
+	iscnGenesis := app.IscnKeeper.ExportGenesis(ctx)
+	require.NoError(t, iscnGenesis.Validate())
+	iscnGenesisJson := app.AppCodec().MustMarshalJSON(iscnGenesis)
+	app = testutil.SetupTestAppWithIscnGenesis(genesisBalances, iscnGenesisJson)
+	ctx = app.SetForQuery()
+	verifyState(ctx)
+}
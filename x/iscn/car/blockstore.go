@@ -0,0 +1,57 @@
+package car
+
+import (
+	gocid "github.com/ipfs/go-cid"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	blockKeyPrefix = []byte{0x01}
+	rootKey        = []byte{0x02}
+)
+
+// BlockStore is a local store of IPLD blocks keyed by CID, e.g. for serving the blocks imported from a CAR file
+type BlockStore struct {
+	db dbm.DB
+}
+
+func NewBlockStore(db dbm.DB) *BlockStore {
+	return &BlockStore{db: db}
+}
+
+func blockKey(cid gocid.Cid) []byte {
+	return append(append([]byte{}, blockKeyPrefix...), cid.Bytes()...)
+}
+
+// Put stores the block without checking the CID against the data
+func (store *BlockStore) Put(cid gocid.Cid, data []byte) error {
+	return store.db.Set(blockKey(cid), data)
+}
+
+// Get returns the data of the block, or nil if the block is not found
+func (store *BlockStore) Get(cid gocid.Cid) ([]byte, error) {
+	return store.db.Get(blockKey(cid))
+}
+
+func (store *BlockStore) Has(cid gocid.Cid) (bool, error) {
+	return store.db.Has(blockKey(cid))
+}
+
+// SetRoot records the root of the last imported registry
+func (store *BlockStore) SetRoot(root gocid.Cid) error {
+	return store.db.SetSync(rootKey, root.Bytes())
+}
+
+// Root returns the root of the last imported registry, or false if nothing is imported
+func (store *BlockStore) Root() (gocid.Cid, bool, error) {
+	bz, err := store.db.Get(rootKey)
+	if err != nil || bz == nil {
+		return gocid.Cid{}, false, err
+	}
+	root, err := gocid.Cast(bz)
+	if err != nil {
+		return gocid.Cid{}, false, err
+	}
+	return root, true, nil
+}
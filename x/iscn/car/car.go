@@ -0,0 +1,254 @@
+// Package car implements reading and writing of CAR (Content Addressable aRchive) files, which is the format for
+// transferring IPLD blocks, e.g. for importing into IPFS.
+//
+// Only the subset needed for ISCN records is implemented: CARv1 with a single root, and CARv2 wrapping a CARv1 payload
+// without index.
+// See https://ipld.io/specs/transport/car/carv1/ and https://ipld.io/specs/transport/car/carv2/
+package car
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+
+	gocid "github.com/ipfs/go-cid"
+)
+
+const (
+	// MaxHeaderSize and MaxBlockSize limit the memory used when reading untrusted CAR files
+	MaxHeaderSize = 32 * 1024
+	MaxBlockSize  = 16 * 1024 * 1024
+
+	carV2HeaderSize = 40
+)
+
+// carV2Pragma is the fixed bytes at the beginning of CARv2 files, which is a CARv1 header with version 2
+var carV2Pragma = []byte{0x0a, 0xa1, 0x67, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x02}
+
+func appendUvarint(bz []byte, n uint64) []byte {
+	buf := make([]byte, binary.MaxVarintLen64)
+	l := binary.PutUvarint(buf, n)
+	return append(bz, buf[:l]...)
+}
+
+// encodeV1Header encodes the CARv1 header `{"roots": [root], "version": 1}` in DAG-CBOR
+func encodeV1Header(root gocid.Cid) []byte {
+	header := []byte{0xa2}
+	header = appendCborHead(header, cborMajorText, 5)
+	header = append(header, "roots"...)
+	header = appendCborHead(header, cborMajorArray, 1)
+	header = appendCborHead(header, cborMajorTag, cborTagCid)
+	cidBytes := root.Bytes()
+	// CIDs in DAG-CBOR are prefixed with the multibase identity prefix 0x00
+	header = appendCborHead(header, cborMajorBytes, uint64(len(cidBytes)+1))
+	header = append(header, 0x00)
+	header = append(header, cidBytes...)
+	header = appendCborHead(header, cborMajorText, 7)
+	header = append(header, "version"...)
+	header = appendCborHead(header, cborMajorUint, 1)
+	return header
+}
+
+// Writer writes blocks into a CARv1 stream
+type Writer struct {
+	w            io.Writer
+	written      uint64
+	headerOffset int64
+	headerSize   int
+}
+
+func NewWriter(w io.Writer, root gocid.Cid) (*Writer, error) {
+	writer := &Writer{w: w}
+	if seeker, ok := w.(io.Seeker); ok {
+		offset, err := seeker.Seek(0, io.SeekCurrent)
+		if err != nil {
+			return nil, err
+		}
+		writer.headerOffset = offset
+	}
+	header := encodeV1Header(root)
+	writer.headerSize = len(header)
+	err := writer.writeSection(header)
+	if err != nil {
+		return nil, err
+	}
+	return writer, nil
+}
+
+// SetRoot overwrites the root in the header, so the root block could be written after the blocks it links to.
+// The underlying writer must be an io.WriteSeeker, and the new root must have the same encoded length as the
+// original one.
+func (writer *Writer) SetRoot(root gocid.Cid) error {
+	seeker, ok := writer.w.(io.WriteSeeker)
+	if !ok {
+		return fmt.Errorf("cannot set root on non-seekable writer")
+	}
+	header := encodeV1Header(root)
+	if len(header) != writer.headerSize {
+		return fmt.Errorf("root %s has different length from the original root", root.String())
+	}
+	end, err := seeker.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	_, err = seeker.Seek(writer.headerOffset, io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = seeker.Write(append(appendUvarint(nil, uint64(len(header))), header...))
+	if err != nil {
+		return err
+	}
+	_, err = seeker.Seek(end, io.SeekStart)
+	return err
+}
+
+func (writer *Writer) writeSection(sections ...[]byte) error {
+	length := 0
+	for _, section := range sections {
+		length += len(section)
+	}
+	bz := appendUvarint(nil, uint64(length))
+	for _, section := range sections {
+		bz = append(bz, section...)
+	}
+	n, err := writer.w.Write(bz)
+	writer.written += uint64(n)
+	return err
+}
+
+func (writer *Writer) WriteBlock(cid gocid.Cid, data []byte) error {
+	return writer.writeSection(cid.Bytes(), data)
+}
+
+// Written returns the number of bytes written, including the header
+func (writer *Writer) Written() uint64 {
+	return writer.written
+}
+
+// V2Writer writes blocks into a CARv2 file without index. Since the CARv2 header contains the size of the CARv1
+// payload, the output needs to be seekable so the header could be filled in when closing.
+type V2Writer struct {
+	*Writer
+	w io.WriteSeeker
+}
+
+func NewV2Writer(w io.WriteSeeker, root gocid.Cid) (*V2Writer, error) {
+	_, err := w.Write(carV2Pragma)
+	if err != nil {
+		return nil, err
+	}
+	// placeholder, filled in Close
+	_, err = w.Write(make([]byte, carV2HeaderSize))
+	if err != nil {
+		return nil, err
+	}
+	writer, err := NewWriter(w, root)
+	if err != nil {
+		return nil, err
+	}
+	return &V2Writer{Writer: writer, w: w}, nil
+}
+
+// Close fills in the CARv2 header, it does not close the underlying writer
+func (writer *V2Writer) Close() error {
+	header := make([]byte, carV2HeaderSize)
+	// the first 16 bytes are characteristics, which are all zero since the payload is not fully indexed
+	binary.LittleEndian.PutUint64(header[16:], uint64(len(carV2Pragma)+carV2HeaderSize))
+	binary.LittleEndian.PutUint64(header[24:], writer.written)
+	// index offset 0 means there is no index
+	binary.LittleEndian.PutUint64(header[32:], 0)
+	end, err := writer.w.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return err
+	}
+	_, err = writer.w.Seek(int64(len(carV2Pragma)), io.SeekStart)
+	if err != nil {
+		return err
+	}
+	_, err = writer.w.Write(header)
+	if err != nil {
+		return err
+	}
+	_, err = writer.w.Seek(end, io.SeekStart)
+	return err
+}
+
+func readSection(r *bufio.Reader, maxSize uint64) ([]byte, error) {
+	length, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if length > maxSize {
+		return nil, fmt.Errorf("section size %d exceeds limit %d", length, maxSize)
+	}
+	bz := make([]byte, length)
+	_, err = io.ReadFull(r, bz)
+	if err != nil {
+		return nil, err
+	}
+	return bz, nil
+}
+
+// Read reads a CARv1 or CARv2 stream, calling f for each block in order, and returns the roots in the header.
+// The CIDs are not verified against the block data.
+func Read(r io.Reader, f func(cid gocid.Cid, data []byte) error) ([]gocid.Cid, error) {
+	reader := bufio.NewReader(r)
+	prefix, err := reader.Peek(len(carV2Pragma))
+	if err != nil {
+		return nil, err
+	}
+	var payload io.Reader = reader
+	if bytes.Equal(prefix, carV2Pragma) {
+		header := make([]byte, len(carV2Pragma)+carV2HeaderSize)
+		_, err = io.ReadFull(reader, header)
+		if err != nil {
+			return nil, err
+		}
+		dataOffset := binary.LittleEndian.Uint64(header[len(carV2Pragma)+16:])
+		dataSize := binary.LittleEndian.Uint64(header[len(carV2Pragma)+24:])
+		if dataOffset < uint64(len(header)) {
+			return nil, fmt.Errorf("invalid CARv2 data offset %d", dataOffset)
+		}
+		_, err = reader.Discard(int(dataOffset) - len(header))
+		if err != nil {
+			return nil, err
+		}
+		payload = io.LimitReader(reader, int64(dataSize))
+	}
+	return readV1(bufio.NewReader(payload), f)
+}
+
+func readV1(r *bufio.Reader, f func(cid gocid.Cid, data []byte) error) ([]gocid.Cid, error) {
+	headerBytes, err := readSection(r, MaxHeaderSize)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read CAR header: %w", err)
+	}
+	roots, version, err := decodeV1Header(headerBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CAR header: %w", err)
+	}
+	if version != 1 {
+		return nil, fmt.Errorf("unsupported CAR version %d", version)
+	}
+	for {
+		_, err := r.Peek(1)
+		if err == io.EOF {
+			return roots, nil
+		}
+		section, err := readSection(r, MaxBlockSize)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read CAR block: %w", err)
+		}
+		cidLen, cid, err := gocid.CidFromBytes(section)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CID in CAR block: %w", err)
+		}
+		err = f(cid, section[cidLen:])
+		if err != nil {
+			return nil, err
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package car_test
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	gocid "github.com/ipfs/go-cid"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/car"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+func TestReadWrite(t *testing.T) {
+	data := [][]byte{[]byte(`{"a":1}`), []byte(`{"b":2}`), bytes.Repeat([]byte("x"), 300)}
+	cids := []gocid.Cid{}
+	for _, d := range data {
+		cids = append(cids, types.ComputeDataCid(d))
+	}
+
+	verify := func(bz []byte) {
+		i := 0
+		roots, err := car.Read(bytes.NewReader(bz), func(cid gocid.Cid, d []byte) error {
+			require.Equal(t, cids[i], cid)
+			require.Equal(t, data[i], d)
+			i++
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(data), i)
+		require.Equal(t, []gocid.Cid{cids[0]}, roots)
+	}
+
+	buf := bytes.Buffer{}
+	writer, err := car.NewWriter(&buf, cids[0])
+	require.NoError(t, err)
+	for i := range data {
+		require.NoError(t, writer.WriteBlock(cids[i], data[i]))
+	}
+	require.Equal(t, uint64(buf.Len()), writer.Written())
+	v1 := buf.Bytes()
+	verify(v1)
+
+	path := filepath.Join(t.TempDir(), "test.car")
+	f, err := os.Create(path)
+	require.NoError(t, err)
+	v2Writer, err := car.NewV2Writer(f, cids[0])
+	require.NoError(t, err)
+	for i := range data {
+		require.NoError(t, v2Writer.WriteBlock(cids[i], data[i]))
+	}
+	require.NoError(t, v2Writer.Close())
+	require.NoError(t, f.Close())
+	v2, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, v1, v2[51:], "CARv2 should contain the CARv1 payload after the header")
+	verify(v2)
+
+	_, err = car.Read(bytes.NewReader(v1[:len(v1)-1]), func(gocid.Cid, []byte) error { return nil })
+	require.Error(t, err, "should not accept truncated file")
+
+	// root replaced after writing the blocks
+	path = filepath.Join(t.TempDir(), "test-set-root.car")
+	f, err = os.Create(path)
+	require.NoError(t, err)
+	v2Writer, err = car.NewV2Writer(f, types.ComputeDataCid(nil))
+	require.NoError(t, err)
+	for i := range data {
+		require.NoError(t, v2Writer.WriteBlock(cids[i], data[i]))
+	}
+	require.NoError(t, v2Writer.SetRoot(cids[0]))
+	require.NoError(t, v2Writer.Close())
+	require.NoError(t, f.Close())
+	v2, err = os.ReadFile(path)
+	require.NoError(t, err)
+	verify(v2)
+
+	writer, err = car.NewWriter(&bytes.Buffer{}, cids[0])
+	require.NoError(t, err)
+	require.Error(t, writer.SetRoot(cids[1]), "should not set root on non-seekable writer")
+}
+
+func TestExportAndVerifyRegistry(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{{Address: addr.String(), Coin: "1000000000000000000nanolike"}})
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	recordCount := car.IndexPageSize + 10
+	msgs := []sdk.Msg{}
+	for i := 0; i < recordCount; i++ {
+		record := types.IscnRecord{
+			RecordNotes:     fmt.Sprintf("record %d", i),
+			ContentMetadata: types.IscnInput(`{"@type":"CreativeWork"}`),
+		}
+		msgs = append(msgs, types.NewMsgCreateIscnRecord(addr, &record, 0))
+		// keeping each block within the block gas limit
+		if len(msgs) == 10 {
+			app.DeliverMsgsNoError(t, msgs, priv)
+			msgs = []sdk.Msg{}
+		}
+	}
+	if len(msgs) > 0 {
+		app.DeliverMsgsNoError(t, msgs, priv)
+	}
+	ctx := app.SetForQuery()
+
+	dir := t.TempDir()
+	for _, carVersion := range []int{1, 2} {
+		path := filepath.Join(dir, fmt.Sprintf("registry-v%d.car", carVersion))
+		f, err := os.Create(path)
+		require.NoError(t, err)
+		root, err := car.ExportRegistry(ctx, app.IscnKeeper, f, carVersion)
+		require.NoError(t, err)
+		require.NoError(t, f.Close())
+
+		bz, err := os.ReadFile(path)
+		require.NoError(t, err)
+
+		// the root block is written after the records and the index pages
+		blockCids := []gocid.Cid{}
+		roots, err := car.Read(bytes.NewReader(bz), func(cid gocid.Cid, _ []byte) error {
+			blockCids = append(blockCids, cid)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Equal(t, []gocid.Cid{root}, roots)
+		require.Equal(t, root, blockCids[len(blockCids)-1])
+
+		result, err := car.VerifyRegistry(bytes.NewReader(bz))
+		require.NoError(t, err)
+		require.Equal(t, root, result.Root)
+		require.Equal(t, ctx.BlockHeight(), result.Height)
+		require.Equal(t, uint64(recordCount), result.RecordCount)
+		// root, 2 index pages and the records
+		require.Equal(t, uint64(recordCount+3), result.BlockCount)
+
+		store := car.NewBlockStore(dbm.NewMemDB())
+		result, err = car.ImportRegistry(bytes.NewReader(bz), store)
+		require.NoError(t, err)
+		require.Equal(t, uint64(recordCount), result.RecordCount)
+		importedRoot, found, err := store.Root()
+		require.NoError(t, err)
+		require.True(t, found)
+		require.Equal(t, root, importedRoot)
+		for _, cid := range blockCids {
+			has, err := store.Has(cid)
+			require.NoError(t, err)
+			require.True(t, has)
+		}
+		record := app.IscnKeeper.GetStoreRecord(ctx, 1)
+		require.NotNil(t, record)
+		data, err := store.Get(record.Cid())
+		require.NoError(t, err)
+		require.Equal(t, []byte(record.Data), data)
+
+		tampered := bytes.Replace(bz, []byte(`record 42"`), []byte(`record 43"`), 1)
+		require.NotEqual(t, bz, tampered)
+		_, err = car.VerifyRegistry(bytes.NewReader(tampered))
+		require.ErrorContains(t, err, "CID mismatch")
+
+		store = car.NewBlockStore(dbm.NewMemDB())
+		_, err = car.ImportRegistry(bytes.NewReader(tampered), store)
+		require.ErrorContains(t, err, "CID mismatch")
+		_, found, err = store.Root()
+		require.NoError(t, err)
+		require.False(t, found, "should not set root for failed import")
+	}
+
+	_, err := car.ExportRegistry(ctx, app.IscnKeeper, nil, 3)
+	require.Error(t, err)
+}
@@ -0,0 +1,173 @@
+package car
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	gocid "github.com/ipfs/go-cid"
+)
+
+// Minimal DAG-CBOR support for the CARv1 header, see https://ipld.io/specs/codecs/dag-cbor/spec/
+
+const (
+	cborMajorUint  = 0
+	cborMajorBytes = 2
+	cborMajorText  = 3
+	cborMajorArray = 4
+	cborMajorMap   = 5
+	cborMajorTag   = 6
+
+	cborTagCid = 42
+)
+
+func appendCborHead(bz []byte, major byte, n uint64) []byte {
+	major <<= 5
+	switch {
+	case n < 24:
+		return append(bz, major|byte(n))
+	case n <= 0xff:
+		return append(bz, major|24, byte(n))
+	case n <= 0xffff:
+		bz = append(bz, major|25)
+		return binary.BigEndian.AppendUint16(bz, uint16(n))
+	case n <= 0xffffffff:
+		bz = append(bz, major|26)
+		return binary.BigEndian.AppendUint32(bz, uint32(n))
+	default:
+		bz = append(bz, major|27)
+		return binary.BigEndian.AppendUint64(bz, n)
+	}
+}
+
+type cborDecoder struct {
+	bz []byte
+}
+
+func (d *cborDecoder) readHead() (major byte, n uint64, err error) {
+	if len(d.bz) == 0 {
+		return 0, 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+	major = d.bz[0] >> 5
+	info := d.bz[0] & 0x1f
+	d.bz = d.bz[1:]
+	var size int
+	switch {
+	case info < 24:
+		return major, uint64(info), nil
+	case info == 24:
+		size = 1
+	case info == 25:
+		size = 2
+	case info == 26:
+		size = 4
+	case info == 27:
+		size = 8
+	default:
+		return 0, 0, fmt.Errorf("unsupported CBOR additional info %d", info)
+	}
+	if len(d.bz) < size {
+		return 0, 0, fmt.Errorf("unexpected end of CBOR data")
+	}
+	for _, b := range d.bz[:size] {
+		n = n<<8 | uint64(b)
+	}
+	d.bz = d.bz[size:]
+	return major, n, nil
+}
+
+func (d *cborDecoder) readExpected(expectedMajor byte) (uint64, error) {
+	major, n, err := d.readHead()
+	if err != nil {
+		return 0, err
+	}
+	if major != expectedMajor {
+		return 0, fmt.Errorf("expect CBOR major type %d, got %d", expectedMajor, major)
+	}
+	return n, nil
+}
+
+func (d *cborDecoder) readBytes(n uint64) ([]byte, error) {
+	if uint64(len(d.bz)) < n {
+		return nil, fmt.Errorf("unexpected end of CBOR data")
+	}
+	bz := d.bz[:n]
+	d.bz = d.bz[n:]
+	return bz, nil
+}
+
+func (d *cborDecoder) readText() (string, error) {
+	n, err := d.readExpected(cborMajorText)
+	if err != nil {
+		return "", err
+	}
+	bz, err := d.readBytes(n)
+	if err != nil {
+		return "", err
+	}
+	return string(bz), nil
+}
+
+func (d *cborDecoder) readCid() (gocid.Cid, error) {
+	tag, err := d.readExpected(cborMajorTag)
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	if tag != cborTagCid {
+		return gocid.Cid{}, fmt.Errorf("expect CID tag, got tag %d", tag)
+	}
+	n, err := d.readExpected(cborMajorBytes)
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	bz, err := d.readBytes(n)
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	if len(bz) == 0 || bz[0] != 0x00 {
+		return gocid.Cid{}, fmt.Errorf("CID bytes not prefixed by 0x00")
+	}
+	return gocid.Cast(bz[1:])
+}
+
+// decodeV1Header decodes the CARv1 header `{"roots": [...], "version": n}`
+func decodeV1Header(bz []byte) (roots []gocid.Cid, version uint64, err error) {
+	d := &cborDecoder{bz: bz}
+	fieldCount, err := d.readExpected(cborMajorMap)
+	if err != nil {
+		return nil, 0, err
+	}
+	for i := uint64(0); i < fieldCount; i++ {
+		key, err := d.readText()
+		if err != nil {
+			return nil, 0, err
+		}
+		switch key {
+		case "roots":
+			rootCount, err := d.readExpected(cborMajorArray)
+			if err != nil {
+				return nil, 0, err
+			}
+			if rootCount > uint64(len(d.bz)) {
+				return nil, 0, fmt.Errorf("invalid root count %d", rootCount)
+			}
+			for j := uint64(0); j < rootCount; j++ {
+				root, err := d.readCid()
+				if err != nil {
+					return nil, 0, err
+				}
+				roots = append(roots, root)
+			}
+		case "version":
+			version, err = d.readExpected(cborMajorUint)
+			if err != nil {
+				return nil, 0, err
+			}
+		default:
+			return nil, 0, fmt.Errorf("unexpected field %s in CAR header", key)
+		}
+	}
+	if len(d.bz) != 0 {
+		return nil, 0, fmt.Errorf("unexpected trailing bytes in CAR header")
+	}
+	return roots, version, nil
+}
@@ -0,0 +1,237 @@
+package car
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+
+	gocid "github.com/ipfs/go-cid"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+// IndexPageSize is the number of ISCN IDs in an index page block, so that the blocks are small enough to be
+// transferred by IPFS
+const IndexPageSize = 1000
+
+// Link is an IPLD link in DAG-JSON
+type Link struct {
+	Cid string `json:"/"`
+}
+
+// IndexRoot is the root block of the exported registry, linking to the index pages in the order of record sequence.
+// Each index page is a DAG-JSON map from ISCN ID to the link of the record.
+// The fields are declared in sorted order, since DAG-JSON requires map keys to be sorted.
+type IndexRoot struct {
+	Height      int64  `json:"height"`
+	Pages       []Link `json:"pages"`
+	RecordCount uint64 `json:"recordCount"`
+}
+
+func newDagJsonBlock(v interface{}) (gocid.Cid, []byte, error) {
+	// json.Marshal sorts map keys and has no whitespace, which is the canonical DAG-JSON form for our data
+	data, err := json.Marshal(v)
+	if err != nil {
+		return gocid.Cid{}, nil, err
+	}
+	return types.ComputeDataCid(data), data, nil
+}
+
+// ExportRegistry writes all ISCN records in the store as IPLD blocks into a CAR file of the given version (1 or 2),
+// together with the index blocks. The root of the CAR file is the IndexRoot block.
+//
+// The store is iterated once, and each index page is written right after its records, so only the current page is
+// kept in memory. The root block is written last, and the root in the header is filled in at the end.
+func ExportRegistry(ctx sdk.Context, k keeper.Keeper, w io.WriteSeeker, carVersion int) (gocid.Cid, error) {
+	// all blocks have CIDs of the same length, so the placeholder could be replaced by the actual root in place
+	placeholderRoot := types.ComputeDataCid(nil)
+	var writer *Writer
+	var v2Writer *V2Writer
+	var err error
+	switch carVersion {
+	case 1:
+		writer, err = NewWriter(w, placeholderRoot)
+	case 2:
+		v2Writer, err = NewV2Writer(w, placeholderRoot)
+		if v2Writer != nil {
+			writer = v2Writer.Writer
+		}
+	default:
+		return gocid.Cid{}, fmt.Errorf("unsupported CAR version %d", carVersion)
+	}
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+
+	root := IndexRoot{
+		Height: ctx.BlockHeight(),
+		Pages:  []Link{},
+	}
+	page := map[string]Link{}
+	flushPage := func() error {
+		pageCid, pageData, err := newDagJsonBlock(page)
+		if err != nil {
+			return err
+		}
+		err = writer.WriteBlock(pageCid, pageData)
+		if err != nil {
+			return err
+		}
+		root.Pages = append(root.Pages, Link{Cid: pageCid.String()})
+		page = map[string]Link{}
+		return nil
+	}
+	k.IterateStoreRecords(ctx, func(_ uint64, record types.StoreRecord) bool {
+		recordCid := record.Cid()
+		err = writer.WriteBlock(recordCid, record.Data)
+		if err != nil {
+			return true
+		}
+		page[record.IscnId.String()] = Link{Cid: recordCid.String()}
+		root.RecordCount++
+		if len(page) >= IndexPageSize {
+			err = flushPage()
+		}
+		return err != nil
+	})
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	if len(page) > 0 {
+		err = flushPage()
+		if err != nil {
+			return gocid.Cid{}, err
+		}
+	}
+	rootCid, rootData, err := newDagJsonBlock(root)
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	err = writer.WriteBlock(rootCid, rootData)
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	err = writer.SetRoot(rootCid)
+	if err != nil {
+		return gocid.Cid{}, err
+	}
+	if v2Writer != nil {
+		err = v2Writer.Close()
+		if err != nil {
+			return gocid.Cid{}, err
+		}
+	}
+	return rootCid, nil
+}
+
+type VerifyResult struct {
+	Root        gocid.Cid
+	Height      int64
+	BlockCount  uint64
+	RecordCount uint64
+}
+
+// VerifyRegistry reads a CAR file exported by ExportRegistry, checks the CID of every block against its data, and
+// checks that the index matches the records in the file
+func VerifyRegistry(r io.Reader) (*VerifyResult, error) {
+	return verifyRegistry(r, nil)
+}
+
+// ImportRegistry verifies a CAR file exported by ExportRegistry as VerifyRegistry does, and stores the blocks into the
+// block store. Each block is stored once its CID is checked, and the root is only set after the whole file is
+// verified, so a failed import leaves no root pointing to an incomplete registry.
+func ImportRegistry(r io.Reader, store *BlockStore) (*VerifyResult, error) {
+	result, err := verifyRegistry(r, store.Put)
+	if err != nil {
+		return nil, err
+	}
+	err = store.SetRoot(result.Root)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// verifyRegistry calls onBlock for each block with verified CID if onBlock is not nil
+func verifyRegistry(r io.Reader, onBlock func(cid gocid.Cid, data []byte) error) (*VerifyResult, error) {
+	result := VerifyResult{}
+	recordIds := map[string]string{}
+	otherBlocks := map[string][]byte{}
+	roots, err := Read(r, func(cid gocid.Cid, data []byte) error {
+		result.BlockCount++
+		computedCid, err := cid.Prefix().Sum(data)
+		if err != nil {
+			return fmt.Errorf("cannot compute CID of block %s: %w", cid.String(), err)
+		}
+		if !computedCid.Equals(cid) {
+			return fmt.Errorf("CID mismatch for block %s, computed %s", cid.String(), computedCid.String())
+		}
+		if onBlock != nil {
+			err = onBlock(cid, data)
+			if err != nil {
+				return err
+			}
+		}
+		record := struct {
+			Id string `json:"@id"`
+		}{}
+		err = json.Unmarshal(data, &record)
+		if err != nil {
+			return fmt.Errorf("block %s is not valid JSON: %w", cid.String(), err)
+		}
+		if record.Id != "" {
+			recordIds[cid.String()] = record.Id
+		} else {
+			otherBlocks[cid.String()] = data
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(roots) != 1 {
+		return nil, fmt.Errorf("expect exactly 1 root, got %d", len(roots))
+	}
+	result.Root = roots[0]
+	rootData, ok := otherBlocks[result.Root.String()]
+	if !ok {
+		return nil, fmt.Errorf("root block %s not found", result.Root.String())
+	}
+	root := IndexRoot{}
+	err = json.Unmarshal(rootData, &root)
+	if err != nil {
+		return nil, fmt.Errorf("invalid root block: %w", err)
+	}
+	result.Height = root.Height
+	for _, pageLink := range root.Pages {
+		pageData, ok := otherBlocks[pageLink.Cid]
+		if !ok {
+			return nil, fmt.Errorf("index page block %s not found", pageLink.Cid)
+		}
+		page := map[string]Link{}
+		err = json.Unmarshal(pageData, &page)
+		if err != nil {
+			return nil, fmt.Errorf("invalid index page block %s: %w", pageLink.Cid, err)
+		}
+		for iscnId, recordLink := range page {
+			id, ok := recordIds[recordLink.Cid]
+			if !ok {
+				return nil, fmt.Errorf("record block %s for %s not found", recordLink.Cid, iscnId)
+			}
+			if id != iscnId {
+				return nil, fmt.Errorf("record block %s has ID %s, but is indexed as %s", recordLink.Cid, id, iscnId)
+			}
+			result.RecordCount++
+		}
+	}
+	if result.RecordCount != root.RecordCount {
+		return nil, fmt.Errorf("index has %d records, but root block states %d", result.RecordCount, root.RecordCount)
+	}
+	if result.RecordCount != uint64(len(recordIds)) {
+		return nil, fmt.Errorf("index has %d records, but there are %d record blocks", result.RecordCount, len(recordIds))
+	}
+	return &result, nil
+}
@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+)
+
+const (
+	flagGroupPolicy   = "group-policy"
+	flagGroupMetadata = "group-metadata"
+	flagGroupExec     = "group-exec"
+
+	groupExecTry = "try"
+)
+
+func addGroupFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flagGroupPolicy, "", "Address of the x/group policy account owning the ISCN record. If set, the message is sent by the group policy and wrapped in a group proposal submitted by --from")
+	cmd.Flags().String(flagGroupMetadata, "", "Metadata of the group proposal, only used with --group-policy")
+	cmd.Flags().String(flagGroupExec, "", `Set to "try" to try executing the group proposal immediately after submission, with the signature of --from counted as a Yes vote, only used with --group-policy`)
+}
+
+// getMsgSender returns the group policy address if --group-policy is set, or the --from address otherwise
+func getMsgSender(cmd *cobra.Command, clientCtx client.Context) (sdk.AccAddress, error) {
+	groupPolicy, _ := cmd.Flags().GetString(flagGroupPolicy)
+	if groupPolicy == "" {
+		return clientCtx.GetFromAddress(), nil
+	}
+	return sdk.AccAddressFromBech32(groupPolicy)
+}
+
+// broadcastMsg broadcasts the message, or wraps it in a group proposal submitted by the --from address if
+// --group-policy is set
+func broadcastMsg(cmd *cobra.Command, clientCtx client.Context, msg sdk.Msg) error {
+	groupPolicy, _ := cmd.Flags().GetString(flagGroupPolicy)
+	if groupPolicy == "" {
+		return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+	}
+	metadata, _ := cmd.Flags().GetString(flagGroupMetadata)
+	execStr, _ := cmd.Flags().GetString(flagGroupExec)
+	exec := group.Exec_EXEC_UNSPECIFIED
+	if execStr == groupExecTry {
+		exec = group.Exec_EXEC_TRY
+	}
+	proposalMsg, err := group.NewMsgSubmitProposal(groupPolicy, []string{clientCtx.GetFromAddress().String()}, []sdk.Msg{msg}, metadata, exec)
+	if err != nil {
+		return err
+	}
+	err = proposalMsg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposalMsg)
+}
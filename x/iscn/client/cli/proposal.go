@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+type contentSchemaProposalJson struct {
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	Type        string          `json:"type"`
+	Schema      json.RawMessage `json:"schema,omitempty"`
+	Deposit     string          `json:"deposit"`
+}
+
+func readContentSchemaProposalFile(path string) (*contentSchemaProposalJson, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	proposal := contentSchemaProposalJson{}
+	err = json.Unmarshal(contents, &proposal)
+	if err != nil {
+		return nil, err
+	}
+	return &proposal, nil
+}
+
+func submitContentSchemaProposal(cmd *cobra.Command, deposit string, content govtypes.Content) error {
+	clientCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+	depositCoins, err := sdk.ParseCoinsNormalized(deposit)
+	if err != nil {
+		return err
+	}
+	msg, err := govtypes.NewMsgSubmitProposal(content, depositCoins, clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+	err = msg.ValidateBasic()
+	if err != nil {
+		return err
+	}
+	return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+}
+
+func NewSubmitAddContentSchemaProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-iscn-content-schema [proposal_json_file]",
+		Short: "Submit a proposal to add or replace the JSON Schema for ISCN content metadata of a type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to add the JSON Schema which ISCN content metadata with the given "@type" must conform to.
+If a schema of the same type already exists, it will be replaced.
+Schemas must be self-contained, i.e. references to external schemas are not allowed.
+
+Example:
+$ %s tx gov submit-proposal add-iscn-content-schema proposal.json --from mykey
+
+Where proposal.json contains:
+
+{
+  "title": "Add schema for Book",
+  "description": "Require name and author for Book",
+  "type": "Book",
+  "schema": {
+    "type": "object",
+    "required": ["name", "author"]
+  },
+  "deposit": "1000000000nanolike"
+}`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal, err := readContentSchemaProposalFile(args[0])
+			if err != nil {
+				return err
+			}
+			schema := bytes.Buffer{}
+			err = json.Compact(&schema, proposal.Schema)
+			if err != nil {
+				return fmt.Errorf("invalid schema: %w", err)
+			}
+			content := types.NewAddContentSchemaProposal(proposal.Title, proposal.Description, types.ContentSchema{
+				Type:   proposal.Type,
+				Schema: schema.String(),
+			})
+			return submitContentSchemaProposal(cmd, proposal.Deposit, content)
+		},
+	}
+	return cmd
+}
+
+func NewSubmitRemoveContentSchemaProposalTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-iscn-content-schema [proposal_json_file]",
+		Short: "Submit a proposal to remove the JSON Schema for ISCN content metadata of a type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to remove the JSON Schema for ISCN content metadata with the given "@type".
+
+Example:
+$ %s tx gov submit-proposal remove-iscn-content-schema proposal.json --from mykey
+
+Where proposal.json contains:
+
+{
+  "title": "Remove schema for Book",
+  "description": "Book metadata no longer needs to follow a schema",
+  "type": "Book",
+  "deposit": "1000000000nanolike"
+}`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			proposal, err := readContentSchemaProposalFile(args[0])
+			if err != nil {
+				return err
+			}
+			content := types.NewRemoveContentSchemaProposal(proposal.Title, proposal.Description, proposal.Type)
+			return submitContentSchemaProposal(cmd, proposal.Deposit, content)
+		},
+	}
+	return cmd
+}
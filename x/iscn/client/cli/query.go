@@ -2,7 +2,10 @@ package cli
 
 import (
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/version"
 
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/proof"
 	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
 )
 
@@ -17,6 +21,13 @@ const (
 	flagFromVersion = "from-version"
 	flagToVersion   = "to-version"
 	flagFromSeq     = "from-sequence"
+	flagFromTime    = "from-time"
+	flagToTime      = "to-time"
+	flagFromHeight  = "from-height"
+	flagToHeight    = "to-height"
+	flagRegistry    = "registry-name"
+	flagIncoming    = "incoming"
+	flagRelation    = "relation-type"
 )
 
 func GetQueryCmd() *cobra.Command {
@@ -29,8 +40,21 @@ func GetQueryCmd() *cobra.Command {
 	}
 	cmd.AddCommand(
 		GetCmdQueryIscnRecord(),
+		GetCmdQueryIscnRecordDiff(),
 		GetCmdQueryFingerprintIscn(),
+		GetCmdQueryStakeholderIscn(),
+		GetCmdQueryTimeRangeIscn(),
 		GetCmdQueryParams(),
+		GetCmdQueryContentSchemas(),
+		GetCmdQueryCollectedFees(),
+		GetCmdQueryPendingTransfer(),
+		GetCmdQueryPendingTransfersByNewOwner(),
+		GetCmdQueryCommitment(),
+		GetCmdQueryAttestationsByAttester(),
+		GetCmdQueryOwnershipHistory(),
+		GetCmdQueryRelatedRecords(),
+		GetCmdProveIscnRecord(),
+		GetCmdValidateIscn(),
 	)
 	return cmd
 }
@@ -76,12 +100,58 @@ Example:
 	return cmd
 }
 
+func GetCmdQueryIscnRecordDiff() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [iscn_id_url] [from_version] [to_version]",
+		Short: "Query the changes between two versions of the given ISCN record as JSON Patch.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the changes of the content metadata, stakeholders, content fingerprints and record notes between two versions of the given ISCN record, as JSON Patch (RFC 6902).
+If to_version is not given, the latest version is used. If from_version is also not given, the version before to_version is used.
+The version part of the ISCN ID URL is ignored.
+
+Example:
+  $ %s query %s diff iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q 1 3
+  $ %s query %s diff iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q
+`,
+				version.AppName, types.ModuleName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			versions := []uint64{0, 0}
+			for i, arg := range args[1:] {
+				versions[i], err = strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			params := types.NewQueryRecordDiffRequest(iscnId, versions[0], versions[1])
+			res, err := queryClient.RecordDiff(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdQueryFingerprintIscn() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "fingerprint [fingerprint_url]",
 		Short: "Query the ISCN records for the given fingerprint.",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the ISCN records for the given fingerprint. There could be more than one record associated with the given fingerprint. Note that the request is paginated, you may provide the --%s flag by the value in the previous response for querying the next page.
+			fmt.Sprintf(`Query the ISCN records for the given fingerprint. There could be more than one record associated with the given fingerprint. Note that the request is paginated, you may use the pagination flags (e.g. --page-key with the next_key in the previous response) for querying the next page. The legacy --%s flag is also supported, which disables the pagination flags.
 
 Example:
   $ %s query %s fingerprint hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e
@@ -99,6 +169,12 @@ Example:
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 			params := types.NewQueryRecordsByFingerprintRequest(args[0], fromSeq)
+			if !cmd.Flags().Changed(flagFromSeq) {
+				params.Pagination, err = client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+			}
 			res, err := queryClient.RecordsByFingerprint(cmd.Context(), params)
 			if err != nil {
 				return err
@@ -108,6 +184,120 @@ Example:
 	}
 	flags.AddQueryFlagsToCmd(cmd)
 	cmd.Flags().Uint64(flagFromSeq, 0, "returns the page starting from the given sequence number, for pagination together with the next_sequence field from the previous response")
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+	return cmd
+}
+
+func GetCmdQueryStakeholderIscn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stakeholder [stakeholder_entity_id]",
+		Short: "Query the ISCN records having a stakeholder with the given entity ID.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ISCN records having a stakeholder with the given entity ID (i.e. the "entity.@id" field in the stakeholders). Note that the request is paginated, you may use the pagination flags (e.g. --page-key with the next_key in the previous response) for querying the next page. The legacy --%s flag is also supported, which disables the pagination flags.
+
+Example:
+  $ %s query %s stakeholder did:cosmos:5sy29r37gfxvxz21rh4r0ktpuc46pzjrmz29g45
+`,
+				flagFromSeq, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromSeq, _ := cmd.Flags().GetUint64(flagFromSeq)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryRecordsByStakeholderRequest(args[0], fromSeq)
+			if !cmd.Flags().Changed(flagFromSeq) {
+				params.Pagination, err = client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+			}
+			res, err := queryClient.RecordsByStakeholder(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagFromSeq, 0, "returns the page starting from the given sequence number, for pagination together with the next_sequence field from the previous response")
+	flags.AddPaginationFlagsToCmd(cmd, "records")
+	return cmd
+}
+
+func parseTimeFlag(cmd *cobra.Command, flag string) (int64, error) {
+	s, _ := cmd.Flags().GetString(flag)
+	if s == "" {
+		return 0, nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid --%s value, expect RFC3339 format: %w", flag, err)
+	}
+	return t.Unix(), nil
+}
+
+func GetCmdQueryTimeRangeIscn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "time-range",
+		Short: "Query the ISCN records registered within the given time range or block height range.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the ISCN records registered within the given time range (by --%s and --%s) or block height range (by --%s and --%s). The ranges are inclusive, and omitted bounds are unbounded. Note that the request is paginated, you may use the pagination flags (e.g. --page-key with the next_key in the previous response) for querying the next page. The legacy --%s flag is also supported, which disables the pagination flags.
+
+Example:
+  $ %s query %s time-range --%s 2022-01-01T00:00:00Z --%s 2022-01-31T23:59:59Z
+  $ %s query %s time-range --%s 1000000 --%s 1001000 --%s likecoin-chain
+`,
+				flagFromTime, flagToTime, flagFromHeight, flagToHeight, flagFromSeq,
+				version.AppName, types.ModuleName, flagFromTime, flagToTime,
+				version.AppName, types.ModuleName, flagFromHeight, flagToHeight, flagRegistry,
+			),
+		),
+		Args: cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			fromTimestamp, err := parseTimeFlag(cmd, flagFromTime)
+			if err != nil {
+				return err
+			}
+			toTimestamp, err := parseTimeFlag(cmd, flagToTime)
+			if err != nil {
+				return err
+			}
+			fromHeight, _ := cmd.Flags().GetUint64(flagFromHeight)
+			toHeight, _ := cmd.Flags().GetUint64(flagToHeight)
+			registryName, _ := cmd.Flags().GetString(flagRegistry)
+			fromSeq, _ := cmd.Flags().GetUint64(flagFromSeq)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			params := types.NewQueryRecordsByTimeRangeRequest(fromTimestamp, toTimestamp, fromHeight, toHeight, registryName, fromSeq)
+			if !cmd.Flags().Changed(flagFromSeq) {
+				params.Pagination, err = client.ReadPageRequest(cmd.Flags())
+				if err != nil {
+					return err
+				}
+			}
+			res, err := queryClient.RecordsByTimeRange(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(flagFromTime, "", "minimum registration time of the records to be queried, in RFC3339 format")
+	cmd.Flags().String(flagToTime, "", "maximum registration time of the records to be queried, in RFC3339 format")
+	cmd.Flags().Uint64(flagFromHeight, 0, "minimum registration block height of the records to be queried")
+	cmd.Flags().Uint64(flagToHeight, 0, "maximum registration block height of the records to be queried")
+	cmd.Flags().String(flagRegistry, "", "only returns records with the given registry name")
+	cmd.Flags().Uint64(flagFromSeq, 0, "returns the page starting from the given sequence number, for pagination together with the next_sequence field from the previous response")
+	flags.AddPaginationFlagsToCmd(cmd, "records")
 	return cmd
 }
 
@@ -133,3 +323,332 @@ func GetCmdQueryParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+func GetCmdQueryContentSchemas() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "content-schemas [type]",
+		Short: "Query the JSON Schemas for ISCN content metadata, optionally only the one for the given type.",
+		Args:  cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			contentType := ""
+			if len(args) > 0 {
+				contentType = args[0]
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContentSchemas(cmd.Context(), types.NewQueryContentSchemasRequest(contentType))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryCollectedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collected-fees",
+		Short: "Query the total ISCN fees routed to the fee collector, the community pool, burning and the registry treasury.",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CollectedFees(cmd.Context(), types.NewQueryCollectedFeesRequest())
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryPendingTransfer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-transfer [iscn_id_url]",
+		Short: "Query the pending ownership transfer of an ISCN record. The version part of the ISCN ID is ignored.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingOwnershipTransfer(cmd.Context(), types.NewQueryPendingOwnershipTransferRequest(iscnId))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryPendingTransfersByNewOwner() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-transfers [new_owner_address]",
+		Short: "Query the pending ownership transfers of ISCN records proposed to the given address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingOwnershipTransfersByNewOwner(cmd.Context(), types.NewQueryPendingOwnershipTransfersByNewOwnerRequest(args[0], pageReq))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-transfers")
+	return cmd
+}
+
+func GetCmdQueryCommitment() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commitment [commitment_hex]",
+		Short: "Query the ISCN commitment with the given hash, including the commit time and height.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Commitment(cmd.Context(), types.NewQueryCommitmentRequest(args[0]))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryAttestationsByAttester() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attestations [attester_address]",
+		Short: "Query the ISCN record attestations made by the given address.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.AttestationsByAttester(cmd.Context(), types.NewQueryAttestationsByAttesterRequest(args[0], pageReq))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "attestations")
+	return cmd
+}
+
+func GetCmdQueryOwnershipHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "ownership-history [iscn_id_url]",
+		Short: "Query the owners of the ISCN record from the oldest to the newest. The version part of the ISCN ID URL is ignored.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.OwnershipHistory(cmd.Context(), types.NewQueryOwnershipHistoryRequest(iscnId, pageReq))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "ownership-history")
+	return cmd
+}
+
+func GetCmdQueryRelatedRecords() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "related [iscn_id_url]",
+		Short: "Query the records related to the given ISCN record.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the relations declared by the given ISCN record version, or by the latest version if the version part of the ISCN ID URL is not given.
+If --%s is set, query the record versions declaring relations to the given ISCN record instead, with the version part of the ISCN ID URL ignored.
+
+Example:
+  $ %s query %s related iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q --%s %s
+  $ %s query %s related iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q --%s --%s %s
+`,
+				flagIncoming,
+				version.AppName, types.ModuleName, flagRelation, types.RelationTypePartOf,
+				version.AppName, types.ModuleName, flagIncoming, flagRelation, types.RelationTypeCitation,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			incoming, _ := cmd.Flags().GetBool(flagIncoming)
+			relationType, _ := cmd.Flags().GetString(flagRelation)
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			direction := types.RELATION_DIRECTION_OUTGOING
+			if incoming {
+				direction = types.RELATION_DIRECTION_INCOMING
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RelatedRecords(cmd.Context(), types.NewQueryRelatedRecordsRequest(iscnId, direction, relationType, pageReq))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().Bool(flagIncoming, false, "Query the records declaring relations to the given record")
+	cmd.Flags().String(flagRelation, "", fmt.Sprintf("Only query relations of this type (%s, %s or %s)", types.RelationTypeDerivedFrom, types.RelationTypeCitation, types.RelationTypePartOf))
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "related")
+	return cmd
+}
+
+func GetCmdProveIscnRecord() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prove [iscn_id_url] [output_file]",
+		Short: "Build a portable proof of the given ISCN record existing on chain.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Build a proof of the given ISCN record existing in the chain state at a block height, which can be verified offline by "%s iscn verify-proof" without trusting any node.
+
+The proof is a JSON file containing the ISCN ID to sequence entry and the store record entry of the record with their ICS-23 Merkle proofs, the signed block header committing the app hash of the state, and the validator set signing the header.
+If the version part of the ISCN ID URL is not given, the latest version at the height is used.
+If --height is not given, the state before the latest block is used, since the app hash of a state is committed in the next block.
+The proof is written to output_file, or printed if output_file is not given.
+
+Example:
+  $ %s query %s prove iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/2 proof.json
+`,
+				version.AppName, version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			node, err := clientCtx.GetNode()
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			recordProof, err := proof.QueryRecordProof(cmd.Context(), node, iscnId, clientCtx.Height)
+			if err != nil {
+				return err
+			}
+			bz, err := recordProof.Marshal()
+			if err != nil {
+				return err
+			}
+			if len(args) < 2 {
+				_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+				return err
+			}
+			return os.WriteFile(args[1], bz, 0o644)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdValidateIscn() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate-iscn [record_json_file]",
+		Short: "Validate ISCN record(s) in a local file against the content metadata schemas on the chain.",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Validate ISCN record(s) in a local file against the content metadata schemas on the chain, so that errors can be found before submitting the record.
+
+Example:
+$ %s query iscn validate-iscn record.json
+
+The file uses the same format as "tx iscn create-iscn", i.e. either a single record or a JSON array of records.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			records, isBatch, err := readIscnRecordsFile(args[0])
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ContentSchemas(cmd.Context(), types.NewQueryContentSchemasRequest(""))
+			if err != nil {
+				return err
+			}
+			for i, record := range records {
+				err = record.Validate()
+				if err == nil {
+					err = types.ValidateContentMetadata(res.ContentSchemas, record.ContentMetadata)
+				}
+				if err != nil {
+					if isBatch {
+						return fmt.Errorf("entry %d: %w", i, err)
+					}
+					return err
+				}
+			}
+			return clientCtx.PrintString("ISCN record is valid\n")
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
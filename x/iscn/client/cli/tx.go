@@ -1,16 +1,17 @@
 package cli
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
-	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -33,6 +34,16 @@ func NewTxCmd() *cobra.Command {
 		NewCreateIscnTxCmd(),
 		NewUpdateIscnTxCmd(),
 		NewChangeIscnOwnershipTxCmd(),
+		NewFreezeIscnTxCmd(),
+		NewDeprecateIscnTxCmd(),
+		NewSupersedeIscnTxCmd(),
+		NewProposeIscnTransferTxCmd(),
+		NewAcceptIscnTransferTxCmd(),
+		NewCancelIscnTransferTxCmd(),
+		NewCommitIscnTxCmd(),
+		NewRevealIscnTxCmd(),
+		NewAttestIscnTxCmd(),
+		NewRevokeIscnAttestationTxCmd(),
 	)
 	return txCmd
 }
@@ -50,6 +61,29 @@ func readIscnRecordFile(path string) (*types.IscnRecord, error) {
 	return &record, nil
 }
 
+// readIscnRecordsFile reads either a single ISCN record or a JSON array of ISCN records from the file, and returns
+// whether the file contains an array
+func readIscnRecordsFile(path string) ([]types.IscnRecord, bool, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, false, err
+	}
+	if !strings.HasPrefix(strings.TrimSpace(string(contents)), "[") {
+		record := types.IscnRecord{}
+		err = json.Unmarshal(contents, &record)
+		if err != nil {
+			return nil, false, err
+		}
+		return []types.IscnRecord{record}, false, nil
+	}
+	records := []types.IscnRecord{}
+	err = json.Unmarshal(contents, &records)
+	if err != nil {
+		return nil, true, err
+	}
+	return records, true, nil
+}
+
 func NewCreateIscnTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-iscn [iscn_record_json_file] (--nonce [nonce])",
@@ -81,6 +115,9 @@ Where:
 "contentFingerprints" must contain URLs representing the fingerprints of the content.
 "stakeholders" must contains valid JSON values.
 "contentMetadata" must be a valid JSON value.
+
+The file may also contain a JSON array of records, in which case all the records are created atomically in a single
+message, with the fee charged once for the total size. The nonce is applied to every record in the array.
 `, version.AppName)),
 		Args: cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -88,7 +125,11 @@ Where:
 			if err != nil {
 				return err
 			}
-			record, err := readIscnRecordFile(args[0])
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			records, isArray, err := readIscnRecordsFile(args[0])
 			if err != nil {
 				return err
 			}
@@ -98,15 +139,25 @@ Where:
 				return err
 			}
 
-			msg := types.NewMsgCreateIscnRecord(clientCtx.GetFromAddress(), record, nonce)
+			var msg sdk.Msg
+			if isArray {
+				nonces := make([]uint64, len(records))
+				for i := range nonces {
+					nonces[i] = nonce
+				}
+				msg = types.NewMsgBatchCreateIscnRecords(sender, records, nonces)
+			} else {
+				msg = types.NewMsgCreateIscnRecord(sender, &records[0], nonce)
+			}
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return broadcastMsg(cmd, clientCtx, msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
 	cmd.Flags().Uint64(flagNonce, 0, "Nonce of the ISCN registration transaction")
 	return cmd
 }
@@ -121,6 +172,9 @@ func NewUpdateIscnTxCmd() *cobra.Command {
 Example:
 $ %s tx iscn update-iscn "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" record.json --from mykey
 
+For a record owned by an x/group policy account, the update is submitted as a group proposal by a group member:
+$ %s tx iscn update-iscn "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" record.json --group-policy cosmos1afk9zr2hn2jsac63h4hm60vl9z3e5u69gndzf7c99cqge3vzwjzsfwkgpd --from mykey
+
 The ISCN ID needs to be a URL representing the newest version of the record to be updated, i.e. the scheme must be "iscn://", the numeric part at the end must be the existing latest version of the record on the chain.
 
 Content of record.json:
@@ -144,13 +198,17 @@ Where:
 "contentFingerprints" must contain URLs representing the fingerprints of the content.
 "stakeholders" must contains valid JSON values.
 "contentMetadata" must be a valid JSON value.
-`, version.AppName)),
+`, version.AppName, version.AppName)),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
 			iscnId, err := types.ParseIscnId(args[0])
 			if err != nil {
 				return err
@@ -159,15 +217,16 @@ Where:
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgUpdateIscnRecord(clientCtx.GetFromAddress(), iscnId, record)
+			msg := types.NewMsgUpdateIscnRecord(sender, iscnId, record)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return broadcastMsg(cmd, clientCtx, msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
 	return cmd
 }
 
@@ -188,6 +247,10 @@ The ISCN ID needs to be a URL representing the newest version of the record to b
 			if err != nil {
 				return err
 			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
 			iscnId, err := types.ParseIscnId(args[0])
 			if err != nil {
 				return err
@@ -196,14 +259,434 @@ The ISCN ID needs to be a URL representing the newest version of the record to b
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgChangeIscnRecordOwnership(clientCtx.GetFromAddress(), iscnId, newOwner)
+			msg := types.NewMsgChangeIscnRecordOwnership(sender, iscnId, newOwner)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewFreezeIscnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "freeze-iscn [iscn_id_url]",
+		Short: `Freeze an ISCN record, so that the record will not accept new versions anymore.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Freeze an ISCN record on the chain, so that the record will not accept new versions anymore.
+
+Example:
+$ %s tx iscn freeze-iscn "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" --from mykey
+
+The ISCN ID needs to be a URL representing the newest version of the record, i.e. the scheme must be "iscn://", the numeric part at the end must be the existing latest version of the record on the chain.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgFreezeIscnRecord(sender, iscnId)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewDeprecateIscnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deprecate-iscn [iscn_id_url]",
+		Short: `Mark an ISCN record as deprecated.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mark an ISCN record on the chain as deprecated. A deprecated record will not accept new versions anymore.
+
+Example:
+$ %s tx iscn deprecate-iscn "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" --from mykey
+
+The ISCN ID needs to be a URL representing the newest version of the record, i.e. the scheme must be "iscn://", the numeric part at the end must be the existing latest version of the record on the chain.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgDeprecateIscnRecord(sender, iscnId)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewSupersedeIscnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supersede-iscn [iscn_id_url] [superseding_iscn_id_prefix]",
+		Short: `Mark an ISCN record as superseded by another ISCN record.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Mark an ISCN record on the chain as superseded by another existing ISCN record. A superseded record will not accept new versions anymore.
+
+Example:
+$ %s tx iscn supersede-iscn "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" "iscn://likecoin-chain/n2cd7fQaHgYhDsWBxWSvPsGLb3dLPqdXhoPcLqM3kLw" --from mykey
+
+The ISCN ID needs to be a URL representing the newest version of the record, i.e. the scheme must be "iscn://", the numeric part at the end must be the existing latest version of the record on the chain.
+The version part of the superseding ISCN ID is ignored.`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			supersededBy, err := types.ParseIscnId(args[1])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgSupersedeIscnRecord(sender, iscnId, supersededBy.Prefix)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewProposeIscnTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "propose-iscn-transfer [iscn_id_url] [new_owner_address] [expiration]",
+		Short: `Propose transferring the ownership of an ISCN record, which takes effect after the new owner accepts.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Propose transferring the ownership of an ISCN record on the chain to a new owner. The ownership is changed only after the new owner accepts the transfer before the expiration time.
+Proposing again replaces the previous pending transfer of the same record.
+
+Example:
+$ %s tx iscn propose-iscn-transfer "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" cosmos1ww3qews2y5jxe8apw2zt8stqqrcu2tptejfwaf 2023-01-31T00:00:00Z --from mykey
+
+The ISCN ID needs to be a URL representing the newest version of the record, i.e. the scheme must be "iscn://", the numeric part at the end must be the existing latest version of the record on the chain.
+The expiration time is in RFC3339 format, and must be within %d days from now.`, version.AppName, int(types.MaxOwnershipTransferDuration.Hours()/24))),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			newOwner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			expiration, err := time.Parse(time.RFC3339, args[2])
+			if err != nil {
+				return fmt.Errorf("invalid expiration time, expect RFC3339 format: %w", err)
+			}
+			msg := types.NewMsgProposeIscnOwnershipTransfer(sender, iscnId, newOwner, expiration)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewAcceptIscnTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "accept-iscn-transfer [iscn_id_url]",
+		Short: `Accept a pending ownership transfer of an ISCN record and become its owner.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Accept a pending ownership transfer of an ISCN record on the chain proposed to the sender, and become the owner of the record.
+
+Example:
+$ %s tx iscn accept-iscn-transfer "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q" --from mykey
+
+The version part of the ISCN ID is ignored.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAcceptIscnOwnershipTransfer(sender, iscnId)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewCancelIscnTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-iscn-transfer [iscn_id_url]",
+		Short: `Cancel a pending ownership transfer of an ISCN record.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Cancel a pending ownership transfer of an ISCN record on the chain proposed by the sender.
+
+Example:
+$ %s tx iscn cancel-iscn-transfer "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q" --from mykey
+
+The version part of the ISCN ID is ignored.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCancelIscnOwnershipTransfer(sender, iscnId)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewCommitIscnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-iscn [iscn_record_json_file] [salt_hex]",
+		Short: `Commit an ISCN record without revealing it, proving the existence of the record at the current time.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Commit an ISCN record on the chain without revealing it. Only a salted hash of the record is stored on chain, together with the block time and height, and the record can be registered later by reveal-iscn with the same record file and salt before the commitment expires.
+
+Example:
+$ %s tx iscn commit-iscn record.json $(openssl rand -hex 32) --from mykey
+
+The record file has the same format as the one in create-iscn. The salt is hex encoded, and should be random and kept secret until the record is revealed.
+The commitment is the SHA-256 hash of the salt, the sender address bytes and the protobuf encoded record.`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			record, err := readIscnRecordFile(args[0])
+			if err != nil {
+				return err
+			}
+			err = record.Validate()
+			if err != nil {
+				return err
+			}
+			salt, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid salt hex: %w", err)
+			}
+			if len(salt) == 0 {
+				return fmt.Errorf("empty salt")
+			}
+			commitment, err := types.ComputeIscnCommitment(salt, sender, record)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgCommitIscn(sender, commitment)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewRevealIscnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reveal-iscn [iscn_record_json_file] [salt_hex] (--nonce [nonce])",
+		Short: `Reveal a previously committed ISCN record, registering it with the commit time.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Reveal an ISCN record committed by commit-iscn with the same sender, record file and salt. The record is registered on chain and assigned an ISCN ID, with the commitment, commit time and commit height recorded in the "recordCommitment", "recordCommitTimestamp" and "recordCommitHeight" fields.
+
+Example:
+$ %s tx iscn reveal-iscn record.json 9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08 --from mykey`, version.AppName)),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			record, err := readIscnRecordFile(args[0])
+			if err != nil {
+				return err
+			}
+			salt, err := hex.DecodeString(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid salt hex: %w", err)
+			}
+			nonce, err := cmd.Flags().GetUint64(flagNonce)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevealIscn(sender, record, salt, nonce)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	cmd.Flags().Uint64(flagNonce, 0, "Nonce of the ISCN registration transaction")
+	return cmd
+}
+
+func NewAttestIscnTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attest-iscn [iscn_id_url]",
+		Short: `Attest a version of an ISCN record as its stakeholder.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Attest a version of an ISCN record on the chain, confirming that the sender is a stakeholder of the record.
+The sender must be named in the "entity.@id" field of a stakeholder of the record version, either as the address or as the address prefixed by "did:cosmos:" or "did:like:".
+
+Example:
+$ %s tx iscn attest-iscn "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" --from mykey
+
+The version part of the ISCN ID is required.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgAttestIscnRecord(sender, iscnId)
+			err = msg.ValidateBasic()
+			if err != nil {
+				return err
+			}
+			return broadcastMsg(cmd, clientCtx, msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
+	return cmd
+}
+
+func NewRevokeIscnAttestationTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-iscn-attestation [iscn_id_url]",
+		Short: `Revoke the attestation of a version of an ISCN record made by the sender.`,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Revoke the attestation of a version of an ISCN record on the chain made by the sender.
+
+Example:
+$ %s tx iscn revoke-iscn-attestation "iscn://likecoin-chain/yc53s4qfazn4z7doh4clxj7rugzkb2runruv4go6qsbix3vt5g2q/1" --from mykey
+
+The version part of the ISCN ID is required.`, version.AppName)),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender, err := getMsgSender(cmd, clientCtx)
+			if err != nil {
+				return err
+			}
+			iscnId, err := types.ParseIscnId(args[0])
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgRevokeIscnRecordAttestation(sender, iscnId)
 			err = msg.ValidateBasic()
 			if err != nil {
 				return err
 			}
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return broadcastMsg(cmd, clientCtx, msg)
 		},
 	}
 	flags.AddTxFlagsToCmd(cmd)
+	addGroupFlags(cmd)
 	return cmd
 }
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/client/cli"
+)
+
+var (
+	AddContentSchemaProposalHandler    = govclient.NewProposalHandler(cli.NewSubmitAddContentSchemaProposalTxCmd)
+	RemoveContentSchemaProposalHandler = govclient.NewProposalHandler(cli.NewSubmitRemoveContentSchemaProposalTxCmd)
+)
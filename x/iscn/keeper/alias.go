@@ -11,6 +11,31 @@ type (
 	MsgUpdateIscnRecordResponse          = types.MsgUpdateIscnRecordResponse
 	MsgChangeIscnRecordOwnership         = types.MsgChangeIscnRecordOwnership
 	MsgChangeIscnRecordOwnershipResponse = types.MsgChangeIscnRecordOwnershipResponse
+	MsgFreezeIscnRecord                  = types.MsgFreezeIscnRecord
+	MsgFreezeIscnRecordResponse          = types.MsgFreezeIscnRecordResponse
+	MsgDeprecateIscnRecord               = types.MsgDeprecateIscnRecord
+	MsgDeprecateIscnRecordResponse       = types.MsgDeprecateIscnRecordResponse
+	MsgSupersedeIscnRecord               = types.MsgSupersedeIscnRecord
+	MsgSupersedeIscnRecordResponse       = types.MsgSupersedeIscnRecordResponse
+	MsgBatchCreateIscnRecords            = types.MsgBatchCreateIscnRecords
+	MsgBatchCreateIscnRecordsResponse    = types.MsgBatchCreateIscnRecordsResponse
+
+	MsgProposeIscnOwnershipTransfer         = types.MsgProposeIscnOwnershipTransfer
+	MsgProposeIscnOwnershipTransferResponse = types.MsgProposeIscnOwnershipTransferResponse
+	MsgAcceptIscnOwnershipTransfer          = types.MsgAcceptIscnOwnershipTransfer
+	MsgAcceptIscnOwnershipTransferResponse  = types.MsgAcceptIscnOwnershipTransferResponse
+	MsgCancelIscnOwnershipTransfer          = types.MsgCancelIscnOwnershipTransfer
+	MsgCancelIscnOwnershipTransferResponse  = types.MsgCancelIscnOwnershipTransferResponse
+
+	MsgCommitIscn         = types.MsgCommitIscn
+	MsgCommitIscnResponse = types.MsgCommitIscnResponse
+	MsgRevealIscn         = types.MsgRevealIscn
+	MsgRevealIscnResponse = types.MsgRevealIscnResponse
+
+	MsgAttestIscnRecord                    = types.MsgAttestIscnRecord
+	MsgAttestIscnRecordResponse            = types.MsgAttestIscnRecordResponse
+	MsgRevokeIscnRecordAttestation         = types.MsgRevokeIscnRecordAttestation
+	MsgRevokeIscnRecordAttestationResponse = types.MsgRevokeIscnRecordAttestationResponse
 
 	Params          = types.Params
 	IscnId          = types.IscnId
@@ -19,11 +44,26 @@ type (
 	IscnInput       = types.IscnInput
 	StoreRecord     = types.StoreRecord
 	ContentIdRecord = types.ContentIdRecord
+
+	PendingOwnershipTransfer = types.PendingOwnershipTransfer
+	IscnCommitment           = types.IscnCommitment
+	IscnAttestation          = types.IscnAttestation
+
+	IscnOwnershipHistoryEntry = types.IscnOwnershipHistoryEntry
+	IscnRelation              = types.IscnRelation
 )
 
 var (
-	ParamKeyRegistryName = types.ParamKeyRegistryName
-	ParamKeyFeePerByte   = types.ParamKeyFeePerByte
+	ParamKeyRegistryName   = types.ParamKeyRegistryName
+	ParamKeyFeePerByte     = types.ParamKeyFeePerByte
+	ParamKeyContentSchemas = types.ParamKeyContentSchemas
+	ParamKeyFeeRouting     = types.ParamKeyFeeRouting
+
+	ParamKeyCommitmentExpiryDays = types.ParamKeyCommitmentExpiryDays
+	ParamKeyMaxRecordBytes       = types.ParamKeyMaxRecordBytes
+	ParamKeyMaxFingerprints      = types.ParamKeyMaxFingerprints
+	ParamKeyMaxStakeholders      = types.ParamKeyMaxStakeholders
+	ParamKeyMaxFingerprintLength = types.ParamKeyMaxFingerprintLength
 
 	SequenceCountKey            = types.SequenceCountKey
 	SequenceToStoreRecordPrefix = types.SequenceToStoreRecordPrefix
@@ -32,6 +72,28 @@ var (
 	ContentIdRecordPrefix       = types.ContentIdRecordPrefix
 	FingerprintSequencePrefix   = types.FingerprintSequencePrefix
 	OwnerSequencePrefix         = types.OwnerSequencePrefix
+	StakeholderSequencePrefix   = types.StakeholderSequencePrefix
+	TimeSequencePrefix          = types.TimeSequencePrefix
+	HeightSequencePrefix        = types.HeightSequencePrefix
+
+	PendingOwnershipTransferPrefix         = types.PendingOwnershipTransferPrefix
+	PendingOwnershipTransferExpiryPrefix   = types.PendingOwnershipTransferExpiryPrefix
+	PendingOwnershipTransferNewOwnerPrefix = types.PendingOwnershipTransferNewOwnerPrefix
+
+	CollectedFeesKey = types.CollectedFeesKey
+
+	CommitmentPrefix       = types.CommitmentPrefix
+	CommitmentExpiryPrefix = types.CommitmentExpiryPrefix
+
+	AttestationPrefix         = types.AttestationPrefix
+	AttesterAttestationPrefix = types.AttesterAttestationPrefix
+
+	OwnershipHistoryPrefix = types.OwnershipHistoryPrefix
+
+	OutgoingRelationPrefix = types.OutgoingRelationPrefix
+	IncomingRelationPrefix = types.IncomingRelationPrefix
+
+	IndexedSequenceCountKey = types.IndexedSequenceCountKey
 
 	NewIscnId = types.NewIscnId
 )
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+func (k Keeper) GetAttestation(ctx sdk.Context, seq uint64, attester sdk.AccAddress) *IscnAttestation {
+	bz := k.prefixStore(ctx, AttestationPrefix).Get(types.GetAttestationKey(seq, attester))
+	if bz == nil {
+		return nil
+	}
+	attestation := k.MustUnmarshalAttestation(bz)
+	return &attestation
+}
+
+// SetAttestation stores the attestation of the record with the sequence, together with the attester index
+func (k Keeper) SetAttestation(ctx sdk.Context, seq uint64, attestation *IscnAttestation) {
+	attester := sdk.MustAccAddressFromBech32(attestation.Attester)
+	k.prefixStore(ctx, AttestationPrefix).Set(types.GetAttestationKey(seq, attester), k.MustMarshalAttestation(attestation))
+	k.prefixStore(ctx, AttesterAttestationPrefix).Set(types.GetAttesterAttestationKey(attester, seq), []byte{0x01})
+}
+
+func (k Keeper) DeleteAttestation(ctx sdk.Context, seq uint64, attester sdk.AccAddress) {
+	k.prefixStore(ctx, AttestationPrefix).Delete(types.GetAttestationKey(seq, attester))
+	k.prefixStore(ctx, AttesterAttestationPrefix).Delete(types.GetAttesterAttestationKey(attester, seq))
+}
+
+// IterateRecordAttestations iterates the attestations of the record with the sequence, in the order of attester
+// address bytes
+func (k Keeper) IterateRecordAttestations(ctx sdk.Context, seq uint64, f func(attestation IscnAttestation) bool) {
+	it := k.prefixStore(ctx, types.GetAttestationStorePrefix(seq)).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		attestation := k.MustUnmarshalAttestation(it.Value())
+		if f(attestation) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetRecordAttestations(ctx sdk.Context, seq uint64) []IscnAttestation {
+	attestations := []IscnAttestation{}
+	k.IterateRecordAttestations(ctx, seq, func(attestation IscnAttestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	return attestations
+}
+
+func (k Keeper) IterateAttestations(ctx sdk.Context, f func(attestation IscnAttestation) bool) {
+	it := k.prefixStore(ctx, AttestationPrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		attestation := k.MustUnmarshalAttestation(it.Value())
+		if f(attestation) {
+			break
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
+)
+
+// GetCollectedFees returns the total ISCN per-byte fees routed to each destination
+func (k Keeper) GetCollectedFees(ctx sdk.Context) (fees likefeegranttypes.CollectedFees) {
+	bz := ctx.KVStore(k.storeKey).Get(CollectedFeesKey)
+	if bz == nil {
+		return fees
+	}
+	k.cdc.MustUnmarshal(bz, &fees)
+	return fees
+}
+
+func (k Keeper) SetCollectedFees(ctx sdk.Context, fees likefeegranttypes.CollectedFees) {
+	ctx.KVStore(k.storeKey).Set(CollectedFeesKey, k.cdc.MustMarshal(&fees))
+}
+
+func (k Keeper) AddCollectedFees(ctx sdk.Context, fees likefeegranttypes.CollectedFees) {
+	k.SetCollectedFees(ctx, k.GetCollectedFees(ctx).Add(fees))
+}
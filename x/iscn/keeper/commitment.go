@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+func (k Keeper) GetCommitment(ctx sdk.Context, commitment []byte) *IscnCommitment {
+	bz := k.prefixStore(ctx, CommitmentPrefix).Get(commitment)
+	if bz == nil {
+		return nil
+	}
+	c := k.MustUnmarshalCommitment(bz)
+	return &c
+}
+
+// SetCommitment stores the commitment, together with the expiry queue entry if it is not yet revealed
+func (k Keeper) SetCommitment(ctx sdk.Context, c *IscnCommitment) {
+	k.DeleteCommitment(ctx, c.Commitment)
+	k.prefixStore(ctx, CommitmentPrefix).Set(c.Commitment, k.MustMarshalCommitment(c))
+	if !c.IsRevealed() {
+		expiryKey := types.GetCommitmentExpiryKey(c.Expiration, c.Commitment)
+		k.prefixStore(ctx, CommitmentExpiryPrefix).Set(expiryKey, []byte{0x01})
+	}
+}
+
+func (k Keeper) DeleteCommitment(ctx sdk.Context, commitment []byte) {
+	c := k.GetCommitment(ctx, commitment)
+	if c == nil {
+		return
+	}
+	k.prefixStore(ctx, CommitmentPrefix).Delete(commitment)
+	if !c.IsRevealed() {
+		expiryKey := types.GetCommitmentExpiryKey(c.Expiration, commitment)
+		k.prefixStore(ctx, CommitmentExpiryPrefix).Delete(expiryKey)
+	}
+}
+
+func (k Keeper) IterateCommitments(ctx sdk.Context, f func(c IscnCommitment) bool) {
+	it := k.prefixStore(ctx, CommitmentPrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		c := k.MustUnmarshalCommitment(it.Value())
+		if f(c) {
+			break
+		}
+	}
+}
+
+// ExpireCommitments removes all unrevealed commitments with expiration not after the block time
+func (k Keeper) ExpireCommitments(ctx sdk.Context) {
+	expiredCommitments := [][]byte{}
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	it := k.prefixStore(ctx, CommitmentExpiryPrefix).Iterator(nil, end)
+	for ; it.Valid(); it.Next() {
+		commitment := types.ParseCommitmentExpiryKey(it.Key())
+		expiredCommitments = append(expiredCommitments, append([]byte{}, commitment...))
+	}
+	it.Close()
+	for _, commitment := range expiredCommitments {
+		c := k.GetCommitment(ctx, commitment)
+		if c == nil {
+			// BUG, should break invariant
+			ctx.Logger().Error("no commitment for expiry queue entry", "commitment", commitment)
+			continue
+		}
+		k.DeleteCommitment(ctx, commitment)
+		ctx.EventManager().EmitEvent(types.NewCommitmentEvent(c, types.AttributeValueCommitmentExpired))
+	}
+}
@@ -34,7 +34,17 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesis *types.GenesisState) {
 		for _, fingerprint := range fingerprints {
 			k.AddFingerprintSequence(ctx, fingerprint.(string), seq)
 		}
+		k.AddStakeholderSequences(ctx, normalizedRecord, seq)
+		k.AddRelationSequences(ctx, normalizedRecord, seq)
+		t, err := types.GetRecordTimestamp(normalizedRecord)
+		if err == nil {
+			k.AddTimeSequence(ctx, t, seq)
+		}
+	}
+	for _, heightSequence := range genesis.HeightSequences {
+		k.AddHeightSequence(ctx, heightSequence.Height, heightSequence.Sequence)
 	}
+	k.setIndexedSequenceCount(ctx, k.GetSequenceCount(ctx))
 	for _, contentIdRecord := range genesis.ContentIdRecords {
 		iscnId, err := types.ParseIscnId(contentIdRecord.IscnId)
 		if err != nil {
@@ -47,8 +57,39 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genesis *types.GenesisState) {
 		k.SetContentIdRecord(ctx, iscnId.Prefix, &ContentIdRecord{
 			OwnerAddressBytes: owner.Bytes(),
 			LatestVersion:     contentIdRecord.LatestVersion,
+			Status:            contentIdRecord.Status,
+			SupersededBy:      contentIdRecord.SupersededBy,
 		})
 	}
+	for _, transfer := range genesis.PendingOwnershipTransfers {
+		iscnId, err := types.ParseIscnId(transfer.IscnIdPrefix)
+		if err != nil {
+			panic(err)
+		}
+		transfer := transfer
+		k.SetPendingOwnershipTransfer(ctx, iscnId.Prefix, &transfer)
+	}
+	k.SetCollectedFees(ctx, genesis.CollectedFees)
+	for _, commitment := range genesis.Commitments {
+		commitment := commitment
+		k.SetCommitment(ctx, &commitment)
+	}
+	for _, attestation := range genesis.Attestations {
+		iscnId, err := types.ParseIscnId(attestation.IscnId)
+		if err != nil {
+			panic(err)
+		}
+		attestation := attestation
+		k.SetAttestation(ctx, k.GetIscnIdSequence(ctx, iscnId), &attestation)
+	}
+	for _, entry := range genesis.OwnershipHistory {
+		iscnId, err := types.ParseIscnId(entry.IscnIdPrefix)
+		if err != nil {
+			panic(err)
+		}
+		entry := entry
+		k.AppendOwnershipHistoryEntry(ctx, iscnId.Prefix, &entry)
+	}
 }
 
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
@@ -59,6 +100,8 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			IscnId:        iscnIdPrefix.String(),
 			Owner:         contentIdRecord.OwnerAddress().String(),
 			LatestVersion: contentIdRecord.LatestVersion,
+			Status:        contentIdRecord.Status,
+			SupersededBy:  contentIdRecord.SupersededBy,
 		})
 		return false
 	})
@@ -67,5 +110,40 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		iscnRecords = append(iscnRecords, IscnInput(record.Data))
 		return false
 	})
-	return types.NewGenesisState(params, contentIdRecords, iscnRecords)
+	heightSequences := []types.GenesisState_HeightSequence{}
+	k.IterateHeightSequences(ctx, func(height uint64, seq uint64) bool {
+		heightSequences = append(heightSequences, types.GenesisState_HeightSequence{
+			Height:   height,
+			Sequence: seq,
+		})
+		return false
+	})
+	pendingOwnershipTransfers := []types.PendingOwnershipTransfer{}
+	k.IteratePendingOwnershipTransfers(ctx, func(_ IscnIdPrefix, transfer PendingOwnershipTransfer) bool {
+		pendingOwnershipTransfers = append(pendingOwnershipTransfers, transfer)
+		return false
+	})
+	commitments := []types.IscnCommitment{}
+	k.IterateCommitments(ctx, func(commitment IscnCommitment) bool {
+		commitments = append(commitments, commitment)
+		return false
+	})
+	attestations := []types.IscnAttestation{}
+	k.IterateAttestations(ctx, func(attestation IscnAttestation) bool {
+		attestations = append(attestations, attestation)
+		return false
+	})
+	ownershipHistory := []types.IscnOwnershipHistoryEntry{}
+	k.IterateOwnershipHistory(ctx, func(entry IscnOwnershipHistoryEntry) bool {
+		ownershipHistory = append(ownershipHistory, entry)
+		return false
+	})
+	genesis := types.NewGenesisState(params, contentIdRecords, iscnRecords)
+	genesis.HeightSequences = heightSequences
+	genesis.PendingOwnershipTransfers = pendingOwnershipTransfers
+	genesis.CollectedFees = k.GetCollectedFees(ctx)
+	genesis.Commitments = commitments
+	genesis.Attestations = attestations
+	genesis.OwnershipHistory = ownershipHistory
+	return genesis
 }
@@ -2,17 +2,23 @@ package keeper
 
 import (
 	context "context"
+	"encoding/hex"
 	"fmt"
+	"time"
 
 	gocid "github.com/ipfs/go-cid"
 
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
 )
 
 const FingerprintRecordsPageLimit = 100
 const OwnerRecordsPageLimit = 100
+const StakeholderRecordsPageLimit = 100
+const TimeRangeRecordsPageLimit = 100
 
 var _ types.QueryServer = Keeper{}
 
@@ -46,6 +52,7 @@ func (k Keeper) RecordsById(ctx context.Context, req *types.QueryRecordsByIdRequ
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "version range exceed current maximum version")
 	}
 	records := make([]types.QueryResponseRecord, 0, toVersion-fromVersion+1)
+	attestations := []types.IscnAttestation{}
 	for version := fromVersion; version <= toVersion; version++ {
 		iscnId.Version = version
 		seq := k.GetIscnIdSequence(sdkCtx, iscnId)
@@ -54,16 +61,73 @@ func (k Keeper) RecordsById(ctx context.Context, req *types.QueryRecordsByIdRequ
 			Ipld: storeRecord.Cid().String(),
 			Data: storeRecord.Data,
 		})
+		attestations = append(attestations, k.GetRecordAttestations(sdkCtx, seq)...)
 	}
 	return &types.QueryRecordsByIdResponse{
 		Owner:         contentIdRecord.OwnerAddress().String(),
 		LatestVersion: latestVersion,
 		Records:       records,
+		Status:        contentIdRecord.Status,
+		SupersededBy:  contentIdRecord.SupersededBy,
+		OwnerGroupId:  k.GetOwnerGroupId(sdkCtx, contentIdRecord.OwnerAddress()),
+		Attestations:  attestations,
+	}, nil
+}
+
+func (k Keeper) RecordDiff(ctx context.Context, req *types.QueryRecordDiffRequest) (*types.QueryRecordDiffResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iscnId, err := types.ParseIscnId(req.IscnIdPrefix)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	contentIdRecord := k.GetContentIdRecord(sdkCtx, iscnId.Prefix)
+	if contentIdRecord == nil {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", iscnId.Prefix.String())
+	}
+	latestVersion := contentIdRecord.LatestVersion
+	toVersion := req.ToVersion
+	if toVersion == 0 {
+		toVersion = latestVersion
+	}
+	fromVersion := req.FromVersion
+	if fromVersion == 0 {
+		if toVersion <= 1 {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "no version before version %d", toVersion)
+		}
+		fromVersion = toVersion - 1
+	}
+	if fromVersion > latestVersion || toVersion > latestVersion {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "version exceed current maximum version")
+	}
+	getData := func(version uint64) []byte {
+		iscnId.Version = version
+		seq := k.GetIscnIdSequence(sdkCtx, iscnId)
+		return k.GetStoreRecord(sdkCtx, seq).Data
+	}
+	operations, err := types.GetRecordDiff(getData(fromVersion), getData(toVersion))
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRecordDiffResponse{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Operations:  operations,
 	}, nil
 }
 
 func (k Keeper) RecordsByFingerprint(ctx context.Context, req *types.QueryRecordsByFingerprintRequest) (*types.QueryRecordsByFingerprintResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.Pagination != nil {
+		store := k.prefixStore(sdkCtx, types.GetFingerprintStorePrefix(types.NormalizeFingerprint(req.Fingerprint)))
+		records, pageRes, err := k.paginateSequences(sdkCtx, store, req.Pagination)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryRecordsByFingerprintResponse{
+			Records:    records,
+			Pagination: pageRes,
+		}, nil
+	}
 	records := []types.QueryResponseRecord{}
 	nextSeq := uint64(0)
 	count := 0
@@ -86,6 +150,133 @@ func (k Keeper) RecordsByFingerprint(ctx context.Context, req *types.QueryRecord
 	}, nil
 }
 
+func (k Keeper) RecordsByStakeholder(ctx context.Context, req *types.QueryRecordsByStakeholderRequest) (*types.QueryRecordsByStakeholderResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if len(req.StakeholderId) == 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "empty stakeholder ID")
+	}
+	if req.Pagination != nil {
+		store := k.prefixStore(sdkCtx, types.GetStakeholderStorePrefix(req.StakeholderId))
+		records, pageRes, err := k.paginateSequences(sdkCtx, store, req.Pagination)
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryRecordsByStakeholderResponse{
+			Records:    records,
+			Pagination: pageRes,
+		}, nil
+	}
+	records := []types.QueryResponseRecord{}
+	nextSeq := uint64(0)
+	count := 0
+	k.IterateStakeholderSequencesWithStartingSequence(sdkCtx, req.StakeholderId, req.FromSequence, func(seq uint64) bool {
+		if count >= StakeholderRecordsPageLimit {
+			nextSeq = seq
+			return true
+		}
+		count++
+		storeRecord := k.GetStoreRecord(sdkCtx, seq)
+		records = append(records, types.QueryResponseRecord{
+			Ipld: storeRecord.Cid().String(),
+			Data: storeRecord.Data,
+		})
+		return false
+	})
+	return &types.QueryRecordsByStakeholderResponse{
+		Records:      records,
+		NextSequence: nextSeq,
+	}, nil
+}
+
+func (k Keeper) RecordsByTimeRange(ctx context.Context, req *types.QueryRecordsByTimeRangeRequest) (*types.QueryRecordsByTimeRangeResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if req.FromTimestamp < 0 || req.ToTimestamp < 0 {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "negative timestamp")
+	}
+	isTimeRange := req.FromTimestamp != 0 || req.ToTimestamp != 0
+	isHeightRange := req.FromHeight != 0 || req.ToHeight != 0
+	var startSeq, endSeq uint64
+	switch {
+	case isTimeRange && isHeightRange:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "cannot query by both timestamp range and height range")
+	case isHeightRange:
+		if req.ToHeight != 0 && req.ToHeight < req.FromHeight {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid height range")
+		}
+		startSeq, endSeq = k.GetHeightSequenceRange(sdkCtx, req.FromHeight, req.ToHeight)
+	default:
+		if req.ToTimestamp != 0 && req.ToTimestamp < req.FromTimestamp {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid timestamp range")
+		}
+		var fromTime, toTime time.Time
+		if req.FromTimestamp != 0 {
+			fromTime = time.Unix(req.FromTimestamp, 0)
+		}
+		if req.ToTimestamp != 0 {
+			toTime = time.Unix(req.ToTimestamp, 0)
+		}
+		startSeq, endSeq = k.GetTimeSequenceRange(sdkCtx, fromTime, toTime)
+	}
+	if req.Pagination == nil && req.FromSequence > startSeq {
+		startSeq = req.FromSequence
+	}
+	records := []types.QueryResponseRecord{}
+	nextSeq := uint64(0)
+	if startSeq >= endSeq {
+		res := &types.QueryRecordsByTimeRangeResponse{
+			Records:      records,
+			NextSequence: nextSeq,
+		}
+		if req.Pagination != nil {
+			res.Pagination = &query.PageResponse{}
+		}
+		return res, nil
+	}
+	if req.Pagination != nil {
+		store := newRangeStore(k.prefixStore(sdkCtx, SequenceToStoreRecordPrefix), types.EncodeUint64(startSeq), types.EncodeUint64(endSeq))
+		pageRes, err := query.FilteredPaginate(store, req.Pagination, func(_ []byte, value []byte, accumulate bool) (bool, error) {
+			storeRecord := k.MustUnmarshalStoreRecord(value)
+			if req.RegistryName != "" && storeRecord.IscnId.Prefix.RegistryName != req.RegistryName {
+				return false, nil
+			}
+			if accumulate {
+				records = append(records, types.QueryResponseRecord{
+					Ipld: storeRecord.Cid().String(),
+					Data: storeRecord.Data,
+				})
+			}
+			return true, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryRecordsByTimeRangeResponse{
+			Records:    records,
+			Pagination: pageRes,
+		}, nil
+	}
+	count := 0
+	k.IterateStoreRecordsInRange(sdkCtx, startSeq, endSeq, func(seq uint64, storeRecord StoreRecord) bool {
+		if req.RegistryName != "" && storeRecord.IscnId.Prefix.RegistryName != req.RegistryName {
+			return false
+		}
+		if count >= TimeRangeRecordsPageLimit {
+			nextSeq = seq
+			return true
+		}
+		count++
+		records = append(records, types.QueryResponseRecord{
+			Ipld: storeRecord.Cid().String(),
+			Data: storeRecord.Data,
+		})
+		return false
+	})
+	return &types.QueryRecordsByTimeRangeResponse{
+		Records:      records,
+		NextSequence: nextSeq,
+	}, nil
+}
+
 func (k Keeper) RecordsByOwner(ctx context.Context, req *types.QueryRecordsByOwnerRequest) (*types.QueryRecordsByOwnerResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	owner, err := sdk.AccAddressFromBech32(req.Owner)
@@ -93,6 +284,30 @@ func (k Keeper) RecordsByOwner(ctx context.Context, req *types.QueryRecordsByOwn
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid owner address")
 	}
 	records := []types.QueryResponseRecord{}
+	if req.Pagination != nil {
+		store := k.prefixStore(sdkCtx, types.GetOwnerStorePrefix(owner))
+		pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+			storeRecord := k.GetStoreRecord(sdkCtx, types.DecodeUint64(key))
+			if storeRecord == nil {
+				// BUG, should break invariant
+				return fmt.Errorf("no store record for owner index entry")
+			}
+			contentIdRecord := k.GetContentIdRecord(sdkCtx, storeRecord.IscnId.Prefix)
+			if contentIdRecord == nil {
+				// BUG, should break invariant
+				return fmt.Errorf("no content ID record for store record")
+			}
+			records = append(records, k.getContentIdResponseRecords(sdkCtx, storeRecord.IscnId.Prefix, contentIdRecord.LatestVersion)...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &types.QueryRecordsByOwnerResponse{
+			Records:    records,
+			Pagination: pageRes,
+		}, nil
+	}
 	nextSeq := uint64(0)
 	count := uint64(0)
 	k.IterateOwnerConetntIdRecords(sdkCtx, owner, req.FromSequence, func(startingSeq uint64, iscnIdPrefix IscnIdPrefix, contentIdRecord ContentIdRecord) bool {
@@ -101,17 +316,7 @@ func (k Keeper) RecordsByOwner(ctx context.Context, req *types.QueryRecordsByOwn
 			return true
 		}
 		count += contentIdRecord.LatestVersion
-		for version := uint64(1); version <= contentIdRecord.LatestVersion; version++ {
-			seq := k.GetIscnIdSequence(sdkCtx, IscnId{
-				Prefix:  iscnIdPrefix,
-				Version: version,
-			})
-			storeRecord := k.GetStoreRecord(sdkCtx, seq)
-			records = append(records, types.QueryResponseRecord{
-				Ipld: storeRecord.Cid().String(),
-				Data: storeRecord.Data,
-			})
-		}
+		records = append(records, k.getContentIdResponseRecords(sdkCtx, iscnIdPrefix, contentIdRecord.LatestVersion)...)
 		return false
 	})
 	return &types.QueryRecordsByOwnerResponse{
@@ -120,6 +325,45 @@ func (k Keeper) RecordsByOwner(ctx context.Context, req *types.QueryRecordsByOwn
 	}, nil
 }
 
+// getContentIdResponseRecords returns all the versions of the records under the ISCN ID prefix
+func (k Keeper) getContentIdResponseRecords(ctx sdk.Context, iscnIdPrefix IscnIdPrefix, latestVersion uint64) []types.QueryResponseRecord {
+	records := make([]types.QueryResponseRecord, 0, latestVersion)
+	for version := uint64(1); version <= latestVersion; version++ {
+		seq := k.GetIscnIdSequence(ctx, IscnId{
+			Prefix:  iscnIdPrefix,
+			Version: version,
+		})
+		storeRecord := k.GetStoreRecord(ctx, seq)
+		records = append(records, types.QueryResponseRecord{
+			Ipld: storeRecord.Cid().String(),
+			Data: storeRecord.Data,
+		})
+	}
+	return records
+}
+
+// paginateSequences paginates an index store with the keys being the encoded sequences of the records
+func (k Keeper) paginateSequences(ctx sdk.Context, store storetypes.KVStore, pageReq *query.PageRequest) ([]types.QueryResponseRecord, *query.PageResponse, error) {
+	records := []types.QueryResponseRecord{}
+	pageRes, err := query.Paginate(store, pageReq, func(key []byte, _ []byte) error {
+		seq := types.DecodeUint64(key)
+		storeRecord := k.GetStoreRecord(ctx, seq)
+		if storeRecord == nil {
+			// BUG, should break invariant
+			return fmt.Errorf("no store record for index entry with sequence %d", seq)
+		}
+		records = append(records, types.QueryResponseRecord{
+			Ipld: storeRecord.Cid().String(),
+			Data: storeRecord.Data,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return records, pageRes, nil
+}
+
 func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params := k.GetParams(sdk.UnwrapSDKContext(ctx))
 	return &types.QueryParamsResponse{
@@ -127,6 +371,180 @@ func (k Keeper) Params(ctx context.Context, req *types.QueryParamsRequest) (*typ
 	}, nil
 }
 
+func (k Keeper) ContentSchemas(ctx context.Context, req *types.QueryContentSchemasRequest) (*types.QueryContentSchemasResponse, error) {
+	schemas := k.GetContentSchemas(sdk.UnwrapSDKContext(ctx))
+	if req.Type != "" {
+		schema := types.GetContentSchema(schemas, req.Type)
+		if schema == nil {
+			return nil, sdkerrors.Wrapf(types.ErrContentSchemaNotFound, "%s", req.Type)
+		}
+		schemas = []types.ContentSchema{*schema}
+	}
+	return &types.QueryContentSchemasResponse{
+		ContentSchemas: schemas,
+	}, nil
+}
+
+func (k Keeper) CollectedFees(ctx context.Context, _ *types.QueryCollectedFeesRequest) (*types.QueryCollectedFeesResponse, error) {
+	return &types.QueryCollectedFeesResponse{
+		CollectedFees: k.GetCollectedFees(sdk.UnwrapSDKContext(ctx)),
+	}, nil
+}
+
+func (k Keeper) Commitment(ctx context.Context, req *types.QueryCommitmentRequest) (*types.QueryCommitmentResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	hash, err := hex.DecodeString(req.Commitment)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid commitment hex: %s", err.Error())
+	}
+	err = types.ValidateCommitment(hash)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "%s", err.Error())
+	}
+	commitment := k.GetCommitment(sdkCtx, hash)
+	if commitment == nil {
+		return nil, sdkerrors.Wrapf(types.ErrCommitmentNotFound, "%s", req.Commitment)
+	}
+	return &types.QueryCommitmentResponse{
+		Commitment: *commitment,
+	}, nil
+}
+
+func (k Keeper) AttestationsByAttester(ctx context.Context, req *types.QueryAttestationsByAttesterRequest) (*types.QueryAttestationsByAttesterResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attester, err := sdk.AccAddressFromBech32(req.Attester)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid attester address")
+	}
+	attestations := []types.IscnAttestation{}
+	store := k.prefixStore(sdkCtx, types.GetAttesterAttestationStorePrefix(attester))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		attestation := k.GetAttestation(sdkCtx, types.DecodeUint64(key), attester)
+		if attestation == nil {
+			// BUG, should break invariant
+			return fmt.Errorf("no attestation for attester index entry")
+		}
+		attestations = append(attestations, *attestation)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryAttestationsByAttesterResponse{
+		Attestations: attestations,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (k Keeper) OwnershipHistory(ctx context.Context, req *types.QueryOwnershipHistoryRequest) (*types.QueryOwnershipHistoryResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iscnId, err := types.ParseIscnId(req.IscnId)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	if k.GetContentIdRecord(sdkCtx, iscnId.Prefix) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", iscnId.Prefix.String())
+	}
+	entries := []types.IscnOwnershipHistoryEntry{}
+	store := k.prefixStore(sdkCtx, types.GetOwnershipHistoryStorePrefix(k.MustMarshalIscnIdPrefix(iscnId.Prefix)))
+	pageRes, err := query.Paginate(store, req.Pagination, func(_ []byte, value []byte) error {
+		entry := k.MustUnmarshalOwnershipHistoryEntry(value)
+		entry.IscnIdPrefix = iscnId.Prefix.String()
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryOwnershipHistoryResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k Keeper) RelatedRecords(ctx context.Context, req *types.QueryRelatedRecordsRequest) (*types.QueryRelatedRecordsResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iscnId, err := types.ParseIscnId(req.IscnId)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	if len(req.RelationType) > 0 && !types.IsValidRelationType(req.RelationType) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid relation type %s", req.RelationType)
+	}
+	contentIdRecord := k.GetContentIdRecord(sdkCtx, iscnId.Prefix)
+	if contentIdRecord == nil {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", iscnId.Prefix.String())
+	}
+	relations := []types.RelatedRecord{}
+	var pageRes *query.PageResponse
+	switch req.Direction {
+	case types.RELATION_DIRECTION_OUTGOING:
+		if iscnId.Version == 0 {
+			iscnId.Version = contentIdRecord.LatestVersion
+		}
+		seq := k.GetIscnIdSequence(sdkCtx, iscnId)
+		if seq == 0 {
+			return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", iscnId.String())
+		}
+		store := k.prefixStore(sdkCtx, types.GetOutgoingRelationStorePrefix(seq, req.RelationType))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+			relationType := req.RelationType
+			targetPrefixBytes := key
+			if len(relationType) == 0 {
+				relationType, targetPrefixBytes = types.ParseOutgoingRelationTypeKey(key)
+			}
+			target := k.MustUnmarshalIscnIdPrefix(targetPrefixBytes)
+			relation := types.RelatedRecord{
+				RelationType:       relationType,
+				SourceIscnId:       iscnId.String(),
+				TargetIscnIdPrefix: target.String(),
+			}
+			targetRecord := k.GetContentIdRecord(sdkCtx, target)
+			if targetRecord != nil {
+				targetSeq := k.GetIscnIdSequence(sdkCtx, IscnId{Prefix: target, Version: targetRecord.LatestVersion})
+				storeRecord := k.GetStoreRecord(sdkCtx, targetSeq)
+				relation.Record = types.QueryResponseRecord{
+					Ipld: storeRecord.Cid().String(),
+					Data: storeRecord.Data,
+				}
+			}
+			relations = append(relations, relation)
+			return nil
+		})
+	case types.RELATION_DIRECTION_INCOMING:
+		store := k.prefixStore(sdkCtx, types.GetIncomingRelationStorePrefix(k.MustMarshalIscnIdPrefix(iscnId.Prefix), req.RelationType))
+		pageRes, err = query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+			relationType := req.RelationType
+			var seq uint64
+			if len(relationType) == 0 {
+				relationType, seq = types.ParseIncomingRelationTypeKey(key)
+			} else {
+				seq = types.DecodeUint64(key)
+			}
+			storeRecord := k.GetStoreRecord(sdkCtx, seq)
+			relations = append(relations, types.RelatedRecord{
+				RelationType:       relationType,
+				SourceIscnId:       storeRecord.IscnId.String(),
+				TargetIscnIdPrefix: iscnId.Prefix.String(),
+				Record: types.QueryResponseRecord{
+					Ipld: storeRecord.Cid().String(),
+					Data: storeRecord.Data,
+				},
+			})
+			return nil
+		})
+	default:
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid relation direction %d", req.Direction)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRelatedRecordsResponse{
+		Relations:  relations,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (k Keeper) GetCid(ctx context.Context, req *types.QueryGetCidRequest) (*types.QueryGetCidResponse, error) {
 	cid, err := gocid.Decode(req.Cid)
 	if err != nil {
@@ -170,3 +588,44 @@ func (k Keeper) GetCidSize(ctx context.Context, req *types.QueryGetCidSizeReques
 	}
 	return &types.QueryGetCidSizeResponse{Size_: size}, nil
 }
+
+func (k Keeper) PendingOwnershipTransfer(ctx context.Context, req *types.QueryPendingOwnershipTransferRequest) (*types.QueryPendingOwnershipTransferResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	iscnId, err := types.ParseIscnId(req.IscnId)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	transfer := k.GetPendingOwnershipTransfer(sdkCtx, iscnId.Prefix)
+	if transfer == nil {
+		return nil, sdkerrors.Wrapf(types.ErrOwnershipTransferNotFound, "%s", iscnId.Prefix.String())
+	}
+	return &types.QueryPendingOwnershipTransferResponse{
+		PendingOwnershipTransfer: *transfer,
+	}, nil
+}
+
+func (k Keeper) PendingOwnershipTransfersByNewOwner(ctx context.Context, req *types.QueryPendingOwnershipTransfersByNewOwnerRequest) (*types.QueryPendingOwnershipTransfersByNewOwnerResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	newOwner, err := sdk.AccAddressFromBech32(req.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address")
+	}
+	transfers := []types.PendingOwnershipTransfer{}
+	store := k.prefixStore(sdkCtx, types.GetPendingOwnershipTransferNewOwnerStorePrefix(newOwner))
+	pageRes, err := query.Paginate(store, req.Pagination, func(key []byte, _ []byte) error {
+		transfer := k.GetPendingOwnershipTransfer(sdkCtx, k.MustUnmarshalIscnIdPrefix(key))
+		if transfer == nil {
+			// BUG, should break invariant
+			return fmt.Errorf("no pending ownership transfer for new owner index entry")
+		}
+		transfers = append(transfers, *transfer)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryPendingOwnershipTransfersByNewOwnerResponse{
+		PendingOwnershipTransfers: transfers,
+		Pagination:                pageRes,
+	}, nil
+}
@@ -20,6 +20,7 @@ const (
 	IscnRecordsInvariantName       = "iscn-records"
 	IscnFingerprintsInvariantName  = "iscn-fingerprints"
 	IscnOwnerSequenceInvariantName = "iscn-owner-seqeunce"
+	IscnStakeholdersInvariantName  = "iscn-stakeholders"
 )
 
 type ProblemLogger struct {
@@ -60,6 +61,7 @@ func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
 	ir.RegisterRoute(types.ModuleName, IscnRecordsInvariantName, IscnRecordsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, IscnFingerprintsInvariantName, IscnFingerprintsInvariant(k))
 	ir.RegisterRoute(types.ModuleName, IscnOwnerSequenceInvariantName, IscnOwnerSequenceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, IscnStakeholdersInvariantName, IscnStakeholdersInvariant(k))
 }
 
 func IscnRecordsInvariant(k Keeper) sdk.Invariant {
@@ -200,11 +202,23 @@ func IscnRecordsInvariant(k Keeper) sdk.Invariant {
 		// 1. check all records are valid
 		// 2. check every content ID record has the corresponding ISCN ID records
 		// 3. check every content ID record has the corresponding owner-sequence record
+		// 4. check every content ID record has valid status, and superseding record exists
 		k.IterateContentIdRecords(ctx, func(iscnIdPrefix IscnIdPrefix, contentIdRecord ContentIdRecord) bool {
 			if contentIdRecord.LatestVersion == 0 {
 				problemLogger.Log(fmt.Sprintf("content ID %s has 0 as latest version record", contentIdRecord.String()))
 				return false
 			}
+			err := types.ValidateStatus(contentIdRecord.Status, contentIdRecord.SupersededBy)
+			if err != nil {
+				problemLogger.Log(fmt.Sprintf("content ID %s has invalid status: %s", iscnIdPrefix.String(), err.Error()))
+			} else if contentIdRecord.SupersededBy != "" {
+				supersededBy, _ := types.ParseIscnId(contentIdRecord.SupersededBy)
+				if supersededBy.Prefix.Equal(iscnIdPrefix) {
+					problemLogger.Log(fmt.Sprintf("content ID %s is superseded by itself", iscnIdPrefix.String()))
+				} else if k.GetContentIdRecord(ctx, supersededBy.Prefix) == nil {
+					problemLogger.Log(fmt.Sprintf("content ID %s is superseded by %s, but the content ID record does not exist", iscnIdPrefix.String(), contentIdRecord.SupersededBy))
+				}
+			}
 			for version := uint64(1); version <= contentIdRecord.LatestVersion; version++ {
 				id := IscnId{
 					Prefix:  iscnIdPrefix,
@@ -240,9 +254,9 @@ func IscnRecordsInvariant(k Keeper) sdk.Invariant {
 			return false
 		})
 
-		// 4. check all ISCN ID has content ID record
-		// 5. check all ISCN ID and CID can reverse lookup sequence
-		// 6. check contiguous sequence
+		// 5. check all ISCN ID has content ID record
+		// 6. check all ISCN ID and CID can reverse lookup sequence
+		// 7. check contiguous sequence
 		prevSeq := uint64(0)
 		k.IterateStoreRecords(ctx, func(seq uint64, storeRecord StoreRecord) bool {
 			if seq != prevSeq+1 {
@@ -270,7 +284,7 @@ func IscnRecordsInvariant(k Keeper) sdk.Invariant {
 			problemLogger.Log(fmt.Sprintf("max sequence (%d) does not equal to sequence count (%d)", prevSeq, seqCount))
 		}
 
-		// 7. check all ISCN ID and CID reverse lookup sequence actually exist
+		// 8. check all ISCN ID and CID reverse lookup sequence actually exist
 		cidIter := k.prefixStore(ctx, CidToSequencePrefix).Iterator(nil, nil)
 		defer cidIter.Close()
 		for ; cidIter.Valid(); cidIter.Next() {
@@ -329,7 +343,7 @@ func IscnFingerprintsInvariant(k Keeper) sdk.Invariant {
 					problemLogger.Log(fmt.Sprintf("record for fingerprint %s has value with wrong type in contentFingerprints field", fingerprint))
 					return false
 				}
-				if recordFingerprint == fingerprint {
+				if types.NormalizeFingerprint(recordFingerprint) == fingerprint {
 					found = true
 				}
 			}
@@ -422,3 +436,41 @@ func IscnOwnerSequenceInvariant(k Keeper) sdk.Invariant {
 		return problemLogger.Result()
 	}
 }
+
+func IscnStakeholdersInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		problemLogger := NewProblemLogger(ctx, IscnStakeholdersInvariantName)
+
+		// 1. to check each stakeholder record actually points to a record with that stakeholder
+		k.IterateAllStakeholders(ctx, func(stakeholderId string, seq uint64) bool {
+			storeRecord := k.GetStoreRecord(ctx, seq)
+			if storeRecord == nil {
+				problemLogger.Log(fmt.Sprintf("stakeholder %s has sequence record %d, but store record does not exist", stakeholderId, seq))
+				return false
+			}
+			found := false
+			for _, id := range types.GetStakeholderEntityIds(storeRecord.Data) {
+				if id == stakeholderId {
+					found = true
+					break
+				}
+			}
+			if !found {
+				problemLogger.Log(fmt.Sprintf("record for stakeholder %s (sequence %d) has no stakeholder with matching entity ID", stakeholderId, seq))
+			}
+			return false
+		})
+
+		// 2. to check each stakeholder in records actually has a stakeholder record points to that record
+		k.IterateStoreRecords(ctx, func(seq uint64, storeRecord StoreRecord) bool {
+			for _, stakeholderId := range types.GetStakeholderEntityIds(storeRecord.Data) {
+				if !k.HasStakeholderSequence(ctx, stakeholderId, seq) {
+					problemLogger.Log(fmt.Sprintf("dangling stakeholder %s in sequence %d", stakeholderId, seq))
+				}
+			}
+			return false
+		})
+
+		return problemLogger.Result()
+	}
+}
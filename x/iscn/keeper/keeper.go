@@ -1,35 +1,49 @@
 package keeper
 
 import (
+	"context"
+	"strconv"
+	"time"
+
 	"github.com/cosmos/cosmos-sdk/codec"
 	prefixstore "github.com/cosmos/cosmos-sdk/store/prefix"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authTypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
 	paramTypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likefeegrant"
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 )
 
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authTypes.AccountI
 }
 
+type GroupKeeper interface {
+	GroupPolicyInfo(goCtx context.Context, request *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+}
+
 type Keeper struct {
 	storeKey       storetypes.StoreKey
 	cdc            codec.BinaryCodec
 	paramstore     paramTypes.Subspace
 	accountKeeper  AccountKeeper
-	bankKeeper     authTypes.BankKeeper
+	bankKeeper     likefeegrant.BankKeeper
+	distrKeeper    likefeegrant.DistributionKeeper
 	feegrantKeeper ante.FeegrantKeeper
+	groupKeeper    GroupKeeper
 }
 
 func NewKeeper(
 	cdc codec.BinaryCodec, key storetypes.StoreKey, accountKeeper AccountKeeper,
-	bankKeeper authTypes.BankKeeper, feegrantKeeper ante.FeegrantKeeper, paramstore paramTypes.Subspace,
+	bankKeeper likefeegrant.BankKeeper, distrKeeper likefeegrant.DistributionKeeper, feegrantKeeper ante.FeegrantKeeper,
+	groupKeeper GroupKeeper, paramstore paramTypes.Subspace,
 ) Keeper {
 	return Keeper{
 		storeKey:       key,
@@ -37,7 +51,9 @@ func NewKeeper(
 		paramstore:     paramstore.WithKeyTable(ParamKeyTable()),
 		accountKeeper:  accountKeeper,
 		bankKeeper:     bankKeeper,
+		distrKeeper:    distrKeeper,
 		feegrantKeeper: feegrantKeeper,
+		groupKeeper:    groupKeeper,
 	}
 }
 
@@ -55,10 +71,65 @@ func (k Keeper) FeePerByte(ctx sdk.Context) (res sdk.DecCoin) {
 	return
 }
 
+// Parameters below may be missing on upgraded chains, so they are read by GetIfExists
+
+// GetContentSchemas returns the content metadata schemas
+func (k Keeper) GetContentSchemas(ctx sdk.Context) (res []types.ContentSchema) {
+	k.paramstore.GetIfExists(ctx, ParamKeyContentSchemas, &res)
+	return
+}
+
+// GetFeeRouting returns the per-byte fee routing
+func (k Keeper) GetFeeRouting(ctx sdk.Context) (res likefeegranttypes.FeeRouting) {
+	k.paramstore.GetIfExists(ctx, ParamKeyFeeRouting, &res)
+	return res.OrDefault()
+}
+
+// CommitmentExpiryDays returns the number of days before an unrevealed commitment expires
+func (k Keeper) CommitmentExpiryDays(ctx sdk.Context) (res uint64) {
+	k.paramstore.GetIfExists(ctx, ParamKeyCommitmentExpiryDays, &res)
+	if res == 0 {
+		return types.DefaultCommitmentExpiryDays
+	}
+	return res
+}
+
+// getRecordLimit returns the record limit parameter of the key, or the default value if it is not set
+func (k Keeper) getRecordLimit(ctx sdk.Context, key []byte, defaultValue uint64) (res uint64) {
+	k.paramstore.GetIfExists(ctx, key, &res)
+	if res == 0 {
+		return defaultValue
+	}
+	return res
+}
+
+func (k Keeper) MaxRecordBytes(ctx sdk.Context) uint64 {
+	return k.getRecordLimit(ctx, ParamKeyMaxRecordBytes, types.DefaultMaxRecordBytes)
+}
+
+func (k Keeper) MaxFingerprints(ctx sdk.Context) uint64 {
+	return k.getRecordLimit(ctx, ParamKeyMaxFingerprints, types.DefaultMaxFingerprints)
+}
+
+func (k Keeper) MaxStakeholders(ctx sdk.Context) uint64 {
+	return k.getRecordLimit(ctx, ParamKeyMaxStakeholders, types.DefaultMaxStakeholders)
+}
+
+func (k Keeper) MaxFingerprintLength(ctx sdk.Context) uint64 {
+	return k.getRecordLimit(ctx, ParamKeyMaxFingerprintLength, types.DefaultMaxFingerprintLength)
+}
+
 func (k Keeper) GetParams(ctx sdk.Context) Params {
 	return Params{
-		RegistryName: k.RegistryName(ctx),
-		FeePerByte:   k.FeePerByte(ctx),
+		RegistryName:         k.RegistryName(ctx),
+		FeePerByte:           k.FeePerByte(ctx),
+		ContentSchemas:       k.GetContentSchemas(ctx),
+		FeeRouting:           k.GetFeeRouting(ctx),
+		CommitmentExpiryDays: k.CommitmentExpiryDays(ctx),
+		MaxRecordBytes:       k.MaxRecordBytes(ctx),
+		MaxFingerprints:      k.MaxFingerprints(ctx),
+		MaxStakeholders:      k.MaxStakeholders(ctx),
+		MaxFingerprintLength: k.MaxFingerprintLength(ctx),
 	}
 }
 
@@ -66,6 +137,70 @@ func (k Keeper) SetParams(ctx sdk.Context, params Params) {
 	k.paramstore.SetParamSet(ctx, &params)
 }
 
+// SetContentSchema adds the content metadata schema, or replaces the existing one with the same type
+func (k Keeper) SetContentSchema(ctx sdk.Context, schema types.ContentSchema) error {
+	err := schema.Validate()
+	if err != nil {
+		return err
+	}
+	schemas := k.GetContentSchemas(ctx)
+	existing := types.GetContentSchema(schemas, schema.Type)
+	if existing != nil {
+		*existing = schema
+	} else {
+		schemas = append(schemas, schema)
+	}
+	k.paramstore.Set(ctx, ParamKeyContentSchemas, schemas)
+	return nil
+}
+
+func (k Keeper) RemoveContentSchema(ctx sdk.Context, contentType string) error {
+	schemas := k.GetContentSchemas(ctx)
+	newSchemas := make([]types.ContentSchema, 0, len(schemas))
+	for _, schema := range schemas {
+		if schema.Type != contentType {
+			newSchemas = append(newSchemas, schema)
+		}
+	}
+	if len(newSchemas) == len(schemas) {
+		return sdkerrors.Wrapf(types.ErrContentSchemaNotFound, "%s", contentType)
+	}
+	k.paramstore.Set(ctx, ParamKeyContentSchemas, newSchemas)
+	return nil
+}
+
+func (k Keeper) ValidateContentMetadata(ctx sdk.Context, metadata IscnInput) error {
+	err := types.ValidateContentMetadata(k.GetContentSchemas(ctx), metadata)
+	if err != nil {
+		return sdkerrors.Wrapf(types.ErrInvalidContentMetadata, "%s", err.Error())
+	}
+	return nil
+}
+
+// ValidateRecordLimits checks the number of fingerprints and stakeholders, the length of each fingerprint and the size
+// of the encoded JSON-LD against the record limit parameters
+func (k Keeper) ValidateRecordLimits(ctx sdk.Context, record *types.IscnRecord, recordJsonLd []byte) error {
+	maxFingerprints := k.MaxFingerprints(ctx)
+	if uint64(len(record.ContentFingerprints)) > maxFingerprints {
+		return sdkerrors.Wrapf(types.ErrRecordLimitExceeded, "record has %d content fingerprints, exceeding the limit %d", len(record.ContentFingerprints), maxFingerprints)
+	}
+	maxFingerprintLength := k.MaxFingerprintLength(ctx)
+	for _, fingerprint := range record.ContentFingerprints {
+		if uint64(len(fingerprint)) > maxFingerprintLength {
+			return sdkerrors.Wrapf(types.ErrRecordLimitExceeded, "content fingerprint has %d bytes, exceeding the limit %d", len(fingerprint), maxFingerprintLength)
+		}
+	}
+	maxStakeholders := k.MaxStakeholders(ctx)
+	if uint64(len(record.Stakeholders)) > maxStakeholders {
+		return sdkerrors.Wrapf(types.ErrRecordLimitExceeded, "record has %d stakeholders, exceeding the limit %d", len(record.Stakeholders), maxStakeholders)
+	}
+	maxRecordBytes := k.MaxRecordBytes(ctx)
+	if uint64(len(recordJsonLd)) > maxRecordBytes {
+		return sdkerrors.Wrapf(types.ErrRecordLimitExceeded, "record has %d bytes, exceeding the limit %d", len(recordJsonLd), maxRecordBytes)
+	}
+	return nil
+}
+
 func (k Keeper) prefixStore(ctx sdk.Context, prefix []byte) prefixstore.Store {
 	return prefixstore.NewStore(ctx.KVStore(k.storeKey), prefix)
 }
@@ -126,8 +261,9 @@ func (k Keeper) GetCidSequence(ctx sdk.Context, cid CID) uint64 {
 	return types.DecodeUint64(seqBytes)
 }
 
+// AddFingerprintSequence indexes the sequence under the canonical form of the fingerprint
 func (k Keeper) AddFingerprintSequence(ctx sdk.Context, fingerprint string, seq uint64) {
-	key := types.GetFingerprintSequenceKey(fingerprint, seq)
+	key := types.GetFingerprintSequenceKey(types.NormalizeFingerprint(fingerprint), seq)
 	k.prefixStore(ctx, FingerprintSequencePrefix).Set(key, []byte{0x01})
 }
 
@@ -143,7 +279,7 @@ func (k Keeper) IterateAllFingerprints(ctx sdk.Context, f func(fingerprint strin
 }
 
 func (k Keeper) IterateFingerprintSequencesWithStartingSequence(ctx sdk.Context, fingerprint string, seq uint64, f func(seq uint64) bool) {
-	prefix := types.GetFingerprintStorePrefix(fingerprint)
+	prefix := types.GetFingerprintStorePrefix(types.NormalizeFingerprint(fingerprint))
 	fromKey := types.EncodeUint64(seq)
 	it := k.prefixStore(ctx, prefix).Iterator(fromKey, nil)
 	defer it.Close()
@@ -160,10 +296,209 @@ func (k Keeper) IterateFingerprintSequences(ctx sdk.Context, fingerprint string,
 }
 
 func (k Keeper) HasFingerprintSequence(ctx sdk.Context, fingerprint string, seq uint64) bool {
-	key := types.GetFingerprintSequenceKey(fingerprint, seq)
+	key := types.GetFingerprintSequenceKey(types.NormalizeFingerprint(fingerprint), seq)
 	return k.prefixStore(ctx, FingerprintSequencePrefix).Has(key)
 }
 
+// CanonicalizeFingerprintIndex moves the fingerprint index entries which are not indexed under the canonical form of
+// the fingerprint to the canonical keys.
+func (k Keeper) CanonicalizeFingerprintIndex(ctx sdk.Context) {
+	store := k.prefixStore(ctx, FingerprintSequencePrefix)
+	oldKeys := [][]byte{}
+	newKeys := [][]byte{}
+	k.IterateAllFingerprints(ctx, func(fingerprint string, seq uint64) bool {
+		canonical := types.NormalizeFingerprint(fingerprint)
+		if canonical != fingerprint {
+			oldKeys = append(oldKeys, types.GetFingerprintSequenceKey(fingerprint, seq))
+			newKeys = append(newKeys, types.GetFingerprintSequenceKey(canonical, seq))
+		}
+		return false
+	})
+	for i := range oldKeys {
+		store.Delete(oldKeys[i])
+		store.Set(newKeys[i], []byte{0x01})
+	}
+}
+
+func (k Keeper) AddStakeholderSequence(ctx sdk.Context, stakeholderId string, seq uint64) {
+	key := types.GetStakeholderSequenceKey(stakeholderId, seq)
+	k.prefixStore(ctx, StakeholderSequencePrefix).Set(key, []byte{0x01})
+}
+
+// AddStakeholderSequences indexes the record data by the entity IDs of its stakeholders
+func (k Keeper) AddStakeholderSequences(ctx sdk.Context, data []byte, seq uint64) {
+	for _, stakeholderId := range types.GetStakeholderEntityIds(data) {
+		k.AddStakeholderSequence(ctx, stakeholderId, seq)
+	}
+}
+
+func (k Keeper) IterateAllStakeholders(ctx sdk.Context, f func(stakeholderId string, seq uint64) bool) {
+	it := k.prefixStore(ctx, StakeholderSequencePrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		stakeholderId, seq := types.ParseStakeholderSequenceBytes(it.Key())
+		if f(stakeholderId, seq) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateStakeholderSequencesWithStartingSequence(ctx sdk.Context, stakeholderId string, seq uint64, f func(seq uint64) bool) {
+	prefix := types.GetStakeholderStorePrefix(stakeholderId)
+	fromKey := types.EncodeUint64(seq)
+	it := k.prefixStore(ctx, prefix).Iterator(fromKey, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		seq := types.DecodeUint64(it.Key())
+		if f(seq) {
+			break
+		}
+	}
+}
+
+func (k Keeper) IterateStakeholderSequences(ctx sdk.Context, stakeholderId string, f func(seq uint64) bool) {
+	k.IterateStakeholderSequencesWithStartingSequence(ctx, stakeholderId, 0, f)
+}
+
+func (k Keeper) HasStakeholderSequence(ctx sdk.Context, stakeholderId string, seq uint64) bool {
+	key := types.GetStakeholderSequenceKey(stakeholderId, seq)
+	return k.prefixStore(ctx, StakeholderSequencePrefix).Has(key)
+}
+
+// RebuildStakeholderIndex indexes all existing records by the entity IDs of their stakeholders
+func (k Keeper) RebuildStakeholderIndex(ctx sdk.Context) {
+	k.IterateStoreRecords(ctx, func(seq uint64, record StoreRecord) bool {
+		k.AddStakeholderSequences(ctx, record.Data, seq)
+		return false
+	})
+}
+
+// setFirstSequence records the sequence as the first sequence for the key, if the key is not set yet
+func (k Keeper) setFirstSequence(ctx sdk.Context, prefix []byte, key []byte, seq uint64) {
+	store := k.prefixStore(ctx, prefix)
+	if store.Has(key) {
+		return
+	}
+	store.Set(key, types.EncodeUint64(seq))
+}
+
+func (k Keeper) AddTimeSequence(ctx sdk.Context, t time.Time, seq uint64) {
+	k.setFirstSequence(ctx, TimeSequencePrefix, types.GetTimeSequenceKey(t), seq)
+}
+
+func (k Keeper) AddHeightSequence(ctx sdk.Context, height uint64, seq uint64) {
+	k.setFirstSequence(ctx, HeightSequencePrefix, types.GetHeightSequenceKey(height), seq)
+}
+
+func (k Keeper) getIndexedSequenceCount(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(IndexedSequenceCountKey)
+	return types.DecodeUint64(bz)
+}
+
+func (k Keeper) setIndexedSequenceCount(ctx sdk.Context, seq uint64) {
+	ctx.KVStore(k.storeKey).Set(IndexedSequenceCountKey, types.EncodeUint64(seq))
+}
+
+// IndexBlockSequences indexes the records added in the current block by the block time and height. It is called in
+// EndBlock instead of on every new record, so the index is not charged to the transactions.
+func (k Keeper) IndexBlockSequences(ctx sdk.Context) {
+	seqCount := k.GetSequenceCount(ctx)
+	indexedCount := k.getIndexedSequenceCount(ctx)
+	if seqCount == indexedCount {
+		return
+	}
+	firstSeq := indexedCount + 1
+	k.AddTimeSequence(ctx, ctx.BlockTime(), firstSeq)
+	k.AddHeightSequence(ctx, uint64(ctx.BlockHeight()), firstSeq)
+	k.setIndexedSequenceCount(ctx, seqCount)
+}
+
+func (k Keeper) IterateHeightSequences(ctx sdk.Context, f func(height uint64, seq uint64) bool) {
+	it := k.prefixStore(ctx, HeightSequencePrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		height := types.DecodeUint64(it.Key())
+		seq := types.DecodeUint64(it.Value())
+		if f(height, seq) {
+			break
+		}
+	}
+}
+
+// getSequenceRange returns the sequence range [startSeq, endSeq) of the records with key in the range [fromKey, toKey]
+// in the time or height index. nil fromKey or toKey means unbounded.
+func (k Keeper) getSequenceRange(ctx sdk.Context, prefix []byte, fromKey []byte, toKey []byte) (startSeq uint64, endSeq uint64) {
+	store := k.prefixStore(ctx, prefix)
+	endSeq = k.GetSequenceCount(ctx) + 1
+	startIt := store.Iterator(fromKey, nil)
+	defer startIt.Close()
+	if !startIt.Valid() {
+		return endSeq, endSeq
+	}
+	startSeq = types.DecodeUint64(startIt.Value())
+	if toKey != nil {
+		endIt := store.Iterator(sdk.PrefixEndBytes(toKey), nil)
+		defer endIt.Close()
+		if endIt.Valid() {
+			endSeq = types.DecodeUint64(endIt.Value())
+		}
+	}
+	return startSeq, endSeq
+}
+
+// GetTimeSequenceRange returns the sequence range [startSeq, endSeq) of the records registered between the times
+// (inclusive, in seconds). Zero time means unbounded.
+func (k Keeper) GetTimeSequenceRange(ctx sdk.Context, fromTime time.Time, toTime time.Time) (startSeq uint64, endSeq uint64) {
+	var fromKey, toKey []byte
+	if !fromTime.IsZero() {
+		fromKey = types.GetTimeSequenceKey(fromTime)
+	}
+	if !toTime.IsZero() {
+		toKey = types.GetTimeSequenceKey(toTime)
+	}
+	return k.getSequenceRange(ctx, TimeSequencePrefix, fromKey, toKey)
+}
+
+// GetHeightSequenceRange returns the sequence range [startSeq, endSeq) of the records registered between the block
+// heights (inclusive). Zero height means unbounded.
+func (k Keeper) GetHeightSequenceRange(ctx sdk.Context, fromHeight uint64, toHeight uint64) (startSeq uint64, endSeq uint64) {
+	var fromKey, toKey []byte
+	if fromHeight != 0 {
+		fromKey = types.GetHeightSequenceKey(fromHeight)
+	}
+	if toHeight != 0 {
+		toKey = types.GetHeightSequenceKey(toHeight)
+	}
+	return k.getSequenceRange(ctx, HeightSequencePrefix, fromKey, toKey)
+}
+
+func (k Keeper) IterateStoreRecordsInRange(ctx sdk.Context, startSeq uint64, endSeq uint64, f func(seq uint64, record StoreRecord) bool) {
+	it := k.prefixStore(ctx, SequenceToStoreRecordPrefix).Iterator(types.EncodeUint64(startSeq), types.EncodeUint64(endSeq))
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		seq := types.DecodeUint64(it.Key())
+		record := k.MustUnmarshalStoreRecord(it.Value())
+		if f(seq, record) {
+			break
+		}
+	}
+}
+
+// RebuildTimeIndex indexes all existing records by the `recordTimestamp` field.
+// Block heights of the existing records are not recorded, so the height index cannot be rebuilt.
+func (k Keeper) RebuildTimeIndex(ctx sdk.Context) {
+	k.IterateStoreRecords(ctx, func(seq uint64, record StoreRecord) bool {
+		t, err := types.GetRecordTimestamp(record.Data)
+		if err != nil {
+			ctx.Logger().Error("cannot parse record timestamp", "iscn_id", record.IscnId.String(), "error", err)
+			return false
+		}
+		k.AddTimeSequence(ctx, t, seq)
+		return false
+	})
+	k.setIndexedSequenceCount(ctx, k.GetSequenceCount(ctx))
+}
+
 func (k Keeper) GetContentIdRecord(ctx sdk.Context, iscnIdPrefix IscnIdPrefix) *ContentIdRecord {
 	key := k.MustMarshalIscnIdPrefix(iscnIdPrefix)
 	bz := k.prefixStore(ctx, ContentIdRecordPrefix).Get(key)
@@ -191,7 +526,31 @@ func (k Keeper) SetContentIdRecord(ctx sdk.Context, iscnIdPrefix IscnIdPrefix, r
 	if oldRecord != nil && !sdk.AccAddress(oldRecord.OwnerAddressBytes).Equals(sdk.AccAddress(record.OwnerAddressBytes)) {
 		oldOwnerKey := types.GetOwnerSequenceKey(oldRecord.OwnerAddressBytes, seq)
 		k.prefixStore(ctx, OwnerSequencePrefix).Delete(oldOwnerKey)
+		// the pending transfer was proposed by the previous owner
+		k.DeletePendingOwnershipTransfer(ctx, iscnIdPrefix)
+	}
+}
+
+// GetOwnerGroupId returns the group ID if the owner is an x/group policy account, or 0 otherwise
+func (k Keeper) GetOwnerGroupId(ctx sdk.Context, owner sdk.AccAddress) uint64 {
+	// group policy accounts are derived module addresses, so key accounts are never group policies
+	if k.groupKeeper == nil || len(owner) != address.Len {
+		return 0
 	}
+	res, err := k.groupKeeper.GroupPolicyInfo(sdk.WrapSDKContext(ctx), &group.QueryGroupPolicyInfoRequest{Address: owner.String()})
+	if err != nil || res.Info == nil {
+		return 0
+	}
+	return res.Info.GroupId
+}
+
+// AppendOwnerGroupAttribute appends the group ID attribute to the event if the owner is an x/group policy account
+func (k Keeper) AppendOwnerGroupAttribute(ctx sdk.Context, event sdk.Event, owner sdk.AccAddress) sdk.Event {
+	groupId := k.GetOwnerGroupId(ctx, owner)
+	if groupId != 0 {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIscnOwnerGroupId, strconv.FormatUint(groupId, 10)))
+	}
+	return event
 }
 
 func (k Keeper) IterateContentIdRecords(ctx sdk.Context, f func(iscnIdPrefix IscnIdPrefix, contentIdRecord ContentIdRecord) bool) {
@@ -251,45 +610,71 @@ func (k Keeper) IterateIscnIds(ctx sdk.Context, f func(iscnId IscnId, contentIdR
 	})
 }
 
-func (k Keeper) DeductFeeForIscn(ctx sdk.Context, msgSender sdk.AccAddress, data []byte, msg sdk.Msg) error {
-	feePerByte := k.GetParams(ctx).FeePerByte
-	feeAmount := feePerByte.Amount.MulInt64(int64(len(data)))
+func (k Keeper) DeductFeeForIscn(ctx sdk.Context, msgSender sdk.AccAddress, bytesLength int, msg sdk.Msg) error {
+	feePerByte := k.FeePerByte(ctx)
+	feeAmount := feePerByte.Amount.MulInt64(int64(bytesLength))
 	fees := sdk.NewCoins(sdk.NewCoin(feePerByte.Denom, feeAmount.Ceil().RoundInt()))
-	return likefeegrant.DeductFeePerByte(
+	routed, err := likefeegrant.DeductFeePerByte(
 		ctx,
-		k.accountKeeper, k.bankKeeper, k.feegrantKeeper,
+		k.accountKeeper, k.bankKeeper, k.feegrantKeeper, k.distrKeeper,
+		types.ModuleName, k.GetFeeRouting(ctx),
 		msgSender, fees, msg,
 	)
+	if err != nil {
+		return err
+	}
+	k.AddCollectedFees(ctx, routed)
+	return nil
 }
 
 func (k Keeper) AddIscnRecord(
 	ctx sdk.Context, iscnId IscnId, owner sdk.AccAddress, data []byte, fingerprints []string, msg sdk.Msg,
 ) (*CID, error) {
+	cid, err := k.checkNewIscnRecord(ctx, iscnId, owner, data)
+	if err != nil {
+		return nil, err
+	}
+	err = k.DeductFeeForIscn(ctx, owner, len(data), msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrDeductIscnFee, "%s", err.Error())
+	}
+	err = k.storeIscnRecord(ctx, iscnId, owner, data, fingerprints, cid)
+	if err != nil {
+		return nil, err
+	}
+	return &cid, nil
+}
+
+// checkNewIscnRecord checks whether the record can be added as the given ISCN ID and returns the CID of the record
+func (k Keeper) checkNewIscnRecord(ctx sdk.Context, iscnId IscnId, owner sdk.AccAddress, data []byte) (CID, error) {
 	contentIdRecord := k.GetContentIdRecord(ctx, iscnId.Prefix)
 	if contentIdRecord == nil {
 		if iscnId.Version != 1 {
-			return nil, sdkerrors.Wrapf(types.ErrInvalidIscnVersion, "expected version: 1")
+			return CID{}, sdkerrors.Wrapf(types.ErrInvalidIscnVersion, "expected version: 1")
 		}
 	} else {
 		if iscnId.Version != contentIdRecord.LatestVersion+1 {
-			return nil, sdkerrors.Wrapf(types.ErrInvalidIscnVersion, "expected version: %d", contentIdRecord.LatestVersion+1)
+			return CID{}, sdkerrors.Wrapf(types.ErrInvalidIscnVersion, "expected version: %d", contentIdRecord.LatestVersion+1)
 		}
 		expectedOwner := contentIdRecord.OwnerAddress()
 		if !expectedOwner.Equals(owner) {
-			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected owner: %s", owner.String())
+			return CID{}, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "expected owner: %s", owner.String())
+		}
+		if !contentIdRecord.IsActive() {
+			return CID{}, sdkerrors.Wrapf(types.ErrRecordNotActive, "%s is %s", iscnId.Prefix.String(), contentIdRecord.Status.String())
 		}
 	}
 	if k.GetIscnIdSequence(ctx, iscnId) != 0 {
-		return nil, sdkerrors.Wrapf(types.ErrReusingIscnId, "%s, please set a different nonce in MsgCreateIscnRecord", iscnId.String())
+		return CID{}, sdkerrors.Wrapf(types.ErrReusingIscnId, "%s, please set a different nonce in MsgCreateIscnRecord", iscnId.String())
 	}
 	cid := types.ComputeDataCid(data)
 	if k.GetCidSequence(ctx, cid) != 0 {
-		return nil, sdkerrors.Wrapf(types.ErrRecordAlreadyExist, "%s", cid.String())
-	}
-	err := k.DeductFeeForIscn(ctx, owner, data, msg)
-	if err != nil {
-		return nil, sdkerrors.Wrapf(types.ErrDeductIscnFee, "%s", err.Error())
+		return CID{}, sdkerrors.Wrapf(types.ErrRecordAlreadyExist, "%s", cid.String())
 	}
+	return cid, nil
+}
+
+func (k Keeper) storeIscnRecord(ctx sdk.Context, iscnId IscnId, owner sdk.AccAddress, data []byte, fingerprints []string, cid CID) error {
 	record := StoreRecord{
 		IscnId:   iscnId,
 		CidBytes: cid.Bytes(),
@@ -307,10 +692,31 @@ func (k Keeper) AddIscnRecord(
 		sdk.NewAttribute(types.AttributeKeyIscnOwner, owner.String()),
 		sdk.NewAttribute(types.AttributeKeyIscnRecordIpld, cid.String()),
 	)
+	event = k.AppendOwnerGroupAttribute(ctx, event, owner)
 	for _, fingerprint := range fingerprints {
 		k.AddFingerprintSequence(ctx, fingerprint, seq)
 		event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIscnContentFingerprint, fingerprint))
 	}
+	k.AddStakeholderSequences(ctx, data, seq)
+	k.AddRelationSequences(ctx, data, seq)
+	// the legacy event with repeated fingerprint attributes is kept for existing indexers during the deprecation period
 	ctx.EventManager().EmitEvent(event)
-	return &cid, nil
+	if iscnId.Version == 1 {
+		return ctx.EventManager().EmitTypedEvent(&types.EventCreateIscnRecord{
+			IscnId:              iscnId.String(),
+			IscnIdPrefix:        iscnId.Prefix.String(),
+			Owner:               owner.String(),
+			Ipld:                cid.String(),
+			Version:             iscnId.Version,
+			ContentFingerprints: fingerprints,
+		})
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventUpdateIscnRecord{
+		IscnId:              iscnId.String(),
+		IscnIdPrefix:        iscnId.Prefix.String(),
+		Owner:               owner.String(),
+		Ipld:                cid.String(),
+		Version:             iscnId.Version,
+		ContentFingerprints: fingerprints,
+	})
 }
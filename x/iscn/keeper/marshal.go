@@ -35,3 +35,39 @@ func (k Keeper) MustUnmarshalContentIdRecord(recordBytes []byte) (record Content
 	k.cdc.MustUnmarshal(recordBytes, &record)
 	return record
 }
+
+func (k Keeper) MustMarshalPendingOwnershipTransfer(transfer *PendingOwnershipTransfer) []byte {
+	return k.cdc.MustMarshal(transfer)
+}
+
+func (k Keeper) MustUnmarshalPendingOwnershipTransfer(transferBytes []byte) (transfer PendingOwnershipTransfer) {
+	k.cdc.MustUnmarshal(transferBytes, &transfer)
+	return transfer
+}
+
+func (k Keeper) MustMarshalCommitment(commitment *IscnCommitment) []byte {
+	return k.cdc.MustMarshal(commitment)
+}
+
+func (k Keeper) MustUnmarshalCommitment(commitmentBytes []byte) (commitment IscnCommitment) {
+	k.cdc.MustUnmarshal(commitmentBytes, &commitment)
+	return commitment
+}
+
+func (k Keeper) MustMarshalAttestation(attestation *IscnAttestation) []byte {
+	return k.cdc.MustMarshal(attestation)
+}
+
+func (k Keeper) MustUnmarshalAttestation(attestationBytes []byte) (attestation IscnAttestation) {
+	k.cdc.MustUnmarshal(attestationBytes, &attestation)
+	return attestation
+}
+
+func (k Keeper) MustMarshalOwnershipHistoryEntry(entry *IscnOwnershipHistoryEntry) []byte {
+	return k.cdc.MustMarshal(entry)
+}
+
+func (k Keeper) MustUnmarshalOwnershipHistoryEntry(entryBytes []byte) (entry IscnOwnershipHistoryEntry) {
+	k.cdc.MustUnmarshal(entryBytes, &entry)
+	return entry
+}
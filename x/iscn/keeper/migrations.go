@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 builds the stakeholder index for the existing records
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.RebuildStakeholderIndex(ctx)
+	return nil
+}
+
+// Migrate2to3 builds the time index for the existing records
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.RebuildTimeIndex(ctx)
+	return nil
+}
+
+// Migrate3to4 re-indexes the fingerprints of the existing records under their canonical forms
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.CanonicalizeFingerprintIndex(ctx)
+	return nil
+}
+
+// Migrate4to5 records the current owners of the existing records as the start of the ownership history
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	m.keeper.InitOwnershipHistory(ctx)
+	return nil
+}
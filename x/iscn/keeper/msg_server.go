@@ -2,6 +2,7 @@ package keeper
 
 import (
 	context "context"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -26,6 +27,10 @@ func (k msgServer) CreateIscnRecord(goCtx context.Context, msg *MsgCreateIscnRec
 	if err != nil {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
 	}
+	err = k.ValidateContentMetadata(ctx, msg.Record.ContentMetadata)
+	if err != nil {
+		return nil, err
+	}
 	registryName := k.RegistryName(ctx)
 	seed := msg.GetSignBytes()
 	id := types.GenerateNewIscnIdWithSeed(registryName, seed)
@@ -37,10 +42,19 @@ func (k msgServer) CreateIscnRecord(goCtx context.Context, msg *MsgCreateIscnRec
 	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrEncodingJsonLd, "%s", err.Error())
 	}
+	err = k.ValidateRecordLimits(ctx, &msg.Record, recordJsonLd)
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidateRelations(ctx, id.Prefix, msg.Record.Relations)
+	if err != nil {
+		return nil, err
+	}
 	cid, err := k.AddIscnRecord(ctx, id, from, recordJsonLd, msg.Record.ContentFingerprints, msg)
 	if err != nil {
 		return nil, err
 	}
+	k.AppendOwnershipHistory(ctx, id.Prefix, from, sdk.MsgTypeURL(msg))
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -54,6 +68,71 @@ func (k msgServer) CreateIscnRecord(goCtx context.Context, msg *MsgCreateIscnRec
 	}, nil
 }
 
+// BatchCreateIscnRecords defines a method to create multiple ISCN records atomically, with the fee deducted once
+func (k msgServer) BatchCreateIscnRecords(goCtx context.Context, msg *MsgBatchCreateIscnRecords) (*MsgBatchCreateIscnRecordsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	registryName := k.RegistryName(ctx)
+	totalBytes := 0
+	records := make([]types.MsgCreateIscnRecordResponse, 0, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		err = k.ValidateContentMetadata(ctx, entry.Record.ContentMetadata)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		// using the sign bytes of the equivalent MsgCreateIscnRecord, so the ISCN ID is the same as creating individually
+		seed := types.NewMsgCreateIscnRecord(from, &entry.Record, entry.Nonce).GetSignBytes()
+		id := types.GenerateNewIscnIdWithSeed(registryName, seed)
+		recordJsonLd, err := entry.Record.ToJsonLd(&types.IscnRecordJsonLdInfo{
+			Id:         id,
+			Timestamp:  ctx.BlockTime(),
+			ParentIpld: nil,
+		})
+		if err != nil {
+			return nil, sdkerrors.Wrapf(types.ErrEncodingJsonLd, "entry %d: %s", i, err.Error())
+		}
+		err = k.ValidateRecordLimits(ctx, &entry.Record, recordJsonLd)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		err = k.ValidateRelations(ctx, id.Prefix, entry.Record.Relations)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		cid, err := k.checkNewIscnRecord(ctx, id, from, recordJsonLd)
+		if err != nil {
+			return nil, sdkerrors.Wrapf(err, "entry %d", i)
+		}
+		err = k.storeIscnRecord(ctx, id, from, recordJsonLd, entry.Record.ContentFingerprints, cid)
+		if err != nil {
+			return nil, err
+		}
+		k.AppendOwnershipHistory(ctx, id.Prefix, from, sdk.MsgTypeURL(msg))
+		totalBytes += len(recordJsonLd)
+		records = append(records, types.MsgCreateIscnRecordResponse{
+			IscnId:     id.String(),
+			RecordIpld: cid.String(),
+		})
+	}
+	err = k.DeductFeeForIscn(ctx, from, totalBytes, msg)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrDeductIscnFee, "%s", err.Error())
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgBatchCreateIscnRecordsResponse{
+		Records: records,
+	}, nil
+}
+
 // UpdateIscnRecord defines a method to update existing ISCN record
 func (k msgServer) UpdateIscnRecord(goCtx context.Context, msg *MsgUpdateIscnRecord) (*MsgUpdateIscnRecordResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -69,6 +148,10 @@ func (k msgServer) UpdateIscnRecord(goCtx context.Context, msg *MsgUpdateIscnRec
 	if parentSeq == 0 {
 		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "parent ISCN ID %s not found", parentId.String())
 	}
+	err = k.ValidateContentMetadata(ctx, msg.Record.ContentMetadata)
+	if err != nil {
+		return nil, err
+	}
 	parentStoreRecord := k.GetStoreRecord(ctx, parentSeq)
 	parentCid := parentStoreRecord.Cid()
 	id := NewIscnId(parentId.Prefix.RegistryName, parentId.Prefix.ContentId, parentId.Version+1)
@@ -80,6 +163,14 @@ func (k msgServer) UpdateIscnRecord(goCtx context.Context, msg *MsgUpdateIscnRec
 	if err != nil {
 		return nil, sdkerrors.Wrapf(types.ErrEncodingJsonLd, "%s", err.Error())
 	}
+	err = k.ValidateRecordLimits(ctx, &msg.Record, recordJsonLd)
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidateRelations(ctx, id.Prefix, msg.Record.Relations)
+	if err != nil {
+		return nil, err
+	}
 	cid, err := k.AddIscnRecord(ctx, id, from, recordJsonLd, msg.Record.ContentFingerprints, msg)
 	if err != nil {
 		return nil, err
@@ -123,16 +214,73 @@ func (k msgServer) ChangeIscnRecordOwnership(goCtx context.Context, msg *MsgChan
 	if !from.Equals(prevOwner) {
 		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender not ISCN record owner, expect %s", prevOwner.String())
 	}
+	if !contentIdRecord.IsActive() {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotActive, "%s is %s", id.Prefix.String(), contentIdRecord.Status.String())
+	}
 	contentIdRecord.OwnerAddressBytes = newOwner.Bytes()
 	k.SetContentIdRecord(ctx, id.Prefix, contentIdRecord)
+	k.AppendOwnershipHistory(ctx, id.Prefix, newOwner, sdk.MsgTypeURL(msg))
+	event := sdk.NewEvent(
+		types.EventTypeIscnRecord,
+		sdk.NewAttribute(types.AttributeKeyIscnId, id.String()),
+		sdk.NewAttribute(types.AttributeKeyIscnIdPrefix, id.Prefix.String()),
+		sdk.NewAttribute(types.AttributeKeyIscnOwner, newOwner.String()),
+	)
+	ctx.EventManager().EmitEvent(k.AppendOwnerGroupAttribute(ctx, event, newOwner))
+	err = ctx.EventManager().EmitTypedEvent(&types.EventChangeIscnRecordOwnership{
+		IscnId:        id.String(),
+		IscnIdPrefix:  id.Prefix.String(),
+		PreviousOwner: prevOwner.String(),
+		NewOwner:      newOwner.String(),
+		Version:       id.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeIscnRecord,
-			sdk.NewAttribute(types.AttributeKeyIscnId, id.String()),
-			sdk.NewAttribute(types.AttributeKeyIscnIdPrefix, id.Prefix.String()),
-			sdk.NewAttribute(types.AttributeKeyIscnOwner, newOwner.String()),
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
 		),
 	)
+	return &types.MsgChangeIscnRecordOwnershipResponse{}, nil
+}
+
+// getOwnedContentIdRecord checks that the sender is the owner of the latest version of the ISCN record
+func (k msgServer) getOwnedContentIdRecord(ctx sdk.Context, from sdk.AccAddress, iscnId string) (IscnId, *ContentIdRecord, error) {
+	id, err := types.ParseIscnId(iscnId)
+	if err != nil {
+		return id, nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	contentIdRecord := k.GetContentIdRecord(ctx, id.Prefix)
+	if contentIdRecord == nil {
+		return id, nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", id.String())
+	}
+	if id.Version != contentIdRecord.LatestVersion {
+		return id, nil, sdkerrors.Wrapf(types.ErrInvalidIscnVersion, "expected version: %d", contentIdRecord.LatestVersion)
+	}
+	owner := contentIdRecord.OwnerAddress()
+	if !from.Equals(owner) {
+		return id, nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender not ISCN record owner, expect %s", owner.String())
+	}
+	return id, contentIdRecord, nil
+}
+
+func (k msgServer) setContentIdRecordStatus(ctx sdk.Context, from sdk.AccAddress, id IscnId, contentIdRecord *ContentIdRecord) {
+	k.SetContentIdRecord(ctx, id.Prefix, contentIdRecord)
+	event := sdk.NewEvent(
+		types.EventTypeIscnRecord,
+		sdk.NewAttribute(types.AttributeKeyIscnId, id.String()),
+		sdk.NewAttribute(types.AttributeKeyIscnIdPrefix, id.Prefix.String()),
+		sdk.NewAttribute(types.AttributeKeyIscnOwner, contentIdRecord.OwnerAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyIscnStatus, contentIdRecord.Status.String()),
+	)
+	event = k.AppendOwnerGroupAttribute(ctx, event, contentIdRecord.OwnerAddress())
+	if contentIdRecord.SupersededBy != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(types.AttributeKeyIscnSupersededBy, contentIdRecord.SupersededBy))
+	}
+	ctx.EventManager().EmitEvent(event)
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			sdk.EventTypeMessage,
@@ -140,5 +288,407 @@ func (k msgServer) ChangeIscnRecordOwnership(goCtx context.Context, msg *MsgChan
 			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
 		),
 	)
-	return &types.MsgChangeIscnRecordOwnershipResponse{}, nil
+}
+
+// FreezeIscnRecord defines a method to stop existing ISCN record from accepting new versions
+func (k msgServer) FreezeIscnRecord(goCtx context.Context, msg *MsgFreezeIscnRecord) (*MsgFreezeIscnRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, contentIdRecord, err := k.getOwnedContentIdRecord(ctx, from, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if contentIdRecord.Status != types.ISCN_RECORD_STATUS_ACTIVE {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnStatus, "cannot freeze record with status %s", contentIdRecord.Status.String())
+	}
+	contentIdRecord.Status = types.ISCN_RECORD_STATUS_FROZEN
+	k.setContentIdRecordStatus(ctx, from, id, contentIdRecord)
+	return &types.MsgFreezeIscnRecordResponse{}, nil
+}
+
+// DeprecateIscnRecord defines a method to mark existing ISCN record as withdrawn
+func (k msgServer) DeprecateIscnRecord(goCtx context.Context, msg *MsgDeprecateIscnRecord) (*MsgDeprecateIscnRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, contentIdRecord, err := k.getOwnedContentIdRecord(ctx, from, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if contentIdRecord.Status != types.ISCN_RECORD_STATUS_ACTIVE && contentIdRecord.Status != types.ISCN_RECORD_STATUS_FROZEN {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnStatus, "cannot deprecate record with status %s", contentIdRecord.Status.String())
+	}
+	contentIdRecord.Status = types.ISCN_RECORD_STATUS_DEPRECATED
+	k.setContentIdRecordStatus(ctx, from, id, contentIdRecord)
+	return &types.MsgDeprecateIscnRecordResponse{}, nil
+}
+
+// SupersedeIscnRecord defines a method to mark existing ISCN record as replaced by another ISCN record
+func (k msgServer) SupersedeIscnRecord(goCtx context.Context, msg *MsgSupersedeIscnRecord) (*MsgSupersedeIscnRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, contentIdRecord, err := k.getOwnedContentIdRecord(ctx, from, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if contentIdRecord.Status == types.ISCN_RECORD_STATUS_SUPERSEDED {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnStatus, "record already superseded by %s", contentIdRecord.SupersededBy)
+	}
+	supersededById, err := types.ParseIscnId(msg.SupersededBy)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "invalid superseding ISCN ID: %s", err.Error())
+	}
+	// the successor is the whole ISCN record, so the version in the ISCN ID is dropped
+	supersededBy := supersededById.Prefix
+	if id.Prefix.Equal(supersededBy) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "ISCN record cannot be superseded by itself")
+	}
+	if k.GetContentIdRecord(ctx, supersededBy) == nil {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "superseding ISCN record %s not found", supersededBy.String())
+	}
+	contentIdRecord.Status = types.ISCN_RECORD_STATUS_SUPERSEDED
+	contentIdRecord.SupersededBy = supersededBy.String()
+	k.setContentIdRecordStatus(ctx, from, id, contentIdRecord)
+	return &types.MsgSupersedeIscnRecordResponse{}, nil
+}
+
+// ProposeIscnOwnershipTransfer defines a method to propose transferring the ownership of existing ISCN record,
+// which takes effect after the new owner accepts
+func (k msgServer) ProposeIscnOwnershipTransfer(goCtx context.Context, msg *MsgProposeIscnOwnershipTransfer) (*MsgProposeIscnOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address: %s", err.Error())
+	}
+	if from.Equals(newOwner) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOwnershipTransfer, "new owner is the same as the current owner")
+	}
+	id, contentIdRecord, err := k.getOwnedContentIdRecord(ctx, from, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if !contentIdRecord.IsActive() {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotActive, "%s is %s", id.Prefix.String(), contentIdRecord.Status.String())
+	}
+	if !msg.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOwnershipTransfer, "expiration is in the past")
+	}
+	if msg.Expiration.After(ctx.BlockTime().Add(types.MaxOwnershipTransferDuration)) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOwnershipTransfer, "expiration exceeds maximum duration %s", types.MaxOwnershipTransferDuration.String())
+	}
+	transfer := PendingOwnershipTransfer{
+		IscnIdPrefix: id.Prefix.String(),
+		Owner:        from.String(),
+		NewOwner:     newOwner.String(),
+		Expiration:   msg.Expiration,
+	}
+	k.SetPendingOwnershipTransfer(ctx, id.Prefix, &transfer)
+	ctx.EventManager().EmitEvent(types.NewOwnershipTransferEvent(&transfer, types.AttributeValueTransferProposed))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgProposeIscnOwnershipTransferResponse{}, nil
+}
+
+// getPendingOwnershipTransfer returns the pending transfer of the ISCN record, ignoring the version part of the ISCN ID
+func (k msgServer) getPendingOwnershipTransfer(ctx sdk.Context, iscnId string) (IscnId, *PendingOwnershipTransfer, error) {
+	id, err := types.ParseIscnId(iscnId)
+	if err != nil {
+		return id, nil, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	transfer := k.GetPendingOwnershipTransfer(ctx, id.Prefix)
+	if transfer == nil {
+		return id, nil, sdkerrors.Wrapf(types.ErrOwnershipTransferNotFound, "%s", id.Prefix.String())
+	}
+	return id, transfer, nil
+}
+
+// AcceptIscnOwnershipTransfer defines a method for the new owner to accept a pending ownership transfer
+func (k msgServer) AcceptIscnOwnershipTransfer(goCtx context.Context, msg *MsgAcceptIscnOwnershipTransfer) (*MsgAcceptIscnOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, transfer, err := k.getPendingOwnershipTransfer(ctx, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if from.String() != transfer.NewOwner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender is not the new owner of the transfer, expect %s", transfer.NewOwner)
+	}
+	if !transfer.Expiration.After(ctx.BlockTime()) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidOwnershipTransfer, "transfer expired")
+	}
+	contentIdRecord := k.GetContentIdRecord(ctx, id.Prefix)
+	if contentIdRecord == nil {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", id.Prefix.String())
+	}
+	// the record may be locked after the transfer is proposed
+	if !contentIdRecord.IsActive() {
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotActive, "%s is %s", id.Prefix.String(), contentIdRecord.Status.String())
+	}
+	contentIdRecord.OwnerAddressBytes = from.Bytes()
+	// also removes the pending transfer
+	k.SetContentIdRecord(ctx, id.Prefix, contentIdRecord)
+	k.AppendOwnershipHistory(ctx, id.Prefix, from, sdk.MsgTypeURL(msg))
+	ctx.EventManager().EmitEvent(types.NewOwnershipTransferEvent(transfer, types.AttributeValueTransferAccepted))
+	latestId := NewIscnId(id.Prefix.RegistryName, id.Prefix.ContentId, contentIdRecord.LatestVersion)
+	event := sdk.NewEvent(
+		types.EventTypeIscnRecord,
+		sdk.NewAttribute(types.AttributeKeyIscnId, latestId.String()),
+		sdk.NewAttribute(types.AttributeKeyIscnIdPrefix, id.Prefix.String()),
+		sdk.NewAttribute(types.AttributeKeyIscnOwner, from.String()),
+	)
+	ctx.EventManager().EmitEvent(k.AppendOwnerGroupAttribute(ctx, event, from))
+	err = ctx.EventManager().EmitTypedEvent(&types.EventChangeIscnRecordOwnership{
+		IscnId:        latestId.String(),
+		IscnIdPrefix:  id.Prefix.String(),
+		PreviousOwner: transfer.Owner,
+		NewOwner:      from.String(),
+		Version:       latestId.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgAcceptIscnOwnershipTransferResponse{}, nil
+}
+
+// CancelIscnOwnershipTransfer defines a method for the owner to cancel a pending ownership transfer
+func (k msgServer) CancelIscnOwnershipTransfer(goCtx context.Context, msg *MsgCancelIscnOwnershipTransfer) (*MsgCancelIscnOwnershipTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, transfer, err := k.getPendingOwnershipTransfer(ctx, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if from.String() != transfer.Owner {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender not ISCN record owner, expect %s", transfer.Owner)
+	}
+	k.DeletePendingOwnershipTransfer(ctx, id.Prefix)
+	ctx.EventManager().EmitEvent(types.NewOwnershipTransferEvent(transfer, types.AttributeValueTransferCancelled))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgCancelIscnOwnershipTransferResponse{}, nil
+}
+
+// CommitIscn defines a method to store a commitment of an ISCN record, to be revealed before expiration
+func (k msgServer) CommitIscn(goCtx context.Context, msg *MsgCommitIscn) (*MsgCommitIscnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	err = types.ValidateCommitment(msg.Commitment)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCommitment, "%s", err.Error())
+	}
+	if k.GetCommitment(ctx, msg.Commitment) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCommitment, "commitment already exists")
+	}
+	expiryDuration := time.Duration(k.CommitmentExpiryDays(ctx)) * 24 * time.Hour
+	commitment := IscnCommitment{
+		Commitment:   msg.Commitment,
+		Committer:    from.String(),
+		CommitTime:   ctx.BlockTime(),
+		CommitHeight: ctx.BlockHeight(),
+		Expiration:   ctx.BlockTime().Add(expiryDuration),
+	}
+	k.SetCommitment(ctx, &commitment)
+	ctx.EventManager().EmitEvent(types.NewCommitmentEvent(&commitment, types.AttributeValueCommitmentCommitted))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgCommitIscnResponse{
+		Expiration: commitment.Expiration,
+	}, nil
+}
+
+// RevealIscn defines a method to create an ISCN record from a previous commitment made by the sender, with the commit
+// time and height recorded in the record
+func (k msgServer) RevealIscn(goCtx context.Context, msg *MsgRevealIscn) (*MsgRevealIscnResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	hash, err := types.ComputeIscnCommitment(msg.Salt, from, &msg.Record)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidIscnRecord, "%s", err.Error())
+	}
+	commitment := k.GetCommitment(ctx, hash)
+	if commitment == nil {
+		return nil, sdkerrors.Wrapf(types.ErrCommitmentNotFound, "no commitment matching the record, salt and sender")
+	}
+	if commitment.Committer != from.String() {
+		// should not happen since the committer address is a part of the hash
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender is not the committer")
+	}
+	if commitment.IsRevealed() {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCommitment, "commitment already revealed as %s", commitment.RevealedIscnId)
+	}
+	if !ctx.BlockTime().Before(commitment.Expiration) {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidCommitment, "commitment expired")
+	}
+	err = k.ValidateContentMetadata(ctx, msg.Record.ContentMetadata)
+	if err != nil {
+		return nil, err
+	}
+	registryName := k.RegistryName(ctx)
+	seed := msg.GetSignBytes()
+	id := types.GenerateNewIscnIdWithSeed(registryName, seed)
+	recordJsonLd, err := msg.Record.ToJsonLd(&types.IscnRecordJsonLdInfo{
+		Id:         id,
+		Timestamp:  ctx.BlockTime(),
+		ParentIpld: nil,
+		Commitment: commitment,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrapf(types.ErrEncodingJsonLd, "%s", err.Error())
+	}
+	err = k.ValidateRecordLimits(ctx, &msg.Record, recordJsonLd)
+	if err != nil {
+		return nil, err
+	}
+	err = k.ValidateRelations(ctx, id.Prefix, msg.Record.Relations)
+	if err != nil {
+		return nil, err
+	}
+	cid, err := k.AddIscnRecord(ctx, id, from, recordJsonLd, msg.Record.ContentFingerprints, msg)
+	if err != nil {
+		return nil, err
+	}
+	k.AppendOwnershipHistory(ctx, id.Prefix, from, sdk.MsgTypeURL(msg))
+	commitment.RevealedIscnId = id.String()
+	k.SetCommitment(ctx, commitment)
+	ctx.EventManager().EmitEvent(types.NewCommitmentEvent(commitment, types.AttributeValueCommitmentRevealed))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgRevealIscnResponse{
+		IscnId:     id.String(),
+		RecordIpld: cid.String(),
+	}, nil
+}
+
+// getAttestedRecordSequence returns the sequence of the ISCN record version to be attested
+func (k msgServer) getAttestedRecordSequence(ctx sdk.Context, iscnIdStr string) (IscnId, uint64, error) {
+	id, err := types.ParseIscnId(iscnIdStr)
+	if err != nil {
+		return id, 0, sdkerrors.Wrapf(types.ErrInvalidIscnId, "%s", err.Error())
+	}
+	if id.Version == 0 {
+		return id, 0, sdkerrors.Wrapf(types.ErrInvalidIscnVersion, "ISCN ID must include the version of the record to be attested")
+	}
+	seq := k.GetIscnIdSequence(ctx, id)
+	if seq == 0 {
+		return id, 0, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", id.String())
+	}
+	return id, seq, nil
+}
+
+// AttestIscnRecord defines a method for an address named as a stakeholder of an ISCN record version to confirm it
+func (k msgServer) AttestIscnRecord(goCtx context.Context, msg *MsgAttestIscnRecord) (*MsgAttestIscnRecordResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, seq, err := k.getAttestedRecordSequence(ctx, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	storeRecord := k.GetStoreRecord(ctx, seq)
+	if storeRecord == nil {
+		// BUG, should break invariant
+		return nil, sdkerrors.Wrapf(types.ErrRecordNotFound, "%s", id.String())
+	}
+	if !types.IsStakeholderAddress(storeRecord.Data, from) {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "sender is not named as a stakeholder entity in %s", id.String())
+	}
+	if k.GetAttestation(ctx, seq, from) != nil {
+		return nil, sdkerrors.Wrapf(types.ErrInvalidAttestation, "sender has already attested %s", id.String())
+	}
+	attestation := IscnAttestation{
+		IscnId:       id.String(),
+		Attester:     from.String(),
+		AttestTime:   ctx.BlockTime(),
+		AttestHeight: ctx.BlockHeight(),
+	}
+	k.SetAttestation(ctx, seq, &attestation)
+	ctx.EventManager().EmitEvent(types.NewAttestationEvent(&attestation, types.AttributeValueAttestationAttested))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgAttestIscnRecordResponse{}, nil
+}
+
+// RevokeIscnRecordAttestation defines a method for an attester to revoke the attestation of an ISCN record version
+func (k msgServer) RevokeIscnRecordAttestation(goCtx context.Context, msg *MsgRevokeIscnRecordAttestation) (*MsgRevokeIscnRecordAttestationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, seq, err := k.getAttestedRecordSequence(ctx, msg.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	attestation := k.GetAttestation(ctx, seq, from)
+	if attestation == nil {
+		return nil, sdkerrors.Wrapf(types.ErrAttestationNotFound, "no attestation of %s from sender", id.String())
+	}
+	k.DeleteAttestation(ctx, seq, from)
+	ctx.EventManager().EmitEvent(types.NewAttestationEvent(attestation, types.AttributeValueAttestationRevoked))
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, from.String()),
+		),
+	)
+	return &types.MsgRevokeIscnRecordAttestationResponse{}, nil
 }
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+// AppendOwnershipHistory records the owner of the ISCN record set by the message with the type URL in the current
+// block
+func (k Keeper) AppendOwnershipHistory(ctx sdk.Context, iscnIdPrefix IscnIdPrefix, owner sdk.AccAddress, msgTypeUrl string) {
+	k.AppendOwnershipHistoryEntry(ctx, iscnIdPrefix, &IscnOwnershipHistoryEntry{
+		IscnIdPrefix: iscnIdPrefix.String(),
+		Owner:        owner.String(),
+		Height:       ctx.BlockHeight(),
+		Time:         ctx.BlockTime(),
+		MsgTypeUrl:   msgTypeUrl,
+	})
+}
+
+// AppendOwnershipHistoryEntry stores the entry after the existing entries of the ISCN ID prefix, the history is
+// append-only. The ISCN ID prefix is already in the key, so it is not stored again in the entry.
+func (k Keeper) AppendOwnershipHistoryEntry(ctx sdk.Context, iscnIdPrefix IscnIdPrefix, entry *IscnOwnershipHistoryEntry) {
+	store := k.prefixStore(ctx, types.GetOwnershipHistoryStorePrefix(k.MustMarshalIscnIdPrefix(iscnIdPrefix)))
+	index := uint64(0)
+	it := store.ReverseIterator(nil, nil)
+	if it.Valid() {
+		index = types.DecodeUint64(it.Key()) + 1
+	}
+	it.Close()
+	storedEntry := *entry
+	storedEntry.IscnIdPrefix = ""
+	store.Set(types.EncodeUint64(index), k.MustMarshalOwnershipHistoryEntry(&storedEntry))
+}
+
+func (k Keeper) GetOwnershipHistory(ctx sdk.Context, iscnIdPrefix IscnIdPrefix) []IscnOwnershipHistoryEntry {
+	entries := []IscnOwnershipHistoryEntry{}
+	it := k.prefixStore(ctx, types.GetOwnershipHistoryStorePrefix(k.MustMarshalIscnIdPrefix(iscnIdPrefix))).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		entry := k.MustUnmarshalOwnershipHistoryEntry(it.Value())
+		entry.IscnIdPrefix = iscnIdPrefix.String()
+		entries = append(entries, entry)
+	}
+	return entries
+}
+
+// IterateOwnershipHistory iterates the ownership history entries of all ISCN ID prefixes, with the entries of the same
+// ISCN ID prefix iterated from the oldest to the newest
+func (k Keeper) IterateOwnershipHistory(ctx sdk.Context, f func(entry IscnOwnershipHistoryEntry) bool) {
+	it := k.prefixStore(ctx, OwnershipHistoryPrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		entry := k.MustUnmarshalOwnershipHistoryEntry(it.Value())
+		entry.IscnIdPrefix = k.MustUnmarshalIscnIdPrefix(types.ParseOwnershipHistoryKey(it.Key())).String()
+		if f(entry) {
+			break
+		}
+	}
+}
+
+// InitOwnershipHistory records the current owners of the existing ISCN records without ownership history, with an
+// empty message type URL, as the starting point of their ownership history
+func (k Keeper) InitOwnershipHistory(ctx sdk.Context) {
+	k.IterateContentIdRecords(ctx, func(iscnIdPrefix IscnIdPrefix, contentIdRecord ContentIdRecord) bool {
+		store := k.prefixStore(ctx, types.GetOwnershipHistoryStorePrefix(k.MustMarshalIscnIdPrefix(iscnIdPrefix)))
+		it := store.Iterator(nil, nil)
+		hasHistory := it.Valid()
+		it.Close()
+		if !hasHistory {
+			k.AppendOwnershipHistory(ctx, iscnIdPrefix, contentIdRecord.OwnerAddress(), "")
+		}
+		return false
+	})
+}
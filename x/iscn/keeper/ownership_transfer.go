@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+func (k Keeper) GetPendingOwnershipTransfer(ctx sdk.Context, iscnIdPrefix IscnIdPrefix) *PendingOwnershipTransfer {
+	key := k.MustMarshalIscnIdPrefix(iscnIdPrefix)
+	bz := k.prefixStore(ctx, PendingOwnershipTransferPrefix).Get(key)
+	if bz == nil {
+		return nil
+	}
+	transfer := k.MustUnmarshalPendingOwnershipTransfer(bz)
+	return &transfer
+}
+
+// SetPendingOwnershipTransfer stores the transfer together with the expiry queue and new owner indexes, replacing
+// any existing pending transfer of the same ISCN record
+func (k Keeper) SetPendingOwnershipTransfer(ctx sdk.Context, iscnIdPrefix IscnIdPrefix, transfer *PendingOwnershipTransfer) {
+	k.DeletePendingOwnershipTransfer(ctx, iscnIdPrefix)
+	iscnIdPrefixBytes := k.MustMarshalIscnIdPrefix(iscnIdPrefix)
+	k.prefixStore(ctx, PendingOwnershipTransferPrefix).Set(iscnIdPrefixBytes, k.MustMarshalPendingOwnershipTransfer(transfer))
+	expiryKey := types.GetPendingOwnershipTransferExpiryKey(transfer.Expiration, iscnIdPrefixBytes)
+	k.prefixStore(ctx, PendingOwnershipTransferExpiryPrefix).Set(expiryKey, []byte{0x01})
+	newOwner := sdk.MustAccAddressFromBech32(transfer.NewOwner)
+	newOwnerKey := types.GetPendingOwnershipTransferNewOwnerKey(newOwner, iscnIdPrefixBytes)
+	k.prefixStore(ctx, PendingOwnershipTransferNewOwnerPrefix).Set(newOwnerKey, []byte{0x01})
+}
+
+func (k Keeper) DeletePendingOwnershipTransfer(ctx sdk.Context, iscnIdPrefix IscnIdPrefix) {
+	transfer := k.GetPendingOwnershipTransfer(ctx, iscnIdPrefix)
+	if transfer == nil {
+		return
+	}
+	iscnIdPrefixBytes := k.MustMarshalIscnIdPrefix(iscnIdPrefix)
+	k.prefixStore(ctx, PendingOwnershipTransferPrefix).Delete(iscnIdPrefixBytes)
+	expiryKey := types.GetPendingOwnershipTransferExpiryKey(transfer.Expiration, iscnIdPrefixBytes)
+	k.prefixStore(ctx, PendingOwnershipTransferExpiryPrefix).Delete(expiryKey)
+	newOwner := sdk.MustAccAddressFromBech32(transfer.NewOwner)
+	newOwnerKey := types.GetPendingOwnershipTransferNewOwnerKey(newOwner, iscnIdPrefixBytes)
+	k.prefixStore(ctx, PendingOwnershipTransferNewOwnerPrefix).Delete(newOwnerKey)
+}
+
+func (k Keeper) IteratePendingOwnershipTransfers(ctx sdk.Context, f func(iscnIdPrefix IscnIdPrefix, transfer PendingOwnershipTransfer) bool) {
+	it := k.prefixStore(ctx, PendingOwnershipTransferPrefix).Iterator(nil, nil)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		iscnIdPrefix := k.MustUnmarshalIscnIdPrefix(it.Key())
+		transfer := k.MustUnmarshalPendingOwnershipTransfer(it.Value())
+		if f(iscnIdPrefix, transfer) {
+			break
+		}
+	}
+}
+
+// ExpirePendingOwnershipTransfers removes all pending transfers with expiration not after the block time
+func (k Keeper) ExpirePendingOwnershipTransfers(ctx sdk.Context) {
+	expiredPrefixes := []IscnIdPrefix{}
+	end := sdk.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime()))
+	it := k.prefixStore(ctx, PendingOwnershipTransferExpiryPrefix).Iterator(nil, end)
+	for ; it.Valid(); it.Next() {
+		iscnIdPrefixBytes := types.ParsePendingOwnershipTransferExpiryKey(it.Key())
+		expiredPrefixes = append(expiredPrefixes, k.MustUnmarshalIscnIdPrefix(iscnIdPrefixBytes))
+	}
+	it.Close()
+	for _, iscnIdPrefix := range expiredPrefixes {
+		transfer := k.GetPendingOwnershipTransfer(ctx, iscnIdPrefix)
+		if transfer == nil {
+			// BUG, should break invariant
+			ctx.Logger().Error("no pending ownership transfer for expiry queue entry", "iscn_id_prefix", iscnIdPrefix.String())
+			continue
+		}
+		k.DeletePendingOwnershipTransfer(ctx, iscnIdPrefix)
+		ctx.EventManager().EmitEvent(types.NewOwnershipTransferEvent(transfer, types.AttributeValueTransferExpired))
+	}
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"bytes"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// rangeStore restricts the iterators of the underlying store to the range [start, end), so that query.Paginate can be
+// used for paginating a key range instead of a whole prefix store
+type rangeStore struct {
+	storetypes.KVStore
+	start []byte
+	end   []byte
+}
+
+func newRangeStore(store storetypes.KVStore, start, end []byte) rangeStore {
+	return rangeStore{
+		KVStore: store,
+		start:   start,
+		end:     end,
+	}
+}
+
+func (s rangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if start == nil || (s.start != nil && bytes.Compare(start, s.start) < 0) {
+		start = s.start
+	}
+	if end == nil || (s.end != nil && bytes.Compare(end, s.end) > 0) {
+		end = s.end
+	}
+	return start, end
+}
+
+func (s rangeStore) Iterator(start, end []byte) dbm.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s rangeStore) ReverseIterator(start, end []byte) dbm.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
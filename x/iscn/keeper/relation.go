@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+// ValidateRelations checks that the relations of the record with the ISCN ID prefix point to other existing records
+func (k Keeper) ValidateRelations(ctx sdk.Context, iscnIdPrefix IscnIdPrefix, relations []*IscnRelation) error {
+	for _, relation := range relations {
+		target, err := relation.ParseTarget()
+		if err != nil {
+			return sdkerrors.Wrapf(types.ErrInvalidRelation, "%s", err.Error())
+		}
+		if target.Equal(iscnIdPrefix) {
+			return sdkerrors.Wrapf(types.ErrInvalidRelation, "record cannot relate to itself")
+		}
+		if k.GetContentIdRecord(ctx, target) == nil {
+			return sdkerrors.Wrapf(types.ErrRecordNotFound, "relation target %s not found", target.String())
+		}
+	}
+	return nil
+}
+
+// AddRelation indexes the relation declared by the record version with the sequence in both directions
+func (k Keeper) AddRelation(ctx sdk.Context, seq uint64, relationType string, target IscnIdPrefix) {
+	targetPrefixBytes := k.MustMarshalIscnIdPrefix(target)
+	k.prefixStore(ctx, OutgoingRelationPrefix).Set(types.GetOutgoingRelationKey(seq, relationType, targetPrefixBytes), []byte{0x01})
+	k.prefixStore(ctx, IncomingRelationPrefix).Set(types.GetIncomingRelationKey(targetPrefixBytes, relationType, seq), []byte{0x01})
+}
+
+// AddRelationSequences indexes the relations in the record data
+func (k Keeper) AddRelationSequences(ctx sdk.Context, data []byte, seq uint64) {
+	for _, relation := range types.GetRecordRelations(data) {
+		target, err := relation.ParseTarget()
+		if err != nil {
+			continue
+		}
+		k.AddRelation(ctx, seq, relation.Type, target)
+	}
+}
+
+func (k Keeper) HasOutgoingRelation(ctx sdk.Context, seq uint64, relationType string, target IscnIdPrefix) bool {
+	key := types.GetOutgoingRelationKey(seq, relationType, k.MustMarshalIscnIdPrefix(target))
+	return k.prefixStore(ctx, OutgoingRelationPrefix).Has(key)
+}
+
+func (k Keeper) HasIncomingRelation(ctx sdk.Context, target IscnIdPrefix, relationType string, seq uint64) bool {
+	key := types.GetIncomingRelationKey(k.MustMarshalIscnIdPrefix(target), relationType, seq)
+	return k.prefixStore(ctx, IncomingRelationPrefix).Has(key)
+}
@@ -0,0 +1,200 @@
+package proof
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"time"
+
+	gocid "github.com/ipfs/go-cid"
+
+	"github.com/tendermint/tendermint/crypto/merkle"
+	tmjson "github.com/tendermint/tendermint/libs/json"
+	tmcrypto "github.com/tendermint/tendermint/proto/tendermint/crypto"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+// StoreEntryProof is a key-value entry in the ISCN store, with the ICS-23 proof of its existence in the app hash
+type StoreEntryProof struct {
+	Key      []byte            `json:"key"`
+	Value    []byte            `json:"value"`
+	ProofOps tmcrypto.ProofOps `json:"proof_ops"`
+}
+
+// RecordProof is a self-contained proof of an ISCN record existing in the state at Height.
+// The app hash of the state at Height is committed in the header of the next block, so SignedHeader is the header
+// and commit at Height + 1, and Validators is the validator set signing that block.
+type RecordProof struct {
+	ChainId          string                `json:"chain_id"`
+	Height           int64                 `json:"height"`
+	IscnId           string                `json:"iscn_id"`
+	StoreName        string                `json:"store_name"`
+	IscnIdToSequence StoreEntryProof       `json:"iscn_id_to_sequence"`
+	StoreRecord      StoreEntryProof       `json:"store_record"`
+	SignedHeader     *tmtypes.SignedHeader `json:"signed_header"`
+	Validators       []*tmtypes.Validator  `json:"validators"`
+}
+
+// VerifyResult is the information proven by a record proof
+type VerifyResult struct {
+	ChainId        string
+	Height         int64
+	BlockTime      time.Time
+	ValidatorsHash []byte
+	IscnId         types.IscnId
+	Sequence       uint64
+	Cid            types.CID
+	Record         types.IscnInput
+}
+
+// Marshal encodes the proof into JSON, using the Tendermint JSON encoding for the header and the validators
+func (p *RecordProof) Marshal() ([]byte, error) {
+	return tmjson.MarshalIndent(p, "", "  ")
+}
+
+// ReadRecordProof decodes a proof file written by RecordProof.Marshal
+func ReadRecordProof(r io.Reader) (*RecordProof, error) {
+	bz, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	p := RecordProof{}
+	err = tmjson.Unmarshal(bz, &p)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proof file: %w", err)
+	}
+	return &p, nil
+}
+
+func (entry StoreEntryProof) verify(storeName string, appHash []byte) error {
+	if len(entry.Value) == 0 {
+		return fmt.Errorf("empty value")
+	}
+	keyPath := merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(entry.Key, merkle.KeyEncodingURL)
+	return rootmulti.DefaultProofRuntime().VerifyValue(&entry.ProofOps, appHash, keyPath.String(), entry.Value)
+}
+
+// verifyHeader checks that the header is signed by more than 2/3 of the voting power of the validator set with the
+// trusted hash, and returns the verified header
+func (p *RecordProof) verifyHeader(trustedValidatorsHash []byte) (*tmtypes.Header, error) {
+	if p.SignedHeader == nil || p.SignedHeader.Header == nil || p.SignedHeader.Commit == nil {
+		return nil, fmt.Errorf("missing signed header")
+	}
+	err := p.SignedHeader.ValidateBasic(p.ChainId)
+	if err != nil {
+		return nil, fmt.Errorf("invalid signed header: %w", err)
+	}
+	header := p.SignedHeader.Header
+	if header.Height != p.Height+1 {
+		return nil, fmt.Errorf("expect header at height %d, got %d", p.Height+1, header.Height)
+	}
+	valSet, err := tmtypes.ValidatorSetFromExistingValidators(p.Validators)
+	if err != nil {
+		return nil, fmt.Errorf("invalid validator set: %w", err)
+	}
+	valSetHash := valSet.Hash()
+	if !bytes.Equal(valSetHash, trustedValidatorsHash) {
+		return nil, fmt.Errorf("validator set hash %X does not match the trusted hash %X", valSetHash, trustedValidatorsHash)
+	}
+	if !bytes.Equal(header.ValidatorsHash, valSetHash) {
+		return nil, fmt.Errorf("header has validators hash %X, but the validator set has hash %X", header.ValidatorsHash, valSetHash)
+	}
+	commit := p.SignedHeader.Commit
+	err = valSet.VerifyCommitLight(p.ChainId, commit.BlockID, header.Height, commit)
+	if err != nil {
+		return nil, fmt.Errorf("invalid commit: %w", err)
+	}
+	return header, nil
+}
+
+// Verify checks the proof offline against the trusted validator set hash, i.e. the header is signed by the trusted
+// validator set, both store entries are proven against the app hash in the header, and the entries are consistent
+// with the ISCN ID and the record data.
+func (p *RecordProof) Verify(trustedValidatorsHash []byte) (*VerifyResult, error) {
+	iscnId, err := types.ParseIscnId(p.IscnId)
+	if err != nil {
+		return nil, err
+	}
+	if iscnId.Version == 0 {
+		return nil, fmt.Errorf("ISCN ID %s has no version", p.IscnId)
+	}
+	if p.StoreName != types.StoreKey {
+		return nil, fmt.Errorf("expect store name %s, got %s", types.StoreKey, p.StoreName)
+	}
+	header, err := p.verifyHeader(trustedValidatorsHash)
+	if err != nil {
+		return nil, err
+	}
+
+	err = p.IscnIdToSequence.verify(p.StoreName, header.AppHash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot verify ISCN ID to sequence entry: %w", err)
+	}
+	expectedKey, err := GetIscnIdToSequenceKey(iscnId)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(p.IscnIdToSequence.Key, expectedKey) {
+		return nil, fmt.Errorf("ISCN ID to sequence entry key %X does not match ISCN ID %s", p.IscnIdToSequence.Key, p.IscnId)
+	}
+	seqBytes := p.IscnIdToSequence.Value
+	if len(seqBytes) != 8 {
+		return nil, fmt.Errorf("invalid sequence %X", seqBytes)
+	}
+	seq := types.DecodeUint64(seqBytes)
+
+	err = p.StoreRecord.verify(p.StoreName, header.AppHash)
+	if err != nil {
+		return nil, fmt.Errorf("cannot verify store record entry: %w", err)
+	}
+	if !bytes.Equal(p.StoreRecord.Key, GetStoreRecordKey(seq)) {
+		return nil, fmt.Errorf("store record entry key %X does not match sequence %d", p.StoreRecord.Key, seq)
+	}
+	record := types.StoreRecord{}
+	err = record.Unmarshal(p.StoreRecord.Value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid store record: %w", err)
+	}
+	if !record.IscnId.Equal(&iscnId) {
+		return nil, fmt.Errorf("store record has ISCN ID %s, expect %s", record.IscnId.String(), p.IscnId)
+	}
+	_, cid, err := gocid.CidFromBytes(record.CidBytes)
+	if err != nil {
+		return nil, fmt.Errorf("invalid CID in store record: %w", err)
+	}
+	computedCid := types.ComputeDataCid(record.Data)
+	if !cid.Equals(computedCid) {
+		return nil, fmt.Errorf("store record has CID %s, but the computed CID for the record is %s", cid.String(), computedCid.String())
+	}
+
+	return &VerifyResult{
+		ChainId:        p.ChainId,
+		Height:         p.Height,
+		BlockTime:      header.Time,
+		ValidatorsHash: header.ValidatorsHash,
+		IscnId:         iscnId,
+		Sequence:       seq,
+		Cid:            cid,
+		Record:         record.Data,
+	}, nil
+}
+
+// GetIscnIdToSequenceKey returns the key of the ISCN ID to sequence entry in the ISCN store
+func GetIscnIdToSequenceKey(iscnId types.IscnId) ([]byte, error) {
+	iscnIdBytes, err := iscnId.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, types.IscnIdToSequencePrefix...), iscnIdBytes...), nil
+}
+
+// GetStoreRecordKey returns the key of the store record entry in the ISCN store
+func GetStoreRecordKey(seq uint64) []byte {
+	return append(append([]byte{}, types.SequenceToStoreRecordPrefix...), types.EncodeUint64(seq)...)
+}
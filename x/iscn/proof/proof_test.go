@@ -0,0 +1,188 @@
+package proof_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmversion "github.com/tendermint/tendermint/proto/tendermint/version"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"github.com/tendermint/tendermint/version"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/proof"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+const chainId = "likecoin-chain-test"
+
+// testNode serves the ABCI queries from the testing app, and signs the headers by a random validator set
+type testNode struct {
+	app       *testutil.TestingApp
+	valSet    *tmtypes.ValidatorSet
+	privVals  []tmtypes.PrivValidator
+	blockTime time.Time
+}
+
+func (n *testNode) ABCIQueryWithOptions(_ context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error) {
+	res := n.app.Query(abci.RequestQuery{Path: path, Data: data, Height: opts.Height, Prove: opts.Prove})
+	return &ctypes.ResultABCIQuery{Response: res}, nil
+}
+
+func (n *testNode) Status(_ context.Context) (*ctypes.ResultStatus, error) {
+	return &ctypes.ResultStatus{
+		SyncInfo: ctypes.SyncInfo{LatestBlockHeight: n.app.LastBlockHeight() + 1},
+	}, nil
+}
+
+func (n *testNode) Commit(_ context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	if *height != n.app.LastBlockHeight()+1 {
+		return nil, fmt.Errorf("no header at height %d", *height)
+	}
+	header := tmtypes.Header{
+		Version:            tmversion.Consensus{Block: version.BlockProtocol},
+		ChainID:            chainId,
+		Height:             *height,
+		Time:               n.blockTime,
+		ValidatorsHash:     n.valSet.Hash(),
+		NextValidatorsHash: n.valSet.Hash(),
+		AppHash:            n.app.LastCommitID().Hash,
+		ProposerAddress:    n.valSet.Proposer.Address,
+	}
+	blockId := tmtypes.BlockID{
+		Hash:          header.Hash(),
+		PartSetHeader: tmtypes.PartSetHeader{Total: 1, Hash: bytes.Repeat([]byte{0x01}, 32)},
+	}
+	voteSet := tmtypes.NewVoteSet(chainId, *height, 0, tmproto.PrecommitType, n.valSet)
+	commit, err := tmtypes.MakeCommit(blockId, *height, 0, voteSet, n.privVals, n.blockTime)
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultCommit(&header, commit, true), nil
+}
+
+func (n *testNode) Validators(_ context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+	start := (*page - 1) * *perPage
+	end := start + *perPage
+	if end > n.valSet.Size() {
+		end = n.valSet.Size()
+	}
+	return &ctypes.ResultValidators{
+		BlockHeight: *height,
+		Validators:  n.valSet.Validators[start:end],
+		Count:       end - start,
+		Total:       n.valSet.Size(),
+	}, nil
+}
+
+func TestRecordProof(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{{addr.String(), "1000000000000000000nanolike"}})
+	app.NextHeader(1234567890)
+	app.SetForTx()
+
+	record := types.IscnRecord{
+		ContentFingerprints: []string{"hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e"},
+		ContentMetadata:     types.IscnInput(`{"name":"proof test"}`),
+	}
+	result := app.DeliverMsgNoError(t, types.NewMsgCreateIscnRecord(addr, &record, 0), priv)
+	iscnId := testutil.GetIscnIdFromResult(t, result)
+	record.RecordNotes = "version 2"
+	app.DeliverMsgNoError(t, types.NewMsgUpdateIscnRecord(addr, iscnId, &record), priv)
+	ctx := app.SetForQuery()
+	app.SetForTx()
+
+	valSet, privVals := tmtypes.RandValidatorSet(4, 10)
+	node := &testNode{
+		app:       app,
+		valSet:    valSet,
+		privVals:  privVals,
+		blockTime: time.Unix(1234567900, 0).UTC(),
+	}
+
+	recordProof, err := proof.QueryRecordProof(context.Background(), node, iscnId.PrefixId(), 0)
+	require.NoError(t, err)
+	require.Equal(t, app.LastBlockHeight(), recordProof.Height)
+	require.Equal(t, chainId, recordProof.ChainId)
+
+	bz, err := recordProof.Marshal()
+	require.NoError(t, err)
+	recordProof, err = proof.ReadRecordProof(bytes.NewReader(bz))
+	require.NoError(t, err)
+
+	verifyResult, err := recordProof.Verify(valSet.Hash())
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), verifyResult.IscnId.Version)
+	require.Equal(t, iscnId.Prefix, verifyResult.IscnId.Prefix)
+	require.Equal(t, uint64(2), verifyResult.Sequence)
+	require.Equal(t, node.blockTime, verifyResult.BlockTime)
+	storeRecord := app.IscnKeeper.GetStoreRecord(ctx, 2)
+	require.Equal(t, storeRecord.Cid(), verifyResult.Cid)
+	require.Equal(t, storeRecord.Data, verifyResult.Record)
+
+	// proof for an explicit version
+	recordProof, err = proof.QueryRecordProof(context.Background(), node, iscnId, 0)
+	require.NoError(t, err)
+	verifyResult, err = recordProof.Verify(valSet.Hash())
+	require.NoError(t, err)
+	require.Equal(t, iscnId, verifyResult.IscnId)
+	require.Equal(t, uint64(1), verifyResult.Sequence)
+
+	verifyTampered := func(tamper func(p *proof.RecordProof), errContains string) {
+		p, err := proof.ReadRecordProof(bytes.NewReader(bz))
+		require.NoError(t, err)
+		tamper(p)
+		_, err = p.Verify(valSet.Hash())
+		require.ErrorContains(t, err, errContains)
+	}
+
+	otherValSet, _ := tmtypes.RandValidatorSet(4, 10)
+	_, err = recordProof.Verify(otherValSet.Hash())
+	require.ErrorContains(t, err, "does not match the trusted hash")
+
+	verifyTampered(func(p *proof.RecordProof) {
+		p.Validators = otherValSet.Validators
+	}, "does not match the trusted hash")
+	verifyTampered(func(p *proof.RecordProof) {
+		// only half of the voting power signed
+		p.SignedHeader.Commit.Signatures[2] = tmtypes.NewCommitSigAbsent()
+		p.SignedHeader.Commit.Signatures[3] = tmtypes.NewCommitSigAbsent()
+	}, "invalid commit")
+	verifyTampered(func(p *proof.RecordProof) {
+		p.SignedHeader.Header.AppHash = bytes.Repeat([]byte{0x01}, 32)
+	}, "invalid signed header")
+	verifyTampered(func(p *proof.RecordProof) {
+		p.ChainId = "another-chain"
+	}, "invalid signed header")
+	verifyTampered(func(p *proof.RecordProof) {
+		p.Height--
+	}, "expect header at height")
+	verifyTampered(func(p *proof.RecordProof) {
+		p.StoreRecord.Value = append(p.StoreRecord.Value, 0x00)
+	}, "cannot verify store record entry")
+	verifyTampered(func(p *proof.RecordProof) {
+		p.IscnIdToSequence.Value = types.EncodeUint64(1)
+	}, "cannot verify ISCN ID to sequence entry")
+	verifyTampered(func(p *proof.RecordProof) {
+		p.IscnId = iscnId.String()
+	}, "does not match ISCN ID")
+
+	// record not existing at the height
+	notExisting := iscnId
+	notExisting.Version = 3
+	_, err = proof.QueryRecordProof(context.Background(), node, notExisting, 0)
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,144 @@
+package proof
+
+import (
+	"context"
+	"fmt"
+
+	tmbytes "github.com/tendermint/tendermint/libs/bytes"
+	rpcclient "github.com/tendermint/tendermint/rpc/client"
+	ctypes "github.com/tendermint/tendermint/rpc/core/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+// validatorsPerPage is the maximum page size of the Tendermint validators RPC
+const validatorsPerPage = 100
+
+// Node is the subset of the Tendermint RPC client used for building proofs
+type Node interface {
+	ABCIQueryWithOptions(ctx context.Context, path string, data tmbytes.HexBytes, opts rpcclient.ABCIQueryOptions) (*ctypes.ResultABCIQuery, error)
+	Status(ctx context.Context) (*ctypes.ResultStatus, error)
+	Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error)
+	Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error)
+}
+
+func queryStore(ctx context.Context, node Node, key []byte, height int64, prove bool) (*ctypes.ResultABCIQuery, error) {
+	path := fmt.Sprintf("/store/%s/key", types.StoreKey)
+	res, err := node.ABCIQueryWithOptions(ctx, path, key, rpcclient.ABCIQueryOptions{Height: height, Prove: prove})
+	if err != nil {
+		return nil, err
+	}
+	if !res.Response.IsOK() {
+		return nil, fmt.Errorf("query for key %X failed: %s", key, res.Response.Log)
+	}
+	return res, nil
+}
+
+func queryStoreEntryProof(ctx context.Context, node Node, key []byte, height int64) (*StoreEntryProof, error) {
+	res, err := queryStore(ctx, node, key, height, true)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Response.Value) == 0 {
+		return nil, fmt.Errorf("entry for key %X not found at height %d", key, height)
+	}
+	if res.Response.ProofOps == nil {
+		return nil, fmt.Errorf("no proof returned for key %X", key)
+	}
+	return &StoreEntryProof{
+		Key:      key,
+		Value:    res.Response.Value,
+		ProofOps: *res.Response.ProofOps,
+	}, nil
+}
+
+func queryValidators(ctx context.Context, node Node, height int64) ([]*tmtypes.Validator, error) {
+	validators := []*tmtypes.Validator{}
+	perPage := validatorsPerPage
+	for page := 1; ; page++ {
+		res, err := node.Validators(ctx, &height, &page, &perPage)
+		if err != nil {
+			return nil, err
+		}
+		validators = append(validators, res.Validators...)
+		if len(res.Validators) == 0 || len(validators) >= res.Total {
+			break
+		}
+	}
+	return validators, nil
+}
+
+// QueryRecordProof builds the proof of the ISCN record from a node.
+// If the ISCN ID has no version, the latest version at the height is used.
+// If height is 0, the state before the latest block is used, since the app hash of a state is only committed in the
+// next block.
+func QueryRecordProof(ctx context.Context, node Node, iscnId types.IscnId, height int64) (*RecordProof, error) {
+	if height == 0 {
+		status, err := node.Status(ctx)
+		if err != nil {
+			return nil, err
+		}
+		height = status.SyncInfo.LatestBlockHeight - 1
+	}
+	if height <= 0 {
+		return nil, fmt.Errorf("invalid height %d", height)
+	}
+	if iscnId.Version == 0 {
+		prefixBytes, err := iscnId.Prefix.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		key := append(append([]byte{}, types.ContentIdRecordPrefix...), prefixBytes...)
+		res, err := queryStore(ctx, node, key, height, false)
+		if err != nil {
+			return nil, err
+		}
+		if len(res.Response.Value) == 0 {
+			return nil, fmt.Errorf("ISCN ID %s not found at height %d", iscnId.String(), height)
+		}
+		contentIdRecord := types.ContentIdRecord{}
+		err = contentIdRecord.Unmarshal(res.Response.Value)
+		if err != nil {
+			return nil, err
+		}
+		iscnId.Version = contentIdRecord.LatestVersion
+	}
+
+	iscnIdToSequenceKey, err := GetIscnIdToSequenceKey(iscnId)
+	if err != nil {
+		return nil, err
+	}
+	iscnIdToSequence, err := queryStoreEntryProof(ctx, node, iscnIdToSequenceKey, height)
+	if err != nil {
+		return nil, err
+	}
+	if len(iscnIdToSequence.Value) != 8 {
+		return nil, fmt.Errorf("invalid sequence %X", iscnIdToSequence.Value)
+	}
+	seq := types.DecodeUint64(iscnIdToSequence.Value)
+	storeRecord, err := queryStoreEntryProof(ctx, node, GetStoreRecordKey(seq), height)
+	if err != nil {
+		return nil, err
+	}
+
+	headerHeight := height + 1
+	commit, err := node.Commit(ctx, &headerHeight)
+	if err != nil {
+		return nil, err
+	}
+	validators, err := queryValidators(ctx, node, headerHeight)
+	if err != nil {
+		return nil, err
+	}
+	return &RecordProof{
+		ChainId:          commit.SignedHeader.Header.ChainID,
+		Height:           height,
+		IscnId:           iscnId.String(),
+		StoreName:        types.StoreKey,
+		IscnIdToSequence: *iscnIdToSequence,
+		StoreRecord:      *storeRecord,
+		SignedHeader:     &commit.SignedHeader,
+		Validators:       validators,
+	}, nil
+}
@@ -0,0 +1,24 @@
+package iscn
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+)
+
+// NewContentSchemaProposalHandler creates a governance handler for adding and removing ISCN content metadata schemas
+func NewContentSchemaProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.AddContentSchemaProposal:
+			return k.SetContentSchema(ctx, c.ContentSchema)
+		case *types.RemoveContentSchemaProposal:
+			return k.RemoveContentSchema(ctx, c.Type)
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized ISCN proposal content type: %T", c)
+		}
+	}
+}
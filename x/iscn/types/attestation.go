@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// stakeholderDidPrefixes are the DID prefixes which may wrap an account address in stakeholder entity IDs
+var stakeholderDidPrefixes = []string{"did:cosmos:", "did:like:"}
+
+// ParseStakeholderAddress returns the account address named by a stakeholder entity ID, which is either a bech32
+// account address or a bech32 account address prefixed by `did:cosmos:` or `did:like:`
+func ParseStakeholderAddress(entityId string) (sdk.AccAddress, bool) {
+	for _, didPrefix := range stakeholderDidPrefixes {
+		if strings.HasPrefix(entityId, didPrefix) {
+			entityId = entityId[len(didPrefix):]
+			break
+		}
+	}
+	addr, err := sdk.AccAddressFromBech32(entityId)
+	if err != nil {
+		return nil, false
+	}
+	return addr, true
+}
+
+// IsStakeholderAddress checks whether the address is named in the `entity.@id` of any stakeholder in the ISCN record
+// JSON-LD
+func IsStakeholderAddress(record []byte, addr sdk.AccAddress) bool {
+	for _, entityId := range GetStakeholderEntityIds(record) {
+		stakeholderAddr, ok := ParseStakeholderAddress(entityId)
+		if ok && stakeholderAddr.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate checks the attestation entry in genesis
+func (a IscnAttestation) Validate() error {
+	err := validateAttestationIscnId(a.IscnId)
+	if err != nil {
+		return err
+	}
+	_, err = sdk.AccAddressFromBech32(a.Attester)
+	if err != nil {
+		return fmt.Errorf("invalid attester address: %w", err)
+	}
+	if a.AttestTime.IsZero() {
+		return fmt.Errorf("attest time not set")
+	}
+	if a.AttestHeight <= 0 {
+		return fmt.Errorf("invalid attest height %d", a.AttestHeight)
+	}
+	return nil
+}
@@ -7,6 +7,10 @@ import (
 )
 
 var _ authz.Authorization = &UpdateAuthorization{}
+var _ authz.Authorization = &CreateAuthorization{}
+var _ authz.Authorization = &BatchCreateAuthorization{}
+var _ authz.Authorization = &ChangeOwnershipAuthorization{}
+var _ authz.Authorization = &ProposeOwnershipTransferAuthorization{}
 
 func NewUpdateAuthorization(iscnIdPrefix string) *UpdateAuthorization {
 	return &UpdateAuthorization{
@@ -14,6 +18,21 @@ func NewUpdateAuthorization(iscnIdPrefix string) *UpdateAuthorization {
 	}
 }
 
+func NewUpdateAuthorizationWithMaxUses(iscnIdPrefix string, maxUses uint64) *UpdateAuthorization {
+	return &UpdateAuthorization{
+		IscnIdPrefix: iscnIdPrefix,
+		MaxUses:      maxUses,
+	}
+}
+
+// NewAllOwnedRecordsUpdateAuthorization returns an authorization for updating any record owned by the granter
+func NewAllOwnedRecordsUpdateAuthorization(maxUses uint64) *UpdateAuthorization {
+	return &UpdateAuthorization{
+		MaxUses:         maxUses,
+		AllOwnedRecords: true,
+	}
+}
+
 func (a UpdateAuthorization) MsgTypeURL() string {
 	return sdk.MsgTypeURL(&MsgUpdateIscnRecord{})
 }
@@ -27,21 +46,263 @@ func (a UpdateAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptR
 	if err != nil {
 		return authz.AcceptResponse{}, ErrInvalidIscnId.Wrapf("%v", err)
 	}
-	authIscnIdPrefix, err := ParseIscnId(a.IscnIdPrefix)
-	if err != nil {
-		return authz.AcceptResponse{}, sdkerrors.ErrLogic.Wrapf("authorization has invalid ISCN ID prefix: %v", err)
+	// the ownership of the record is checked by the message handler, since the message is sent as the granter
+	if !a.AllOwnedRecords {
+		authIscnIdPrefix, err := ParseIscnId(a.IscnIdPrefix)
+		if err != nil {
+			return authz.AcceptResponse{}, sdkerrors.ErrLogic.Wrapf("authorization has invalid ISCN ID prefix: %v", err)
+		}
+		if !iscnId.PrefixEqual(&authIscnIdPrefix) {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("ISCN ID prefix mismatch")
+		}
 	}
-	if !iscnId.PrefixEqual(&authIscnIdPrefix) {
-		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("ISCN ID prefix mismatch")
+	switch a.MaxUses {
+	case 0:
+		return authz.AcceptResponse{Accept: true}, nil
+	case 1:
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	default:
+		updated := a
+		updated.MaxUses--
+		return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
 	}
-
-	return authz.AcceptResponse{Accept: true}, nil
 }
 
 func (a UpdateAuthorization) ValidateBasic() error {
+	if a.AllOwnedRecords {
+		if a.IscnIdPrefix != "" {
+			return ErrInvalidIscnId.Wrap("ISCN ID prefix must be empty when all owned records are authorized")
+		}
+		return nil
+	}
 	_, err := ParseIscnId(a.IscnIdPrefix)
 	if err != nil {
 		return ErrInvalidIscnId.Wrapf("%v", err)
 	}
 	return nil
 }
+
+func NewCreateAuthorization(byteQuota uint64, maxRecords uint64) *CreateAuthorization {
+	return &CreateAuthorization{
+		ByteQuota:  byteQuota,
+		MaxRecords: maxRecords,
+	}
+}
+
+func (a CreateAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCreateIscnRecord{})
+}
+
+func (a CreateAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgCreate, ok := msg.(*MsgCreateIscnRecord)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	sizes := []uint64{0}
+	if a.ByteQuota != 0 {
+		size, err := newRecordJsonLdSize(ctx, &msgCreate.Record, msgCreate.GetSignBytes())
+		if err != nil {
+			return authz.AcceptResponse{}, err
+		}
+		sizes[0] = size
+	}
+	byteQuota, maxRecords, usedUp, err := deductCreateQuota(a.ByteQuota, a.MaxRecords, sizes)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if usedUp {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	updated := CreateAuthorization{ByteQuota: byteQuota, MaxRecords: maxRecords}
+	if updated == a {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+// deductCreateQuota deducts the quotas by the JSON-LD sizes of the new records, and reports whether the grant is used
+// up, which is when any of the limited quotas reaches 0
+func deductCreateQuota(byteQuota uint64, maxRecords uint64, sizes []uint64) (uint64, uint64, bool, error) {
+	remainingBytes := byteQuota
+	if byteQuota != 0 {
+		for _, size := range sizes {
+			if size > remainingBytes {
+				return 0, 0, false, sdkerrors.ErrUnauthorized.Wrapf("record size %d exceeds remaining byte quota %d", size, remainingBytes)
+			}
+			remainingBytes -= size
+		}
+	}
+	remainingRecords := maxRecords
+	if maxRecords != 0 {
+		count := uint64(len(sizes))
+		if count > maxRecords {
+			return 0, 0, false, sdkerrors.ErrUnauthorized.Wrapf("%d records exceed remaining record quota %d", count, maxRecords)
+		}
+		remainingRecords -= count
+	}
+	usedUp := (byteQuota != 0 && remainingBytes == 0) || (maxRecords != 0 && remainingRecords == 0)
+	return remainingBytes, remainingRecords, usedUp, nil
+}
+
+// newRecordJsonLdSize returns the length of the JSON-LD stored for a new record created in the current block, which is
+// the length charged by the per-byte fee
+func newRecordJsonLdSize(ctx sdk.Context, record *IscnRecord, seed []byte) (uint64, error) {
+	registryName, ok := GetRegistryName(ctx)
+	if !ok {
+		return 0, sdkerrors.ErrLogic.Wrap("registry name is not set in context")
+	}
+	recordJsonLd, err := record.ToJsonLd(&IscnRecordJsonLdInfo{
+		Id:         GenerateNewIscnIdWithSeed(registryName, seed),
+		Timestamp:  ctx.BlockTime(),
+		ParentIpld: nil,
+	})
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrEncodingJsonLd, "%s", err.Error())
+	}
+	return uint64(len(recordJsonLd)), nil
+}
+
+func (a CreateAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func NewBatchCreateAuthorization(byteQuota uint64, maxRecords uint64) *BatchCreateAuthorization {
+	return &BatchCreateAuthorization{
+		ByteQuota:  byteQuota,
+		MaxRecords: maxRecords,
+	}
+}
+
+func (a BatchCreateAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgBatchCreateIscnRecords{})
+}
+
+func (a BatchCreateAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgBatch, ok := msg.(*MsgBatchCreateIscnRecords)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	from, err := sdk.AccAddressFromBech32(msgBatch.From)
+	if err != nil {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidAddress.Wrapf("invalid sender address: %v", err)
+	}
+	sizes := make([]uint64, len(msgBatch.Entries))
+	if a.ByteQuota != 0 {
+		for i, entry := range msgBatch.Entries {
+			// the same seed as the keeper uses for generating the ISCN ID of the entry
+			seed := NewMsgCreateIscnRecord(from, &entry.Record, entry.Nonce).GetSignBytes()
+			size, err := newRecordJsonLdSize(ctx, &entry.Record, seed)
+			if err != nil {
+				return authz.AcceptResponse{}, sdkerrors.Wrapf(err, "entry %d", i)
+			}
+			sizes[i] = size
+		}
+	}
+	byteQuota, maxRecords, usedUp, err := deductCreateQuota(a.ByteQuota, a.MaxRecords, sizes)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	if usedUp {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+	updated := BatchCreateAuthorization{ByteQuota: byteQuota, MaxRecords: maxRecords}
+	if updated == a {
+		return authz.AcceptResponse{Accept: true}, nil
+	}
+	return authz.AcceptResponse{Accept: true, Updated: &updated}, nil
+}
+
+func (a BatchCreateAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func NewChangeOwnershipAuthorization(allowedRecipients []sdk.AccAddress) *ChangeOwnershipAuthorization {
+	recipients := make([]string, 0, len(allowedRecipients))
+	for _, recipient := range allowedRecipients {
+		recipients = append(recipients, recipient.String())
+	}
+	return &ChangeOwnershipAuthorization{
+		AllowedRecipients: recipients,
+	}
+}
+
+func (a ChangeOwnershipAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgChangeIscnRecordOwnership{})
+}
+
+func (a ChangeOwnershipAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgChange, ok := msg.(*MsgChangeIscnRecordOwnership)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	err := checkAllowedRecipient(a.AllowedRecipients, msgChange.NewOwner)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a ChangeOwnershipAuthorization) ValidateBasic() error {
+	return validateAllowedRecipients(a.AllowedRecipients)
+}
+
+func NewProposeOwnershipTransferAuthorization(allowedRecipients []sdk.AccAddress) *ProposeOwnershipTransferAuthorization {
+	recipients := make([]string, 0, len(allowedRecipients))
+	for _, recipient := range allowedRecipients {
+		recipients = append(recipients, recipient.String())
+	}
+	return &ProposeOwnershipTransferAuthorization{
+		AllowedRecipients: recipients,
+	}
+}
+
+func (a ProposeOwnershipTransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgProposeIscnOwnershipTransfer{})
+}
+
+func (a ProposeOwnershipTransferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgPropose, ok := msg.(*MsgProposeIscnOwnershipTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	err := checkAllowedRecipient(a.AllowedRecipients, msgPropose.NewOwner)
+	if err != nil {
+		return authz.AcceptResponse{}, err
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a ProposeOwnershipTransferAuthorization) ValidateBasic() error {
+	return validateAllowedRecipients(a.AllowedRecipients)
+}
+
+func checkAllowedRecipient(allowedRecipients []string, newOwnerStr string) error {
+	newOwner, err := sdk.AccAddressFromBech32(newOwnerStr)
+	if err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid new owner address: %v", err)
+	}
+	for _, recipient := range allowedRecipients {
+		// comparing by bytes, since the addresses may be in different prefixes
+		addr, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return sdkerrors.ErrLogic.Wrapf("authorization has invalid recipient address: %v", err)
+		}
+		if addr.Equals(newOwner) {
+			return nil
+		}
+	}
+	return sdkerrors.ErrUnauthorized.Wrapf("new owner %s is not an allowed recipient", newOwnerStr)
+}
+
+func validateAllowedRecipients(allowedRecipients []string) error {
+	if len(allowedRecipients) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("no allowed recipients")
+	}
+	for _, recipient := range allowedRecipients {
+		_, err := sdk.AccAddressFromBech32(recipient)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid recipient address %s: %v", recipient, err)
+		}
+	}
+	return nil
+}
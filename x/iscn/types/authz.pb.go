@@ -5,9 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,7 +25,12 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type UpdateAuthorization struct {
+	// ISCN ID prefix of the record allowed to be updated, must be empty if all_owned_records is set
 	IscnIdPrefix string `protobuf:"bytes,1,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	// remaining number of updates allowed, 0 means unlimited
+	MaxUses uint64 `protobuf:"varint,2,opt,name=max_uses,json=maxUses,proto3" json:"max_uses,omitempty"`
+	// allows updating any record owned by the granter instead of only the record with iscn_id_prefix
+	AllOwnedRecords bool `protobuf:"varint,3,opt,name=all_owned_records,json=allOwnedRecords,proto3" json:"all_owned_records,omitempty"`
 }
 
 func (m *UpdateAuthorization) Reset()         { *m = UpdateAuthorization{} }
@@ -68,28 +73,257 @@ func (m *UpdateAuthorization) GetIscnIdPrefix() string {
 	return ""
 }
 
+func (m *UpdateAuthorization) GetMaxUses() uint64 {
+	if m != nil {
+		return m.MaxUses
+	}
+	return 0
+}
+
+func (m *UpdateAuthorization) GetAllOwnedRecords() bool {
+	if m != nil {
+		return m.AllOwnedRecords
+	}
+	return false
+}
+
+type CreateAuthorization struct {
+	// remaining bytes of records allowed to be created, counted by the length of the JSON-LD stored for each record,
+	// which is also the length charged by the per-byte fee, 0 means unlimited
+	ByteQuota uint64 `protobuf:"varint,1,opt,name=byte_quota,json=byteQuota,proto3" json:"byte_quota,omitempty"`
+	// remaining number of records allowed to be created, 0 means unlimited
+	MaxRecords uint64 `protobuf:"varint,2,opt,name=max_records,json=maxRecords,proto3" json:"max_records,omitempty"`
+}
+
+func (m *CreateAuthorization) Reset()         { *m = CreateAuthorization{} }
+func (m *CreateAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreateAuthorization) ProtoMessage()    {}
+func (*CreateAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69559c01192448b8, []int{1}
+}
+func (m *CreateAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAuthorization.Merge(m, src)
+}
+func (m *CreateAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAuthorization proto.InternalMessageInfo
+
+func (m *CreateAuthorization) GetByteQuota() uint64 {
+	if m != nil {
+		return m.ByteQuota
+	}
+	return 0
+}
+
+func (m *CreateAuthorization) GetMaxRecords() uint64 {
+	if m != nil {
+		return m.MaxRecords
+	}
+	return 0
+}
+
+type BatchCreateAuthorization struct {
+	// remaining bytes of records allowed to be created, counted in the same way as CreateAuthorization for each record
+	// in the batch, 0 means unlimited
+	ByteQuota uint64 `protobuf:"varint,1,opt,name=byte_quota,json=byteQuota,proto3" json:"byte_quota,omitempty"`
+	// remaining number of records allowed to be created, counting each record in the batch, 0 means unlimited
+	MaxRecords uint64 `protobuf:"varint,2,opt,name=max_records,json=maxRecords,proto3" json:"max_records,omitempty"`
+}
+
+func (m *BatchCreateAuthorization) Reset()         { *m = BatchCreateAuthorization{} }
+func (m *BatchCreateAuthorization) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateAuthorization) ProtoMessage()    {}
+func (*BatchCreateAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69559c01192448b8, []int{2}
+}
+func (m *BatchCreateAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCreateAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BatchCreateAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateAuthorization.Merge(m, src)
+}
+func (m *BatchCreateAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCreateAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateAuthorization proto.InternalMessageInfo
+
+func (m *BatchCreateAuthorization) GetByteQuota() uint64 {
+	if m != nil {
+		return m.ByteQuota
+	}
+	return 0
+}
+
+func (m *BatchCreateAuthorization) GetMaxRecords() uint64 {
+	if m != nil {
+		return m.MaxRecords
+	}
+	return 0
+}
+
+type ChangeOwnershipAuthorization struct {
+	// addresses allowed to be the new owner of the records
+	AllowedRecipients []string `protobuf:"bytes,1,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *ChangeOwnershipAuthorization) Reset()         { *m = ChangeOwnershipAuthorization{} }
+func (m *ChangeOwnershipAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ChangeOwnershipAuthorization) ProtoMessage()    {}
+func (*ChangeOwnershipAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69559c01192448b8, []int{3}
+}
+func (m *ChangeOwnershipAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChangeOwnershipAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChangeOwnershipAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChangeOwnershipAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangeOwnershipAuthorization.Merge(m, src)
+}
+func (m *ChangeOwnershipAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChangeOwnershipAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangeOwnershipAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangeOwnershipAuthorization proto.InternalMessageInfo
+
+func (m *ChangeOwnershipAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
+type ProposeOwnershipTransferAuthorization struct {
+	// addresses allowed to be proposed as the new owner of the records
+	AllowedRecipients []string `protobuf:"bytes,1,rep,name=allowed_recipients,json=allowedRecipients,proto3" json:"allowed_recipients,omitempty"`
+}
+
+func (m *ProposeOwnershipTransferAuthorization) Reset()         { *m = ProposeOwnershipTransferAuthorization{} }
+func (m *ProposeOwnershipTransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*ProposeOwnershipTransferAuthorization) ProtoMessage()    {}
+func (*ProposeOwnershipTransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_69559c01192448b8, []int{4}
+}
+func (m *ProposeOwnershipTransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProposeOwnershipTransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProposeOwnershipTransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProposeOwnershipTransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProposeOwnershipTransferAuthorization.Merge(m, src)
+}
+func (m *ProposeOwnershipTransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProposeOwnershipTransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProposeOwnershipTransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProposeOwnershipTransferAuthorization proto.InternalMessageInfo
+
+func (m *ProposeOwnershipTransferAuthorization) GetAllowedRecipients() []string {
+	if m != nil {
+		return m.AllowedRecipients
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UpdateAuthorization)(nil), "likechain.iscn.UpdateAuthorization")
+	proto.RegisterType((*CreateAuthorization)(nil), "likechain.iscn.CreateAuthorization")
+	proto.RegisterType((*BatchCreateAuthorization)(nil), "likechain.iscn.BatchCreateAuthorization")
+	proto.RegisterType((*ChangeOwnershipAuthorization)(nil), "likechain.iscn.ChangeOwnershipAuthorization")
+	proto.RegisterType((*ProposeOwnershipTransferAuthorization)(nil), "likechain.iscn.ProposeOwnershipTransferAuthorization")
 }
 
 func init() { proto.RegisterFile("likechain/iscn/authz.proto", fileDescriptor_69559c01192448b8) }
 
 var fileDescriptor_69559c01192448b8 = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xc9, 0xcc, 0x4e,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0x2c, 0x4e, 0xce, 0xd3, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8,
-	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xcb, 0xe9, 0x81, 0xe4, 0xa4, 0x24, 0x93,
-	0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0xb2, 0xfa, 0x10, 0x0e, 0x44, 0xa9, 0x94, 0x48, 0x7a,
-	0x7e, 0x7a, 0x3e, 0x44, 0x1c, 0xc4, 0x82, 0x88, 0x2a, 0xf9, 0x71, 0x09, 0x87, 0x16, 0xa4, 0x24,
-	0x96, 0xa4, 0x3a, 0x96, 0x96, 0x64, 0xe4, 0x17, 0x65, 0x56, 0x25, 0x96, 0x64, 0xe6, 0xe7, 0x09,
-	0xa9, 0x70, 0xf1, 0x81, 0xcc, 0x8b, 0xcf, 0x4c, 0x89, 0x2f, 0x28, 0x4a, 0x4d, 0xcb, 0xac, 0x90,
-	0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x01, 0x89, 0x7a, 0xa6, 0x04, 0x80, 0xc5, 0xac, 0x04,
-	0x2f, 0x6d, 0xd1, 0xe5, 0x45, 0xd1, 0xe8, 0xe4, 0x73, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72,
-	0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7,
-	0x72, 0x0c, 0x51, 0x46, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x60,
-	0x57, 0xe7, 0x67, 0xe6, 0xc1, 0x19, 0xba, 0x10, 0xff, 0x95, 0x99, 0xe8, 0x57, 0x40, 0x3c, 0x59,
-	0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xa4, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x1f,
-	0x41, 0x59, 0x6b, 0x03, 0x01, 0x00, 0x00,
+	// 395 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x41, 0xcb, 0xd3, 0x30,
+	0x1c, 0xc6, 0x17, 0xdf, 0xa1, 0x5b, 0xd4, 0xc9, 0x3a, 0x0f, 0xdd, 0xd0, 0x5a, 0x86, 0x42, 0x11,
+	0xb6, 0x82, 0x7a, 0xf2, 0xe6, 0x76, 0x12, 0x04, 0x67, 0x71, 0x17, 0x2f, 0x35, 0x6b, 0xb3, 0x26,
+	0xd8, 0xe6, 0x5f, 0x93, 0xd4, 0x75, 0xfb, 0x14, 0x5e, 0xfc, 0x26, 0x7e, 0x08, 0xf1, 0xb4, 0xa3,
+	0x47, 0xd9, 0xbe, 0x88, 0xa4, 0xd1, 0xc9, 0x50, 0x3c, 0xc9, 0x7b, 0x4b, 0x7f, 0x4f, 0xc9, 0xef,
+	0x21, 0x3c, 0x78, 0x94, 0xf3, 0x77, 0x34, 0x61, 0x84, 0x8b, 0x90, 0xab, 0x44, 0x84, 0xa4, 0xd2,
+	0x6c, 0x37, 0x2d, 0x25, 0x68, 0x70, 0x7a, 0xa7, 0x6c, 0x6a, 0xb2, 0xd1, 0x30, 0x01, 0x55, 0x80,
+	0x8a, 0x9b, 0x34, 0xb4, 0x1f, 0xf6, 0xd7, 0xd1, 0xed, 0x0c, 0x32, 0xb0, 0xdc, 0x9c, 0x2c, 0x1d,
+	0x7f, 0x42, 0x78, 0xb0, 0x2c, 0x53, 0xa2, 0xe9, 0xb3, 0x4a, 0x33, 0x90, 0x7c, 0x47, 0x34, 0x07,
+	0xe1, 0xdc, 0xc7, 0x3d, 0x73, 0x61, 0xcc, 0xd3, 0xb8, 0x94, 0x74, 0xcd, 0x6b, 0x17, 0xf9, 0x28,
+	0xe8, 0x46, 0x37, 0x0c, 0x7d, 0x9e, 0x2e, 0x1a, 0xe6, 0x0c, 0x71, 0xa7, 0x20, 0x75, 0x5c, 0x29,
+	0xaa, 0xdc, 0x2b, 0x3e, 0x0a, 0xda, 0xd1, 0xb5, 0x82, 0xd4, 0x4b, 0x45, 0x95, 0xf3, 0x10, 0xf7,
+	0x49, 0x9e, 0xc7, 0xb0, 0x11, 0x34, 0x8d, 0x25, 0x4d, 0x40, 0xa6, 0xca, 0xbd, 0xf0, 0x51, 0xd0,
+	0x89, 0x6e, 0x91, 0x3c, 0x7f, 0x69, 0x78, 0x64, 0xf1, 0xd3, 0xfe, 0xd7, 0xcf, 0x93, 0x9b, 0x67,
+	0xfe, 0x31, 0xc3, 0x83, 0xb9, 0xa4, 0x7f, 0xd4, 0xba, 0x8b, 0xf1, 0x6a, 0xab, 0x69, 0xfc, 0xbe,
+	0x02, 0x4d, 0x9a, 0x4a, 0xed, 0xa8, 0x6b, 0xc8, 0x2b, 0x03, 0x9c, 0x7b, 0xf8, 0xba, 0xe9, 0xf3,
+	0x4b, 0x67, 0x2b, 0xe1, 0x82, 0xd4, 0xff, 0x30, 0x15, 0xd8, 0x9d, 0x11, 0x9d, 0xb0, 0x4b, 0xd2,
+	0xbd, 0xc5, 0x77, 0xe6, 0x8c, 0x88, 0x8c, 0x9a, 0x17, 0x90, 0x8a, 0xf1, 0xf2, 0x5c, 0x39, 0xc1,
+	0x0e, 0xc9, 0x73, 0xd8, 0xd8, 0x57, 0xe3, 0x25, 0xa7, 0x42, 0x2b, 0x17, 0xf9, 0x17, 0x41, 0x37,
+	0xea, 0xff, 0x4c, 0xa2, 0x53, 0xf0, 0x37, 0x03, 0xc7, 0x0f, 0x16, 0x12, 0x4a, 0x50, 0xbf, 0x15,
+	0xaf, 0x25, 0x11, 0x6a, 0x4d, 0xe5, 0x7f, 0x56, 0xcd, 0x5e, 0x7c, 0x39, 0x78, 0x68, 0x7f, 0xf0,
+	0xd0, 0xf7, 0x83, 0x87, 0x3e, 0x1e, 0xbd, 0xd6, 0xfe, 0xe8, 0xb5, 0xbe, 0x1d, 0xbd, 0xd6, 0x9b,
+	0x47, 0x19, 0xd7, 0xac, 0x5a, 0x4d, 0x13, 0x28, 0xc2, 0x66, 0xa3, 0xc0, 0xc5, 0xe9, 0x30, 0xb1,
+	0x6b, 0xfe, 0xf0, 0x24, 0xac, 0xed, 0xa4, 0xf5, 0xb6, 0xa4, 0x6a, 0x75, 0xb5, 0x99, 0xe4, 0xe3,
+	0x1f, 0x03, 0x00, 0x36, 0xab, 0x85, 0x4d, 0xf1, 0x02, 0x00, 0x00,
 }
 
 func (m *UpdateAuthorization) Marshal() (dAtA []byte, err error) {
@@ -112,6 +346,21 @@ func (m *UpdateAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AllOwnedRecords {
+		i--
+		if m.AllOwnedRecords {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxUses))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.IscnIdPrefix) > 0 {
 		i -= len(m.IscnIdPrefix)
 		copy(dAtA[i:], m.IscnIdPrefix)
@@ -119,40 +368,615 @@ func (m *UpdateAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0xa
 	}
-	return len(dAtA) - i, nil
+	return len(dAtA) - i, nil
+}
+
+func (m *CreateAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CreateAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CreateAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecords != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxRecords))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ByteQuota != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.ByteQuota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxRecords != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.MaxRecords))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.ByteQuota != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.ByteQuota))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChangeOwnershipAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChangeOwnershipAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChangeOwnershipAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProposeOwnershipTransferAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProposeOwnershipTransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProposeOwnershipTransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for iNdEx := len(m.AllowedRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedRecipients[iNdEx])
+			copy(dAtA[i:], m.AllowedRecipients[iNdEx])
+			i = encodeVarintAuthz(dAtA, i, uint64(len(m.AllowedRecipients[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *UpdateAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.MaxUses != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxUses))
+	}
+	if m.AllOwnedRecords {
+		n += 2
+	}
+	return n
+}
+
+func (m *CreateAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ByteQuota != 0 {
+		n += 1 + sovAuthz(uint64(m.ByteQuota))
+	}
+	if m.MaxRecords != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxRecords))
+	}
+	return n
+}
+
+func (m *BatchCreateAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ByteQuota != 0 {
+		n += 1 + sovAuthz(uint64(m.ByteQuota))
+	}
+	if m.MaxRecords != 0 {
+		n += 1 + sovAuthz(uint64(m.MaxRecords))
+	}
+	return n
+}
+
+func (m *ChangeOwnershipAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ProposeOwnershipTransferAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AllowedRecipients) > 0 {
+		for _, s := range m.AllowedRecipients {
+			l = len(s)
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *UpdateAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUses", wireType)
+			}
+			m.MaxUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllOwnedRecords", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AllOwnedRecords = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
+func (m *CreateAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteQuota", wireType)
+			}
+			m.ByteQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecords", wireType)
+			}
+			m.MaxRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthz(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *UpdateAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BatchCreateAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ByteQuota", wireType)
+			}
+			m.ByteQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ByteQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecords", wireType)
+			}
+			m.MaxRecords = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecords |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.IscnIdPrefix)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *ChangeOwnershipAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChangeOwnershipAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChangeOwnershipAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *UpdateAuthorization) Unmarshal(dAtA []byte) error {
+func (m *ProposeOwnershipTransferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -175,15 +999,15 @@ func (m *UpdateAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProposeOwnershipTransferAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProposeOwnershipTransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedRecipients", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -211,7 +1035,7 @@ func (m *UpdateAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			m.AllowedRecipients = append(m.AllowedRecipients, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -61,3 +61,301 @@ func TestUpdateAuthorization(t *testing.T) {
 	err = auth.ValidateBasic()
 	require.ErrorIs(t, err, types.ErrInvalidIscnId)
 }
+
+func TestUpdateAuthorizationMaxUses(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	iscnId1v1 := types.NewIscnId("test", "1111", 1)
+	iscnId2v1 := types.NewIscnId("test", "2222", 1)
+	msg1 := &types.MsgUpdateIscnRecord{
+		IscnId: iscnId1v1.String(),
+	}
+	msg2 := &types.MsgUpdateIscnRecord{
+		IscnId: iscnId2v1.String(),
+	}
+
+	auth := types.NewUpdateAuthorizationWithMaxUses(iscnId1v1.Prefix.String(), 2)
+	res, err := auth.Accept(ctx, msg1)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, types.NewUpdateAuthorizationWithMaxUses(iscnId1v1.Prefix.String(), 1), res.Updated)
+
+	auth = res.Updated.(*types.UpdateAuthorization)
+	res, err = auth.Accept(ctx, msg1)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	auth = types.NewAllOwnedRecordsUpdateAuthorization(0)
+	require.NoError(t, auth.ValidateBasic())
+	res, err = auth.Accept(ctx, msg1)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+	res, err = auth.Accept(ctx, msg2)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+
+	auth = types.NewAllOwnedRecordsUpdateAuthorization(1)
+	res, err = auth.Accept(ctx, msg2)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	auth = types.NewAllOwnedRecordsUpdateAuthorization(0)
+	auth.IscnIdPrefix = iscnId1v1.Prefix.String()
+	require.ErrorIs(t, auth.ValidateBasic(), types.ErrInvalidIscnId)
+}
+
+func contextWithRegistryName(registryName string) sdk.Context {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	return types.WithRegistryNameGetter(ctx, func(sdk.Context) string { return registryName })
+}
+
+func TestCreateAuthorization(t *testing.T) {
+	ctx := contextWithRegistryName(types.DefaultRegistryName)
+	msg := &types.MsgCreateIscnRecord{
+		Record: types.IscnRecord{
+			ContentMetadata: types.IscnInput(`{"name":"test"}`),
+		},
+	}
+	// counted by the stored JSON-LD instead of the encoded message
+	recordJsonLd, err := msg.Record.ToJsonLd(&types.IscnRecordJsonLdInfo{
+		Id:        types.GenerateNewIscnIdWithSeed(types.DefaultRegistryName, msg.GetSignBytes()),
+		Timestamp: ctx.BlockTime(),
+	})
+	require.NoError(t, err)
+	size := uint64(len(recordJsonLd))
+	require.NotEqual(t, uint64(msg.Record.Size()), size)
+
+	auth := types.NewCreateAuthorization(0, 0)
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	require.NoError(t, auth.ValidateBasic())
+	res, err := auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	auth = types.NewCreateAuthorization(size*2+1, 0)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, types.NewCreateAuthorization(size+1, 0), res.Updated)
+	auth = res.Updated.(*types.CreateAuthorization)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.Equal(t, types.NewCreateAuthorization(1, 0), res.Updated)
+	auth = res.Updated.(*types.CreateAuthorization)
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	auth = types.NewCreateAuthorization(size, 0)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	// deleted when byte quota is used up, even if there are remaining records
+	auth = types.NewCreateAuthorization(size, 2)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	auth = types.NewCreateAuthorization(size*2, 2)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.Equal(t, types.NewCreateAuthorization(size, 1), res.Updated)
+
+	auth = types.NewCreateAuthorization(0, 2)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, types.NewCreateAuthorization(0, 1), res.Updated)
+	auth = res.Updated.(*types.CreateAuthorization)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	_, err = auth.Accept(ctx, &types.MsgUpdateIscnRecord{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestCreateAuthorizationRegistryName(t *testing.T) {
+	registryName := "a-registry-name-longer-than-the-default"
+	require.NotEqual(t, len(types.DefaultRegistryName), len(registryName))
+	ctx := contextWithRegistryName(registryName)
+	msg := &types.MsgCreateIscnRecord{
+		Record: types.IscnRecord{
+			ContentMetadata: types.IscnInput(`{"name":"test"}`),
+		},
+	}
+	// charged by the JSON-LD with the ISCN ID in the registry of the chain
+	recordJsonLd, err := msg.Record.ToJsonLd(&types.IscnRecordJsonLdInfo{
+		Id:        types.GenerateNewIscnIdWithSeed(registryName, msg.GetSignBytes()),
+		Timestamp: ctx.BlockTime(),
+	})
+	require.NoError(t, err)
+	size := uint64(len(recordJsonLd))
+
+	auth := types.NewCreateAuthorization(size, 0)
+	res, err := auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	auth = types.NewCreateAuthorization(size-1, 0)
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// the byte quota cannot be charged without the registry name
+	ctx = sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrLogic)
+
+	// records quota alone does not need the registry name
+	res, err = types.NewCreateAuthorization(0, 1).Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Delete)
+}
+
+func TestBatchCreateAuthorization(t *testing.T) {
+	ctx := contextWithRegistryName(types.DefaultRegistryName)
+	from := sdk.AccAddress([]byte("creator_____________"))
+	records := []types.IscnRecord{
+		{ContentMetadata: types.IscnInput(`{"name":"test"}`)},
+		{ContentMetadata: types.IscnInput(`{"name":"another test"}`)},
+	}
+	msg := types.NewMsgBatchCreateIscnRecords(from, records, nil)
+	// each record is counted by its stored JSON-LD, the same as creating individually
+	size := uint64(0)
+	for _, record := range records {
+		createMsg := types.NewMsgCreateIscnRecord(from, &record, 0)
+		recordJsonLd, err := record.ToJsonLd(&types.IscnRecordJsonLdInfo{
+			Id:        types.GenerateNewIscnIdWithSeed(types.DefaultRegistryName, createMsg.GetSignBytes()),
+			Timestamp: ctx.BlockTime(),
+		})
+		require.NoError(t, err)
+		size += uint64(len(recordJsonLd))
+	}
+
+	auth := types.NewBatchCreateAuthorization(0, 0)
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	require.NoError(t, auth.ValidateBasic())
+	res, err := auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	auth = types.NewBatchCreateAuthorization(size+1, 0)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Equal(t, types.NewBatchCreateAuthorization(1, 0), res.Updated)
+
+	auth = types.NewBatchCreateAuthorization(size, 0)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	auth = types.NewBatchCreateAuthorization(size-1, 0)
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	auth = types.NewBatchCreateAuthorization(0, 3)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.Equal(t, types.NewBatchCreateAuthorization(0, 1), res.Updated)
+
+	auth = types.NewBatchCreateAuthorization(0, 2)
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.True(t, res.Delete)
+
+	auth = types.NewBatchCreateAuthorization(0, 1)
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgCreateIscnRecord{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestChangeOwnershipAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	addr1 := sdk.AccAddress([]byte("recipient1__________"))
+	addr2 := sdk.AccAddress([]byte("recipient2__________"))
+	addr3 := sdk.AccAddress([]byte("recipient3__________"))
+
+	auth := types.NewChangeOwnershipAuthorization([]sdk.AccAddress{addr1, addr2})
+	require.NoError(t, auth.ValidateBasic())
+	msg := &types.MsgChangeIscnRecordOwnership{
+		NewOwner: addr2.String(),
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err := auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgChangeIscnRecordOwnership{
+		NewOwner: addr3.String(),
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgChangeIscnRecordOwnership{
+		NewOwner: "invalid",
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidAddress)
+
+	_, err = auth.Accept(ctx, &types.MsgUpdateIscnRecord{})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	auth = types.NewChangeOwnershipAuthorization(nil)
+	require.Error(t, auth.ValidateBasic())
+	auth = &types.ChangeOwnershipAuthorization{AllowedRecipients: []string{"invalid"}}
+	require.ErrorIs(t, auth.ValidateBasic(), sdkerrors.ErrInvalidAddress)
+}
+
+func TestProposeOwnershipTransferAuthorization(t *testing.T) {
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	addr1 := sdk.AccAddress([]byte("recipient1__________"))
+	addr2 := sdk.AccAddress([]byte("recipient2__________"))
+
+	auth := types.NewProposeOwnershipTransferAuthorization([]sdk.AccAddress{addr1})
+	require.NoError(t, auth.ValidateBasic())
+	msg := &types.MsgProposeIscnOwnershipTransfer{
+		NewOwner: addr1.String(),
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err := auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgProposeIscnOwnershipTransfer{
+		NewOwner: addr2.String(),
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgChangeIscnRecordOwnership{NewOwner: addr1.String()})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	auth = types.NewProposeOwnershipTransferAuthorization(nil)
+	require.Error(t, auth.ValidateBasic())
+}
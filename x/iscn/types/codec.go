@@ -7,13 +7,31 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/x/authz"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 )
 
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateIscnRecord{}, "likecoin-chain/MsgCreateIscnRecord", nil)
 	cdc.RegisterConcrete(&MsgUpdateIscnRecord{}, "likecoin-chain/MsgUpdateIscnRecord", nil)
 	cdc.RegisterConcrete(&MsgChangeIscnRecordOwnership{}, "likecoin-chain/MsgChangeIscnRecordOwnership", nil)
+	cdc.RegisterConcrete(&MsgFreezeIscnRecord{}, "likecoin-chain/MsgFreezeIscnRecord", nil)
+	cdc.RegisterConcrete(&MsgDeprecateIscnRecord{}, "likecoin-chain/MsgDeprecateIscnRecord", nil)
+	cdc.RegisterConcrete(&MsgSupersedeIscnRecord{}, "likecoin-chain/MsgSupersedeIscnRecord", nil)
+	cdc.RegisterConcrete(&MsgBatchCreateIscnRecords{}, "likecoin-chain/MsgBatchCreateIscnRecords", nil)
+	cdc.RegisterConcrete(&MsgProposeIscnOwnershipTransfer{}, "likecoin-chain/MsgProposeIscnOwnershipTransfer", nil)
+	cdc.RegisterConcrete(&MsgAcceptIscnOwnershipTransfer{}, "likecoin-chain/MsgAcceptIscnOwnershipTransfer", nil)
+	cdc.RegisterConcrete(&MsgCancelIscnOwnershipTransfer{}, "likecoin-chain/MsgCancelIscnOwnershipTransfer", nil)
+	cdc.RegisterConcrete(&MsgCommitIscn{}, "likecoin-chain/MsgCommitIscn", nil)
+	cdc.RegisterConcrete(&MsgRevealIscn{}, "likecoin-chain/MsgRevealIscn", nil)
+	cdc.RegisterConcrete(&MsgAttestIscnRecord{}, "likecoin-chain/MsgAttestIscnRecord", nil)
+	cdc.RegisterConcrete(&MsgRevokeIscnRecordAttestation{}, "likecoin-chain/MsgRevokeIscnRecordAttestation", nil)
 	cdc.RegisterConcrete(&UpdateAuthorization{}, "likecoin-chain/UpdateAuthorization", nil)
+	cdc.RegisterConcrete(&CreateAuthorization{}, "likecoin-chain/CreateAuthorization", nil)
+	cdc.RegisterConcrete(&BatchCreateAuthorization{}, "likecoin-chain/BatchCreateAuthorization", nil)
+	cdc.RegisterConcrete(&ChangeOwnershipAuthorization{}, "likecoin-chain/ChangeOwnershipAuthorization", nil)
+	cdc.RegisterConcrete(&ProposeOwnershipTransferAuthorization{}, "likecoin-chain/ProposeOwnershipTransferAuthorization", nil)
+	cdc.RegisterConcrete(&AddContentSchemaProposal{}, "likecoin-chain/AddIscnContentSchemaProposal", nil)
+	cdc.RegisterConcrete(&RemoveContentSchemaProposal{}, "likecoin-chain/RemoveIscnContentSchemaProposal", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -21,10 +39,30 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateIscnRecord{},
 		&MsgUpdateIscnRecord{},
 		&MsgChangeIscnRecordOwnership{},
+		&MsgFreezeIscnRecord{},
+		&MsgDeprecateIscnRecord{},
+		&MsgSupersedeIscnRecord{},
+		&MsgBatchCreateIscnRecords{},
+		&MsgProposeIscnOwnershipTransfer{},
+		&MsgAcceptIscnOwnershipTransfer{},
+		&MsgCancelIscnOwnershipTransfer{},
+		&MsgCommitIscn{},
+		&MsgRevealIscn{},
+		&MsgAttestIscnRecord{},
+		&MsgRevokeIscnRecordAttestation{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&UpdateAuthorization{},
+		&CreateAuthorization{},
+		&BatchCreateAuthorization{},
+		&ChangeOwnershipAuthorization{},
+		&ProposeOwnershipTransferAuthorization{},
+	)
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&AddContentSchemaProposal{},
+		&RemoveContentSchemaProposal{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
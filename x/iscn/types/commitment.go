@@ -0,0 +1,64 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CommitmentLength is the length of a commitment, which is a SHA-256 hash
+const CommitmentLength = sha256.Size
+
+// ComputeIscnCommitment computes the commitment of an ISCN record, which is the SHA-256 hash of the concatenation of
+// the salt, the committer address bytes and the protobuf encoded record.
+// The committer address is included so the commitment cannot be replayed by others after the record is revealed.
+func ComputeIscnCommitment(salt []byte, committer sdk.AccAddress, record *IscnRecord) ([]byte, error) {
+	recordBytes, err := record.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	hasher := sha256.New()
+	hasher.Write(salt)
+	hasher.Write(committer)
+	hasher.Write(recordBytes)
+	return hasher.Sum(nil), nil
+}
+
+func ValidateCommitment(commitment []byte) error {
+	if len(commitment) != CommitmentLength {
+		return fmt.Errorf("invalid commitment length, expect %d bytes, got %d", CommitmentLength, len(commitment))
+	}
+	return nil
+}
+
+func (c IscnCommitment) IsRevealed() bool {
+	return c.RevealedIscnId != ""
+}
+
+// Validate checks the commitment entry in genesis
+func (c IscnCommitment) Validate() error {
+	err := ValidateCommitment(c.Commitment)
+	if err != nil {
+		return err
+	}
+	_, err = sdk.AccAddressFromBech32(c.Committer)
+	if err != nil {
+		return fmt.Errorf("invalid committer address: %w", err)
+	}
+	if c.CommitTime.IsZero() {
+		return fmt.Errorf("commit time not set")
+	}
+	if c.CommitHeight <= 0 {
+		return fmt.Errorf("invalid commit height %d", c.CommitHeight)
+	}
+	if c.IsRevealed() {
+		_, err = ParseIscnId(c.RevealedIscnId)
+		if err != nil {
+			return fmt.Errorf("invalid revealed ISCN ID: %w", err)
+		}
+	} else if !c.Expiration.After(c.CommitTime) {
+		return fmt.Errorf("expiration is not after commit time")
+	}
+	return nil
+}
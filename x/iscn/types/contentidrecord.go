@@ -1,9 +1,42 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 func (record ContentIdRecord) OwnerAddress() sdk.AccAddress {
 	return sdk.AccAddress(record.OwnerAddressBytes)
 }
+
+func (status IscnRecordStatus) IsValid() bool {
+	_, ok := IscnRecordStatus_name[int32(status)]
+	return ok
+}
+
+func (record ContentIdRecord) IsActive() bool {
+	return record.Status == ISCN_RECORD_STATUS_ACTIVE
+}
+
+// ValidateStatus checks the status and the superseding ISCN ID prefix of the record, without checking the existence
+// of the superseding record
+func ValidateStatus(status IscnRecordStatus, supersededBy string) error {
+	if !status.IsValid() {
+		return fmt.Errorf("unknown status %d", status)
+	}
+	if status != ISCN_RECORD_STATUS_SUPERSEDED {
+		if supersededBy != "" {
+			return fmt.Errorf("record with status %s should not have superseding ISCN ID", status.String())
+		}
+		return nil
+	}
+	id, err := ParseIscnId(supersededBy)
+	if err != nil {
+		return fmt.Errorf("invalid superseding ISCN ID %s: %w", supersededBy, err)
+	}
+	if id.Version != 0 {
+		return fmt.Errorf("superseding ISCN ID %s should not contain version", supersededBy)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// contentSchemaUrl is the URL under which a content schema is compiled, since schemas are stored on chain and are not
+// addressable
+const contentSchemaUrl = "iscn-content-schema.json"
+
+func newContentSchemaCompiler() *jsonschema.Compiler {
+	compiler := jsonschema.NewCompiler()
+	compiler.Draft = jsonschema.Draft7
+	// schemas must be self-contained, loading external resources would make the validation result depend on the
+	// environment of the node
+	compiler.LoadURL = func(url string) (io.ReadCloser, error) {
+		return nil, fmt.Errorf("loading external schema %s is not allowed", url)
+	}
+	return compiler
+}
+
+func (s ContentSchema) Compile() (*jsonschema.Schema, error) {
+	compiler := newContentSchemaCompiler()
+	err := compiler.AddResource(contentSchemaUrl, strings.NewReader(s.Schema))
+	if err != nil {
+		return nil, err
+	}
+	return compiler.Compile(contentSchemaUrl)
+}
+
+func (s ContentSchema) Validate() error {
+	if s.Type == "" {
+		return fmt.Errorf("empty content schema type")
+	}
+	_, err := s.Compile()
+	if err != nil {
+		return fmt.Errorf("invalid content schema for type %s: %w", s.Type, err)
+	}
+	return nil
+}
+
+func ValidateContentSchemas(schemas []ContentSchema) error {
+	usedTypes := map[string]struct{}{}
+	for _, schema := range schemas {
+		_, ok := usedTypes[schema.Type]
+		if ok {
+			return fmt.Errorf("repeated content schema type %s", schema.Type)
+		}
+		usedTypes[schema.Type] = struct{}{}
+		err := schema.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetContentSchema returns the schema for the given content metadata type, or nil if there is none
+func GetContentSchema(schemas []ContentSchema, contentType string) *ContentSchema {
+	for i := range schemas {
+		if schemas[i].Type == contentType {
+			return &schemas[i]
+		}
+	}
+	return nil
+}
+
+// GetContentMetadataType returns the `@type` field of the content metadata, or empty string if it is absent or is
+// not a string
+func GetContentMetadataType(metadata IscnInput) string {
+	v, ok := metadata.GetPath("@type")
+	if !ok {
+		return ""
+	}
+	contentType, ok := v.(string)
+	if !ok {
+		return ""
+	}
+	return contentType
+}
+
+// ValidateContentMetadata validates the content metadata against the schema of its `@type`, metadata with a type
+// without schema is always accepted
+func ValidateContentMetadata(schemas []ContentSchema, metadata IscnInput) error {
+	contentType := GetContentMetadataType(metadata)
+	schema := GetContentSchema(schemas, contentType)
+	if schema == nil {
+		return nil
+	}
+	compiled, err := schema.Compile()
+	if err != nil {
+		return fmt.Errorf("invalid content schema for type %s: %w", contentType, err)
+	}
+	// numbers are decoded as json.Number as required by the validator
+	decoder := json.NewDecoder(bytes.NewReader(metadata))
+	decoder.UseNumber()
+	var v interface{}
+	err = decoder.Decode(&v)
+	if err != nil {
+		return err
+	}
+	err = compiled.Validate(v)
+	if err != nil {
+		return fmt.Errorf("content metadata of type %s does not match its schema: %w", contentType, err)
+	}
+	return nil
+}
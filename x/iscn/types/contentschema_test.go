@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateContentSchemas(t *testing.T) {
+	bookSchema := ContentSchema{
+		Type:   "Book",
+		Schema: `{"type":"object","required":["name","author"],"properties":{"isbn":{"type":"string","pattern":"^[0-9-]+$"}}}`,
+	}
+	photoSchema := ContentSchema{
+		Type:   "Photograph",
+		Schema: `{"type":"object","definitions":{"size":{"type":"integer","minimum":1}},"properties":{"width":{"$ref":"#/definitions/size"}}}`,
+	}
+
+	err := ValidateContentSchemas(nil)
+	require.NoError(t, err)
+
+	err = ValidateContentSchemas([]ContentSchema{bookSchema, photoSchema})
+	require.NoError(t, err)
+
+	err = ValidateContentSchemas([]ContentSchema{bookSchema, bookSchema})
+	require.Error(t, err, "should not accept repeated type")
+
+	err = ValidateContentSchemas([]ContentSchema{{Type: "", Schema: `{}`}})
+	require.Error(t, err, "should not accept empty type")
+
+	err = ValidateContentSchemas([]ContentSchema{{Type: "Book", Schema: `{"type":`}})
+	require.Error(t, err, "should not accept invalid JSON")
+
+	err = ValidateContentSchemas([]ContentSchema{{Type: "Book", Schema: `{"type":5}`}})
+	require.Error(t, err, "should not accept invalid schema")
+
+	err = ValidateContentSchemas([]ContentSchema{{Type: "Book", Schema: `{"$ref":"file:///etc/passwd"}`}})
+	require.Error(t, err, "should not accept reference to external resource")
+
+	params := DefaultParams()
+	params.ContentSchemas = []ContentSchema{bookSchema, {Type: "Book", Schema: `{}`}}
+	require.Error(t, params.Validate())
+}
+
+func TestValidateContentMetadata(t *testing.T) {
+	schemas := []ContentSchema{{
+		Type:   "Book",
+		Schema: `{"type":"object","required":["name","author"],"properties":{"isbn":{"type":"string","pattern":"^[0-9-]+$"},"numberOfPages":{"type":"integer"}}}`,
+	}}
+
+	err := ValidateContentMetadata(schemas, IscnInput(`{"@type":"Book","name":"a book","author":"someone","isbn":"978-3-16-148410-0","numberOfPages":100}`))
+	require.NoError(t, err)
+
+	err = ValidateContentMetadata(schemas, IscnInput(`{"@type":"Book","name":"a book"}`))
+	require.Error(t, err, "should not accept metadata without required field")
+
+	err = ValidateContentMetadata(schemas, IscnInput(`{"@type":"Book","name":"a book","author":"someone","isbn":"ISBN 978"}`))
+	require.Error(t, err, "should not accept metadata with field not matching pattern")
+
+	err = ValidateContentMetadata(schemas, IscnInput(`{"@type":"Book","name":"a book","author":"someone","numberOfPages":1.5}`))
+	require.Error(t, err, "should not accept non-integer for integer field")
+
+	err = ValidateContentMetadata(schemas, IscnInput(`{"@type":"Photograph"}`))
+	require.NoError(t, err, "should accept metadata with type without schema")
+
+	err = ValidateContentMetadata(schemas, IscnInput(`{"name":"no type"}`))
+	require.NoError(t, err, "should accept metadata without type")
+
+	err = ValidateContentMetadata(nil, IscnInput(`{"@type":"Book"}`))
+	require.NoError(t, err)
+}
+
+func TestContentSchemaProposalValidateBasic(t *testing.T) {
+	schema := ContentSchema{Type: "Book", Schema: `{"type":"object"}`}
+
+	require.NoError(t, NewAddContentSchemaProposal("title", "description", schema).ValidateBasic())
+	require.Error(t, NewAddContentSchemaProposal("", "description", schema).ValidateBasic())
+	require.Error(t, NewAddContentSchemaProposal("title", "description", ContentSchema{Type: "Book", Schema: `not JSON`}).ValidateBasic())
+
+	require.NoError(t, NewRemoveContentSchemaProposal("title", "description", "Book").ValidateBasic())
+	require.Error(t, NewRemoveContentSchemaProposal("title", "description", "").ValidateBasic())
+}
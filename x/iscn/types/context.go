@@ -0,0 +1,25 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type contextKey string
+
+const RegistryNameGetterKey contextKey = "iscn-registry-name-getter"
+
+// RegistryNameGetter returns the registry name parameter of the chain
+type RegistryNameGetter func(ctx sdk.Context) string
+
+func WithRegistryNameGetter(ctx sdk.Context, getter RegistryNameGetter) sdk.Context {
+	return ctx.WithValue(RegistryNameGetterKey, getter)
+}
+
+// GetRegistryName returns the registry name from the getter in the context, which is set by the ante handler
+func GetRegistryName(ctx sdk.Context) (string, bool) {
+	value := ctx.Value(RegistryNameGetterKey)
+	if value == nil {
+		return "", false
+	}
+	return value.(RegistryNameGetter)(ctx), true
+}
@@ -5,12 +5,29 @@ import (
 )
 
 var (
-	ErrInvalidIscnRecord  = sdkerrors.Register(ModuleName, 1, "invalid ISCN record")
-	ErrInvalidIscnId      = sdkerrors.Register(ModuleName, 2, "invalid ISCN ID")
-	ErrReusingIscnId      = sdkerrors.Register(ModuleName, 3, "reusing ISCN ID")
-	ErrRecordAlreadyExist = sdkerrors.Register(ModuleName, 4, "record already exist")
-	ErrEncodingJsonLd     = sdkerrors.Register(ModuleName, 5, "error when encoding JSON-LD record")
-	ErrInvalidIscnVersion = sdkerrors.Register(ModuleName, 6, "invalid ISCN ID version")
-	ErrDeductIscnFee      = sdkerrors.Register(ModuleName, 7, "error when deducting fee for ISCN record")
-	ErrRecordNotFound     = sdkerrors.Register(ModuleName, 8, "record not found")
+	ErrInvalidIscnRecord      = sdkerrors.Register(ModuleName, 1, "invalid ISCN record")
+	ErrInvalidIscnId          = sdkerrors.Register(ModuleName, 2, "invalid ISCN ID")
+	ErrReusingIscnId          = sdkerrors.Register(ModuleName, 3, "reusing ISCN ID")
+	ErrRecordAlreadyExist     = sdkerrors.Register(ModuleName, 4, "record already exist")
+	ErrEncodingJsonLd         = sdkerrors.Register(ModuleName, 5, "error when encoding JSON-LD record")
+	ErrInvalidIscnVersion     = sdkerrors.Register(ModuleName, 6, "invalid ISCN ID version")
+	ErrDeductIscnFee          = sdkerrors.Register(ModuleName, 7, "error when deducting fee for ISCN record")
+	ErrRecordNotFound         = sdkerrors.Register(ModuleName, 8, "record not found")
+	ErrInvalidIscnStatus      = sdkerrors.Register(ModuleName, 9, "invalid ISCN record status")
+	ErrRecordNotActive        = sdkerrors.Register(ModuleName, 10, "ISCN record is not active")
+	ErrInvalidContentMetadata = sdkerrors.Register(ModuleName, 11, "content metadata does not match its schema")
+	ErrContentSchemaNotFound  = sdkerrors.Register(ModuleName, 12, "content schema not found")
+
+	ErrInvalidOwnershipTransfer  = sdkerrors.Register(ModuleName, 13, "invalid ISCN ownership transfer")
+	ErrOwnershipTransferNotFound = sdkerrors.Register(ModuleName, 14, "pending ISCN ownership transfer not found")
+
+	ErrInvalidCommitment  = sdkerrors.Register(ModuleName, 15, "invalid ISCN commitment")
+	ErrCommitmentNotFound = sdkerrors.Register(ModuleName, 16, "ISCN commitment not found")
+
+	ErrInvalidAttestation  = sdkerrors.Register(ModuleName, 17, "invalid ISCN attestation")
+	ErrAttestationNotFound = sdkerrors.Register(ModuleName, 18, "ISCN attestation not found")
+
+	ErrRecordLimitExceeded = sdkerrors.Register(ModuleName, 19, "ISCN record exceeds limit")
+
+	ErrInvalidRelation = sdkerrors.Register(ModuleName, 20, "invalid ISCN record relation")
 )
@@ -0,0 +1,1339 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: likechain/iscn/event.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type EventCreateIscnRecord struct {
+	IscnId              string   `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	IscnIdPrefix        string   `protobuf:"bytes,2,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	Owner               string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Ipld                string   `protobuf:"bytes,4,opt,name=ipld,proto3" json:"ipld,omitempty"`
+	Version             uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	ContentFingerprints []string `protobuf:"bytes,6,rep,name=content_fingerprints,json=contentFingerprints,proto3" json:"content_fingerprints,omitempty"`
+}
+
+func (m *EventCreateIscnRecord) Reset()         { *m = EventCreateIscnRecord{} }
+func (m *EventCreateIscnRecord) String() string { return proto.CompactTextString(m) }
+func (*EventCreateIscnRecord) ProtoMessage()    {}
+func (*EventCreateIscnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1666725ab4f1e2ee, []int{0}
+}
+func (m *EventCreateIscnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCreateIscnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCreateIscnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCreateIscnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCreateIscnRecord.Merge(m, src)
+}
+func (m *EventCreateIscnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCreateIscnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCreateIscnRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCreateIscnRecord proto.InternalMessageInfo
+
+func (m *EventCreateIscnRecord) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+func (m *EventCreateIscnRecord) GetIscnIdPrefix() string {
+	if m != nil {
+		return m.IscnIdPrefix
+	}
+	return ""
+}
+
+func (m *EventCreateIscnRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventCreateIscnRecord) GetIpld() string {
+	if m != nil {
+		return m.Ipld
+	}
+	return ""
+}
+
+func (m *EventCreateIscnRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventCreateIscnRecord) GetContentFingerprints() []string {
+	if m != nil {
+		return m.ContentFingerprints
+	}
+	return nil
+}
+
+type EventUpdateIscnRecord struct {
+	IscnId              string   `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	IscnIdPrefix        string   `protobuf:"bytes,2,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	Owner               string   `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Ipld                string   `protobuf:"bytes,4,opt,name=ipld,proto3" json:"ipld,omitempty"`
+	Version             uint64   `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	ContentFingerprints []string `protobuf:"bytes,6,rep,name=content_fingerprints,json=contentFingerprints,proto3" json:"content_fingerprints,omitempty"`
+}
+
+func (m *EventUpdateIscnRecord) Reset()         { *m = EventUpdateIscnRecord{} }
+func (m *EventUpdateIscnRecord) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateIscnRecord) ProtoMessage()    {}
+func (*EventUpdateIscnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1666725ab4f1e2ee, []int{1}
+}
+func (m *EventUpdateIscnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateIscnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateIscnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateIscnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateIscnRecord.Merge(m, src)
+}
+func (m *EventUpdateIscnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateIscnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateIscnRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateIscnRecord proto.InternalMessageInfo
+
+func (m *EventUpdateIscnRecord) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+func (m *EventUpdateIscnRecord) GetIscnIdPrefix() string {
+	if m != nil {
+		return m.IscnIdPrefix
+	}
+	return ""
+}
+
+func (m *EventUpdateIscnRecord) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *EventUpdateIscnRecord) GetIpld() string {
+	if m != nil {
+		return m.Ipld
+	}
+	return ""
+}
+
+func (m *EventUpdateIscnRecord) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *EventUpdateIscnRecord) GetContentFingerprints() []string {
+	if m != nil {
+		return m.ContentFingerprints
+	}
+	return nil
+}
+
+type EventChangeIscnRecordOwnership struct {
+	IscnId        string `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	IscnIdPrefix  string `protobuf:"bytes,2,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	PreviousOwner string `protobuf:"bytes,3,opt,name=previous_owner,json=previousOwner,proto3" json:"previous_owner,omitempty"`
+	NewOwner      string `protobuf:"bytes,4,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Version       uint64 `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (m *EventChangeIscnRecordOwnership) Reset()         { *m = EventChangeIscnRecordOwnership{} }
+func (m *EventChangeIscnRecordOwnership) String() string { return proto.CompactTextString(m) }
+func (*EventChangeIscnRecordOwnership) ProtoMessage()    {}
+func (*EventChangeIscnRecordOwnership) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1666725ab4f1e2ee, []int{2}
+}
+func (m *EventChangeIscnRecordOwnership) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventChangeIscnRecordOwnership) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventChangeIscnRecordOwnership.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventChangeIscnRecordOwnership) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventChangeIscnRecordOwnership.Merge(m, src)
+}
+func (m *EventChangeIscnRecordOwnership) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventChangeIscnRecordOwnership) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventChangeIscnRecordOwnership.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventChangeIscnRecordOwnership proto.InternalMessageInfo
+
+func (m *EventChangeIscnRecordOwnership) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+func (m *EventChangeIscnRecordOwnership) GetIscnIdPrefix() string {
+	if m != nil {
+		return m.IscnIdPrefix
+	}
+	return ""
+}
+
+func (m *EventChangeIscnRecordOwnership) GetPreviousOwner() string {
+	if m != nil {
+		return m.PreviousOwner
+	}
+	return ""
+}
+
+func (m *EventChangeIscnRecordOwnership) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *EventChangeIscnRecordOwnership) GetVersion() uint64 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventCreateIscnRecord)(nil), "likechain.iscn.EventCreateIscnRecord")
+	proto.RegisterType((*EventUpdateIscnRecord)(nil), "likechain.iscn.EventUpdateIscnRecord")
+	proto.RegisterType((*EventChangeIscnRecordOwnership)(nil), "likechain.iscn.EventChangeIscnRecordOwnership")
+}
+
+func init() { proto.RegisterFile("likechain/iscn/event.proto", fileDescriptor_1666725ab4f1e2ee) }
+
+var fileDescriptor_1666725ab4f1e2ee = []byte{
+	// 335 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x92, 0xcb, 0x4a, 0x03, 0x31,
+	0x14, 0x86, 0x1b, 0x7b, 0xb3, 0x41, 0xbb, 0x88, 0x15, 0x07, 0x85, 0xa1, 0x88, 0x42, 0x37, 0x76,
+	0xf0, 0xf2, 0x04, 0x8a, 0x42, 0x41, 0x50, 0x06, 0xdc, 0xb8, 0x19, 0xda, 0x99, 0xd3, 0x4e, 0xb0,
+	0x9e, 0x84, 0x24, 0x9d, 0xd6, 0xb7, 0xf0, 0x85, 0xdc, 0xbb, 0x11, 0xba, 0x74, 0x29, 0xed, 0x8b,
+	0xc8, 0x24, 0x6d, 0xed, 0xc6, 0x95, 0x3b, 0x77, 0x39, 0xff, 0x77, 0xe0, 0xf0, 0x91, 0x9f, 0xee,
+	0x0f, 0xf9, 0x13, 0xc4, 0x69, 0x97, 0x63, 0xc0, 0x75, 0x8c, 0x01, 0x64, 0x80, 0xa6, 0x2d, 0x95,
+	0x30, 0x82, 0xd5, 0x57, 0xac, 0x9d, 0xb3, 0xc3, 0x0f, 0x42, 0x77, 0xaf, 0x73, 0x7e, 0xa5, 0xa0,
+	0x6b, 0xa0, 0xa3, 0x63, 0x0c, 0x21, 0x16, 0x2a, 0x61, 0x7b, 0xb4, 0x9a, 0x6f, 0x44, 0x3c, 0xf1,
+	0x48, 0x93, 0xb4, 0x6a, 0x61, 0x25, 0x1f, 0x3b, 0x09, 0x3b, 0xa2, 0xf5, 0x05, 0x88, 0xa4, 0x82,
+	0x3e, 0x9f, 0x78, 0x1b, 0x96, 0x6f, 0x39, 0x7e, 0x6f, 0x33, 0xd6, 0xa0, 0x65, 0x31, 0x46, 0x50,
+	0x5e, 0xd1, 0x42, 0x37, 0x30, 0x46, 0x4b, 0x5c, 0x0e, 0x13, 0xaf, 0x64, 0x43, 0xfb, 0x66, 0x1e,
+	0xad, 0x66, 0xa0, 0x34, 0x17, 0xe8, 0x95, 0x9b, 0xa4, 0x55, 0x0a, 0x97, 0x23, 0x3b, 0xa5, 0x8d,
+	0x58, 0xa0, 0x01, 0x34, 0x51, 0x9f, 0xe3, 0x00, 0x94, 0x54, 0x1c, 0x8d, 0xf6, 0x2a, 0xcd, 0x62,
+	0xab, 0x16, 0xee, 0x2c, 0xd8, 0xcd, 0x1a, 0xfa, 0xf1, 0x79, 0x90, 0xc9, 0x7f, 0xf0, 0x79, 0x23,
+	0xd4, 0x77, 0xff, 0x93, 0x76, 0x71, 0xb0, 0xe6, 0x73, 0x97, 0xdf, 0xd7, 0x29, 0x97, 0x7f, 0x15,
+	0x3b, 0xa6, 0x75, 0xa9, 0x20, 0xe3, 0x62, 0xa4, 0xa3, 0x75, 0xc3, 0xed, 0x65, 0x6a, 0x2f, 0xb1,
+	0x03, 0x5a, 0x43, 0x18, 0x2f, 0x36, 0x9c, 0xee, 0x26, 0xc2, 0xd8, 0xc1, 0x5f, 0x95, 0x2f, 0x6f,
+	0xdf, 0x67, 0x3e, 0x99, 0xce, 0x7c, 0xf2, 0x35, 0xf3, 0xc9, 0xeb, 0xdc, 0x2f, 0x4c, 0xe7, 0x7e,
+	0xe1, 0x73, 0xee, 0x17, 0x1e, 0xcf, 0x06, 0xdc, 0xa4, 0xa3, 0x5e, 0x3b, 0x16, 0xcf, 0x81, 0x2d,
+	0xa5, 0xe0, 0xb8, 0x7a, 0x9c, 0xb8, 0xfa, 0x66, 0x17, 0xc1, 0xc4, 0x75, 0xd8, 0xbc, 0x48, 0xd0,
+	0xbd, 0x8a, 0x2d, 0xf1, 0xf9, 0xf7, 0x00, 0x83, 0xf3, 0x1e, 0x25, 0xe2, 0x02, 0x00, 0x00,
+}
+
+func (m *EventCreateIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCreateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCreateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentFingerprints) > 0 {
+		for iNdEx := len(m.ContentFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContentFingerprints[iNdEx])
+			copy(dAtA[i:], m.ContentFingerprints[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.ContentFingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Ipld) > 0 {
+		i -= len(m.Ipld)
+		copy(dAtA[i:], m.Ipld)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Ipld)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentFingerprints) > 0 {
+		for iNdEx := len(m.ContentFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContentFingerprints[iNdEx])
+			copy(dAtA[i:], m.ContentFingerprints[iNdEx])
+			i = encodeVarintEvent(dAtA, i, uint64(len(m.ContentFingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Version != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Ipld) > 0 {
+		i -= len(m.Ipld)
+		copy(dAtA[i:], m.Ipld)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Ipld)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventChangeIscnRecordOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventChangeIscnRecordOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventChangeIscnRecordOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Version != 0 {
+		i = encodeVarintEvent(dAtA, i, uint64(m.Version))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PreviousOwner) > 0 {
+		i -= len(m.PreviousOwner)
+		copy(dAtA[i:], m.PreviousOwner)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.PreviousOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintEvent(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvent(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvent(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventCreateIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Ipld)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvent(uint64(m.Version))
+	}
+	if len(m.ContentFingerprints) > 0 {
+		for _, s := range m.ContentFingerprints {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventUpdateIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.Ipld)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvent(uint64(m.Version))
+	}
+	if len(m.ContentFingerprints) > 0 {
+		for _, s := range m.ContentFingerprints {
+			l = len(s)
+			n += 1 + l + sovEvent(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EventChangeIscnRecordOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.PreviousOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovEvent(uint64(l))
+	}
+	if m.Version != 0 {
+		n += 1 + sovEvent(uint64(m.Version))
+	}
+	return n
+}
+
+func sovEvent(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvent(x uint64) (n int) {
+	return sovEvent(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventCreateIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCreateIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCreateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentFingerprints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentFingerprints = append(m.ContentFingerprints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentFingerprints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentFingerprints = append(m.ContentFingerprints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventChangeIscnRecordOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventChangeIscnRecordOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventChangeIscnRecordOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PreviousOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvent
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			m.Version = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Version |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvent(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvent
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvent(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvent
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvent
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvent
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvent
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvent
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvent        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvent          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvent = fmt.Errorf("proto: unexpected end of group")
+)
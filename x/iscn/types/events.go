@@ -1,12 +1,79 @@
 package types
 
+import (
+	"encoding/hex"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 var (
-	EventTypeIscnRecord = "iscn_record"
+	// Deprecated: use the typed events EventCreateIscnRecord, EventUpdateIscnRecord and EventChangeIscnRecordOwnership.
+	// The legacy event is still emitted during the deprecation period.
+	EventTypeIscnRecord            = "iscn_record"
+	EventTypeIscnOwnershipTransfer = "iscn_ownership_transfer"
+	EventTypeIscnCommitment        = "iscn_commitment"
+	EventTypeIscnAttestation       = "iscn_attestation"
 
 	AttributeKeyIscnId                 = "iscn_id"
 	AttributeKeyIscnIdPrefix           = "iscn_id_prefix"
 	AttributeKeyIscnOwner              = "owner"
+	AttributeKeyIscnOwnerGroupId       = "owner_group_id"
 	AttributeKeyIscnRecordIpld         = "ipld"
 	AttributeKeyIscnContentFingerprint = "content_fingerprint"
-	AttributeValueCategory             = ModuleName
+	AttributeKeyIscnStatus             = "status"
+	AttributeKeyIscnSupersededBy       = "superseded_by"
+	AttributeKeyIscnNewOwner           = "new_owner"
+	AttributeKeyIscnExpiration         = "expiration"
+	AttributeKeyIscnTransferAction     = "action"
+	AttributeKeyIscnCommitment         = "commitment"
+	AttributeKeyIscnCommitter          = "committer"
+	AttributeKeyIscnCommitmentAction   = "action"
+	AttributeKeyIscnAttester           = "attester"
+	AttributeKeyIscnAttestationAction  = "action"
+
+	AttributeValueTransferProposed    = "proposed"
+	AttributeValueTransferAccepted    = "accepted"
+	AttributeValueTransferCancelled   = "cancelled"
+	AttributeValueTransferExpired     = "expired"
+	AttributeValueCommitmentCommitted = "committed"
+	AttributeValueCommitmentRevealed  = "revealed"
+	AttributeValueCommitmentExpired   = "expired"
+	AttributeValueAttestationAttested = "attested"
+	AttributeValueAttestationRevoked  = "revoked"
+	AttributeValueCategory            = ModuleName
 )
+
+func NewOwnershipTransferEvent(transfer *PendingOwnershipTransfer, action string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeIscnOwnershipTransfer,
+		sdk.NewAttribute(AttributeKeyIscnIdPrefix, transfer.IscnIdPrefix),
+		sdk.NewAttribute(AttributeKeyIscnOwner, transfer.Owner),
+		sdk.NewAttribute(AttributeKeyIscnNewOwner, transfer.NewOwner),
+		sdk.NewAttribute(AttributeKeyIscnExpiration, transfer.Expiration.UTC().Format(time.RFC3339Nano)),
+		sdk.NewAttribute(AttributeKeyIscnTransferAction, action),
+	)
+}
+
+func NewCommitmentEvent(commitment *IscnCommitment, action string) sdk.Event {
+	event := sdk.NewEvent(
+		EventTypeIscnCommitment,
+		sdk.NewAttribute(AttributeKeyIscnCommitment, hex.EncodeToString(commitment.Commitment)),
+		sdk.NewAttribute(AttributeKeyIscnCommitter, commitment.Committer),
+		sdk.NewAttribute(AttributeKeyIscnExpiration, commitment.Expiration.UTC().Format(time.RFC3339Nano)),
+		sdk.NewAttribute(AttributeKeyIscnCommitmentAction, action),
+	)
+	if commitment.RevealedIscnId != "" {
+		event = event.AppendAttributes(sdk.NewAttribute(AttributeKeyIscnId, commitment.RevealedIscnId))
+	}
+	return event
+}
+
+func NewAttestationEvent(attestation *IscnAttestation, action string) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeIscnAttestation,
+		sdk.NewAttribute(AttributeKeyIscnId, attestation.IscnId),
+		sdk.NewAttribute(AttributeKeyIscnAttester, attestation.Attester),
+		sdk.NewAttribute(AttributeKeyIscnAttestationAction, action),
+	)
+}
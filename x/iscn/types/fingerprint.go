@@ -0,0 +1,114 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	gocid "github.com/ipfs/go-cid"
+)
+
+const (
+	FingerprintSchemeHash = "hash"
+	FingerprintSchemeIpfs = "ipfs"
+	FingerprintSchemeAr   = "ar"
+)
+
+var hashFingerprintLengths = map[string]int{
+	"sha256": 32,
+	"sha1":   20,
+}
+
+var arweaveTxIdRegexp = regexp.MustCompile(`^[A-Za-z0-9_-]{43}$`)
+
+// splitFingerprintPath splits the part after `scheme://` into the first path segment and the remaining path (including
+// the leading `/`, if any)
+func splitFingerprintPath(s string) (string, string) {
+	i := strings.IndexByte(s, '/')
+	if i < 0 {
+		return s, ""
+	}
+	return s[:i], s[i:]
+}
+
+func canonicalizeHashFingerprint(fingerprint string, body string) (string, error) {
+	algo, digest := splitFingerprintPath(body)
+	algo = strings.ToLower(algo)
+	expectedLen, ok := hashFingerprintLengths[algo]
+	if !ok {
+		// unknown hash algorithms are kept as is
+		return fingerprint, nil
+	}
+	digest = strings.TrimPrefix(digest, "/")
+	bz, err := hex.DecodeString(digest)
+	if err != nil {
+		return "", fmt.Errorf("invalid %s digest in fingerprint %s: %w", algo, fingerprint, err)
+	}
+	if len(bz) != expectedLen {
+		return "", fmt.Errorf("invalid %s digest length in fingerprint %s: expect %d bytes, got %d", algo, fingerprint, expectedLen, len(bz))
+	}
+	return fmt.Sprintf("%s://%s/%s", FingerprintSchemeHash, algo, hex.EncodeToString(bz)), nil
+}
+
+func canonicalizeIpfsFingerprint(fingerprint string, body string) (string, error) {
+	cidStr, path := splitFingerprintPath(body)
+	c, err := gocid.Decode(cidStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid CID in fingerprint %s: %w", fingerprint, err)
+	}
+	cidV1 := gocid.NewCidV1(c.Type(), c.Hash())
+	return fmt.Sprintf("%s://%s%s", FingerprintSchemeIpfs, cidV1.String(), path), nil
+}
+
+func canonicalizeArFingerprint(fingerprint string, body string) (string, error) {
+	txId, path := splitFingerprintPath(body)
+	if !arweaveTxIdRegexp.MatchString(txId) {
+		return "", fmt.Errorf("invalid Arweave transaction ID in fingerprint %s", fingerprint)
+	}
+	return fmt.Sprintf("%s://%s%s", FingerprintSchemeAr, txId, path), nil
+}
+
+// CanonicalizeFingerprint validates the fingerprint and returns its canonical form.
+// Fingerprints with `hash://sha256`, `hash://sha1`, `ipfs://` and `ar://` prefixes are validated according to the scheme,
+// while fingerprints with other schemes are only checked to be URLs and are returned as is.
+func CanonicalizeFingerprint(fingerprint string) (string, error) {
+	u, err := url.ParseRequestURI(fingerprint)
+	if err != nil {
+		return "", fmt.Errorf("invalid fingerprint URL %s: %w", fingerprint, err)
+	}
+	if u.Scheme == "" {
+		return "", fmt.Errorf("empty fingerprint URL scheme in fingerprint %s", fingerprint)
+	}
+	scheme := strings.ToLower(u.Scheme)
+	switch scheme {
+	case FingerprintSchemeHash, FingerprintSchemeIpfs, FingerprintSchemeAr:
+	default:
+		return fingerprint, nil
+	}
+	body := fingerprint[len(u.Scheme)+1:]
+	if !strings.HasPrefix(body, "//") {
+		return "", fmt.Errorf("fingerprint %s with scheme %s should start with %s://", fingerprint, scheme, scheme)
+	}
+	body = body[2:]
+	switch scheme {
+	case FingerprintSchemeHash:
+		return canonicalizeHashFingerprint(fingerprint, body)
+	case FingerprintSchemeIpfs:
+		return canonicalizeIpfsFingerprint(fingerprint, body)
+	default:
+		return canonicalizeArFingerprint(fingerprint, body)
+	}
+}
+
+// NormalizeFingerprint returns the canonical form of the fingerprint, or the fingerprint itself if it cannot be
+// canonicalized (e.g. invalid fingerprints in records created before the scheme-aware validation was introduced).
+// It is used for indexing and querying fingerprints.
+func NormalizeFingerprint(fingerprint string) string {
+	canonical, err := CanonicalizeFingerprint(fingerprint)
+	if err != nil {
+		return fingerprint
+	}
+	return canonical
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCanonicalizeFingerprint(t *testing.T) {
+	sha256Lower := "hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e"
+	sha256Upper := "HASH://SHA256/9564B85669D5E96AC969DD0161B8475BBCED9E5999C6EC598DA718A3045D6F2E"
+	sha1Lower := "hash://sha1/a94a8fe5ccb19ba61c4c0873d391e987982fbbd3"
+	cidV0 := "ipfs://QmNrgEMcUygbKzZeZgYFosdd27VE9KnWbyUD73bKZJ3bGi"
+	cidV1 := "ipfs://bafybeiahwesqupoyvqxxuiuo2hf4hhevr2fmj2s7zh3gifue2242t2weg4"
+	arweave := "ar://3N8wHrO_K3-j9Az4E8ALbn0TNVWpP2_9Uv9Ro9GhbFE"
+
+	for _, tc := range []struct {
+		input     string
+		canonical string
+	}{
+		{sha256Lower, sha256Lower},
+		{sha256Upper, sha256Lower},
+		{sha1Lower, sha1Lower},
+		{"hash://SHA1/A94A8FE5CCB19BA61C4C0873D391E987982FBBD3", sha1Lower},
+		{"hash://md5/whatever", "hash://md5/whatever"},
+		{cidV0, cidV1},
+		{cidV1, cidV1},
+		{cidV0 + "/metadata.json", cidV1 + "/metadata.json"},
+		{arweave, arweave},
+		{arweave + "/index.html", arweave + "/index.html"},
+		{"https://example.com/a", "https://example.com/a"},
+	} {
+		canonical, err := CanonicalizeFingerprint(tc.input)
+		require.NoError(t, err, tc.input)
+		require.Equal(t, tc.canonical, canonical, tc.input)
+		require.Equal(t, tc.canonical, NormalizeFingerprint(tc.input), tc.input)
+	}
+
+	for _, input := range []string{
+		"hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f",
+		"hash://sha256/zz64b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e",
+		"hash://sha1/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e",
+		"hash://sha256",
+		"hash:sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e",
+		"ipfs://not-a-cid",
+		"ipfs://",
+		"ar://too-short",
+		"ar://3N8wHrO_K3-j9Az4E8ALbn0TNVWpP2_9Uv9Ro9GhbF!",
+		"sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e",
+	} {
+		_, err := CanonicalizeFingerprint(input)
+		require.Error(t, err, input)
+		require.Equal(t, input, NormalizeFingerprint(input))
+	}
+}
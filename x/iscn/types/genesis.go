@@ -14,6 +14,9 @@ func (genesis GenesisState) Validate() error {
 		return fmt.Errorf("invalid ISCN parameters: %w", err)
 	}
 	iscnVersionMap := map[string]uint64{}
+	iscnPrefixSet := map[string]struct{}{}
+	iscnRecordMap := map[string]IscnInput{}
+	relationTargetMap := map[string]string{}
 	for i, record := range genesis.IscnRecords {
 		recordMap := map[string]interface{}{}
 		err := json.Unmarshal(record, &recordMap)
@@ -41,6 +44,8 @@ func (genesis GenesisState) Validate() error {
 			return fmt.Errorf("record at index %d (ISCN ID %s) has non-contiguous version (previous version %d, current version %d)", i, iscnId.String(), prevVersion, iscnId.Version)
 		}
 		iscnVersionMap[iscnPrefix] = iscnId.Version
+		iscnPrefixSet[iscnPrefix] = struct{}{}
+		iscnRecordMap[iscnId.String()] = record
 		// not checking repeated CID, since CID bases from the hash of content, CID repeated -> hash repeated -> content repeated -> "@id" field repeated -> invalid version
 		fingerprintsAny, ok := recordMap["contentFingerprints"]
 		if !ok {
@@ -58,11 +63,23 @@ func (genesis GenesisState) Validate() error {
 			}
 			fingerprints = append(fingerprints, fingerprint)
 		}
-		err = ValidateFingerprints(fingerprints)
+		// existing records may contain fingerprints which do not pass the scheme-aware validation
+		err = validateLegacyFingerprints(fingerprints)
 		if err != nil {
 			return fmt.Errorf("record at index %d (ISCN ID %s) has invalid \"contentFingerprints\" entries: %w", i, iscnId.String(), err)
 		}
+		for _, relation := range GetRecordRelations(record) {
+			target, _ := relation.ParseTarget()
+			relationTargetMap[target.String()] = iscnId.String()
+		}
+	}
+	for target, source := range relationTargetMap {
+		if _, ok := iscnPrefixSet[target]; !ok {
+			return fmt.Errorf("record %s has relation to non-existing ISCN ID prefix %s", source, target)
+		}
 	}
+	supersededByMap := map[string]string{}
+	ownerMap := map[string]string{}
 	for _, contentIdRecord := range genesis.ContentIdRecords {
 		_, err := sdk.AccAddressFromBech32(contentIdRecord.Owner)
 		if err != nil {
@@ -80,10 +97,135 @@ func (genesis GenesisState) Validate() error {
 			return fmt.Errorf("ISCN ID prefix %s latest version does not match the content ID record entry", iscnId.String())
 		}
 		delete(iscnVersionMap, idPrefixStr)
+		ownerMap[idPrefixStr] = contentIdRecord.Owner
+		err = ValidateStatus(contentIdRecord.Status, contentIdRecord.SupersededBy)
+		if err != nil {
+			return fmt.Errorf("ISCN ID prefix %s has invalid status in content ID record entries: %w", idPrefixStr, err)
+		}
+		if contentIdRecord.SupersededBy != "" {
+			supersededByMap[idPrefixStr] = contentIdRecord.SupersededBy
+		}
 	}
 	for prefixStr := range iscnVersionMap {
 		return fmt.Errorf("ISCN ID prefix %s has related ISCN record but no content ID record", prefixStr)
 	}
+	prevHeight := uint64(0)
+	prevSeq := uint64(0)
+	for _, heightSequence := range genesis.HeightSequences {
+		if heightSequence.Height <= prevHeight {
+			return fmt.Errorf("height sequence entries are not in strictly increasing height order (height %d after %d)", heightSequence.Height, prevHeight)
+		}
+		if heightSequence.Sequence <= prevSeq || heightSequence.Sequence > uint64(len(genesis.IscnRecords)) {
+			return fmt.Errorf("height sequence entry for height %d has invalid sequence %d", heightSequence.Height, heightSequence.Sequence)
+		}
+		prevHeight = heightSequence.Height
+		prevSeq = heightSequence.Sequence
+	}
+	transferSet := map[string]struct{}{}
+	for _, transfer := range genesis.PendingOwnershipTransfers {
+		iscnId, err := ParseIscnId(transfer.IscnIdPrefix)
+		if err != nil {
+			return fmt.Errorf("cannot parse ISCN ID %s in pending ownership transfer entries: %w", transfer.IscnIdPrefix, err)
+		}
+		if iscnId.Version != 0 {
+			return fmt.Errorf("invalid version in ISCN ID %s in pending ownership transfer entries, expect version 0", iscnId.String())
+		}
+		idPrefixStr := iscnId.Prefix.String()
+		if _, ok := transferSet[idPrefixStr]; ok {
+			return fmt.Errorf("ISCN ID prefix %s has multiple pending ownership transfers", idPrefixStr)
+		}
+		transferSet[idPrefixStr] = struct{}{}
+		owner, ok := ownerMap[idPrefixStr]
+		if !ok {
+			return fmt.Errorf("ISCN ID prefix %s has pending ownership transfer but no content ID record", idPrefixStr)
+		}
+		if transfer.Owner != owner {
+			return fmt.Errorf("ISCN ID prefix %s has pending ownership transfer from %s, but the owner is %s", idPrefixStr, transfer.Owner, owner)
+		}
+		newOwner, err := sdk.AccAddressFromBech32(transfer.NewOwner)
+		if err != nil {
+			return fmt.Errorf("invalid new owner address %s in pending ownership transfer entries: %w", transfer.NewOwner, err)
+		}
+		if newOwner.String() == owner {
+			return fmt.Errorf("ISCN ID prefix %s has pending ownership transfer to its owner", idPrefixStr)
+		}
+		if transfer.Expiration.IsZero() {
+			return fmt.Errorf("ISCN ID prefix %s has pending ownership transfer without expiration", idPrefixStr)
+		}
+	}
+	err = genesis.CollectedFees.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid collected fees: %w", err)
+	}
+	commitmentSet := map[string]struct{}{}
+	for i, commitment := range genesis.Commitments {
+		err = commitment.Validate()
+		if err != nil {
+			return fmt.Errorf("commitment at index %d is invalid: %w", i, err)
+		}
+		commitmentStr := string(commitment.Commitment)
+		if _, ok := commitmentSet[commitmentStr]; ok {
+			return fmt.Errorf("commitment at index %d is repeated", i)
+		}
+		commitmentSet[commitmentStr] = struct{}{}
+		if commitment.IsRevealed() {
+			iscnId, _ := ParseIscnId(commitment.RevealedIscnId)
+			if _, ok := iscnPrefixSet[iscnId.Prefix.String()]; !ok {
+				return fmt.Errorf("commitment at index %d is revealed as ISCN ID %s, which does not exist", i, commitment.RevealedIscnId)
+			}
+		}
+	}
+	attestationSet := map[string]struct{}{}
+	for i, attestation := range genesis.Attestations {
+		err = attestation.Validate()
+		if err != nil {
+			return fmt.Errorf("attestation at index %d is invalid: %w", i, err)
+		}
+		iscnId, _ := ParseIscnId(attestation.IscnId)
+		attester, _ := sdk.AccAddressFromBech32(attestation.Attester)
+		attestationKey := fmt.Sprintf("%s %s", iscnId.String(), attester.String())
+		if _, ok := attestationSet[attestationKey]; ok {
+			return fmt.Errorf("attestation at index %d is repeated", i)
+		}
+		attestationSet[attestationKey] = struct{}{}
+		record, ok := iscnRecordMap[iscnId.String()]
+		if !ok {
+			return fmt.Errorf("attestation at index %d is for ISCN ID %s, which does not exist", i, attestation.IscnId)
+		}
+		if !IsStakeholderAddress(record, attester) {
+			return fmt.Errorf("attestation at index %d is from %s, which is not a stakeholder of ISCN ID %s", i, attestation.Attester, attestation.IscnId)
+		}
+	}
+	lastHistoryEntryMap := map[string]IscnOwnershipHistoryEntry{}
+	for i, entry := range genesis.OwnershipHistory {
+		err = entry.Validate()
+		if err != nil {
+			return fmt.Errorf("ownership history entry at index %d is invalid: %w", i, err)
+		}
+		iscnId, _ := ParseIscnId(entry.IscnIdPrefix)
+		idPrefixStr := iscnId.Prefix.String()
+		if _, ok := ownerMap[idPrefixStr]; !ok {
+			return fmt.Errorf("ownership history entry at index %d is for ISCN ID prefix %s, which does not exist", i, entry.IscnIdPrefix)
+		}
+		if prevEntry, ok := lastHistoryEntryMap[idPrefixStr]; ok && entry.Height < prevEntry.Height {
+			return fmt.Errorf("ownership history entry at index %d is before the previous entry of ISCN ID prefix %s", i, idPrefixStr)
+		}
+		lastHistoryEntryMap[idPrefixStr] = entry
+	}
+	for idPrefixStr, entry := range lastHistoryEntryMap {
+		if entry.Owner != ownerMap[idPrefixStr] {
+			return fmt.Errorf("last ownership history entry of ISCN ID prefix %s has owner %s, but the current owner is %s", idPrefixStr, entry.Owner, ownerMap[idPrefixStr])
+		}
+	}
+	for prefixStr, supersededBy := range supersededByMap {
+		if supersededBy == prefixStr {
+			return fmt.Errorf("ISCN ID prefix %s is superseded by itself", prefixStr)
+		}
+		_, ok := iscnPrefixSet[supersededBy]
+		if !ok {
+			return fmt.Errorf("ISCN ID prefix %s is superseded by non-existing ISCN ID prefix %s", prefixStr, supersededBy)
+		}
+	}
 	return nil
 }
 
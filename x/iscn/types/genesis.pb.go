@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -24,9 +25,16 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
-	Params           Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ContentIdRecords []GenesisState_ContentIdRecord `protobuf:"bytes,2,rep,name=content_id_records,json=contentIdRecords,proto3" json:"content_id_records"`
-	IscnRecords      []IscnInput                    `protobuf:"bytes,3,rep,name=iscn_records,json=iscnRecords,proto3,customtype=IscnInput" json:"iscn_records"`
+	Params                    Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ContentIdRecords          []GenesisState_ContentIdRecord `protobuf:"bytes,2,rep,name=content_id_records,json=contentIdRecords,proto3" json:"content_id_records"`
+	IscnRecords               []IscnInput                    `protobuf:"bytes,3,rep,name=iscn_records,json=iscnRecords,proto3,customtype=IscnInput" json:"iscn_records"`
+	HeightSequences           []GenesisState_HeightSequence  `protobuf:"bytes,4,rep,name=height_sequences,json=heightSequences,proto3" json:"height_sequences"`
+	PendingOwnershipTransfers []PendingOwnershipTransfer     `protobuf:"bytes,5,rep,name=pending_ownership_transfers,json=pendingOwnershipTransfers,proto3" json:"pending_ownership_transfers"`
+	CollectedFees             types.CollectedFees            `protobuf:"bytes,6,opt,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
+	Commitments               []IscnCommitment               `protobuf:"bytes,7,rep,name=commitments,proto3" json:"commitments"`
+	Attestations              []IscnAttestation              `protobuf:"bytes,8,rep,name=attestations,proto3" json:"attestations"`
+	// ownership history entries, in the order of each ISCN ID prefix
+	OwnershipHistory []IscnOwnershipHistoryEntry `protobuf:"bytes,9,rep,name=ownership_history,json=ownershipHistory,proto3" json:"ownership_history"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -76,10 +84,54 @@ func (m *GenesisState) GetContentIdRecords() []GenesisState_ContentIdRecord {
 	return nil
 }
 
+func (m *GenesisState) GetHeightSequences() []GenesisState_HeightSequence {
+	if m != nil {
+		return m.HeightSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingOwnershipTransfers() []PendingOwnershipTransfer {
+	if m != nil {
+		return m.PendingOwnershipTransfers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCollectedFees() types.CollectedFees {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return types.CollectedFees{}
+}
+
+func (m *GenesisState) GetCommitments() []IscnCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAttestations() []IscnAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOwnershipHistory() []IscnOwnershipHistoryEntry {
+	if m != nil {
+		return m.OwnershipHistory
+	}
+	return nil
+}
+
 type GenesisState_ContentIdRecord struct {
-	IscnId        string `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
-	Owner         string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
-	LatestVersion uint64 `protobuf:"varint,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	IscnId        string           `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	Owner         string           `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	LatestVersion uint64           `protobuf:"varint,3,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Status        IscnRecordStatus `protobuf:"varint,4,opt,name=status,proto3,enum=likechain.iscn.IscnRecordStatus" json:"status,omitempty"`
+	SupersededBy  string           `protobuf:"bytes,5,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
 }
 
 func (m *GenesisState_ContentIdRecord) Reset()         { *m = GenesisState_ContentIdRecord{} }
@@ -136,37 +188,123 @@ func (m *GenesisState_ContentIdRecord) GetLatestVersion() uint64 {
 	return 0
 }
 
+func (m *GenesisState_ContentIdRecord) GetStatus() IscnRecordStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ISCN_RECORD_STATUS_ACTIVE
+}
+
+func (m *GenesisState_ContentIdRecord) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+type GenesisState_HeightSequence struct {
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the first sequence registered in the block, where sequence is the 1-based index in `iscn_records`
+	Sequence uint64 `protobuf:"varint,2,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *GenesisState_HeightSequence) Reset()         { *m = GenesisState_HeightSequence{} }
+func (m *GenesisState_HeightSequence) String() string { return proto.CompactTextString(m) }
+func (*GenesisState_HeightSequence) ProtoMessage()    {}
+func (*GenesisState_HeightSequence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_17b0ee1060d60841, []int{0, 1}
+}
+func (m *GenesisState_HeightSequence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState_HeightSequence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState_HeightSequence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState_HeightSequence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState_HeightSequence.Merge(m, src)
+}
+func (m *GenesisState_HeightSequence) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState_HeightSequence) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState_HeightSequence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState_HeightSequence proto.InternalMessageInfo
+
+func (m *GenesisState_HeightSequence) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *GenesisState_HeightSequence) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "likechain.iscn.GenesisState")
 	proto.RegisterType((*GenesisState_ContentIdRecord)(nil), "likechain.iscn.GenesisState.ContentIdRecord")
+	proto.RegisterType((*GenesisState_HeightSequence)(nil), "likechain.iscn.GenesisState.HeightSequence")
 }
 
 func init() { proto.RegisterFile("likechain/iscn/genesis.proto", fileDescriptor_17b0ee1060d60841) }
 
 var fileDescriptor_17b0ee1060d60841 = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0xcf, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc7, 0xdb, 0x75, 0x4e, 0x96, 0xcd, 0xa9, 0x61, 0x68, 0x99, 0xd2, 0x15, 0x41, 0xe8, 0x41,
-	0x5b, 0x98, 0x7b, 0x82, 0x79, 0x90, 0x82, 0x07, 0xa9, 0xe0, 0xc1, 0xcb, 0xec, 0xd2, 0xd0, 0x05,
-	0xb7, 0xa4, 0x34, 0xd9, 0xd4, 0xb7, 0xf0, 0xa5, 0x84, 0x1d, 0x77, 0x14, 0x0f, 0x43, 0xb6, 0x17,
-	0x91, 0x24, 0x65, 0xb8, 0xde, 0xf2, 0xfb, 0xfe, 0xf9, 0xfc, 0x68, 0x7f, 0xe0, 0x7c, 0x42, 0x5e,
-	0x31, 0x1a, 0xc7, 0x84, 0x06, 0x84, 0x23, 0x1a, 0xa4, 0x98, 0x62, 0x4e, 0xb8, 0x9f, 0xe5, 0x4c,
-	0x30, 0xd8, 0xda, 0xba, 0xbe, 0x74, 0x3b, 0xed, 0x94, 0xa5, 0x4c, 0x59, 0x81, 0x7c, 0xe9, 0x54,
-	0xe7, 0xac, 0xc4, 0xc8, 0xe2, 0x3c, 0x9e, 0x16, 0x88, 0x8b, 0xaf, 0x0a, 0x68, 0xde, 0x69, 0xe8,
-	0xa3, 0x88, 0x05, 0x86, 0x7d, 0x50, 0xd3, 0x01, 0xdb, 0x74, 0x4d, 0xaf, 0xd1, 0x3b, 0xf1, 0x77,
-	0x97, 0xf8, 0x0f, 0xca, 0x1d, 0x54, 0x17, 0xab, 0xae, 0x11, 0x15, 0x59, 0xf8, 0x02, 0x20, 0x62,
-	0x54, 0x60, 0x2a, 0x86, 0x24, 0x19, 0xe6, 0x18, 0xb1, 0x3c, 0xe1, 0x76, 0xc5, 0xb5, 0xbc, 0x46,
-	0xef, 0xaa, 0x4c, 0xf8, 0xbf, 0xcf, 0xbf, 0xd5, 0xb5, 0x30, 0x89, 0x54, 0xa9, 0xe0, 0x1e, 0xa1,
-	0x5d, 0x99, 0xc3, 0x3e, 0x68, 0xca, 0xf2, 0x96, 0x6d, 0xb9, 0x96, 0xd7, 0x1c, 0x1c, 0xcb, 0xf4,
-	0xcf, 0xaa, 0x5b, 0x0f, 0x39, 0xa2, 0x21, 0xcd, 0x66, 0x22, 0x6a, 0xc8, 0x58, 0xd1, 0xea, 0xa4,
-	0xe0, 0xb0, 0xb4, 0x00, 0x9e, 0x82, 0x7d, 0x05, 0x22, 0x89, 0xfa, 0xc2, 0x7a, 0x54, 0x93, 0x63,
-	0x98, 0xc0, 0x36, 0xd8, 0x63, 0x6f, 0x14, 0xe7, 0x76, 0x45, 0xc9, 0x7a, 0x80, 0x97, 0xa0, 0x35,
-	0x89, 0x05, 0xe6, 0x62, 0x38, 0xc7, 0x39, 0x27, 0x8c, 0xda, 0x96, 0x6b, 0x7a, 0xd5, 0xe8, 0x40,
-	0xab, 0x4f, 0x5a, 0x1c, 0xdc, 0x2f, 0xd6, 0x8e, 0xb9, 0x5c, 0x3b, 0xe6, 0xef, 0xda, 0x31, 0x3f,
-	0x37, 0x8e, 0xb1, 0xdc, 0x38, 0xc6, 0xf7, 0xc6, 0x31, 0x9e, 0x7b, 0x29, 0x11, 0xe3, 0xd9, 0xc8,
-	0x47, 0x6c, 0x1a, 0xa8, 0x1f, 0xc1, 0x08, 0xdd, 0x3e, 0xae, 0xf5, 0x5d, 0xe6, 0xfd, 0xe0, 0x5d,
-	0x1f, 0x47, 0x7c, 0x64, 0x98, 0x8f, 0x6a, 0xea, 0x38, 0x37, 0x7f, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0xa4, 0x92, 0x57, 0x00, 0xff, 0x01, 0x00, 0x00,
+	// 640 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x54, 0x5d, 0x6f, 0xd3, 0x30,
+	0x14, 0x6d, 0xd6, 0xae, 0xdb, 0xdc, 0xae, 0xdb, 0xac, 0x69, 0x84, 0x0c, 0x65, 0x15, 0x68, 0x52,
+	0x10, 0x90, 0x4a, 0x65, 0x0f, 0xbc, 0xd2, 0xc1, 0x58, 0x25, 0x24, 0x50, 0x86, 0x78, 0x40, 0x93,
+	0x42, 0xe6, 0xdc, 0x26, 0x16, 0xad, 0x1d, 0x6c, 0x77, 0xd0, 0x7f, 0xc1, 0xaf, 0x42, 0x7b, 0xdc,
+	0x23, 0xe2, 0x61, 0x42, 0xdb, 0xff, 0x40, 0x28, 0x76, 0xfa, 0xc9, 0xe0, 0xcd, 0xbe, 0xf7, 0x9c,
+	0x73, 0x8f, 0xed, 0x23, 0xa3, 0x7b, 0x7d, 0xfa, 0x09, 0x48, 0x1a, 0x51, 0xd6, 0xa2, 0x92, 0xb0,
+	0x56, 0x02, 0x0c, 0x24, 0x95, 0x7e, 0x26, 0xb8, 0xe2, 0xb8, 0x31, 0xe9, 0xfa, 0x79, 0xd7, 0xd9,
+	0x4e, 0x78, 0xc2, 0x75, 0xab, 0x95, 0xaf, 0x0c, 0xca, 0xd9, 0x5d, 0xd0, 0xc8, 0x22, 0x11, 0x0d,
+	0x0a, 0x09, 0xc7, 0x9b, 0x36, 0xf3, 0x55, 0x0f, 0x20, 0x11, 0x11, 0x53, 0xad, 0x1e, 0x40, 0x28,
+	0xf8, 0x50, 0x51, 0x96, 0x14, 0x48, 0x67, 0x41, 0x46, 0x2a, 0x2e, 0xc0, 0xf4, 0xee, 0xff, 0x5e,
+	0x41, 0xf5, 0x57, 0xc6, 0xda, 0x89, 0x8a, 0x14, 0xe0, 0x03, 0x54, 0x35, 0x63, 0x6c, 0xab, 0x69,
+	0x79, 0xb5, 0xf6, 0x8e, 0x3f, 0x6f, 0xd5, 0x7f, 0xab, 0xbb, 0x9d, 0xca, 0xc5, 0xd5, 0x5e, 0x29,
+	0x28, 0xb0, 0xf8, 0x23, 0xc2, 0x84, 0x33, 0x05, 0x4c, 0x85, 0x34, 0x0e, 0x05, 0x10, 0x2e, 0x62,
+	0x69, 0x2f, 0x35, 0xcb, 0x5e, 0xad, 0xfd, 0x78, 0x51, 0x61, 0x76, 0x9e, 0x7f, 0x68, 0x68, 0xdd,
+	0x38, 0xd0, 0xa4, 0x42, 0x77, 0x93, 0xcc, 0x97, 0x25, 0x3e, 0x40, 0xf5, 0x9c, 0x3c, 0xd1, 0x2e,
+	0x37, 0xcb, 0x5e, 0xbd, 0xb3, 0x95, 0xa3, 0x7f, 0x5e, 0xed, 0xad, 0x75, 0x25, 0x61, 0x5d, 0x96,
+	0x0d, 0x55, 0x50, 0xcb, 0x61, 0x63, 0xd6, 0x29, 0xda, 0x4c, 0x81, 0x26, 0xa9, 0x0a, 0x25, 0x7c,
+	0x1e, 0x02, 0x23, 0x20, 0xed, 0x8a, 0x76, 0xf5, 0xe8, 0xbf, 0xae, 0x8e, 0x35, 0xe9, 0xa4, 0xe0,
+	0x14, 0xa6, 0x36, 0xd2, 0xb9, 0xaa, 0xc4, 0x0c, 0xed, 0x66, 0xc0, 0x62, 0xca, 0x92, 0x90, 0x7f,
+	0x61, 0x20, 0x64, 0x4a, 0xb3, 0x50, 0x89, 0x88, 0xc9, 0x1e, 0x08, 0x69, 0x2f, 0xeb, 0x41, 0xde,
+	0x5f, 0x17, 0x68, 0x28, 0x6f, 0xc6, 0x8c, 0x77, 0x05, 0xa1, 0x98, 0x72, 0x37, 0xfb, 0x47, 0x5f,
+	0xe2, 0x00, 0x35, 0x08, 0xef, 0xf7, 0x81, 0x28, 0x88, 0xc3, 0x1e, 0x80, 0xb4, 0xab, 0xfa, 0x8d,
+	0xf6, 0x67, 0x46, 0xcc, 0x66, 0xc1, 0x3f, 0x1c, 0xa3, 0x8f, 0x00, 0xc6, 0x4f, 0xb6, 0x4e, 0x66,
+	0x8b, 0xf8, 0x08, 0xd5, 0x08, 0x1f, 0x0c, 0xa8, 0x1a, 0x00, 0x53, 0xd2, 0x5e, 0xd1, 0x9e, 0xdd,
+	0x45, 0xcf, 0xf9, 0xf5, 0x1e, 0x4e, 0x60, 0x85, 0xd2, 0x2c, 0x11, 0x77, 0x51, 0x3d, 0x52, 0x0a,
+	0xa4, 0x8a, 0x14, 0xe5, 0x4c, 0xda, 0xab, 0x5a, 0x68, 0xef, 0x36, 0xa1, 0xe7, 0x53, 0x5c, 0xa1,
+	0x34, 0x47, 0xc5, 0xa7, 0x68, 0x6b, 0x7a, 0x9d, 0x29, 0xcd, 0xe3, 0x3a, 0xb2, 0xd7, 0xb4, 0xde,
+	0xc3, 0xdb, 0xf4, 0x26, 0x37, 0x75, 0x6c, 0xb0, 0x2f, 0x99, 0x12, 0xa3, 0x71, 0x90, 0xf8, 0x42,
+	0xd3, 0xf9, 0x6e, 0xa1, 0x8d, 0x85, 0xd0, 0xe1, 0x3b, 0x68, 0x45, 0x87, 0x8b, 0xc6, 0x3a, 0xf5,
+	0x6b, 0x41, 0x35, 0xdf, 0x76, 0x63, 0xbc, 0x8d, 0x96, 0xb5, 0x80, 0xbd, 0xa4, 0xcb, 0x66, 0x83,
+	0xf7, 0x51, 0xa3, 0x1f, 0xe5, 0x86, 0xc3, 0x73, 0x10, 0x92, 0x72, 0x66, 0x97, 0x9b, 0x96, 0x57,
+	0x09, 0xd6, 0x4d, 0xf5, 0xbd, 0x29, 0xe2, 0x67, 0xa8, 0x9a, 0x9f, 0x69, 0x98, 0x47, 0xce, 0xf2,
+	0x1a, 0xed, 0xe6, 0x6d, 0xe6, 0x8d, 0x83, 0x13, 0x8d, 0x0b, 0x0a, 0x3c, 0x7e, 0x80, 0xd6, 0xe5,
+	0x30, 0x03, 0x21, 0x21, 0x86, 0x38, 0x3c, 0x1b, 0xd9, 0xcb, 0x7a, 0x7c, 0x7d, 0x5a, 0xec, 0x8c,
+	0x9c, 0x17, 0xa8, 0x31, 0x1f, 0x53, 0xbc, 0x83, 0xaa, 0x26, 0xa2, 0xfa, 0x14, 0x95, 0xa0, 0xd8,
+	0x61, 0x07, 0xad, 0x8e, 0xe3, 0xaf, 0x0f, 0x52, 0x09, 0x26, 0xfb, 0xce, 0xeb, 0x8b, 0x6b, 0xd7,
+	0xba, 0xbc, 0x76, 0xad, 0x5f, 0xd7, 0xae, 0xf5, 0xed, 0xc6, 0x2d, 0x5d, 0xde, 0xb8, 0xa5, 0x1f,
+	0x37, 0x6e, 0xe9, 0x43, 0x3b, 0xa1, 0x2a, 0x1d, 0x9e, 0xf9, 0x84, 0x0f, 0xf4, 0x0f, 0x43, 0x38,
+	0x65, 0x93, 0xc5, 0x13, 0xf3, 0x9f, 0x9c, 0x1f, 0xb4, 0xbe, 0x9a, 0x4f, 0x45, 0x8d, 0x32, 0x90,
+	0x67, 0x55, 0xfd, 0xab, 0x3c, 0xfd, 0x33, 0x00, 0xe6, 0x9c, 0x94, 0x9f, 0xfe, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -189,6 +327,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.OwnershipHistory) > 0 {
+		for iNdEx := len(m.OwnershipHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OwnershipHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.CollectedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.PendingOwnershipTransfers) > 0 {
+		for iNdEx := len(m.PendingOwnershipTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOwnershipTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.HeightSequences) > 0 {
+		for iNdEx := len(m.HeightSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HeightSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.IscnRecords) > 0 {
 		for iNdEx := len(m.IscnRecords) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -250,6 +468,18 @@ func (m *GenesisState_ContentIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, e
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
 	if m.LatestVersion != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.LatestVersion))
 		i--
@@ -272,6 +502,39 @@ func (m *GenesisState_ContentIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
+func (m *GenesisState_HeightSequence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState_HeightSequence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState_HeightSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Height != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -303,6 +566,38 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.HeightSequences) > 0 {
+		for _, e := range m.HeightSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingOwnershipTransfers) > 0 {
+		for _, e := range m.PendingOwnershipTransfers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CollectedFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OwnershipHistory) > 0 {
+		for _, e := range m.OwnershipHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -323,6 +618,28 @@ func (m *GenesisState_ContentIdRecord) Size() (n int) {
 	if m.LatestVersion != 0 {
 		n += 1 + sovGenesis(uint64(m.LatestVersion))
 	}
+	if m.Status != 0 {
+		n += 1 + sovGenesis(uint64(m.Status))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *GenesisState_HeightSequence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovGenesis(uint64(m.Height))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -463,6 +780,209 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeightSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HeightSequences = append(m.HeightSequences, GenesisState_HeightSequence{})
+			if err := m.HeightSequences[len(m.HeightSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnershipTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwnershipTransfers = append(m.PendingOwnershipTransfers, PendingOwnershipTransfer{})
+			if err := m.PendingOwnershipTransfers[len(m.PendingOwnershipTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, IscnCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, IscnAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnershipHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OwnershipHistory = append(m.OwnershipHistory, IscnOwnershipHistoryEntry{})
+			if err := m.OwnershipHistory[len(m.OwnershipHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -596,6 +1116,145 @@ func (m *GenesisState_ContentIdRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IscnRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState_HeightSequence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HeightSequence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HeightSequence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
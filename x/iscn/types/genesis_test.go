@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -104,6 +105,282 @@ func TestGenesisValidate(t *testing.T) {
 	err = state.Validate()
 	require.Error(t, err, "should not accept record with invalid ISCN ID")
 
+	state = goodState()
+	state.ContentIdRecords[0].Status = ISCN_RECORD_STATUS_FROZEN
+	state.ContentIdRecords[1].Status = ISCN_RECORD_STATUS_DEPRECATED
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.ContentIdRecords[0].Status = ISCN_RECORD_STATUS_SUPERSEDED
+	state.ContentIdRecords[0].SupersededBy = "iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM"
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.ContentIdRecords[0].Status = 4
+	err = state.Validate()
+	require.Error(t, err, "should not accept content ID record with unknown status")
+
+	state = goodState()
+	state.ContentIdRecords[0].Status = ISCN_RECORD_STATUS_SUPERSEDED
+	err = state.Validate()
+	require.Error(t, err, "should not accept superseded content ID record without superseding ISCN ID")
+
+	state = goodState()
+	state.ContentIdRecords[0].SupersededBy = "iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM"
+	err = state.Validate()
+	require.Error(t, err, "should not accept active content ID record with superseding ISCN ID")
+
+	state = goodState()
+	state.ContentIdRecords[0].Status = ISCN_RECORD_STATUS_SUPERSEDED
+	state.ContentIdRecords[0].SupersededBy = "iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM/1"
+	err = state.Validate()
+	require.Error(t, err, "should not accept superseding ISCN ID with version")
+
+	state = goodState()
+	state.ContentIdRecords[0].Status = ISCN_RECORD_STATUS_SUPERSEDED
+	state.ContentIdRecords[0].SupersededBy = "iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU"
+	err = state.Validate()
+	require.Error(t, err, "should not accept non-existing superseding ISCN ID")
+
+	state = goodState()
+	state.ContentIdRecords[0].Status = ISCN_RECORD_STATUS_SUPERSEDED
+	state.ContentIdRecords[0].SupersededBy = "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU"
+	err = state.Validate()
+	require.Error(t, err, "should not accept content ID record superseded by itself")
+
+	state = goodState()
+	state.HeightSequences = []GenesisState_HeightSequence{{Height: 10, Sequence: 1}, {Height: 12, Sequence: 3}}
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.HeightSequences = []GenesisState_HeightSequence{{Height: 12, Sequence: 1}, {Height: 10, Sequence: 3}}
+	err = state.Validate()
+	require.Error(t, err, "should not accept height sequences with decreasing height")
+
+	state = goodState()
+	state.HeightSequences = []GenesisState_HeightSequence{{Height: 10, Sequence: 2}, {Height: 12, Sequence: 2}}
+	err = state.Validate()
+	require.Error(t, err, "should not accept height sequences with non-increasing sequence")
+
+	state = goodState()
+	state.HeightSequences = []GenesisState_HeightSequence{{Height: 10, Sequence: 4}}
+	err = state.Validate()
+	require.Error(t, err, "should not accept height sequences with sequence out of range")
+
+	goodTransfer := func() PendingOwnershipTransfer {
+		return PendingOwnershipTransfer{
+			IscnIdPrefix: "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU",
+			Owner:        "cosmos1r623mw6k77g6s3t67fy3042u9nshdl49fgvtex",
+			NewOwner:     "cosmos172nhdqasd2t9e8vvqw4cxfnnutt98q7elzluk9",
+			Expiration:   time.Unix(1234567890, 0),
+		}
+	}
+
+	state = goodState()
+	state.PendingOwnershipTransfers = []PendingOwnershipTransfer{goodTransfer()}
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.PendingOwnershipTransfers = []PendingOwnershipTransfer{goodTransfer(), goodTransfer()}
+	err = state.Validate()
+	require.Error(t, err, "should not accept multiple pending ownership transfers for the same record")
+
+	state = goodState()
+	state.PendingOwnershipTransfers = []PendingOwnershipTransfer{goodTransfer()}
+	state.PendingOwnershipTransfers[0].IscnIdPrefix = "iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU"
+	err = state.Validate()
+	require.Error(t, err, "should not accept pending ownership transfer for non-existing record")
+
+	state = goodState()
+	state.PendingOwnershipTransfers = []PendingOwnershipTransfer{goodTransfer()}
+	state.PendingOwnershipTransfers[0].Owner = "cosmos17dj36xsnaszfwpmv92ct6hfkc2m88nqyls2pvd"
+	err = state.Validate()
+	require.Error(t, err, "should not accept pending ownership transfer not from the record owner")
+
+	state = goodState()
+	state.PendingOwnershipTransfers = []PendingOwnershipTransfer{goodTransfer()}
+	state.PendingOwnershipTransfers[0].NewOwner = "cosmos172nhdqasd2t9e8vvqw4cxfnnutt98q7elzluk8" // invalid checksum
+	err = state.Validate()
+	require.Error(t, err, "should not accept pending ownership transfer with invalid new owner")
+
+	state = goodState()
+	state.PendingOwnershipTransfers = []PendingOwnershipTransfer{goodTransfer()}
+	state.PendingOwnershipTransfers[0].Expiration = time.Time{}
+	err = state.Validate()
+	require.Error(t, err, "should not accept pending ownership transfer without expiration")
+
+	goodCommitment := func() IscnCommitment {
+		return IscnCommitment{
+			Commitment:   make([]byte, CommitmentLength),
+			Committer:    "cosmos1r623mw6k77g6s3t67fy3042u9nshdl49fgvtex",
+			CommitTime:   time.Unix(1234567890, 0),
+			CommitHeight: 1,
+			Expiration:   time.Unix(1234567890, 0).Add(24 * time.Hour),
+		}
+	}
+
+	state = goodState()
+	state.Commitments = []IscnCommitment{goodCommitment()}
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.Commitments = []IscnCommitment{goodCommitment()}
+	state.Commitments[0].Expiration = time.Time{}
+	state.Commitments[0].RevealedIscnId = "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/1"
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.Commitments = []IscnCommitment{goodCommitment(), goodCommitment()}
+	err = state.Validate()
+	require.Error(t, err, "should not accept duplicated commitments")
+
+	state = goodState()
+	state.Commitments = []IscnCommitment{goodCommitment()}
+	state.Commitments[0].Commitment = state.Commitments[0].Commitment[1:]
+	err = state.Validate()
+	require.Error(t, err, "should not accept commitment with invalid length")
+
+	state = goodState()
+	state.Commitments = []IscnCommitment{goodCommitment()}
+	state.Commitments[0].Expiration = time.Unix(1234567890, 0)
+	err = state.Validate()
+	require.Error(t, err, "should not accept unrevealed commitment without valid expiration")
+
+	state = goodState()
+	state.Commitments = []IscnCommitment{goodCommitment()}
+	state.Commitments[0].RevealedIscnId = "iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU/1"
+	err = state.Validate()
+	require.Error(t, err, "should not accept commitment revealed as non-existing record")
+
+	attestedState := func() *GenesisState {
+		state := goodState()
+		state.IscnRecords[0] = IscnInput(`{"@id":"iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/1","contentFingerprints":["hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e"],"stakeholders":[{"entity":{"@id":"did:cosmos:cosmos172nhdqasd2t9e8vvqw4cxfnnutt98q7elzluk9"}}]}`)
+		return state
+	}
+	goodAttestation := func() IscnAttestation {
+		return IscnAttestation{
+			IscnId:       "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/1",
+			Attester:     "cosmos172nhdqasd2t9e8vvqw4cxfnnutt98q7elzluk9",
+			AttestTime:   time.Unix(1234567890, 0),
+			AttestHeight: 1,
+		}
+	}
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation()}
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation(), goodAttestation()}
+	err = state.Validate()
+	require.Error(t, err, "should not accept duplicated attestations")
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation()}
+	state.Attestations[0].Attester = "cosmos17dj36xsnaszfwpmv92ct6hfkc2m88nqyls2pvd"
+	err = state.Validate()
+	require.Error(t, err, "should not accept attestation from address not named as stakeholder")
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation()}
+	state.Attestations[0].IscnId = "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/2"
+	err = state.Validate()
+	require.Error(t, err, "should not accept attestation on record version not naming the attester")
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation()}
+	state.Attestations[0].IscnId = "iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU/1"
+	err = state.Validate()
+	require.Error(t, err, "should not accept attestation on non-existing record")
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation()}
+	state.Attestations[0].IscnId = "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU"
+	err = state.Validate()
+	require.Error(t, err, "should not accept attestation on ISCN ID without version")
+
+	state = attestedState()
+	state.Attestations = []IscnAttestation{goodAttestation()}
+	state.Attestations[0].AttestHeight = 0
+	err = state.Validate()
+	require.Error(t, err, "should not accept attestation without attest height")
+
+	goodHistory := func() []IscnOwnershipHistoryEntry {
+		return []IscnOwnershipHistoryEntry{
+			{
+				IscnIdPrefix: "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU",
+				Owner:        "cosmos172nhdqasd2t9e8vvqw4cxfnnutt98q7elzluk9",
+				Height:       1,
+				Time:         time.Unix(1234567890, 0),
+				MsgTypeUrl:   "/likechain.iscn.MsgCreateIscnRecord",
+			},
+			{
+				IscnIdPrefix: "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU",
+				Owner:        "cosmos1r623mw6k77g6s3t67fy3042u9nshdl49fgvtex",
+				Height:       2,
+				Time:         time.Unix(1234567900, 0),
+				MsgTypeUrl:   "/likechain.iscn.MsgChangeIscnRecordOwnership",
+			},
+		}
+	}
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()[:1]
+	err = state.Validate()
+	require.Error(t, err, "should not accept ownership history not ending with the current owner")
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()
+	state.OwnershipHistory[0].Height = 3
+	err = state.Validate()
+	require.Error(t, err, "should not accept ownership history entries not in height order")
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()
+	state.OwnershipHistory[0].IscnIdPrefix = "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/1"
+	err = state.Validate()
+	require.Error(t, err, "should not accept ownership history entry with ISCN ID version")
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()
+	state.OwnershipHistory[0].IscnIdPrefix = "iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU"
+	err = state.Validate()
+	require.Error(t, err, "should not accept ownership history entry for non-existing record")
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()
+	state.OwnershipHistory[0].Owner = "invalid_address"
+	err = state.Validate()
+	require.Error(t, err, "should not accept ownership history entry with invalid owner")
+
+	state = goodState()
+	state.OwnershipHistory = goodHistory()
+	state.OwnershipHistory[0].Time = time.Time{}
+	err = state.Validate()
+	require.Error(t, err, "should not accept ownership history entry without time")
+
+	state = goodState()
+	state.IscnRecords[2] = IscnInput(`{"@id":"iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/2","contentFingerprints":[],"relations":[{"type":"partOf","target":"iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM"}]}`)
+	err = state.Validate()
+	require.NoError(t, err)
+
+	state = goodState()
+	state.IscnRecords[2] = IscnInput(`{"@id":"iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/2","contentFingerprints":[],"relations":[{"type":"partOf","target":"iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU"}]}`)
+	err = state.Validate()
+	require.Error(t, err, "should not accept relation to non-existing record")
+
 	// iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/1
 	// iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM/1
 	// iscn://likecoin-chain/Mgd7LH0aAAwyEUYW_rU9EKp9J5cb0598PlHSzN4cQiU/
@@ -2,6 +2,7 @@ package types
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -240,3 +241,312 @@ func TestMsgCreateIscnRecord_GenerateNewIscnIdWithSeed(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgSupersedeIscnRecord_ValidateBasic(t *testing.T) {
+	SetAddressPrefixes()
+	tests := []struct {
+		name string
+		msg  MsgSupersedeIscnRecord
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgSupersedeIscnRecord{
+				From:         "invalid_address",
+				IscnId:       iscnIdNoNonce.String(),
+				SupersededBy: iscnIdNonce1.Prefix.String(),
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid ISCN ID",
+			msg: MsgSupersedeIscnRecord{
+				From:         addr1,
+				IscnId:       "iscn://",
+				SupersededBy: iscnIdNonce1.Prefix.String(),
+			},
+			err: ErrInvalidIscnId,
+		}, {
+			name: "invalid superseding ISCN ID",
+			msg: MsgSupersedeIscnRecord{
+				From:         addr1,
+				IscnId:       iscnIdNoNonce.String(),
+				SupersededBy: "",
+			},
+			err: ErrInvalidIscnId,
+		}, {
+			name: "superseded by itself",
+			msg: MsgSupersedeIscnRecord{
+				From:         addr1,
+				IscnId:       iscnIdNoNonce.String(),
+				SupersededBy: iscnIdNoNonce.Prefix.String(),
+			},
+			err: ErrInvalidIscnId,
+		}, {
+			name: "valid",
+			msg: MsgSupersedeIscnRecord{
+				From:         addr1,
+				IscnId:       iscnIdNoNonce.String(),
+				SupersededBy: iscnIdNonce1.Prefix.String(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgProposeIscnOwnershipTransfer_ValidateBasic(t *testing.T) {
+	SetAddressPrefixes()
+	addr2 := "like1y5ev5hcx72vf8l98v6hs6ddsm2ln4hjx4pd57q"
+	expiration := time.Unix(1234567890, 0)
+	tests := []struct {
+		name string
+		msg  MsgProposeIscnOwnershipTransfer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgProposeIscnOwnershipTransfer{
+				From:       "invalid_address",
+				IscnId:     iscnIdNoNonce.String(),
+				NewOwner:   addr2,
+				Expiration: expiration,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid new owner address",
+			msg: MsgProposeIscnOwnershipTransfer{
+				From:       addr1,
+				IscnId:     iscnIdNoNonce.String(),
+				NewOwner:   "invalid_address",
+				Expiration: expiration,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "transfer to self",
+			msg: MsgProposeIscnOwnershipTransfer{
+				From:       addr1,
+				IscnId:     iscnIdNoNonce.String(),
+				NewOwner:   addr1,
+				Expiration: expiration,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "invalid ISCN ID",
+			msg: MsgProposeIscnOwnershipTransfer{
+				From:       addr1,
+				IscnId:     "iscn://",
+				NewOwner:   addr2,
+				Expiration: expiration,
+			},
+			err: ErrInvalidIscnId,
+		}, {
+			name: "no expiration",
+			msg: MsgProposeIscnOwnershipTransfer{
+				From:     addr1,
+				IscnId:   iscnIdNoNonce.String(),
+				NewOwner: addr2,
+			},
+			err: ErrInvalidOwnershipTransfer,
+		}, {
+			name: "valid",
+			msg: MsgProposeIscnOwnershipTransfer{
+				From:       addr1,
+				IscnId:     iscnIdNoNonce.String(),
+				NewOwner:   addr2,
+				Expiration: expiration,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgCommitIscn_ValidateBasic(t *testing.T) {
+	SetAddressPrefixes()
+	commitment := make([]byte, CommitmentLength)
+	tests := []struct {
+		name string
+		msg  MsgCommitIscn
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCommitIscn{
+				From:       "invalid_address",
+				Commitment: commitment,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty commitment",
+			msg: MsgCommitIscn{
+				From: addr1,
+			},
+			err: ErrInvalidCommitment,
+		}, {
+			name: "commitment too short",
+			msg: MsgCommitIscn{
+				From:       addr1,
+				Commitment: commitment[:CommitmentLength-1],
+			},
+			err: ErrInvalidCommitment,
+		}, {
+			name: "valid",
+			msg: MsgCommitIscn{
+				From:       addr1,
+				Commitment: commitment,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevealIscn_ValidateBasic(t *testing.T) {
+	SetAddressPrefixes()
+	salt := []byte("salt")
+	tests := []struct {
+		name string
+		msg  MsgRevealIscn
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgRevealIscn{
+				From:   "invalid_address",
+				Record: createIscnRecord1,
+				Salt:   salt,
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "empty salt",
+			msg: MsgRevealIscn{
+				From:   addr1,
+				Record: createIscnRecord1,
+			},
+			err: ErrInvalidCommitment,
+		}, {
+			name: "invalid record",
+			msg: MsgRevealIscn{
+				From: addr1,
+				Salt: salt,
+			},
+			err: ErrInvalidIscnRecord,
+		}, {
+			name: "valid",
+			msg: MsgRevealIscn{
+				From:   addr1,
+				Record: createIscnRecord1,
+				Salt:   salt,
+				Nonce:  1,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgAttestIscnRecord_ValidateBasic(t *testing.T) {
+	SetAddressPrefixes()
+	tests := []struct {
+		name   string
+		from   string
+		iscnId string
+		err    error
+	}{
+		{
+			name:   "invalid address",
+			from:   "invalid_address",
+			iscnId: iscnIdNoNonce.String(),
+			err:    sdkerrors.ErrInvalidAddress,
+		}, {
+			name:   "invalid ISCN ID",
+			from:   addr1,
+			iscnId: "iscn://likecoin-chain",
+			err:    ErrInvalidIscnId,
+		}, {
+			name:   "ISCN ID without version",
+			from:   addr1,
+			iscnId: iscnIdNoNonce.PrefixId().String(),
+			err:    ErrInvalidIscnVersion,
+		}, {
+			name:   "valid",
+			from:   addr1,
+			iscnId: iscnIdNoNonce.String(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msgs := []sdk.Msg{
+				&MsgAttestIscnRecord{From: tt.from, IscnId: tt.iscnId},
+				&MsgRevokeIscnRecordAttestation{From: tt.from, IscnId: tt.iscnId},
+			}
+			for _, msg := range msgs {
+				err := msg.ValidateBasic()
+				if tt.err != nil {
+					require.ErrorIs(t, err, tt.err)
+				} else {
+					require.NoError(t, err)
+				}
+			}
+		})
+	}
+}
+
+func TestComputeIscnCommitment(t *testing.T) {
+	SetAddressPrefixes()
+	sender := sdk.MustAccAddressFromBech32(addr1)
+	record := createIscnRecord1
+	commitment, err := ComputeIscnCommitment([]byte("salt"), sender, &record)
+	require.NoError(t, err)
+	require.Len(t, commitment, CommitmentLength)
+	require.NoError(t, ValidateCommitment(commitment))
+
+	again, err := ComputeIscnCommitment([]byte("salt"), sender, &record)
+	require.NoError(t, err)
+	require.Equal(t, commitment, again)
+
+	other, err := ComputeIscnCommitment([]byte("another salt"), sender, &record)
+	require.NoError(t, err)
+	require.NotEqual(t, commitment, other)
+
+	other, err = ComputeIscnCommitment([]byte("salt"), sdk.AccAddress(make([]byte, 20)), &record)
+	require.NoError(t, err)
+	require.NotEqual(t, commitment, other)
+
+	record.RecordNotes = "modified"
+	other, err = ComputeIscnCommitment([]byte("salt"), sender, &record)
+	require.NoError(t, err)
+	require.NotEqual(t, commitment, other)
+}
@@ -1,13 +1,36 @@
 package types
 
 import (
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"time"
 )
 
+const RecordTimestampFormat = "2006-01-02T15:04:05-07:00"
+
+// ValidateFingerprints checks that each fingerprint is valid according to its scheme, and that no two fingerprints
+// have the same canonical form.
 func ValidateFingerprints(fingerprints []string) error {
+	usedFingerprint := map[string]struct{}{}
+	for _, fingerprint := range fingerprints {
+		canonical, err := CanonicalizeFingerprint(fingerprint)
+		if err != nil {
+			return err
+		}
+		_, ok := usedFingerprint[canonical]
+		if ok {
+			return fmt.Errorf("repeated fingerprint entry")
+		}
+		usedFingerprint[canonical] = struct{}{}
+	}
+	return nil
+}
+
+// validateLegacyFingerprints only checks that the fingerprints are non-repeating URLs, which is the validation applied
+// to records before scheme-aware validation was introduced.
+func validateLegacyFingerprints(fingerprints []string) error {
 	usedFingerprint := map[string]struct{}{}
 	for _, fingerprint := range fingerprints {
 		_, ok := usedFingerprint[fingerprint]
@@ -41,6 +64,10 @@ func (record *IscnRecord) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = ValidateRelations(record.Relations)
+	if err != nil {
+		return fmt.Errorf("invalid relations: %w", err)
+	}
 	return nil
 }
 
@@ -48,6 +75,8 @@ type IscnRecordJsonLdInfo struct {
 	Id         IscnId
 	Timestamp  time.Time
 	ParentIpld *CID
+	// set when the record is revealed from a commitment
+	Commitment *IscnCommitment
 }
 
 func (record *IscnRecord) ToJsonLd(info *IscnRecordJsonLdInfo) ([]byte, error) {
@@ -90,17 +119,49 @@ func (record *IscnRecord) ToJsonLd(info *IscnRecordJsonLdInfo) ([]byte, error) {
 		},
 		"@type":               "Record",
 		"@id":                 info.Id.String(),
-		"recordTimestamp":     info.Timestamp.UTC().Format("2006-01-02T15:04:05-07:00"),
+		"recordTimestamp":     info.Timestamp.UTC().Format(RecordTimestampFormat),
 		"recordVersion":       info.Id.Version,
 		"recordNotes":         record.RecordNotes,
 		"contentFingerprints": fingerprints,
 		"stakeholders":        stakeholders,
 		"contentMetadata":     normalizedContentMetadata,
 	}
+	if len(record.Relations) > 0 {
+		// only set when non-empty, so records without relations have the same JSON-LD as before
+		relations := []map[string]string{}
+		for _, relation := range record.Relations {
+			target, err := relation.ParseTarget()
+			if err != nil {
+				return nil, err
+			}
+			relations = append(relations, map[string]string{
+				"type":   relation.Type,
+				"target": target.String(),
+			})
+		}
+		recordMap["relations"] = relations
+	}
 	if info.ParentIpld != nil {
 		recordMap["recordParentIPLD"] = map[string]string{
 			"/": info.ParentIpld.String(),
 		}
 	}
+	if info.Commitment != nil {
+		recordMap["recordCommitment"] = hex.EncodeToString(info.Commitment.Commitment)
+		recordMap["recordCommitTimestamp"] = info.Commitment.CommitTime.UTC().Format(RecordTimestampFormat)
+		recordMap["recordCommitHeight"] = info.Commitment.CommitHeight
+	}
 	return json.Marshal(recordMap)
 }
+
+// GetRecordTimestamp parses the `recordTimestamp` field in the ISCN record JSON-LD
+func GetRecordTimestamp(record []byte) (time.Time, error) {
+	recordMap := struct {
+		RecordTimestamp string `json:"recordTimestamp"`
+	}{}
+	err := json.Unmarshal(record, &recordMap)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Parse(RecordTimestampFormat, recordMap.RecordTimestamp)
+}
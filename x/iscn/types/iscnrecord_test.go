@@ -41,6 +41,18 @@ func TestIscnRecordValidateFingerprints(t *testing.T) {
 	fingerprints = []string{"9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e"}
 	err = ValidateFingerprints(fingerprints)
 	require.Error(t, err, "should not accept non-URI fingerprint")
+
+	fingerprints = []string{goodFingerprint1, "hash://sha256/9564B85669D5E96AC969DD0161B8475BBCED9E5999C6EC598DA718A3045D6F2E"}
+	err = ValidateFingerprints(fingerprints)
+	require.Error(t, err, "should not accept fingerprints with the same canonical form")
+
+	fingerprints = []string{goodFingerprint2, "ipfs://bafybeiahwesqupoyvqxxuiuo2hf4hhevr2fmj2s7zh3gifue2242t2weg4"}
+	err = ValidateFingerprints(fingerprints)
+	require.Error(t, err, "should not accept CIDv0 and CIDv1 of the same content")
+
+	fingerprints = []string{"ipfs://not-a-cid"}
+	err = ValidateFingerprints(fingerprints)
+	require.Error(t, err, "should not accept invalid IPFS fingerprint")
 }
 func TestIscnRecordValidate(t *testing.T) {
 	goodIscnInput := IscnInput(`""`)
@@ -103,6 +115,52 @@ func TestIscnRecordValidate(t *testing.T) {
 	record.ContentFingerprints[0] = badFingerprint
 	err = record.Validate()
 	require.Error(t, err, "should not accept record with invalid fingerprints")
+
+	goodRelation := func() *IscnRelation {
+		return &IscnRelation{
+			Type:   RelationTypeCitation,
+			Target: "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU",
+		}
+	}
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation()}
+	err = record.Validate()
+	require.NoError(t, err)
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation()}
+	record.Relations[0].Type = "cites"
+	err = record.Validate()
+	require.Error(t, err, "should not accept relation with unknown type")
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation()}
+	record.Relations[0].Target = "iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/1"
+	err = record.Validate()
+	require.Error(t, err, "should not accept relation target with version")
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation()}
+	record.Relations[0].Target = "btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU"
+	err = record.Validate()
+	require.Error(t, err, "should not accept relation with invalid target")
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation(), goodRelation()}
+	err = record.Validate()
+	require.Error(t, err, "should not accept repeated relations")
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation(), nil}
+	err = record.Validate()
+	require.Error(t, err, "should not accept empty relation")
+
+	record = goodRecord()
+	record.Relations = []*IscnRelation{goodRelation(), goodRelation()}
+	record.Relations[1].Type = RelationTypeDerivedFrom
+	err = record.Validate()
+	require.NoError(t, err, "should accept relations with the same target but different types")
 }
 
 func TestIscnRecordToJsonLd(t *testing.T) {
@@ -162,6 +220,14 @@ func TestIscnRecordToJsonLd(t *testing.T) {
 	expected = []byte(`{"@context":{"@vocab":"http://iscn.io/","contentMetadata":{"@context":null},"recordParentIPLD":{"@container":"@index"},"stakeholders":{"@context":{"@vocab":"http://schema.org/","contributionType":"http://iscn.io/contributionType","entity":"http://iscn.io/entity","footprint":"http://iscn.io/footprint","rewardProportion":"http://iscn.io/rewardProportion"}}},"@id":"iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/2","@type":"Record","contentFingerprints":["hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e"],"contentMetadata":{"description":"a Cosmos SDK module","title":"iscn module"},"recordNotes":"testing","recordParentIPLD":{"/":"bahuaierav3bfvm4ytx7gvn4yqeu4piiocuvtvdpyyb5f6moxniwemae4tjyq"},"recordTimestamp":"2009-02-13T23:31:30+00:00","recordVersion":2,"stakeholders":[]}`)
 	require.Equal(t, expected, bz)
 
+	record = goodRecord()
+	record.Relations = []*IscnRelation{{Type: RelationTypePartOf, Target: "iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM"}}
+	bz, err = record.ToJsonLd(&jsonLdInfo)
+	require.NoError(t, err, "should be able to convert record with relations to JSON LD")
+	expected = []byte(`{"@context":{"@vocab":"http://iscn.io/","contentMetadata":{"@context":null},"recordParentIPLD":{"@container":"@index"},"stakeholders":{"@context":{"@vocab":"http://schema.org/","contributionType":"http://iscn.io/contributionType","entity":"http://iscn.io/entity","footprint":"http://iscn.io/footprint","rewardProportion":"http://iscn.io/rewardProportion"}}},"@id":"iscn://likecoin-chain/btC7CJvMm4WLj9Tau9LAPTfGK7sfymTJW7ORcFdruCU/2","@type":"Record","contentFingerprints":["hash://sha256/9564b85669d5e96ac969dd0161b8475bbced9e5999c6ec598da718a3045d6f2e"],"contentMetadata":{"description":"a Cosmos SDK module","title":"iscn module"},"recordNotes":"testing","recordParentIPLD":{"/":"bahuaierav3bfvm4ytx7gvn4yqeu4piiocuvtvdpyyb5f6moxniwemae4tjyq"},"recordTimestamp":"2009-02-13T23:31:30+00:00","recordVersion":2,"relations":[{"target":"iscn://likecoin-chain/pZWQk7vER3nkA8wCF4E4sJ9AOi3O-p-6kXxl2JkWviM","type":"partOf"}],"stakeholders":[{"description":"developer","name":"chung"}]}`)
+	require.Equal(t, expected, bz)
+	require.Equal(t, []IscnRelation{*record.Relations[0]}, GetRecordRelations(bz))
+
 	record = goodRecord()
 	record.ContentFingerprints = nil
 	bz, err = record.ToJsonLd(&jsonLdInfo)
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/binary"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -21,6 +22,28 @@ var (
 	ContentIdRecordPrefix       = []byte{0x05}
 	FingerprintSequencePrefix   = []byte{0x06}
 	OwnerSequencePrefix         = []byte{0x07}
+	StakeholderSequencePrefix   = []byte{0x08}
+	TimeSequencePrefix          = []byte{0x09}
+	HeightSequencePrefix        = []byte{0x0a}
+
+	PendingOwnershipTransferPrefix         = []byte{0x0b}
+	PendingOwnershipTransferExpiryPrefix   = []byte{0x0c}
+	PendingOwnershipTransferNewOwnerPrefix = []byte{0x0d}
+
+	CollectedFeesKey = []byte{0x0e}
+
+	CommitmentPrefix       = []byte{0x0f}
+	CommitmentExpiryPrefix = []byte{0x10}
+
+	AttestationPrefix         = []byte{0x11}
+	AttesterAttestationPrefix = []byte{0x12}
+
+	OwnershipHistoryPrefix = []byte{0x13}
+
+	OutgoingRelationPrefix = []byte{0x14}
+	IncomingRelationPrefix = []byte{0x15}
+
+	IndexedSequenceCountKey = []byte{0x16}
 )
 
 // one fingerprint points to many sequence
@@ -88,3 +111,247 @@ func ParseOwnerSequenceBytes(key []byte) (owner sdk.AccAddress, seq uint64) {
 	seq = binary.BigEndian.Uint64(key[4+addrLen:])
 	return owner, seq
 }
+
+// one stakeholder entity ID points to many sequence
+// key structure:
+//  - 4 bytes stakeholder ID length
+//  - idLen bytes stakeholder ID
+//  - 8 bytes sequence
+func GetStakeholderStorePrefix(stakeholderId string) []byte {
+	idBytes := []byte(stakeholderId)
+	idLen := len(idBytes)
+	output := make([]byte, len(StakeholderSequencePrefix)+4+idLen)
+	copy(output, StakeholderSequencePrefix)
+	binary.BigEndian.PutUint32(output[len(StakeholderSequencePrefix):], uint32(idLen))
+	copy(output[len(StakeholderSequencePrefix)+4:], idBytes)
+	return output
+}
+
+func GetStakeholderSequenceKey(stakeholderId string, seq uint64) []byte {
+	idBytes := []byte(stakeholderId)
+	idLen := len(idBytes)
+	output := make([]byte, 4+idLen+8)
+	binary.BigEndian.PutUint32(output, uint32(idLen))
+	copy(output[4:], idBytes)
+	binary.BigEndian.PutUint64(output[4+idLen:], seq)
+	return output
+}
+
+func ParseStakeholderSequenceBytes(key []byte) (stakeholderId string, seq uint64) {
+	idLen := binary.BigEndian.Uint32(key[:4])
+	idBytes := key[4 : 4+idLen]
+	stakeholderId = string(idBytes)
+	seq = binary.BigEndian.Uint64(key[4+idLen:])
+	return stakeholderId, seq
+}
+
+// time points to the first sequence registered at that time, in seconds
+// key structure:
+//  - sdk.FormatTimeBytes of the time
+func GetTimeSequenceKey(t time.Time) []byte {
+	return sdk.FormatTimeBytes(t.UTC().Truncate(time.Second))
+}
+
+// block height points to the first sequence registered in that block
+// key structure:
+//  - 8 bytes block height
+func GetHeightSequenceKey(height uint64) []byte {
+	return EncodeUint64(height)
+}
+
+// pending ownership transfers are queued by expiration time
+// key structure:
+//  - sdk.FormatTimeBytes of the expiration time
+//  - marshaled ISCN ID prefix
+func GetPendingOwnershipTransferExpiryKey(expiration time.Time, iscnIdPrefixBytes []byte) []byte {
+	timeBytes := sdk.FormatTimeBytes(expiration)
+	output := make([]byte, 0, len(timeBytes)+len(iscnIdPrefixBytes))
+	output = append(output, timeBytes...)
+	output = append(output, iscnIdPrefixBytes...)
+	return output
+}
+
+func ParsePendingOwnershipTransferExpiryKey(key []byte) (iscnIdPrefixBytes []byte) {
+	timeBytesLen := len(sdk.FormatTimeBytes(time.Time{}))
+	return key[timeBytesLen:]
+}
+
+// one new owner points to many pending ownership transfers
+// key structure:
+//  - 4 bytes new owner address bytes length
+//  - addrLen bytes new owner address
+//  - marshaled ISCN ID prefix
+func GetPendingOwnershipTransferNewOwnerStorePrefix(newOwner sdk.AccAddress) []byte {
+	addrBytes := []byte(newOwner)
+	addrLen := len(addrBytes)
+	output := make([]byte, len(PendingOwnershipTransferNewOwnerPrefix)+4+addrLen)
+	copy(output, PendingOwnershipTransferNewOwnerPrefix)
+	binary.BigEndian.PutUint32(output[len(PendingOwnershipTransferNewOwnerPrefix):], uint32(addrLen))
+	copy(output[len(PendingOwnershipTransferNewOwnerPrefix)+4:], addrBytes)
+	return output
+}
+
+func GetPendingOwnershipTransferNewOwnerKey(newOwner sdk.AccAddress, iscnIdPrefixBytes []byte) []byte {
+	addrBytes := []byte(newOwner)
+	addrLen := len(addrBytes)
+	output := make([]byte, 4+addrLen+len(iscnIdPrefixBytes))
+	binary.BigEndian.PutUint32(output, uint32(addrLen))
+	copy(output[4:], addrBytes)
+	copy(output[4+addrLen:], iscnIdPrefixBytes)
+	return output
+}
+
+// unrevealed commitments are queued by expiration time
+// key structure:
+//  - sdk.FormatTimeBytes of the expiration time
+//  - commitment hash
+func GetCommitmentExpiryKey(expiration time.Time, commitment []byte) []byte {
+	timeBytes := sdk.FormatTimeBytes(expiration)
+	output := make([]byte, 0, len(timeBytes)+len(commitment))
+	output = append(output, timeBytes...)
+	output = append(output, commitment...)
+	return output
+}
+
+func ParseCommitmentExpiryKey(key []byte) (commitment []byte) {
+	timeBytesLen := len(sdk.FormatTimeBytes(time.Time{}))
+	return key[timeBytesLen:]
+}
+
+// attestations are stored under the sequence of the attested record version
+// key structure:
+//  - 8 bytes sequence
+//  - attester address bytes
+func GetAttestationStorePrefix(seq uint64) []byte {
+	output := make([]byte, 0, len(AttestationPrefix)+8)
+	output = append(output, AttestationPrefix...)
+	output = append(output, EncodeUint64(seq)...)
+	return output
+}
+
+func GetAttestationKey(seq uint64, attester sdk.AccAddress) []byte {
+	output := make([]byte, 0, 8+len(attester))
+	output = append(output, EncodeUint64(seq)...)
+	output = append(output, attester...)
+	return output
+}
+
+// one attester points to many sequence
+// key structure:
+//  - 4 bytes attester address bytes length
+//  - addrLen bytes attester address
+//  - 8 bytes sequence
+func GetAttesterAttestationStorePrefix(attester sdk.AccAddress) []byte {
+	addrBytes := []byte(attester)
+	addrLen := len(addrBytes)
+	output := make([]byte, len(AttesterAttestationPrefix)+4+addrLen)
+	copy(output, AttesterAttestationPrefix)
+	binary.BigEndian.PutUint32(output[len(AttesterAttestationPrefix):], uint32(addrLen))
+	copy(output[len(AttesterAttestationPrefix)+4:], addrBytes)
+	return output
+}
+
+func GetAttesterAttestationKey(attester sdk.AccAddress, seq uint64) []byte {
+	addrBytes := []byte(attester)
+	addrLen := len(addrBytes)
+	output := make([]byte, 4+addrLen+8)
+	binary.BigEndian.PutUint32(output, uint32(addrLen))
+	copy(output[4:], addrBytes)
+	binary.BigEndian.PutUint64(output[4+addrLen:], seq)
+	return output
+}
+
+// one ISCN ID prefix points to many ownership history entries
+// key structure:
+//  - 4 bytes marshaled ISCN ID prefix length
+//  - prefixLen bytes marshaled ISCN ID prefix
+//  - 8 bytes entry index
+func GetOwnershipHistoryStorePrefix(iscnIdPrefixBytes []byte) []byte {
+	prefixLen := len(iscnIdPrefixBytes)
+	output := make([]byte, len(OwnershipHistoryPrefix)+4+prefixLen)
+	copy(output, OwnershipHistoryPrefix)
+	binary.BigEndian.PutUint32(output[len(OwnershipHistoryPrefix):], uint32(prefixLen))
+	copy(output[len(OwnershipHistoryPrefix)+4:], iscnIdPrefixBytes)
+	return output
+}
+
+// ParseOwnershipHistoryKey parses the key under the OwnershipHistoryPrefix store
+func ParseOwnershipHistoryKey(key []byte) (iscnIdPrefixBytes []byte) {
+	prefixLen := binary.BigEndian.Uint32(key)
+	return key[4 : 4+prefixLen]
+}
+
+func appendLengthPrefixed(output []byte, bz []byte) []byte {
+	output = binary.BigEndian.AppendUint32(output, uint32(len(bz)))
+	return append(output, bz...)
+}
+
+func parseLengthPrefixed(key []byte) (bz []byte, rest []byte) {
+	bzLen := binary.BigEndian.Uint32(key[:4])
+	return key[4 : 4+bzLen], key[4+bzLen:]
+}
+
+// one record version points to many related ISCN ID prefixes
+// key structure:
+//  - 8 bytes source sequence
+//  - 4 bytes relation type length
+//  - typeLen bytes relation type
+//  - marshaled target ISCN ID prefix
+// if relationType is empty, the returned prefix covers all relation types
+func GetOutgoingRelationStorePrefix(seq uint64, relationType string) []byte {
+	output := make([]byte, 0, len(OutgoingRelationPrefix)+8+4+len(relationType))
+	output = append(output, OutgoingRelationPrefix...)
+	output = append(output, EncodeUint64(seq)...)
+	if len(relationType) > 0 {
+		output = appendLengthPrefixed(output, []byte(relationType))
+	}
+	return output
+}
+
+func GetOutgoingRelationKey(seq uint64, relationType string, targetPrefixBytes []byte) []byte {
+	output := make([]byte, 0, 8+4+len(relationType)+len(targetPrefixBytes))
+	output = append(output, EncodeUint64(seq)...)
+	output = appendLengthPrefixed(output, []byte(relationType))
+	output = append(output, targetPrefixBytes...)
+	return output
+}
+
+// ParseOutgoingRelationTypeKey parses the key under the store prefix from GetOutgoingRelationStorePrefix with empty
+// relation type
+func ParseOutgoingRelationTypeKey(key []byte) (relationType string, targetPrefixBytes []byte) {
+	relationTypeBytes, targetPrefixBytes := parseLengthPrefixed(key)
+	return string(relationTypeBytes), targetPrefixBytes
+}
+
+// one ISCN ID prefix is pointed to by many record versions
+// key structure:
+//  - 4 bytes marshaled target ISCN ID prefix length
+//  - prefixLen bytes marshaled target ISCN ID prefix
+//  - 4 bytes relation type length
+//  - typeLen bytes relation type
+//  - 8 bytes source sequence
+// if relationType is empty, the returned prefix covers all relation types
+func GetIncomingRelationStorePrefix(targetPrefixBytes []byte, relationType string) []byte {
+	output := make([]byte, 0, len(IncomingRelationPrefix)+4+len(targetPrefixBytes)+4+len(relationType))
+	output = append(output, IncomingRelationPrefix...)
+	output = appendLengthPrefixed(output, targetPrefixBytes)
+	if len(relationType) > 0 {
+		output = appendLengthPrefixed(output, []byte(relationType))
+	}
+	return output
+}
+
+func GetIncomingRelationKey(targetPrefixBytes []byte, relationType string, seq uint64) []byte {
+	output := make([]byte, 0, 4+len(targetPrefixBytes)+4+len(relationType)+8)
+	output = appendLengthPrefixed(output, targetPrefixBytes)
+	output = appendLengthPrefixed(output, []byte(relationType))
+	output = append(output, EncodeUint64(seq)...)
+	return output
+}
+
+// ParseIncomingRelationTypeKey parses the key under the store prefix from GetIncomingRelationStorePrefix with empty
+// relation type
+func ParseIncomingRelationTypeKey(key []byte) (relationType string, seq uint64) {
+	relationTypeBytes, seqBytes := parseLengthPrefixed(key)
+	return string(relationTypeBytes), binary.BigEndian.Uint64(seqBytes)
+}
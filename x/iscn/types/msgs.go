@@ -1,6 +1,8 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -9,11 +11,37 @@ const (
 	TypeMsgCreateIscnRecord          = "create_iscn_record"
 	TypeMsgUpdateIscnRecord          = "update_iscn_record"
 	TypeMsgChangeIscnRecordOwnership = "msg_change_iscn_record_ownership"
+	TypeMsgFreezeIscnRecord          = "freeze_iscn_record"
+	TypeMsgDeprecateIscnRecord       = "deprecate_iscn_record"
+	TypeMsgSupersedeIscnRecord       = "supersede_iscn_record"
+	TypeMsgBatchCreateIscnRecords    = "batch_create_iscn_records"
+
+	TypeMsgProposeIscnOwnershipTransfer = "propose_iscn_ownership_transfer"
+	TypeMsgAcceptIscnOwnershipTransfer  = "accept_iscn_ownership_transfer"
+	TypeMsgCancelIscnOwnershipTransfer  = "cancel_iscn_ownership_transfer"
+	TypeMsgCommitIscn                   = "commit_iscn"
+	TypeMsgRevealIscn                   = "reveal_iscn"
+	TypeMsgAttestIscnRecord             = "attest_iscn_record"
+	TypeMsgRevokeIscnRecordAttestation  = "revoke_iscn_record_attestation"
+
+	// MaxOwnershipTransferDuration is the maximum time a proposed ownership transfer can wait for acceptance
+	MaxOwnershipTransferDuration = 180 * 24 * time.Hour
 )
 
 var _ sdk.Msg = &MsgCreateIscnRecord{}
 var _ sdk.Msg = &MsgUpdateIscnRecord{}
 var _ sdk.Msg = &MsgChangeIscnRecordOwnership{}
+var _ sdk.Msg = &MsgFreezeIscnRecord{}
+var _ sdk.Msg = &MsgDeprecateIscnRecord{}
+var _ sdk.Msg = &MsgSupersedeIscnRecord{}
+var _ sdk.Msg = &MsgBatchCreateIscnRecords{}
+var _ sdk.Msg = &MsgProposeIscnOwnershipTransfer{}
+var _ sdk.Msg = &MsgAcceptIscnOwnershipTransfer{}
+var _ sdk.Msg = &MsgCancelIscnOwnershipTransfer{}
+var _ sdk.Msg = &MsgCommitIscn{}
+var _ sdk.Msg = &MsgRevealIscn{}
+var _ sdk.Msg = &MsgAttestIscnRecord{}
+var _ sdk.Msg = &MsgRevokeIscnRecordAttestation{}
 
 func NewMsgCreateIscnRecord(from sdk.AccAddress, record *IscnRecord, nonce uint64) *MsgCreateIscnRecord {
 	return &MsgCreateIscnRecord{
@@ -127,3 +155,408 @@ func (msg MsgChangeIscnRecordOwnership) ValidateBasic() error {
 	}
 	return nil
 }
+
+func NewMsgFreezeIscnRecord(from sdk.AccAddress, iscnId IscnId) *MsgFreezeIscnRecord {
+	return &MsgFreezeIscnRecord{
+		From:   from.String(),
+		IscnId: iscnId.String(),
+	}
+}
+
+func (m MsgFreezeIscnRecord) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgFreezeIscnRecord) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgFreezeIscnRecord) Route() string { return RouterKey }
+
+func (msg MsgFreezeIscnRecord) Type() string { return TypeMsgFreezeIscnRecord }
+
+func (msg MsgFreezeIscnRecord) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	_, err = ParseIscnId(msg.IscnId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	return nil
+}
+
+func NewMsgDeprecateIscnRecord(from sdk.AccAddress, iscnId IscnId) *MsgDeprecateIscnRecord {
+	return &MsgDeprecateIscnRecord{
+		From:   from.String(),
+		IscnId: iscnId.String(),
+	}
+}
+
+func (m MsgDeprecateIscnRecord) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgDeprecateIscnRecord) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgDeprecateIscnRecord) Route() string { return RouterKey }
+
+func (msg MsgDeprecateIscnRecord) Type() string { return TypeMsgDeprecateIscnRecord }
+
+func (msg MsgDeprecateIscnRecord) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	_, err = ParseIscnId(msg.IscnId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	return nil
+}
+
+func NewMsgSupersedeIscnRecord(from sdk.AccAddress, iscnId IscnId, supersededBy IscnIdPrefix) *MsgSupersedeIscnRecord {
+	return &MsgSupersedeIscnRecord{
+		From:         from.String(),
+		IscnId:       iscnId.String(),
+		SupersededBy: supersededBy.String(),
+	}
+}
+
+func (m MsgSupersedeIscnRecord) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgSupersedeIscnRecord) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgSupersedeIscnRecord) Route() string { return RouterKey }
+
+func (msg MsgSupersedeIscnRecord) Type() string { return TypeMsgSupersedeIscnRecord }
+
+func (msg MsgSupersedeIscnRecord) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	id, err := ParseIscnId(msg.IscnId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	supersededBy, err := ParseIscnId(msg.SupersededBy)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "invalid superseding ISCN ID: %s", err.Error())
+	}
+	if id.PrefixEqual(&supersededBy) {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "ISCN record cannot be superseded by itself")
+	}
+	return nil
+}
+
+func NewMsgBatchCreateIscnRecords(from sdk.AccAddress, records []IscnRecord, nonces []uint64) *MsgBatchCreateIscnRecords {
+	entries := make([]BatchCreateIscnRecordEntry, 0, len(records))
+	for i, record := range records {
+		entry := BatchCreateIscnRecordEntry{Record: record}
+		if i < len(nonces) {
+			entry.Nonce = nonces[i]
+		}
+		entries = append(entries, entry)
+	}
+	return &MsgBatchCreateIscnRecords{
+		From:    from.String(),
+		Entries: entries,
+	}
+}
+
+func (m MsgBatchCreateIscnRecords) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgBatchCreateIscnRecords) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgBatchCreateIscnRecords) Route() string { return RouterKey }
+
+func (msg MsgBatchCreateIscnRecords) Type() string { return TypeMsgBatchCreateIscnRecords }
+
+func (msg MsgBatchCreateIscnRecords) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	if len(msg.Entries) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidIscnRecord, "no records in batch")
+	}
+	for i, entry := range msg.Entries {
+		err = entry.Record.Validate()
+		if err != nil {
+			return sdkerrors.Wrapf(ErrInvalidIscnRecord, "entry %d: %s", i, err.Error())
+		}
+	}
+	return nil
+}
+
+func NewMsgProposeIscnOwnershipTransfer(from sdk.AccAddress, iscnId IscnId, newOwner sdk.AccAddress, expiration time.Time) *MsgProposeIscnOwnershipTransfer {
+	return &MsgProposeIscnOwnershipTransfer{
+		From:       from.String(),
+		IscnId:     iscnId.String(),
+		NewOwner:   newOwner.String(),
+		Expiration: expiration,
+	}
+}
+
+func (m MsgProposeIscnOwnershipTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgProposeIscnOwnershipTransfer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgProposeIscnOwnershipTransfer) Route() string { return RouterKey }
+
+func (msg MsgProposeIscnOwnershipTransfer) Type() string { return TypeMsgProposeIscnOwnershipTransfer }
+
+func (msg MsgProposeIscnOwnershipTransfer) ValidateBasic() error {
+	from, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	newOwner, err := sdk.AccAddressFromBech32(msg.NewOwner)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid new owner address: %s", err.Error())
+	}
+	if from.Equals(newOwner) {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "new owner is the same as sender")
+	}
+	_, err = ParseIscnId(msg.IscnId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	if msg.Expiration.IsZero() {
+		return sdkerrors.Wrapf(ErrInvalidOwnershipTransfer, "expiration not set")
+	}
+	return nil
+}
+
+func NewMsgAcceptIscnOwnershipTransfer(from sdk.AccAddress, iscnId IscnId) *MsgAcceptIscnOwnershipTransfer {
+	return &MsgAcceptIscnOwnershipTransfer{
+		From:   from.String(),
+		IscnId: iscnId.String(),
+	}
+}
+
+func (m MsgAcceptIscnOwnershipTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgAcceptIscnOwnershipTransfer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgAcceptIscnOwnershipTransfer) Route() string { return RouterKey }
+
+func (msg MsgAcceptIscnOwnershipTransfer) Type() string { return TypeMsgAcceptIscnOwnershipTransfer }
+
+func (msg MsgAcceptIscnOwnershipTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	_, err = ParseIscnId(msg.IscnId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	return nil
+}
+
+func NewMsgCancelIscnOwnershipTransfer(from sdk.AccAddress, iscnId IscnId) *MsgCancelIscnOwnershipTransfer {
+	return &MsgCancelIscnOwnershipTransfer{
+		From:   from.String(),
+		IscnId: iscnId.String(),
+	}
+}
+
+func (m MsgCancelIscnOwnershipTransfer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgCancelIscnOwnershipTransfer) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgCancelIscnOwnershipTransfer) Route() string { return RouterKey }
+
+func (msg MsgCancelIscnOwnershipTransfer) Type() string { return TypeMsgCancelIscnOwnershipTransfer }
+
+func (msg MsgCancelIscnOwnershipTransfer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	_, err = ParseIscnId(msg.IscnId)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	return nil
+}
+
+func NewMsgCommitIscn(from sdk.AccAddress, commitment []byte) *MsgCommitIscn {
+	return &MsgCommitIscn{
+		From:       from.String(),
+		Commitment: commitment,
+	}
+}
+
+func (m MsgCommitIscn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgCommitIscn) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgCommitIscn) Route() string { return RouterKey }
+
+func (msg MsgCommitIscn) Type() string { return TypeMsgCommitIscn }
+
+func (msg MsgCommitIscn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	err = ValidateCommitment(msg.Commitment)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "%s", err.Error())
+	}
+	return nil
+}
+
+func NewMsgRevealIscn(from sdk.AccAddress, record *IscnRecord, salt []byte, nonce uint64) *MsgRevealIscn {
+	return &MsgRevealIscn{
+		From:   from.String(),
+		Record: *record,
+		Salt:   salt,
+		Nonce:  nonce,
+	}
+}
+
+func (m MsgRevealIscn) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgRevealIscn) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgRevealIscn) Route() string { return RouterKey }
+
+func (msg MsgRevealIscn) Type() string { return TypeMsgRevealIscn }
+
+func (msg MsgRevealIscn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	if len(msg.Salt) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidCommitment, "empty salt")
+	}
+	err = msg.Record.Validate()
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnRecord, "%s", err.Error())
+	}
+	return nil
+}
+
+func NewMsgAttestIscnRecord(from sdk.AccAddress, iscnId IscnId) *MsgAttestIscnRecord {
+	return &MsgAttestIscnRecord{
+		From:   from.String(),
+		IscnId: iscnId.String(),
+	}
+}
+
+func (m MsgAttestIscnRecord) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgAttestIscnRecord) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgAttestIscnRecord) Route() string { return RouterKey }
+
+func (msg MsgAttestIscnRecord) Type() string { return TypeMsgAttestIscnRecord }
+
+func (msg MsgAttestIscnRecord) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	return validateAttestationIscnId(msg.IscnId)
+}
+
+func NewMsgRevokeIscnRecordAttestation(from sdk.AccAddress, iscnId IscnId) *MsgRevokeIscnRecordAttestation {
+	return &MsgRevokeIscnRecordAttestation{
+		From:   from.String(),
+		IscnId: iscnId.String(),
+	}
+}
+
+func (m MsgRevokeIscnRecordAttestation) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(&m)
+	return sdk.MustSortJSON(bz)
+}
+
+func (m MsgRevokeIscnRecordAttestation) GetSigners() []sdk.AccAddress {
+	from, _ := sdk.AccAddressFromBech32(m.From)
+	return []sdk.AccAddress{from}
+}
+
+func (msg MsgRevokeIscnRecordAttestation) Route() string { return RouterKey }
+
+func (msg MsgRevokeIscnRecordAttestation) Type() string { return TypeMsgRevokeIscnRecordAttestation }
+
+func (msg MsgRevokeIscnRecordAttestation) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.From)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err.Error())
+	}
+	return validateAttestationIscnId(msg.IscnId)
+}
+
+// validateAttestationIscnId checks the ISCN ID in attestation messages, which must specify the version
+func validateAttestationIscnId(iscnIdStr string) error {
+	iscnId, err := ParseIscnId(iscnIdStr)
+	if err != nil {
+		return sdkerrors.Wrapf(ErrInvalidIscnId, "%s", err.Error())
+	}
+	if iscnId.Version == 0 {
+		return sdkerrors.Wrapf(ErrInvalidIscnVersion, "ISCN ID must include the version of the record to be attested")
+	}
+	return nil
+}
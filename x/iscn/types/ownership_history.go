@@ -0,0 +1,29 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the ownership history entry in genesis
+func (entry IscnOwnershipHistoryEntry) Validate() error {
+	iscnId, err := ParseIscnId(entry.IscnIdPrefix)
+	if err != nil {
+		return fmt.Errorf("invalid ISCN ID prefix: %w", err)
+	}
+	if iscnId.Version != 0 {
+		return fmt.Errorf("invalid version in ISCN ID prefix %s, expect version 0", entry.IscnIdPrefix)
+	}
+	_, err = sdk.AccAddressFromBech32(entry.Owner)
+	if err != nil {
+		return fmt.Errorf("invalid owner address: %w", err)
+	}
+	if entry.Time.IsZero() {
+		return fmt.Errorf("time not set")
+	}
+	if entry.Height <= 0 {
+		return fmt.Errorf("invalid height %d", entry.Height)
+	}
+	return nil
+}
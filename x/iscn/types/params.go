@@ -3,9 +3,12 @@ package types
 import (
 	"fmt"
 	"regexp"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 )
 
 var (
@@ -15,11 +18,26 @@ var (
 	DefaultFeePerByte             = sdk.NewDecCoin(
 		DefaultFeePerByteDenom, sdk.NewInt(DefaultFeePerByteAmount),
 	)
+	DefaultCommitmentExpiryDays uint64 = 365
+
+	DefaultMaxRecordBytes       uint64 = 64 * 1024
+	DefaultMaxFingerprints      uint64 = 64
+	DefaultMaxStakeholders      uint64 = 64
+	DefaultMaxFingerprintLength uint64 = 1024
 )
 
 var (
-	ParamKeyRegistryName = []byte("RegistryName")
-	ParamKeyFeePerByte   = []byte("FeePerByte")
+	ParamKeyRegistryName   = []byte("RegistryName")
+	ParamKeyFeePerByte     = []byte("FeePerByte")
+	ParamKeyContentSchemas = []byte("ContentSchemas")
+	ParamKeyFeeRouting     = []byte("FeeRouting")
+
+	ParamKeyCommitmentExpiryDays = []byte("CommitmentExpiryDays")
+
+	ParamKeyMaxRecordBytes       = []byte("MaxRecordBytes")
+	ParamKeyMaxFingerprints      = []byte("MaxFingerprints")
+	ParamKeyMaxStakeholders      = []byte("MaxStakeholders")
+	ParamKeyMaxFingerprintLength = []byte("MaxFingerprintLength")
 )
 
 func ParamKeyTable() paramtypes.KeyTable {
@@ -32,6 +50,13 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(ParamKeyRegistryName, &p.RegistryName, validateRegistryName),
 		paramtypes.NewParamSetPair(ParamKeyFeePerByte, &p.FeePerByte, validateFeePerByte),
+		paramtypes.NewParamSetPair(ParamKeyContentSchemas, &p.ContentSchemas, validateContentSchemas),
+		paramtypes.NewParamSetPair(ParamKeyFeeRouting, &p.FeeRouting, validateFeeRouting),
+		paramtypes.NewParamSetPair(ParamKeyCommitmentExpiryDays, &p.CommitmentExpiryDays, validateCommitmentExpiryDays),
+		paramtypes.NewParamSetPair(ParamKeyMaxRecordBytes, &p.MaxRecordBytes, validateRecordLimit),
+		paramtypes.NewParamSetPair(ParamKeyMaxFingerprints, &p.MaxFingerprints, validateRecordLimit),
+		paramtypes.NewParamSetPair(ParamKeyMaxStakeholders, &p.MaxStakeholders, validateRecordLimit),
+		paramtypes.NewParamSetPair(ParamKeyMaxFingerprintLength, &p.MaxFingerprintLength, validateRecordLimit),
 	}
 }
 
@@ -64,10 +89,60 @@ func validateFeePerByte(i interface{}) error {
 	return nil
 }
 
+func validateContentSchemas(i interface{}) error {
+	v, ok := i.([]ContentSchema)
+	if !ok {
+		return fmt.Errorf("ISCN content schemas has invalid type: %T", i)
+	}
+	return ValidateContentSchemas(v)
+}
+
+// validateFeeRouting accepts empty fee routing, which means all the fees go to the fee collector
+func validateFeeRouting(i interface{}) error {
+	v, ok := i.(likefeegranttypes.FeeRouting)
+	if !ok {
+		return fmt.Errorf("ISCN fee routing has invalid type: %T", i)
+	}
+	if v.IsEmpty() {
+		return nil
+	}
+	err := v.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid ISCN fee routing: %w", err)
+	}
+	return nil
+}
+
+// validateCommitmentExpiryDays accepts 0, which means the default value
+func validateCommitmentExpiryDays(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("ISCN commitment expiry days has invalid type: %T", i)
+	}
+	return nil
+}
+
+// validateRecordLimit accepts 0, which means the default value
+func validateRecordLimit(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("ISCN record limit has invalid type: %T", i)
+	}
+	return nil
+}
+
 func DefaultParams() Params {
 	return Params{
 		RegistryName: DefaultRegistryName,
 		FeePerByte:   DefaultFeePerByte,
+		FeeRouting:   likefeegranttypes.DefaultFeeRouting(),
+
+		CommitmentExpiryDays: DefaultCommitmentExpiryDays,
+
+		MaxRecordBytes:       DefaultMaxRecordBytes,
+		MaxFingerprints:      DefaultMaxFingerprints,
+		MaxStakeholders:      DefaultMaxStakeholders,
+		MaxFingerprintLength: DefaultMaxFingerprintLength,
 	}
 }
 
@@ -81,14 +156,50 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = ValidateContentSchemas(p.ContentSchemas)
+	if err != nil {
+		return err
+	}
+	err = validateFeeRouting(p.FeeRouting)
+	if err != nil {
+		return err
+	}
+	err = validateCommitmentExpiryDays(p.CommitmentExpiryDays)
+	if err != nil {
+		return err
+	}
+	for _, limit := range []uint64{p.MaxRecordBytes, p.MaxFingerprints, p.MaxStakeholders, p.MaxFingerprintLength} {
+		err = validateRecordLimit(limit)
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func (p Params) String() string {
+	types := make([]string, 0, len(p.ContentSchemas))
+	for _, schema := range p.ContentSchemas {
+		types = append(types, schema.Type)
+	}
 	return fmt.Sprintf(`Params:
   Registry name: %s,
-  Fee per byte: %s`,
+  Fee per byte: %s,
+  Content schema types: [%s],
+  Fee routing: %s,
+  Commitment expiry days: %d,
+  Max record bytes: %d,
+  Max fingerprints: %d,
+  Max stakeholders: %d,
+  Max fingerprint length: %d`,
 		p.RegistryName,
 		p.FeePerByte.String(),
+		strings.Join(types, ", "),
+		p.FeeRouting.String(),
+		p.CommitmentExpiryDays,
+		p.MaxRecordBytes,
+		p.MaxFingerprints,
+		p.MaxStakeholders,
+		p.MaxFingerprintLength,
 	)
 }
@@ -8,6 +8,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,8 +26,20 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Params struct {
-	RegistryName string        `protobuf:"bytes,1,opt,name=registry_name,json=registryName,proto3" json:"registry_name,omitempty"`
-	FeePerByte   types.DecCoin `protobuf:"bytes,2,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte"`
+	RegistryName   string            `protobuf:"bytes,1,opt,name=registry_name,json=registryName,proto3" json:"registry_name,omitempty"`
+	FeePerByte     types.DecCoin     `protobuf:"bytes,2,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte"`
+	ContentSchemas []ContentSchema   `protobuf:"bytes,3,rep,name=content_schemas,json=contentSchemas,proto3" json:"content_schemas"`
+	FeeRouting     types1.FeeRouting `protobuf:"bytes,4,opt,name=fee_routing,json=feeRouting,proto3" json:"fee_routing"`
+	// number of days before an unrevealed ISCN commitment expires, 0 means the default value
+	CommitmentExpiryDays uint64 `protobuf:"varint,5,opt,name=commitment_expiry_days,json=commitmentExpiryDays,proto3" json:"commitment_expiry_days,omitempty"`
+	// maximum size of the ISCN record JSON-LD in bytes, 0 means the default value
+	MaxRecordBytes uint64 `protobuf:"varint,6,opt,name=max_record_bytes,json=maxRecordBytes,proto3" json:"max_record_bytes,omitempty"`
+	// maximum number of content fingerprints in an ISCN record, 0 means the default value
+	MaxFingerprints uint64 `protobuf:"varint,7,opt,name=max_fingerprints,json=maxFingerprints,proto3" json:"max_fingerprints,omitempty"`
+	// maximum number of stakeholders in an ISCN record, 0 means the default value
+	MaxStakeholders uint64 `protobuf:"varint,8,opt,name=max_stakeholders,json=maxStakeholders,proto3" json:"max_stakeholders,omitempty"`
+	// maximum length of a content fingerprint in bytes, 0 means the default value
+	MaxFingerprintLength uint64 `protobuf:"varint,9,opt,name=max_fingerprint_length,json=maxFingerprintLength,proto3" json:"max_fingerprint_length,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -61,32 +74,100 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// ContentSchema is a JSON Schema which the content metadata with the matching `@type` must conform to
+type ContentSchema struct {
+	Type   string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Schema string `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *ContentSchema) Reset()         { *m = ContentSchema{} }
+func (m *ContentSchema) String() string { return proto.CompactTextString(m) }
+func (*ContentSchema) ProtoMessage()    {}
+func (*ContentSchema) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4a4c68825ff5be8, []int{1}
+}
+func (m *ContentSchema) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContentSchema) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContentSchema.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContentSchema) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContentSchema.Merge(m, src)
+}
+func (m *ContentSchema) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContentSchema) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContentSchema.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContentSchema proto.InternalMessageInfo
+
+func (m *ContentSchema) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ContentSchema) GetSchema() string {
+	if m != nil {
+		return m.Schema
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "likechain.iscn.Params")
+	proto.RegisterType((*ContentSchema)(nil), "likechain.iscn.ContentSchema")
 }
 
 func init() { proto.RegisterFile("likechain/iscn/params.proto", fileDescriptor_a4a4c68825ff5be8) }
 
 var fileDescriptor_a4a4c68825ff5be8 = []byte{
-	// 285 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xce, 0xc9, 0xcc, 0x4e,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0xcf, 0x2c, 0x4e, 0xce, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc,
-	0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0x4b, 0xea, 0x81, 0x24, 0xa5, 0xe4,
-	0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5, 0x93, 0x12, 0x8b, 0x53, 0xf5, 0xcb, 0x0c, 0x93, 0x52,
-	0x4b, 0x12, 0x0d, 0xf5, 0x93, 0xf3, 0x33, 0xf3, 0x20, 0xea, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xd4, 0xca, 0xc8, 0xc5, 0x16, 0x00, 0x36, 0x56, 0x48,
-	0x99, 0x8b, 0xb7, 0x28, 0x35, 0x3d, 0xb3, 0xb8, 0xa4, 0xa8, 0x32, 0x3e, 0x2f, 0x31, 0x37, 0x55,
-	0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x07, 0x26, 0xe8, 0x97, 0x98, 0x9b, 0x2a, 0xe4, 0xc2,
-	0xc5, 0x93, 0x96, 0x9a, 0x1a, 0x5f, 0x90, 0x5a, 0x14, 0x9f, 0x54, 0x59, 0x92, 0x2a, 0xc1, 0xa4,
-	0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa3, 0x07, 0xb1, 0x5c, 0x0f, 0x64, 0xb9, 0x1e, 0xd4, 0x72, 0x3d,
-	0x97, 0xd4, 0x64, 0xe7, 0xfc, 0xcc, 0x3c, 0x27, 0x96, 0x13, 0xf7, 0xe4, 0x19, 0x82, 0xb8, 0xd2,
-	0x52, 0x53, 0x03, 0x52, 0x8b, 0x9c, 0x2a, 0x4b, 0x52, 0xad, 0x04, 0x3a, 0x16, 0xc8, 0x33, 0xcc,
-	0x58, 0x20, 0xcf, 0xf0, 0x62, 0x81, 0x3c, 0x43, 0xc3, 0x1d, 0x05, 0x06, 0x27, 0x9f, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x07, 0x7b, 0x39, 0x3f, 0x33, 0x0f, 0xce, 0xd0, 0x85, 0x84, 0x4e, 0x99,
-	0x89, 0x7e, 0x05, 0x24, 0x88, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x9e, 0x33, 0x06,
-	0x04, 0x00, 0x00, 0xff, 0xff, 0x5b, 0x21, 0x61, 0xce, 0x41, 0x01, 0x00, 0x00,
+	// 511 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x93, 0x31, 0x6f, 0xdb, 0x3e,
+	0x10, 0xc5, 0xa5, 0x7f, 0xfc, 0x77, 0x6b, 0x3a, 0x71, 0x02, 0x22, 0x08, 0x84, 0xb4, 0x95, 0x0d,
+	0x77, 0x51, 0x87, 0x4a, 0x88, 0x9b, 0xa9, 0xdd, 0x1c, 0x37, 0x40, 0x01, 0xa3, 0x08, 0x94, 0xad,
+	0x8b, 0x40, 0xd3, 0x67, 0x99, 0x88, 0x49, 0x0a, 0x24, 0x13, 0x58, 0x5b, 0xc7, 0xa2, 0x53, 0xc7,
+	0x8e, 0xfe, 0x38, 0x19, 0x33, 0x76, 0x28, 0x8a, 0xc2, 0x5e, 0xfa, 0x31, 0x0a, 0x51, 0xb6, 0x23,
+	0x77, 0x3b, 0xbd, 0xfb, 0xe1, 0xf8, 0x78, 0x7c, 0x42, 0xcf, 0x66, 0xec, 0x06, 0xe8, 0x94, 0x30,
+	0x11, 0x31, 0x4d, 0x45, 0x94, 0x11, 0x45, 0xb8, 0x0e, 0x33, 0x25, 0x8d, 0xc4, 0xad, 0x6d, 0x33,
+	0x2c, 0x9a, 0xa7, 0x3e, 0x95, 0x9a, 0x4b, 0x1d, 0x8d, 0x88, 0x86, 0xe8, 0xee, 0x6c, 0x04, 0x86,
+	0x9c, 0x45, 0x54, 0x32, 0x51, 0xf2, 0xa7, 0xc7, 0xa9, 0x4c, 0xa5, 0x2d, 0xa3, 0xa2, 0x5a, 0xab,
+	0xc1, 0xe3, 0x11, 0x45, 0x35, 0x01, 0x48, 0x15, 0x11, 0x26, 0x9a, 0x00, 0x24, 0x4a, 0xde, 0x1a,
+	0x26, 0xd2, 0x92, 0xec, 0x7e, 0xad, 0xa1, 0xfa, 0x95, 0x35, 0x80, 0x5f, 0xa2, 0x03, 0x05, 0x29,
+	0xd3, 0x46, 0xe5, 0x89, 0x20, 0x1c, 0x3c, 0xb7, 0xe3, 0x06, 0x8d, 0x78, 0x7f, 0x23, 0x7e, 0x24,
+	0x1c, 0xf0, 0x00, 0xed, 0x17, 0x43, 0x32, 0x50, 0xc9, 0x28, 0x37, 0xe0, 0xfd, 0xd7, 0x71, 0x83,
+	0x66, 0xef, 0x79, 0x58, 0xda, 0x0c, 0x0b, 0x9b, 0xe1, 0xda, 0x66, 0x38, 0x00, 0x7a, 0x21, 0x99,
+	0xe8, 0xd7, 0xee, 0x7f, 0xb5, 0x9d, 0x18, 0x4d, 0x00, 0xae, 0x40, 0xf5, 0x73, 0x03, 0x78, 0x88,
+	0x0e, 0xa9, 0x14, 0x06, 0x84, 0x49, 0x34, 0x9d, 0x02, 0x27, 0xda, 0xdb, 0xeb, 0xec, 0x05, 0xcd,
+	0xde, 0x8b, 0x70, 0xf7, 0xfe, 0xe1, 0x45, 0x89, 0x5d, 0x5b, 0x6a, 0x3d, 0xa9, 0x45, 0xab, 0xa2,
+	0xc6, 0x1f, 0x50, 0xb3, 0x72, 0x31, 0xaf, 0x66, 0x2d, 0x75, 0x2b, 0x93, 0xaa, 0x3b, 0x08, 0x2f,
+	0x01, 0xe2, 0x92, 0xac, 0x18, 0x5b, 0x2b, 0xf8, 0x1c, 0x9d, 0x50, 0xc9, 0x39, 0x33, 0xbc, 0xf0,
+	0x06, 0xf3, 0x8c, 0xa9, 0x3c, 0x19, 0x93, 0x5c, 0x7b, 0xff, 0x77, 0xdc, 0xa0, 0x16, 0x1f, 0x3f,
+	0x76, 0xdf, 0xdb, 0xe6, 0x80, 0xe4, 0x1a, 0x07, 0xe8, 0x88, 0x93, 0x79, 0xa2, 0x80, 0x4a, 0x35,
+	0xb6, 0x7b, 0xd1, 0x5e, 0xdd, 0xf2, 0x2d, 0x4e, 0xe6, 0xb1, 0x95, 0x8b, 0x7b, 0x6b, 0xfc, 0xaa,
+	0x24, 0x27, 0x4c, 0xa4, 0xa0, 0x32, 0xc5, 0x84, 0xd1, 0xde, 0x13, 0x4b, 0x1e, 0x72, 0x32, 0xbf,
+	0xac, 0xc8, 0x1b, 0x54, 0x1b, 0x72, 0x03, 0x53, 0x39, 0x1b, 0x83, 0xd2, 0xde, 0xd3, 0x2d, 0x7a,
+	0x5d, 0x91, 0x0b, 0xd7, 0xff, 0x4c, 0x4d, 0x66, 0x20, 0x52, 0x33, 0xf5, 0x1a, 0xa5, 0xeb, 0xdd,
+	0xd9, 0x43, 0xdb, 0x7b, 0x7b, 0xf4, 0x65, 0xd1, 0x76, 0xbe, 0x2f, 0xda, 0xce, 0x9f, 0x45, 0xdb,
+	0xf9, 0xfc, 0xb3, 0xe3, 0x74, 0xdf, 0xa1, 0x83, 0x9d, 0x7d, 0x63, 0x8c, 0x6a, 0x26, 0xcf, 0x36,
+	0x49, 0xb0, 0x35, 0x3e, 0x41, 0xf5, 0xf2, 0xcd, 0xec, 0xdb, 0x37, 0xe2, 0xf5, 0x57, 0x7f, 0x78,
+	0xbf, 0xf4, 0xdd, 0x87, 0xa5, 0xef, 0xfe, 0x5e, 0xfa, 0xee, 0xb7, 0x95, 0xef, 0x3c, 0xac, 0x7c,
+	0xe7, 0xc7, 0xca, 0x77, 0x3e, 0xf5, 0x52, 0x66, 0xa6, 0xb7, 0xa3, 0x90, 0x4a, 0x6e, 0xe3, 0x58,
+	0xc4, 0x77, 0x5b, 0xbc, 0x2e, 0x63, 0x7a, 0x77, 0x1e, 0xcd, 0xcb, 0xdf, 0xa1, 0x38, 0x44, 0x8f,
+	0xea, 0x36, 0x9e, 0x6f, 0xfe, 0x0e, 0x00, 0x91, 0x8d, 0x68, 0x08, 0x2d, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -109,6 +190,55 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxFingerprintLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFingerprintLength))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxStakeholders != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxStakeholders))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.MaxFingerprints != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxFingerprints))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxRecordBytes != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxRecordBytes))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CommitmentExpiryDays != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.CommitmentExpiryDays))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.FeeRouting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.ContentSchemas) > 0 {
+		for iNdEx := len(m.ContentSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContentSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.FeePerByte.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -129,6 +259,43 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ContentSchema) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContentSchema) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContentSchema) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schema) > 0 {
+		i -= len(m.Schema)
+		copy(dAtA[i:], m.Schema)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Schema)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -152,6 +319,46 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.FeePerByte.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if len(m.ContentSchemas) > 0 {
+		for _, e := range m.ContentSchemas {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.FeeRouting.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.CommitmentExpiryDays != 0 {
+		n += 1 + sovParams(uint64(m.CommitmentExpiryDays))
+	}
+	if m.MaxRecordBytes != 0 {
+		n += 1 + sovParams(uint64(m.MaxRecordBytes))
+	}
+	if m.MaxFingerprints != 0 {
+		n += 1 + sovParams(uint64(m.MaxFingerprints))
+	}
+	if m.MaxStakeholders != 0 {
+		n += 1 + sovParams(uint64(m.MaxStakeholders))
+	}
+	if m.MaxFingerprintLength != 0 {
+		n += 1 + sovParams(uint64(m.MaxFingerprintLength))
+	}
+	return n
+}
+
+func (m *ContentSchema) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Schema)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
 	return n
 }
 
@@ -255,6 +462,282 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSchemas = append(m.ContentSchemas, ContentSchema{})
+			if err := m.ContentSchemas[len(m.ContentSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRouting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitmentExpiryDays", wireType)
+			}
+			m.CommitmentExpiryDays = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitmentExpiryDays |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRecordBytes", wireType)
+			}
+			m.MaxRecordBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRecordBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFingerprints", wireType)
+			}
+			m.MaxFingerprints = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFingerprints |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxStakeholders", wireType)
+			}
+			m.MaxStakeholders = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxStakeholders |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFingerprintLength", wireType)
+			}
+			m.MaxFingerprintLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFingerprintLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContentSchema) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContentSchema: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContentSchema: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Schema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"fmt"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+)
+
+const (
+	ProposalTypeAddContentSchema    = "AddIscnContentSchema"
+	ProposalTypeRemoveContentSchema = "RemoveIscnContentSchema"
+)
+
+var (
+	_ govtypes.Content = &AddContentSchemaProposal{}
+	_ govtypes.Content = &RemoveContentSchemaProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeAddContentSchema)
+	govtypes.RegisterProposalType(ProposalTypeRemoveContentSchema)
+}
+
+func NewAddContentSchemaProposal(title, description string, schema ContentSchema) *AddContentSchemaProposal {
+	return &AddContentSchemaProposal{
+		Title:         title,
+		Description:   description,
+		ContentSchema: schema,
+	}
+}
+
+func (p *AddContentSchemaProposal) GetTitle() string { return p.Title }
+
+func (p *AddContentSchemaProposal) GetDescription() string { return p.Description }
+
+func (p *AddContentSchemaProposal) ProposalRoute() string { return RouterKey }
+
+func (p *AddContentSchemaProposal) ProposalType() string { return ProposalTypeAddContentSchema }
+
+func (p *AddContentSchemaProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	return p.ContentSchema.Validate()
+}
+
+func (p AddContentSchemaProposal) String() string {
+	return fmt.Sprintf(`Add ISCN Content Schema Proposal:
+  Title:       %s
+  Description: %s
+  Type:        %s
+  Schema:      %s
+`, p.Title, p.Description, p.ContentSchema.Type, p.ContentSchema.Schema)
+}
+
+func NewRemoveContentSchemaProposal(title, description string, contentType string) *RemoveContentSchemaProposal {
+	return &RemoveContentSchemaProposal{
+		Title:       title,
+		Description: description,
+		Type:        contentType,
+	}
+}
+
+func (p *RemoveContentSchemaProposal) GetTitle() string { return p.Title }
+
+func (p *RemoveContentSchemaProposal) GetDescription() string { return p.Description }
+
+func (p *RemoveContentSchemaProposal) ProposalRoute() string { return RouterKey }
+
+func (p *RemoveContentSchemaProposal) ProposalType() string { return ProposalTypeRemoveContentSchema }
+
+func (p *RemoveContentSchemaProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if p.Type == "" {
+		return fmt.Errorf("empty content schema type")
+	}
+	return nil
+}
+
+func (p RemoveContentSchemaProposal) String() string {
+	return fmt.Sprintf(`Remove ISCN Content Schema Proposal:
+  Title:       %s
+  Description: %s
+  Type:        %s
+`, p.Title, p.Description, p.Type)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 )
 
 func NewQueryRecordsByIdRequest(iscnId IscnId, fromVersion, toVersion uint64) *QueryRecordsByIdRequest {
@@ -26,6 +27,84 @@ func NewQueryRecordsByOwnerRequest(owner sdk.AccAddress, fromSeq uint64) *QueryR
 	}
 }
 
+func NewQueryRecordsByStakeholderRequest(stakeholderId string, fromSeq uint64) *QueryRecordsByStakeholderRequest {
+	return &QueryRecordsByStakeholderRequest{
+		StakeholderId: stakeholderId,
+		FromSequence:  fromSeq,
+	}
+}
+
+func NewQueryRecordsByTimeRangeRequest(fromTimestamp, toTimestamp int64, fromHeight, toHeight uint64, registryName string, fromSeq uint64) *QueryRecordsByTimeRangeRequest {
+	return &QueryRecordsByTimeRangeRequest{
+		FromTimestamp: fromTimestamp,
+		ToTimestamp:   toTimestamp,
+		FromHeight:    fromHeight,
+		ToHeight:      toHeight,
+		RegistryName:  registryName,
+		FromSequence:  fromSeq,
+	}
+}
+
+func NewQueryRecordDiffRequest(iscnIdPrefix IscnId, fromVersion, toVersion uint64) *QueryRecordDiffRequest {
+	return &QueryRecordDiffRequest{
+		IscnIdPrefix: iscnIdPrefix.PrefixId().String(),
+		FromVersion:  fromVersion,
+		ToVersion:    toVersion,
+	}
+}
+
 func NewQueryParamsRequest() *QueryParamsRequest {
 	return &QueryParamsRequest{}
 }
+
+func NewQueryContentSchemasRequest(contentType string) *QueryContentSchemasRequest {
+	return &QueryContentSchemasRequest{
+		Type: contentType,
+	}
+}
+
+func NewQueryCollectedFeesRequest() *QueryCollectedFeesRequest {
+	return &QueryCollectedFeesRequest{}
+}
+
+func NewQueryPendingOwnershipTransferRequest(iscnId IscnId) *QueryPendingOwnershipTransferRequest {
+	return &QueryPendingOwnershipTransferRequest{
+		IscnId: iscnId.PrefixId().String(),
+	}
+}
+
+func NewQueryPendingOwnershipTransfersByNewOwnerRequest(newOwner string, pagination *query.PageRequest) *QueryPendingOwnershipTransfersByNewOwnerRequest {
+	return &QueryPendingOwnershipTransfersByNewOwnerRequest{
+		NewOwner:   newOwner,
+		Pagination: pagination,
+	}
+}
+
+func NewQueryCommitmentRequest(commitment string) *QueryCommitmentRequest {
+	return &QueryCommitmentRequest{
+		Commitment: commitment,
+	}
+}
+
+func NewQueryAttestationsByAttesterRequest(attester string, pagination *query.PageRequest) *QueryAttestationsByAttesterRequest {
+	return &QueryAttestationsByAttesterRequest{
+		Attester:   attester,
+		Pagination: pagination,
+	}
+}
+
+func NewQueryOwnershipHistoryRequest(iscnId IscnId, pagination *query.PageRequest) *QueryOwnershipHistoryRequest {
+	return &QueryOwnershipHistoryRequest{
+		IscnId:     iscnId.String(),
+		Pagination: pagination,
+	}
+}
+
+func NewQueryRelatedRecordsRequest(iscnId IscnId, direction RelationDirection, relationType string, pagination *query.PageRequest) *QueryRelatedRecordsRequest {
+	return &QueryRelatedRecordsRequest{
+		IscnId:       iscnId.String(),
+		Direction:    direction,
+		RelationType: relationType,
+		Pagination:   pagination,
+	}
+}
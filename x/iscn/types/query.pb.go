@@ -6,9 +6,11 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -29,6 +31,33 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type RelationDirection int32
+
+const (
+	// Relations declared by the queried record
+	RELATION_DIRECTION_OUTGOING RelationDirection = 0
+	// Relations declared by other records pointing to the queried record
+	RELATION_DIRECTION_INCOMING RelationDirection = 1
+)
+
+var RelationDirection_name = map[int32]string{
+	0: "RELATION_DIRECTION_OUTGOING",
+	1: "RELATION_DIRECTION_INCOMING",
+}
+
+var RelationDirection_value = map[string]int32{
+	"RELATION_DIRECTION_OUTGOING": 0,
+	"RELATION_DIRECTION_INCOMING": 1,
+}
+
+func (x RelationDirection) String() string {
+	return proto.EnumName(RelationDirection_name, int32(x))
+}
+
+func (RelationDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{0}
+}
+
 type QueryResponseRecord struct {
 	Ipld string    `protobuf:"bytes,1,opt,name=ipld,proto3" json:"ipld,omitempty"`
 	Data IscnInput `protobuf:"bytes,2,opt,name=data,proto3,customtype=IscnInput" json:"data"`
@@ -146,6 +175,12 @@ type QueryRecordsByIdResponse struct {
 	Owner         string                `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
 	LatestVersion uint64                `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
 	Records       []QueryResponseRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records"`
+	Status        IscnRecordStatus      `protobuf:"varint,4,opt,name=status,proto3,enum=likechain.iscn.IscnRecordStatus" json:"status,omitempty"`
+	SupersededBy  string                `protobuf:"bytes,5,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
+	// group ID of the owner if the owner is an x/group policy account, 0 otherwise
+	OwnerGroupId uint64 `protobuf:"varint,6,opt,name=owner_group_id,json=ownerGroupId,proto3" json:"owner_group_id,omitempty"`
+	// attestations by the stakeholders of the returned record versions
+	Attestations []IscnAttestation `protobuf:"bytes,7,rep,name=attestations,proto3" json:"attestations"`
 }
 
 func (m *QueryRecordsByIdResponse) Reset()         { *m = QueryRecordsByIdResponse{} }
@@ -202,6 +237,34 @@ func (m *QueryRecordsByIdResponse) GetRecords() []QueryResponseRecord {
 	return nil
 }
 
+func (m *QueryRecordsByIdResponse) GetStatus() IscnRecordStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ISCN_RECORD_STATUS_ACTIVE
+}
+
+func (m *QueryRecordsByIdResponse) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+func (m *QueryRecordsByIdResponse) GetOwnerGroupId() uint64 {
+	if m != nil {
+		return m.OwnerGroupId
+	}
+	return 0
+}
+
+func (m *QueryRecordsByIdResponse) GetAttestations() []IscnAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
+}
+
 type QueryRecordsByFingerprintRequest struct {
 	// The fingerprint of the record(s) to be queried.
 	// All fingerprints in records should be URIs.
@@ -210,6 +273,9 @@ type QueryRecordsByFingerprintRequest struct {
 	// For the first query, fill in 0 or just omit this field.
 	// For continuous queries, fill in the `next_sequence` field in the previous response.
 	FromSequence uint64 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Standard pagination. If set, `from_sequence` is ignored and `next_sequence` in the response is not filled,
+	// with `pagination` in the response filled instead.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRecordsByFingerprintRequest) Reset()         { *m = QueryRecordsByFingerprintRequest{} }
@@ -259,10 +325,19 @@ func (m *QueryRecordsByFingerprintRequest) GetFromSequence() uint64 {
 	return 0
 }
 
+func (m *QueryRecordsByFingerprintRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryRecordsByFingerprintResponse struct {
 	Records []QueryResponseRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 	// For pagination.
 	NextSequence uint64 `protobuf:"varint,2,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+	// For pagination with the `pagination` field in the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRecordsByFingerprintResponse) Reset()         { *m = QueryRecordsByFingerprintResponse{} }
@@ -312,6 +387,13 @@ func (m *QueryRecordsByFingerprintResponse) GetNextSequence() uint64 {
 	return 0
 }
 
+func (m *QueryRecordsByFingerprintResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryRecordsByOwnerRequest struct {
 	// Owner address of the record(s) to be queried.
 	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
@@ -319,6 +401,10 @@ type QueryRecordsByOwnerRequest struct {
 	// For the first query, fill in 0 or just omit this field.
 	// For continuous queries, fill in the `next_sequence` field in the previous response.
 	FromSequence uint64 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Standard pagination. If set, `from_sequence` is ignored and `next_sequence` in the response is not filled,
+	// with `pagination` in the response filled instead.
+	// Each item in the page is an ISCN ID owned by the owner, with all the versions of the ISCN ID returned.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRecordsByOwnerRequest) Reset()         { *m = QueryRecordsByOwnerRequest{} }
@@ -368,9 +454,18 @@ func (m *QueryRecordsByOwnerRequest) GetFromSequence() uint64 {
 	return 0
 }
 
+func (m *QueryRecordsByOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 type QueryRecordsByOwnerResponse struct {
 	Records      []QueryResponseRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 	NextSequence uint64                `protobuf:"varint,2,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+	// For pagination with the `pagination` field in the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
 func (m *QueryRecordsByOwnerResponse) Reset()         { *m = QueryRecordsByOwnerResponse{} }
@@ -420,21 +515,37 @@ func (m *QueryRecordsByOwnerResponse) GetNextSequence() uint64 {
 	return 0
 }
 
-type QueryParamsRequest struct {
+func (m *QueryRecordsByOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+type QueryRecordsByStakeholderRequest struct {
+	// The `entity.@id` field of the stakeholder in the record(s) to be queried.
+	StakeholderId string `protobuf:"bytes,1,opt,name=stakeholder_id,json=stakeholderId,proto3" json:"stakeholder_id,omitempty"`
+	// For pagination.
+	// For the first query, fill in 0 or just omit this field.
+	// For continuous queries, fill in the `next_sequence` field in the previous response.
+	FromSequence uint64 `protobuf:"varint,2,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Standard pagination. If set, `from_sequence` is ignored and `next_sequence` in the response is not filled,
+	// with `pagination` in the response filled instead.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsByStakeholderRequest) Reset()         { *m = QueryRecordsByStakeholderRequest{} }
+func (m *QueryRecordsByStakeholderRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsByStakeholderRequest) ProtoMessage()    {}
+func (*QueryRecordsByStakeholderRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{7}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecordsByStakeholderRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecordsByStakeholderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecordsByStakeholderRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -444,34 +555,59 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryRecordsByStakeholderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsByStakeholderRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryRecordsByStakeholderRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryRecordsByStakeholderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsByStakeholderRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecordsByStakeholderRequest proto.InternalMessageInfo
 
-type QueryParamsResponse struct {
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+func (m *QueryRecordsByStakeholderRequest) GetStakeholderId() string {
+	if m != nil {
+		return m.StakeholderId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRecordsByStakeholderRequest) GetFromSequence() uint64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
+}
+
+func (m *QueryRecordsByStakeholderRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecordsByStakeholderResponse struct {
+	Records []QueryResponseRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// For pagination.
+	NextSequence uint64 `protobuf:"varint,2,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+	// For pagination with the `pagination` field in the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsByStakeholderResponse) Reset()         { *m = QueryRecordsByStakeholderResponse{} }
+func (m *QueryRecordsByStakeholderResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsByStakeholderResponse) ProtoMessage()    {}
+func (*QueryRecordsByStakeholderResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{8}
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecordsByStakeholderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecordsByStakeholderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecordsByStakeholderResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -481,41 +617,73 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryRecordsByStakeholderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsByStakeholderResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryRecordsByStakeholderResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryRecordsByStakeholderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsByStakeholderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecordsByStakeholderResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() Params {
+func (m *QueryRecordsByStakeholderResponse) GetRecords() []QueryResponseRecord {
 	if m != nil {
-		return m.Params
+		return m.Records
 	}
-	return Params{}
+	return nil
 }
 
-type QueryGetCidRequest struct {
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+func (m *QueryRecordsByStakeholderResponse) GetNextSequence() uint64 {
+	if m != nil {
+		return m.NextSequence
+	}
+	return 0
 }
 
-func (m *QueryGetCidRequest) Reset()         { *m = QueryGetCidRequest{} }
-func (m *QueryGetCidRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCidRequest) ProtoMessage()    {}
-func (*QueryGetCidRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRecordsByStakeholderResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecordsByTimeRangeRequest struct {
+	// Range of the registration time of the record(s) to be queried, in Unix timestamp seconds, both inclusive.
+	// 0 means unbounded.
+	FromTimestamp int64 `protobuf:"varint,1,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	ToTimestamp   int64 `protobuf:"varint,2,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	// Range of the registration block height of the record(s) to be queried, both inclusive.
+	// 0 means unbounded.
+	// Cannot be used together with the timestamp range.
+	// Records registered before the block height index exists are not included.
+	FromHeight uint64 `protobuf:"varint,3,opt,name=from_height,json=fromHeight,proto3" json:"from_height,omitempty"`
+	ToHeight   uint64 `protobuf:"varint,4,opt,name=to_height,json=toHeight,proto3" json:"to_height,omitempty"`
+	// If not empty, only returns records with the given registry name.
+	RegistryName string `protobuf:"bytes,5,opt,name=registry_name,json=registryName,proto3" json:"registry_name,omitempty"`
+	// For pagination.
+	// For the first query, fill in 0 or just omit this field.
+	// For continuous queries, fill in the `next_sequence` field in the previous response.
+	FromSequence uint64 `protobuf:"varint,6,opt,name=from_sequence,json=fromSequence,proto3" json:"from_sequence,omitempty"`
+	// Standard pagination. If set, `from_sequence` is ignored and `next_sequence` in the response is not filled,
+	// with `pagination` in the response filled instead.
+	Pagination *query.PageRequest `protobuf:"bytes,7,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsByTimeRangeRequest) Reset()         { *m = QueryRecordsByTimeRangeRequest{} }
+func (m *QueryRecordsByTimeRangeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsByTimeRangeRequest) ProtoMessage()    {}
+func (*QueryRecordsByTimeRangeRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{9}
 }
-func (m *QueryGetCidRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecordsByTimeRangeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecordsByTimeRangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCidRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecordsByTimeRangeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -525,41 +693,87 @@ func (m *QueryGetCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCidRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCidRequest.Merge(m, src)
+func (m *QueryRecordsByTimeRangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsByTimeRangeRequest.Merge(m, src)
 }
-func (m *QueryGetCidRequest) XXX_Size() int {
+func (m *QueryRecordsByTimeRangeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCidRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCidRequest.DiscardUnknown(m)
+func (m *QueryRecordsByTimeRangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsByTimeRangeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCidRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecordsByTimeRangeRequest proto.InternalMessageInfo
 
-func (m *QueryGetCidRequest) GetCid() string {
+func (m *QueryRecordsByTimeRangeRequest) GetFromTimestamp() int64 {
 	if m != nil {
-		return m.Cid
+		return m.FromTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRangeRequest) GetToTimestamp() int64 {
+	if m != nil {
+		return m.ToTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRangeRequest) GetFromHeight() uint64 {
+	if m != nil {
+		return m.FromHeight
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRangeRequest) GetToHeight() uint64 {
+	if m != nil {
+		return m.ToHeight
+	}
+	return 0
+}
+
+func (m *QueryRecordsByTimeRangeRequest) GetRegistryName() string {
+	if m != nil {
+		return m.RegistryName
 	}
 	return ""
 }
 
-type QueryGetCidResponse struct {
-	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+func (m *QueryRecordsByTimeRangeRequest) GetFromSequence() uint64 {
+	if m != nil {
+		return m.FromSequence
+	}
+	return 0
 }
 
-func (m *QueryGetCidResponse) Reset()         { *m = QueryGetCidResponse{} }
-func (m *QueryGetCidResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCidResponse) ProtoMessage()    {}
-func (*QueryGetCidResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRecordsByTimeRangeRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecordsByTimeRangeResponse struct {
+	Records []QueryResponseRecord `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
+	// For pagination.
+	NextSequence uint64 `protobuf:"varint,2,opt,name=next_sequence,json=nextSequence,proto3" json:"next_sequence,omitempty"`
+	// For pagination with the `pagination` field in the request.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRecordsByTimeRangeResponse) Reset()         { *m = QueryRecordsByTimeRangeResponse{} }
+func (m *QueryRecordsByTimeRangeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordsByTimeRangeResponse) ProtoMessage()    {}
+func (*QueryRecordsByTimeRangeResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{10}
 }
-func (m *QueryGetCidResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecordsByTimeRangeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecordsByTimeRangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCidResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecordsByTimeRangeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -569,41 +783,60 @@ func (m *QueryGetCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCidResponse.Merge(m, src)
+func (m *QueryRecordsByTimeRangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordsByTimeRangeResponse.Merge(m, src)
 }
-func (m *QueryGetCidResponse) XXX_Size() int {
+func (m *QueryRecordsByTimeRangeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCidResponse.DiscardUnknown(m)
+func (m *QueryRecordsByTimeRangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordsByTimeRangeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCidResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecordsByTimeRangeResponse proto.InternalMessageInfo
 
-func (m *QueryGetCidResponse) GetData() []byte {
+func (m *QueryRecordsByTimeRangeResponse) GetRecords() []QueryResponseRecord {
 	if m != nil {
-		return m.Data
+		return m.Records
 	}
 	return nil
 }
 
-type QueryGetCidSizeRequest struct {
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+func (m *QueryRecordsByTimeRangeResponse) GetNextSequence() uint64 {
+	if m != nil {
+		return m.NextSequence
+	}
+	return 0
 }
 
-func (m *QueryGetCidSizeRequest) Reset()         { *m = QueryGetCidSizeRequest{} }
-func (m *QueryGetCidSizeRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCidSizeRequest) ProtoMessage()    {}
-func (*QueryGetCidSizeRequest) Descriptor() ([]byte, []int) {
+func (m *QueryRecordsByTimeRangeResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+type QueryRecordDiffRequest struct {
+	// version part is ignored if given
+	IscnIdPrefix string `protobuf:"bytes,1,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	// 0 means the version before `to_version`
+	FromVersion uint64 `protobuf:"varint,2,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	// 0 means the latest version
+	ToVersion uint64 `protobuf:"varint,3,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+}
+
+func (m *QueryRecordDiffRequest) Reset()         { *m = QueryRecordDiffRequest{} }
+func (m *QueryRecordDiffRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordDiffRequest) ProtoMessage()    {}
+func (*QueryRecordDiffRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{11}
 }
-func (m *QueryGetCidSizeRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecordDiffRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCidSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecordDiffRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCidSizeRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecordDiffRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -613,41 +846,60 @@ func (m *QueryGetCidSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCidSizeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCidSizeRequest.Merge(m, src)
+func (m *QueryRecordDiffRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordDiffRequest.Merge(m, src)
 }
-func (m *QueryGetCidSizeRequest) XXX_Size() int {
+func (m *QueryRecordDiffRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCidSizeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCidSizeRequest.DiscardUnknown(m)
+func (m *QueryRecordDiffRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordDiffRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCidSizeRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecordDiffRequest proto.InternalMessageInfo
 
-func (m *QueryGetCidSizeRequest) GetCid() string {
+func (m *QueryRecordDiffRequest) GetIscnIdPrefix() string {
 	if m != nil {
-		return m.Cid
+		return m.IscnIdPrefix
 	}
 	return ""
 }
 
-type QueryGetCidSizeResponse struct {
-	Size_ uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+func (m *QueryRecordDiffRequest) GetFromVersion() uint64 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
 }
 
-func (m *QueryGetCidSizeResponse) Reset()         { *m = QueryGetCidSizeResponse{} }
-func (m *QueryGetCidSizeResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGetCidSizeResponse) ProtoMessage()    {}
-func (*QueryGetCidSizeResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRecordDiffRequest) GetToVersion() uint64 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+// JsonPatchOperation is an operation in JSON Patch (RFC 6902)
+type JsonPatchOperation struct {
+	// one of "add", "remove" and "replace"
+	Op   string `protobuf:"bytes,1,opt,name=op,proto3" json:"op,omitempty"`
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// empty for "remove"
+	Value IscnInput `protobuf:"bytes,3,opt,name=value,proto3,customtype=IscnInput" json:"value"`
+}
+
+func (m *JsonPatchOperation) Reset()         { *m = JsonPatchOperation{} }
+func (m *JsonPatchOperation) String() string { return proto.CompactTextString(m) }
+func (*JsonPatchOperation) ProtoMessage()    {}
+func (*JsonPatchOperation) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{12}
 }
-func (m *QueryGetCidSizeResponse) XXX_Unmarshal(b []byte) error {
+func (m *JsonPatchOperation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGetCidSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JsonPatchOperation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGetCidSizeResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_JsonPatchOperation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -657,41 +909,52 @@ func (m *QueryGetCidSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *QueryGetCidSizeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGetCidSizeResponse.Merge(m, src)
+func (m *JsonPatchOperation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JsonPatchOperation.Merge(m, src)
 }
-func (m *QueryGetCidSizeResponse) XXX_Size() int {
+func (m *JsonPatchOperation) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGetCidSizeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGetCidSizeResponse.DiscardUnknown(m)
+func (m *JsonPatchOperation) XXX_DiscardUnknown() {
+	xxx_messageInfo_JsonPatchOperation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGetCidSizeResponse proto.InternalMessageInfo
+var xxx_messageInfo_JsonPatchOperation proto.InternalMessageInfo
 
-func (m *QueryGetCidSizeResponse) GetSize_() uint64 {
+func (m *JsonPatchOperation) GetOp() string {
 	if m != nil {
-		return m.Size_
+		return m.Op
 	}
-	return 0
-}
-
-type QueryHasCidRequest struct {
-	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+	return ""
 }
 
-func (m *QueryHasCidRequest) Reset()         { *m = QueryHasCidRequest{} }
-func (m *QueryHasCidRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryHasCidRequest) ProtoMessage()    {}
-func (*QueryHasCidRequest) Descriptor() ([]byte, []int) {
+func (m *JsonPatchOperation) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+type QueryRecordDiffResponse struct {
+	FromVersion uint64 `protobuf:"varint,1,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion   uint64 `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// operations transforming the content metadata, stakeholders, content fingerprints and record notes of
+	// `from_version` into those of `to_version`
+	Operations []JsonPatchOperation `protobuf:"bytes,3,rep,name=operations,proto3" json:"operations"`
+}
+
+func (m *QueryRecordDiffResponse) Reset()         { *m = QueryRecordDiffResponse{} }
+func (m *QueryRecordDiffResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecordDiffResponse) ProtoMessage()    {}
+func (*QueryRecordDiffResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{13}
 }
-func (m *QueryHasCidRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryRecordDiffResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHasCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryRecordDiffResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHasCidRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryRecordDiffResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -701,41 +964,56 @@ func (m *QueryHasCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryHasCidRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHasCidRequest.Merge(m, src)
+func (m *QueryRecordDiffResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecordDiffResponse.Merge(m, src)
 }
-func (m *QueryHasCidRequest) XXX_Size() int {
+func (m *QueryRecordDiffResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHasCidRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHasCidRequest.DiscardUnknown(m)
+func (m *QueryRecordDiffResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecordDiffResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHasCidRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryRecordDiffResponse proto.InternalMessageInfo
 
-func (m *QueryHasCidRequest) GetCid() string {
+func (m *QueryRecordDiffResponse) GetFromVersion() uint64 {
 	if m != nil {
-		return m.Cid
+		return m.FromVersion
 	}
-	return ""
+	return 0
 }
 
-type QueryHasCidResponse struct {
-	Exist bool `protobuf:"varint,1,opt,name=exist,proto3" json:"exist,omitempty"`
+func (m *QueryRecordDiffResponse) GetToVersion() uint64 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
 }
 
-func (m *QueryHasCidResponse) Reset()         { *m = QueryHasCidResponse{} }
-func (m *QueryHasCidResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryHasCidResponse) ProtoMessage()    {}
-func (*QueryHasCidResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRecordDiffResponse) GetOperations() []JsonPatchOperation {
+	if m != nil {
+		return m.Operations
+	}
+	return nil
+}
+
+type QueryPendingOwnershipTransferRequest struct {
+	// version part is ignored
+	IscnId string `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+}
+
+func (m *QueryPendingOwnershipTransferRequest) Reset()         { *m = QueryPendingOwnershipTransferRequest{} }
+func (m *QueryPendingOwnershipTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOwnershipTransferRequest) ProtoMessage()    {}
+func (*QueryPendingOwnershipTransferRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_30477fb2f7bdc5a8, []int{14}
 }
-func (m *QueryHasCidResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryPendingOwnershipTransferRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryHasCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryPendingOwnershipTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryHasCidResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryPendingOwnershipTransferRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -745,1154 +1023,7444 @@ func (m *QueryHasCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryHasCidResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryHasCidResponse.Merge(m, src)
+func (m *QueryPendingOwnershipTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnershipTransferRequest.Merge(m, src)
 }
-func (m *QueryHasCidResponse) XXX_Size() int {
+func (m *QueryPendingOwnershipTransferRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryHasCidResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryHasCidResponse.DiscardUnknown(m)
+func (m *QueryPendingOwnershipTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnershipTransferRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryHasCidResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryPendingOwnershipTransferRequest proto.InternalMessageInfo
 
-func (m *QueryHasCidResponse) GetExist() bool {
+func (m *QueryPendingOwnershipTransferRequest) GetIscnId() string {
 	if m != nil {
-		return m.Exist
+		return m.IscnId
 	}
-	return false
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryResponseRecord)(nil), "likechain.iscn.QueryResponseRecord")
-	proto.RegisterType((*QueryRecordsByIdRequest)(nil), "likechain.iscn.QueryRecordsByIdRequest")
-	proto.RegisterType((*QueryRecordsByIdResponse)(nil), "likechain.iscn.QueryRecordsByIdResponse")
-	proto.RegisterType((*QueryRecordsByFingerprintRequest)(nil), "likechain.iscn.QueryRecordsByFingerprintRequest")
-	proto.RegisterType((*QueryRecordsByFingerprintResponse)(nil), "likechain.iscn.QueryRecordsByFingerprintResponse")
-	proto.RegisterType((*QueryRecordsByOwnerRequest)(nil), "likechain.iscn.QueryRecordsByOwnerRequest")
-	proto.RegisterType((*QueryRecordsByOwnerResponse)(nil), "likechain.iscn.QueryRecordsByOwnerResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "likechain.iscn.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "likechain.iscn.QueryParamsResponse")
-	proto.RegisterType((*QueryGetCidRequest)(nil), "likechain.iscn.QueryGetCidRequest")
-	proto.RegisterType((*QueryGetCidResponse)(nil), "likechain.iscn.QueryGetCidResponse")
-	proto.RegisterType((*QueryGetCidSizeRequest)(nil), "likechain.iscn.QueryGetCidSizeRequest")
-	proto.RegisterType((*QueryGetCidSizeResponse)(nil), "likechain.iscn.QueryGetCidSizeResponse")
-	proto.RegisterType((*QueryHasCidRequest)(nil), "likechain.iscn.QueryHasCidRequest")
-	proto.RegisterType((*QueryHasCidResponse)(nil), "likechain.iscn.QueryHasCidResponse")
+type QueryPendingOwnershipTransferResponse struct {
+	PendingOwnershipTransfer PendingOwnershipTransfer `protobuf:"bytes,1,opt,name=pending_ownership_transfer,json=pendingOwnershipTransfer,proto3" json:"pending_ownership_transfer"`
 }
 
-func init() { proto.RegisterFile("likechain/iscn/query.proto", fileDescriptor_30477fb2f7bdc5a8) }
-
-var fileDescriptor_30477fb2f7bdc5a8 = []byte{
-	// 804 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcf, 0x4f, 0x1b, 0x47,
-	0x14, 0xf6, 0x62, 0x63, 0xca, 0x18, 0x10, 0x1d, 0x5c, 0x70, 0xd7, 0xad, 0x31, 0x8b, 0x00, 0x17,
-	0x84, 0xb7, 0x75, 0xf9, 0x0b, 0x8c, 0xd4, 0xd6, 0x6a, 0xa5, 0xd2, 0x45, 0x6a, 0xa5, 0x5e, 0xac,
-	0x65, 0x77, 0x30, 0x23, 0xcc, 0xcc, 0xb2, 0x33, 0x26, 0x40, 0x14, 0x45, 0xca, 0x21, 0x39, 0x45,
-	0x8a, 0x94, 0x6b, 0x94, 0xbf, 0x87, 0x23, 0x52, 0x2e, 0x51, 0x0e, 0x28, 0x82, 0xfc, 0x17, 0xb9,
-	0x44, 0xf3, 0x63, 0xed, 0x5d, 0x63, 0x1b, 0x92, 0x43, 0x6e, 0xb3, 0xef, 0x7d, 0xfb, 0xbe, 0xef,
-	0xbd, 0x79, 0xdf, 0x6a, 0x81, 0xd9, 0xc6, 0x87, 0xc8, 0x3b, 0x70, 0x31, 0xb1, 0x31, 0xf3, 0x88,
-	0x7d, 0xdc, 0x41, 0xe1, 0x59, 0x35, 0x08, 0x29, 0xa7, 0x70, 0xa6, 0x9b, 0xab, 0x8a, 0x9c, 0x99,
-	0x6f, 0xd1, 0x16, 0x95, 0x29, 0x5b, 0x9c, 0x14, 0xca, 0xfc, 0xa1, 0x45, 0x69, 0xab, 0x8d, 0x6c,
-	0x37, 0xc0, 0xb6, 0x4b, 0x08, 0xe5, 0x2e, 0xc7, 0x94, 0x30, 0x9d, 0x2d, 0xf6, 0xd5, 0x0f, 0xdc,
-	0xd0, 0x3d, 0xd2, 0x49, 0x6b, 0x07, 0xcc, 0xfd, 0x23, 0xf8, 0x1c, 0xc4, 0x02, 0x4a, 0x18, 0x72,
-	0x90, 0x47, 0x43, 0x1f, 0x42, 0x90, 0xc1, 0x41, 0xdb, 0x2f, 0x18, 0x65, 0xa3, 0x32, 0xe9, 0xc8,
-	0x33, 0x5c, 0x01, 0x19, 0xdf, 0xe5, 0x6e, 0x61, 0xac, 0x6c, 0x54, 0xa6, 0xea, 0xdf, 0x5e, 0x5c,
-	0x2d, 0xa6, 0xde, 0x5d, 0x2d, 0x4e, 0x36, 0x98, 0x47, 0x1a, 0x24, 0xe8, 0x70, 0x47, 0xa6, 0x2d,
-	0x0e, 0x16, 0x74, 0x45, 0x51, 0x89, 0xd5, 0xcf, 0x1a, 0xbe, 0x83, 0x8e, 0x3b, 0x88, 0x71, 0xb8,
-	0x00, 0x26, 0x84, 0x82, 0x26, 0x8e, 0x0a, 0x67, 0xc5, 0x63, 0xc3, 0x87, 0x4b, 0x60, 0x6a, 0x3f,
-	0xa4, 0x47, 0xcd, 0x13, 0x14, 0x32, 0x4c, 0x89, 0xa4, 0xc8, 0x38, 0x39, 0x11, 0xfb, 0x57, 0x85,
-	0xe0, 0x8f, 0x00, 0x70, 0xda, 0x05, 0xa4, 0x25, 0x60, 0x92, 0x53, 0x9d, 0xb6, 0x5e, 0x19, 0xa0,
-	0x70, 0x9b, 0x56, 0xf5, 0x04, 0xf3, 0x60, 0x9c, 0x3e, 0x20, 0x28, 0xd4, 0xac, 0xea, 0x01, 0xae,
-	0x80, 0x99, 0xb6, 0xcb, 0x11, 0xe3, 0x7d, 0xb4, 0xd3, 0x2a, 0x1a, 0x11, 0x6f, 0x83, 0x89, 0x50,
-	0xd5, 0x2c, 0xa4, 0xcb, 0xe9, 0x4a, 0xae, 0xb6, 0x5c, 0x4d, 0x5e, 0x4a, 0x75, 0xc0, 0x00, 0xeb,
-	0x19, 0x31, 0x1e, 0x27, 0x7a, 0xd3, 0xc2, 0xa0, 0x9c, 0x54, 0xf7, 0x1b, 0x26, 0x2d, 0x14, 0x06,
-	0x21, 0x26, 0x3c, 0x9a, 0x4e, 0x19, 0xe4, 0xf6, 0x7b, 0x51, 0xad, 0x35, 0x1e, 0x82, 0xcb, 0x60,
-	0x5a, 0x8e, 0x89, 0x89, 0x37, 0x88, 0x87, 0xb4, 0x60, 0x39, 0xbb, 0x5d, 0x1d, 0xb3, 0x9e, 0x1b,
-	0x60, 0x69, 0x04, 0x97, 0x1e, 0x49, 0xac, 0x2b, 0xe3, 0x4b, 0xbb, 0x12, 0x7a, 0x08, 0x3a, 0xe5,
-	0xb7, 0xf4, 0x88, 0x60, 0x57, 0xcf, 0x7f, 0xc0, 0x4c, 0xca, 0xf9, 0x5b, 0x4c, 0x3f, 0x6a, 0x7a,
-	0xf0, 0xd5, 0xdc, 0xab, 0xd1, 0x67, 0x06, 0x28, 0x0e, 0xac, 0xfc, 0xd5, 0x5b, 0xcc, 0x03, 0x28,
-	0x4b, 0xed, 0x48, 0x67, 0xe9, 0xd6, 0xac, 0x3f, 0xb5, 0xb5, 0xa2, 0xa8, 0x96, 0xb5, 0x05, 0xb2,
-	0xca, 0x81, 0xb2, 0xe5, 0x5c, 0x6d, 0xbe, 0x5f, 0x95, 0xc2, 0x6b, 0x21, 0x1a, 0x6b, 0xad, 0x6a,
-	0x8a, 0xdf, 0x11, 0xdf, 0xc6, 0x5d, 0x43, 0xcd, 0x82, 0xb4, 0xd7, 0x35, 0x93, 0x38, 0x5a, 0x3f,
-	0x69, 0xd2, 0x08, 0xa7, 0x49, 0xa1, 0xf6, 0xae, 0x40, 0x4e, 0x69, 0xa3, 0xae, 0x83, 0xf9, 0x18,
-	0x74, 0x17, 0x9f, 0xa3, 0xe1, 0x65, 0x37, 0xb5, 0xa9, 0xe3, 0xd8, 0x5e, 0x69, 0x86, 0xcf, 0x91,
-	0x44, 0x67, 0x1c, 0x79, 0xee, 0xaa, 0xfd, 0xc3, 0x65, 0x23, 0xd5, 0x6e, 0x68, 0xb5, 0x11, 0xae,
-	0xe7, 0x57, 0x74, 0x8a, 0x99, 0xf2, 0xc0, 0x37, 0x8e, 0x7a, 0xa8, 0x7d, 0xcc, 0x82, 0x71, 0x89,
-	0x86, 0xe7, 0x20, 0x17, 0xb3, 0x39, 0x5c, 0x1b, 0x72, 0xaf, 0xfd, 0xdf, 0x1f, 0xb3, 0x72, 0x37,
-	0x50, 0x29, 0xb0, 0x0a, 0x4f, 0xde, 0x7c, 0x78, 0x39, 0x06, 0xe1, 0xac, 0xfa, 0x64, 0xea, 0x6d,
-	0xb0, 0xb1, 0x0f, 0x5f, 0x1b, 0x20, 0x3f, 0xc8, 0x59, 0xf0, 0xe7, 0xd1, 0xc5, 0x6f, 0x1b, 0xde,
-	0xfc, 0xe5, 0x33, 0xde, 0xd0, 0xba, 0x96, 0xa4, 0xae, 0x22, 0xfc, 0x3e, 0xa9, 0x2b, 0xfe, 0x91,
-	0x78, 0x6a, 0x80, 0x99, 0xa4, 0x23, 0xe0, 0xfa, 0x68, 0xa2, 0xb8, 0x21, 0xcd, 0x8d, 0x7b, 0x61,
-	0xb5, 0x9c, 0xa2, 0x94, 0xf3, 0x1d, 0x9c, 0x4b, 0xca, 0x51, 0x26, 0x3e, 0x04, 0x59, 0xb5, 0xca,
-	0xd0, 0x1a, 0x58, 0x33, 0xe1, 0x16, 0x73, 0x79, 0x24, 0x66, 0xf0, 0xb5, 0x48, 0x6f, 0x20, 0x8e,
-	0x42, 0x06, 0x09, 0xc8, 0xaa, 0xdd, 0x1c, 0x42, 0x96, 0xf0, 0xcd, 0x10, 0xb2, 0xa4, 0x67, 0xfa,
-	0x9b, 0x6b, 0x21, 0xde, 0xf4, 0xb0, 0x6f, 0x3f, 0xf4, 0xb0, 0xff, 0x48, 0xf0, 0xa9, 0xa5, 0x1d,
-	0xc2, 0x97, 0xd8, 0xfc, 0x21, 0x7c, 0xc9, 0xad, 0xef, 0xe7, 0x3b, 0x70, 0x59, 0x8c, 0xef, 0x31,
-	0x00, 0x3d, 0xef, 0xc1, 0xd5, 0x11, 0xfa, 0x63, 0x46, 0x36, 0xd7, 0xee, 0xc4, 0x69, 0xee, 0xb2,
-	0xe4, 0x36, 0x61, 0x21, 0xd1, 0x6b, 0x53, 0x98, 0x59, 0x09, 0xa8, 0xff, 0x75, 0x71, 0x5d, 0x32,
-	0x2e, 0xaf, 0x4b, 0xc6, 0xfb, 0xeb, 0x92, 0xf1, 0xe2, 0xa6, 0x94, 0xba, 0xbc, 0x29, 0xa5, 0xde,
-	0xde, 0x94, 0x52, 0xff, 0xd7, 0x5a, 0x98, 0x1f, 0x74, 0xf6, 0xaa, 0x1e, 0x3d, 0xb2, 0x25, 0x1d,
-	0xc5, 0xa4, 0x7b, 0xd8, 0x54, 0x3f, 0x1e, 0x27, 0x5b, 0xf6, 0xa9, 0x2a, 0xcd, 0xcf, 0x02, 0xc4,
-	0xf6, 0xb2, 0xf2, 0xef, 0xe3, 0xd7, 0x4f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x2f, 0xde, 0x4d, 0xfa,
-	0xfc, 0x08, 0x00, 0x00,
+func (m *QueryPendingOwnershipTransferResponse) Reset()         { *m = QueryPendingOwnershipTransferResponse{} }
+func (m *QueryPendingOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingOwnershipTransferResponse) ProtoMessage()    {}
+func (*QueryPendingOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{15}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	RecordsById(ctx context.Context, in *QueryRecordsByIdRequest, opts ...grpc.CallOption) (*QueryRecordsByIdResponse, error)
-	RecordsByFingerprint(ctx context.Context, in *QueryRecordsByFingerprintRequest, opts ...grpc.CallOption) (*QueryRecordsByFingerprintResponse, error)
-	RecordsByOwner(ctx context.Context, in *QueryRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryRecordsByOwnerResponse, error)
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	GetCid(ctx context.Context, in *QueryGetCidRequest, opts ...grpc.CallOption) (*QueryGetCidResponse, error)
-	HasCid(ctx context.Context, in *QueryHasCidRequest, opts ...grpc.CallOption) (*QueryHasCidResponse, error)
-	GetCidSize(ctx context.Context, in *QueryGetCidSizeRequest, opts ...grpc.CallOption) (*QueryGetCidSizeResponse, error)
+func (m *QueryPendingOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryPendingOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryPendingOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnershipTransferResponse.Merge(m, src)
 }
-
-func (c *queryClient) RecordsById(ctx context.Context, in *QueryRecordsByIdRequest, opts ...grpc.CallOption) (*QueryRecordsByIdResponse, error) {
-	out := new(QueryRecordsByIdResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsById", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPendingOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *queryClient) RecordsByFingerprint(ctx context.Context, in *QueryRecordsByFingerprintRequest, opts ...grpc.CallOption) (*QueryRecordsByFingerprintResponse, error) {
-	out := new(QueryRecordsByFingerprintResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsByFingerprint", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryPendingOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnershipTransferResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) RecordsByOwner(ctx context.Context, in *QueryRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryRecordsByOwnerResponse, error) {
-	out := new(QueryRecordsByOwnerResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsByOwner", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingOwnershipTransferResponse proto.InternalMessageInfo
+
+func (m *QueryPendingOwnershipTransferResponse) GetPendingOwnershipTransfer() PendingOwnershipTransfer {
+	if m != nil {
+		return m.PendingOwnershipTransfer
 	}
-	return out, nil
+	return PendingOwnershipTransfer{}
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryPendingOwnershipTransfersByNewOwnerRequest struct {
+	NewOwner   string             `protobuf:"bytes,1,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) GetCid(ctx context.Context, in *QueryGetCidRequest, opts ...grpc.CallOption) (*QueryGetCidResponse, error) {
-	out := new(QueryGetCidResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/GetCid", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) Reset() {
+	*m = QueryPendingOwnershipTransfersByNewOwnerRequest{}
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryPendingOwnershipTransfersByNewOwnerRequest) ProtoMessage() {}
+func (*QueryPendingOwnershipTransfersByNewOwnerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{16}
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerRequest.Merge(m, src)
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) HasCid(ctx context.Context, in *QueryHasCidRequest, opts ...grpc.CallOption) (*QueryHasCidResponse, error) {
-	out := new(QueryHasCidResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/HasCid", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerRequest proto.InternalMessageInfo
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetCidSize(ctx context.Context, in *QueryGetCidSizeRequest, opts ...grpc.CallOption) (*QueryGetCidSizeResponse, error) {
-	out := new(QueryGetCidSizeResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/GetCidSize", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	RecordsById(context.Context, *QueryRecordsByIdRequest) (*QueryRecordsByIdResponse, error)
-	RecordsByFingerprint(context.Context, *QueryRecordsByFingerprintRequest) (*QueryRecordsByFingerprintResponse, error)
-	RecordsByOwner(context.Context, *QueryRecordsByOwnerRequest) (*QueryRecordsByOwnerResponse, error)
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	GetCid(context.Context, *QueryGetCidRequest) (*QueryGetCidResponse, error)
-	HasCid(context.Context, *QueryHasCidRequest) (*QueryHasCidResponse, error)
-	GetCidSize(context.Context, *QueryGetCidSizeRequest) (*QueryGetCidSizeResponse, error)
+type QueryPendingOwnershipTransfersByNewOwnerResponse struct {
+	PendingOwnershipTransfers []PendingOwnershipTransfer `protobuf:"bytes,1,rep,name=pending_ownership_transfers,json=pendingOwnershipTransfers,proto3" json:"pending_ownership_transfers"`
+	Pagination                *query.PageResponse        `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) Reset() {
+	*m = QueryPendingOwnershipTransfersByNewOwnerResponse{}
 }
-
-func (*UnimplementedQueryServer) RecordsById(ctx context.Context, req *QueryRecordsByIdRequest) (*QueryRecordsByIdResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordsById not implemented")
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) String() string {
+	return proto.CompactTextString(m)
 }
-func (*UnimplementedQueryServer) RecordsByFingerprint(ctx context.Context, req *QueryRecordsByFingerprintRequest) (*QueryRecordsByFingerprintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordsByFingerprint not implemented")
+func (*QueryPendingOwnershipTransfersByNewOwnerResponse) ProtoMessage() {}
+func (*QueryPendingOwnershipTransfersByNewOwnerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{17}
 }
-func (*UnimplementedQueryServer) RecordsByOwner(ctx context.Context, req *QueryRecordsByOwnerRequest) (*QueryRecordsByOwnerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RecordsByOwner not implemented")
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetCid(ctx context.Context, req *QueryGetCidRequest) (*QueryGetCidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCid not implemented")
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) HasCid(ctx context.Context, req *QueryHasCidRequest) (*QueryHasCidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method HasCid not implemented")
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) GetCidSize(ctx context.Context, req *QueryGetCidSizeRequest) (*QueryGetCidSizeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCidSize not implemented")
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryPendingOwnershipTransfersByNewOwnerResponse proto.InternalMessageInfo
 
-func _Query_RecordsById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecordsByIdRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordsById(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/RecordsById",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordsById(ctx, req.(*QueryRecordsByIdRequest))
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) GetPendingOwnershipTransfers() []PendingOwnershipTransfer {
+	if m != nil {
+		return m.PendingOwnershipTransfers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RecordsByFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecordsByFingerprintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordsByFingerprint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/RecordsByFingerprint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordsByFingerprint(ctx, req.(*QueryRecordsByFingerprintRequest))
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_RecordsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRecordsByOwnerRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RecordsByOwner(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/RecordsByOwner",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RecordsByOwner(ctx, req.(*QueryRecordsByOwnerRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryParamsRequest struct {
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{18}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func _Query_GetCid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/GetCid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCid(ctx, req.(*QueryGetCidRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func _Query_HasCid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryHasCidRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).HasCid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/HasCid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).HasCid(ctx, req.(*QueryHasCidRequest))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{19}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func _Query_GetCidSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetCidSizeRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetCidSize(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Query/GetCidSize",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetCidSize(ctx, req.(*QueryGetCidSizeRequest))
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "likechain.iscn.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "RecordsById",
-			Handler:    _Query_RecordsById_Handler,
-		},
-		{
-			MethodName: "RecordsByFingerprint",
-			Handler:    _Query_RecordsByFingerprint_Handler,
-		},
-		{
-			MethodName: "RecordsByOwner",
-			Handler:    _Query_RecordsByOwner_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetCid",
-			Handler:    _Query_GetCid_Handler,
-		},
-		{
-			MethodName: "HasCid",
-			Handler:    _Query_HasCid_Handler,
-		},
-		{
-			MethodName: "GetCidSize",
-			Handler:    _Query_GetCidSize_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "likechain/iscn/query.proto",
+type QueryContentSchemasRequest struct {
+	// optional, returns only the schema of this content metadata type if set
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
 }
 
-func (m *QueryResponseRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryContentSchemasRequest) Reset()         { *m = QueryContentSchemasRequest{} }
+func (m *QueryContentSchemasRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryContentSchemasRequest) ProtoMessage()    {}
+func (*QueryContentSchemasRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{20}
+}
+func (m *QueryContentSchemasRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentSchemasRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentSchemasRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryContentSchemasRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentSchemasRequest.Merge(m, src)
+}
+func (m *QueryContentSchemasRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentSchemasRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentSchemasRequest.DiscardUnknown(m)
 }
 
-func (m *QueryResponseRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryContentSchemasRequest proto.InternalMessageInfo
+
+func (m *QueryContentSchemasRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
 }
 
-func (m *QueryResponseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Data.Size()
-		i -= size
-		if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+type QueryContentSchemasResponse struct {
+	ContentSchemas []ContentSchema `protobuf:"bytes,1,rep,name=content_schemas,json=contentSchemas,proto3" json:"content_schemas"`
+}
+
+func (m *QueryContentSchemasResponse) Reset()         { *m = QueryContentSchemasResponse{} }
+func (m *QueryContentSchemasResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContentSchemasResponse) ProtoMessage()    {}
+func (*QueryContentSchemasResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{21}
+}
+func (m *QueryContentSchemasResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContentSchemasResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContentSchemasResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Ipld) > 0 {
-		i -= len(m.Ipld)
-		copy(dAtA[i:], m.Ipld)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ipld)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryContentSchemasResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContentSchemasResponse.Merge(m, src)
+}
+func (m *QueryContentSchemasResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContentSchemasResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContentSchemasResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsByIdRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryContentSchemasResponse proto.InternalMessageInfo
+
+func (m *QueryContentSchemasResponse) GetContentSchemas() []ContentSchema {
+	if m != nil {
+		return m.ContentSchemas
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRecordsByIdRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCollectedFeesRequest struct {
 }
 
-func (m *QueryRecordsByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.ToVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.ToVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if m.FromVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.IscnId) > 0 {
-		i -= len(m.IscnId)
-		copy(dAtA[i:], m.IscnId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryCollectedFeesRequest) Reset()         { *m = QueryCollectedFeesRequest{} }
+func (m *QueryCollectedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesRequest) ProtoMessage()    {}
+func (*QueryCollectedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{22}
 }
-
-func (m *QueryRecordsByIdResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryCollectedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryCollectedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesRequest.Merge(m, src)
+}
+func (m *QueryCollectedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsByIdResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryCollectedFeesRequest proto.InternalMessageInfo
+
+type QueryCollectedFeesResponse struct {
+	CollectedFees types.CollectedFees `protobuf:"bytes,1,opt,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
 }
 
-func (m *QueryRecordsByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+func (m *QueryCollectedFeesResponse) Reset()         { *m = QueryCollectedFeesResponse{} }
+func (m *QueryCollectedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesResponse) ProtoMessage()    {}
+func (*QueryCollectedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{23}
+}
+func (m *QueryCollectedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.LatestVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LatestVersion))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCollectedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesResponse.Merge(m, src)
+}
+func (m *QueryCollectedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsByFingerprintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCollectedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryCollectedFeesResponse) GetCollectedFees() types.CollectedFees {
+	if m != nil {
+		return m.CollectedFees
 	}
-	return dAtA[:n], nil
+	return types.CollectedFees{}
 }
 
-func (m *QueryRecordsByFingerprintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCommitmentRequest struct {
+	// hex encoded commitment hash
+	Commitment string `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
 }
 
-func (m *QueryRecordsByFingerprintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FromSequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryCommitmentRequest) Reset()         { *m = QueryCommitmentRequest{} }
+func (m *QueryCommitmentRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentRequest) ProtoMessage()    {}
+func (*QueryCommitmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{24}
+}
+func (m *QueryCommitmentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCommitmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentRequest.Merge(m, src)
+}
+func (m *QueryCommitmentRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentRequest.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsByFingerprintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommitmentRequest proto.InternalMessageInfo
+
+func (m *QueryCommitmentRequest) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryRecordsByFingerprintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryCommitmentResponse struct {
+	Commitment IscnCommitment `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment"`
 }
 
-func (m *QueryRecordsByFingerprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryCommitmentResponse) Reset()         { *m = QueryCommitmentResponse{} }
+func (m *QueryCommitmentResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCommitmentResponse) ProtoMessage()    {}
+func (*QueryCommitmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{25}
+}
+func (m *QueryCommitmentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCommitmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCommitmentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryCommitmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCommitmentResponse.Merge(m, src)
+}
+func (m *QueryCommitmentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCommitmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCommitmentResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsByOwnerRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCommitmentResponse proto.InternalMessageInfo
+
+func (m *QueryCommitmentResponse) GetCommitment() IscnCommitment {
+	if m != nil {
+		return m.Commitment
 	}
-	return dAtA[:n], nil
+	return IscnCommitment{}
 }
 
-func (m *QueryRecordsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAttestationsByAttesterRequest struct {
+	Attester   string             `protobuf:"bytes,1,opt,name=attester,proto3" json:"attester,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRecordsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.FromSequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.FromSequence))
-		i--
-		dAtA[i] = 0x10
+func (m *QueryAttestationsByAttesterRequest) Reset()         { *m = QueryAttestationsByAttesterRequest{} }
+func (m *QueryAttestationsByAttesterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsByAttesterRequest) ProtoMessage()    {}
+func (*QueryAttestationsByAttesterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{26}
+}
+func (m *QueryAttestationsByAttesterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsByAttesterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsByAttesterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *QueryAttestationsByAttesterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsByAttesterRequest.Merge(m, src)
+}
+func (m *QueryAttestationsByAttesterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsByAttesterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsByAttesterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestationsByAttesterRequest proto.InternalMessageInfo
+
+func (m *QueryAttestationsByAttesterRequest) GetAttester() string {
+	if m != nil {
+		return m.Attester
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryRecordsByOwnerResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAttestationsByAttesterRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryRecordsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryAttestationsByAttesterResponse struct {
+	Attestations []IscnAttestation   `protobuf:"bytes,1,rep,name=attestations,proto3" json:"attestations"`
+	Pagination   *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRecordsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.NextSequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequence))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.Records) > 0 {
-		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryAttestationsByAttesterResponse) Reset()         { *m = QueryAttestationsByAttesterResponse{} }
+func (m *QueryAttestationsByAttesterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestationsByAttesterResponse) ProtoMessage()    {}
+func (*QueryAttestationsByAttesterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{27}
+}
+func (m *QueryAttestationsByAttesterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestationsByAttesterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestationsByAttesterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryAttestationsByAttesterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestationsByAttesterResponse.Merge(m, src)
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAttestationsByAttesterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestationsByAttesterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestationsByAttesterResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryAttestationsByAttesterResponse proto.InternalMessageInfo
+
+func (m *QueryAttestationsByAttesterResponse) GetAttestations() []IscnAttestation {
+	if m != nil {
+		return m.Attestations
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryAttestationsByAttesterResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryOwnershipHistoryRequest struct {
+	// version part is ignored
+	IscnId     string             `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryOwnershipHistoryRequest) Reset()         { *m = QueryOwnershipHistoryRequest{} }
+func (m *QueryOwnershipHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipHistoryRequest) ProtoMessage()    {}
+func (*QueryOwnershipHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{28}
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipHistoryRequest.Merge(m, src)
+}
+func (m *QueryOwnershipHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetCidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOwnershipHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryOwnershipHistoryRequest) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetCidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOwnershipHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryGetCidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+type QueryOwnershipHistoryResponse struct {
+	Entries    []IscnOwnershipHistoryEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOwnershipHistoryResponse) Reset()         { *m = QueryOwnershipHistoryResponse{} }
+func (m *QueryOwnershipHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOwnershipHistoryResponse) ProtoMessage()    {}
+func (*QueryOwnershipHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{29}
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOwnershipHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryGetCidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryOwnershipHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOwnershipHistoryResponse.Merge(m, src)
+}
+func (m *QueryOwnershipHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOwnershipHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOwnershipHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetCidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryOwnershipHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryOwnershipHistoryResponse) GetEntries() []IscnOwnershipHistoryEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryGetCidSizeRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryOwnershipHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetCidSizeRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type QueryRelatedRecordsRequest struct {
+	// For outgoing relations, the relations declared by the given version are returned, with version 0 meaning the
+	// latest version.
+	// For incoming relations, version part is ignored, and all record versions declaring the relation are returned.
+	IscnId    string            `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	Direction RelationDirection `protobuf:"varint,2,opt,name=direction,proto3,enum=likechain.iscn.RelationDirection" json:"direction,omitempty"`
+	// Only returns relations of this type if non-empty
+	RelationType string             `protobuf:"bytes,3,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	Pagination   *query.PageRequest `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetCidSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryRelatedRecordsRequest) Reset()         { *m = QueryRelatedRecordsRequest{} }
+func (m *QueryRelatedRecordsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRelatedRecordsRequest) ProtoMessage()    {}
+func (*QueryRelatedRecordsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{30}
+}
+func (m *QueryRelatedRecordsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelatedRecordsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelatedRecordsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRelatedRecordsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelatedRecordsRequest.Merge(m, src)
+}
+func (m *QueryRelatedRecordsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelatedRecordsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelatedRecordsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetCidSizeResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRelatedRecordsRequest proto.InternalMessageInfo
+
+func (m *QueryRelatedRecordsRequest) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetCidSizeResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRelatedRecordsRequest) GetDirection() RelationDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return RELATION_DIRECTION_OUTGOING
 }
 
-func (m *QueryGetCidSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryRelatedRecordsRequest) GetRelationType() string {
+	if m != nil {
+		return m.RelationType
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryHasCidRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRelatedRecordsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHasCidRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type RelatedRecord struct {
+	RelationType string `protobuf:"bytes,1,opt,name=relation_type,json=relationType,proto3" json:"relation_type,omitempty"`
+	// The ISCN ID of the record version declaring the relation
+	SourceIscnId       string `protobuf:"bytes,2,opt,name=source_iscn_id,json=sourceIscnId,proto3" json:"source_iscn_id,omitempty"`
+	TargetIscnIdPrefix string `protobuf:"bytes,3,opt,name=target_iscn_id_prefix,json=targetIscnIdPrefix,proto3" json:"target_iscn_id_prefix,omitempty"`
+	// The latest version of the target record for outgoing relations, or the source record for incoming relations
+	Record QueryResponseRecord `protobuf:"bytes,4,opt,name=record,proto3" json:"record"`
 }
 
-func (m *QueryHasCidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Cid) > 0 {
-		i -= len(m.Cid)
-		copy(dAtA[i:], m.Cid)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cid)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *RelatedRecord) Reset()         { *m = RelatedRecord{} }
+func (m *RelatedRecord) String() string { return proto.CompactTextString(m) }
+func (*RelatedRecord) ProtoMessage()    {}
+func (*RelatedRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{31}
 }
-
-func (m *QueryHasCidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryHasCidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *RelatedRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryHasCidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Exist {
-		i--
-		if m.Exist {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *RelatedRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RelatedRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *RelatedRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RelatedRecord.Merge(m, src)
 }
-func (m *QueryResponseRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Ipld)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.Data.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *RelatedRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *RelatedRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_RelatedRecord.DiscardUnknown(m)
 }
 
-func (m *QueryRecordsByIdRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IscnId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FromVersion != 0 {
-		n += 1 + sovQuery(uint64(m.FromVersion))
-	}
-	if m.ToVersion != 0 {
-		n += 1 + sovQuery(uint64(m.ToVersion))
+var xxx_messageInfo_RelatedRecord proto.InternalMessageInfo
+
+func (m *RelatedRecord) GetRelationType() string {
+	if m != nil {
+		return m.RelationType
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRecordsByIdResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.LatestVersion != 0 {
-		n += 1 + sovQuery(uint64(m.LatestVersion))
-	}
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+func (m *RelatedRecord) GetSourceIscnId() string {
+	if m != nil {
+		return m.SourceIscnId
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRecordsByFingerprintRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FromSequence != 0 {
-		n += 1 + sovQuery(uint64(m.FromSequence))
+func (m *RelatedRecord) GetTargetIscnIdPrefix() string {
+	if m != nil {
+		return m.TargetIscnIdPrefix
 	}
-	return n
+	return ""
 }
 
-func (m *QueryRecordsByFingerprintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.NextSequence != 0 {
-		n += 1 + sovQuery(uint64(m.NextSequence))
+func (m *RelatedRecord) GetRecord() QueryResponseRecord {
+	if m != nil {
+		return m.Record
 	}
-	return n
+	return QueryResponseRecord{}
 }
 
-func (m *QueryRecordsByOwnerRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.FromSequence != 0 {
-		n += 1 + sovQuery(uint64(m.FromSequence))
-	}
-	return n
+type QueryRelatedRecordsResponse struct {
+	Relations  []RelatedRecord     `protobuf:"bytes,1,rep,name=relations,proto3" json:"relations"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryRecordsByOwnerResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Records) > 0 {
-		for _, e := range m.Records {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *QueryRelatedRecordsResponse) Reset()         { *m = QueryRelatedRecordsResponse{} }
+func (m *QueryRelatedRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRelatedRecordsResponse) ProtoMessage()    {}
+func (*QueryRelatedRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{32}
+}
+func (m *QueryRelatedRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRelatedRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRelatedRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.NextSequence != 0 {
-		n += 1 + sovQuery(uint64(m.NextSequence))
-	}
-	return n
+}
+func (m *QueryRelatedRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRelatedRecordsResponse.Merge(m, src)
+}
+func (m *QueryRelatedRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRelatedRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRelatedRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryRelatedRecordsResponse proto.InternalMessageInfo
+
+func (m *QueryRelatedRecordsResponse) GetRelations() []RelatedRecord {
+	if m != nil {
+		return m.Relations
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryRelatedRecordsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryGetCidRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+type QueryGetCidRequest struct {
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
 }
 
-func (m *QueryGetCidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryGetCidRequest) Reset()         { *m = QueryGetCidRequest{} }
+func (m *QueryGetCidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCidRequest) ProtoMessage()    {}
+func (*QueryGetCidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{33}
+}
+func (m *QueryGetCidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetCidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCidRequest.Merge(m, src)
+}
+func (m *QueryGetCidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetCidSizeRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryGetCidRequest proto.InternalMessageInfo
+
+func (m *QueryGetCidRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
 	}
-	return n
+	return ""
+}
+
+type QueryGetCidResponse struct {
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *QueryGetCidResponse) Reset()         { *m = QueryGetCidResponse{} }
+func (m *QueryGetCidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCidResponse) ProtoMessage()    {}
+func (*QueryGetCidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{34}
+}
+func (m *QueryGetCidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCidResponse.Merge(m, src)
+}
+func (m *QueryGetCidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCidResponse proto.InternalMessageInfo
+
+func (m *QueryGetCidResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+type QueryGetCidSizeRequest struct {
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (m *QueryGetCidSizeRequest) Reset()         { *m = QueryGetCidSizeRequest{} }
+func (m *QueryGetCidSizeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCidSizeRequest) ProtoMessage()    {}
+func (*QueryGetCidSizeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{35}
+}
+func (m *QueryGetCidSizeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCidSizeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCidSizeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCidSizeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCidSizeRequest.Merge(m, src)
+}
+func (m *QueryGetCidSizeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCidSizeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCidSizeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCidSizeRequest proto.InternalMessageInfo
+
+func (m *QueryGetCidSizeRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type QueryGetCidSizeResponse struct {
+	Size_ uint64 `protobuf:"varint,1,opt,name=size,proto3" json:"size,omitempty"`
+}
+
+func (m *QueryGetCidSizeResponse) Reset()         { *m = QueryGetCidSizeResponse{} }
+func (m *QueryGetCidSizeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCidSizeResponse) ProtoMessage()    {}
+func (*QueryGetCidSizeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{36}
+}
+func (m *QueryGetCidSizeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCidSizeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCidSizeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCidSizeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCidSizeResponse.Merge(m, src)
+}
+func (m *QueryGetCidSizeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCidSizeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCidSizeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCidSizeResponse proto.InternalMessageInfo
+
+func (m *QueryGetCidSizeResponse) GetSize_() uint64 {
+	if m != nil {
+		return m.Size_
+	}
+	return 0
+}
+
+type QueryHasCidRequest struct {
+	Cid string `protobuf:"bytes,1,opt,name=cid,proto3" json:"cid,omitempty"`
+}
+
+func (m *QueryHasCidRequest) Reset()         { *m = QueryHasCidRequest{} }
+func (m *QueryHasCidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHasCidRequest) ProtoMessage()    {}
+func (*QueryHasCidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{37}
+}
+func (m *QueryHasCidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasCidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasCidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasCidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasCidRequest.Merge(m, src)
+}
+func (m *QueryHasCidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasCidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasCidRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasCidRequest proto.InternalMessageInfo
+
+func (m *QueryHasCidRequest) GetCid() string {
+	if m != nil {
+		return m.Cid
+	}
+	return ""
+}
+
+type QueryHasCidResponse struct {
+	Exist bool `protobuf:"varint,1,opt,name=exist,proto3" json:"exist,omitempty"`
+}
+
+func (m *QueryHasCidResponse) Reset()         { *m = QueryHasCidResponse{} }
+func (m *QueryHasCidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHasCidResponse) ProtoMessage()    {}
+func (*QueryHasCidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_30477fb2f7bdc5a8, []int{38}
+}
+func (m *QueryHasCidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasCidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasCidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasCidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasCidResponse.Merge(m, src)
+}
+func (m *QueryHasCidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasCidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasCidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasCidResponse proto.InternalMessageInfo
+
+func (m *QueryHasCidResponse) GetExist() bool {
+	if m != nil {
+		return m.Exist
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterEnum("likechain.iscn.RelationDirection", RelationDirection_name, RelationDirection_value)
+	proto.RegisterType((*QueryResponseRecord)(nil), "likechain.iscn.QueryResponseRecord")
+	proto.RegisterType((*QueryRecordsByIdRequest)(nil), "likechain.iscn.QueryRecordsByIdRequest")
+	proto.RegisterType((*QueryRecordsByIdResponse)(nil), "likechain.iscn.QueryRecordsByIdResponse")
+	proto.RegisterType((*QueryRecordsByFingerprintRequest)(nil), "likechain.iscn.QueryRecordsByFingerprintRequest")
+	proto.RegisterType((*QueryRecordsByFingerprintResponse)(nil), "likechain.iscn.QueryRecordsByFingerprintResponse")
+	proto.RegisterType((*QueryRecordsByOwnerRequest)(nil), "likechain.iscn.QueryRecordsByOwnerRequest")
+	proto.RegisterType((*QueryRecordsByOwnerResponse)(nil), "likechain.iscn.QueryRecordsByOwnerResponse")
+	proto.RegisterType((*QueryRecordsByStakeholderRequest)(nil), "likechain.iscn.QueryRecordsByStakeholderRequest")
+	proto.RegisterType((*QueryRecordsByStakeholderResponse)(nil), "likechain.iscn.QueryRecordsByStakeholderResponse")
+	proto.RegisterType((*QueryRecordsByTimeRangeRequest)(nil), "likechain.iscn.QueryRecordsByTimeRangeRequest")
+	proto.RegisterType((*QueryRecordsByTimeRangeResponse)(nil), "likechain.iscn.QueryRecordsByTimeRangeResponse")
+	proto.RegisterType((*QueryRecordDiffRequest)(nil), "likechain.iscn.QueryRecordDiffRequest")
+	proto.RegisterType((*JsonPatchOperation)(nil), "likechain.iscn.JsonPatchOperation")
+	proto.RegisterType((*QueryRecordDiffResponse)(nil), "likechain.iscn.QueryRecordDiffResponse")
+	proto.RegisterType((*QueryPendingOwnershipTransferRequest)(nil), "likechain.iscn.QueryPendingOwnershipTransferRequest")
+	proto.RegisterType((*QueryPendingOwnershipTransferResponse)(nil), "likechain.iscn.QueryPendingOwnershipTransferResponse")
+	proto.RegisterType((*QueryPendingOwnershipTransfersByNewOwnerRequest)(nil), "likechain.iscn.QueryPendingOwnershipTransfersByNewOwnerRequest")
+	proto.RegisterType((*QueryPendingOwnershipTransfersByNewOwnerResponse)(nil), "likechain.iscn.QueryPendingOwnershipTransfersByNewOwnerResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "likechain.iscn.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "likechain.iscn.QueryParamsResponse")
+	proto.RegisterType((*QueryContentSchemasRequest)(nil), "likechain.iscn.QueryContentSchemasRequest")
+	proto.RegisterType((*QueryContentSchemasResponse)(nil), "likechain.iscn.QueryContentSchemasResponse")
+	proto.RegisterType((*QueryCollectedFeesRequest)(nil), "likechain.iscn.QueryCollectedFeesRequest")
+	proto.RegisterType((*QueryCollectedFeesResponse)(nil), "likechain.iscn.QueryCollectedFeesResponse")
+	proto.RegisterType((*QueryCommitmentRequest)(nil), "likechain.iscn.QueryCommitmentRequest")
+	proto.RegisterType((*QueryCommitmentResponse)(nil), "likechain.iscn.QueryCommitmentResponse")
+	proto.RegisterType((*QueryAttestationsByAttesterRequest)(nil), "likechain.iscn.QueryAttestationsByAttesterRequest")
+	proto.RegisterType((*QueryAttestationsByAttesterResponse)(nil), "likechain.iscn.QueryAttestationsByAttesterResponse")
+	proto.RegisterType((*QueryOwnershipHistoryRequest)(nil), "likechain.iscn.QueryOwnershipHistoryRequest")
+	proto.RegisterType((*QueryOwnershipHistoryResponse)(nil), "likechain.iscn.QueryOwnershipHistoryResponse")
+	proto.RegisterType((*QueryRelatedRecordsRequest)(nil), "likechain.iscn.QueryRelatedRecordsRequest")
+	proto.RegisterType((*RelatedRecord)(nil), "likechain.iscn.RelatedRecord")
+	proto.RegisterType((*QueryRelatedRecordsResponse)(nil), "likechain.iscn.QueryRelatedRecordsResponse")
+	proto.RegisterType((*QueryGetCidRequest)(nil), "likechain.iscn.QueryGetCidRequest")
+	proto.RegisterType((*QueryGetCidResponse)(nil), "likechain.iscn.QueryGetCidResponse")
+	proto.RegisterType((*QueryGetCidSizeRequest)(nil), "likechain.iscn.QueryGetCidSizeRequest")
+	proto.RegisterType((*QueryGetCidSizeResponse)(nil), "likechain.iscn.QueryGetCidSizeResponse")
+	proto.RegisterType((*QueryHasCidRequest)(nil), "likechain.iscn.QueryHasCidRequest")
+	proto.RegisterType((*QueryHasCidResponse)(nil), "likechain.iscn.QueryHasCidResponse")
+}
+
+func init() { proto.RegisterFile("likechain/iscn/query.proto", fileDescriptor_30477fb2f7bdc5a8) }
+
+var fileDescriptor_30477fb2f7bdc5a8 = []byte{
+	// 2152 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0x4f, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xdb, 0x8e, 0x13, 0x3f, 0xdb, 0x43, 0xb6, 0xec, 0xd8, 0x93, 0x9e, 0x78, 0x6c, 0xf7,
+	0xc4, 0xb1, 0xe3, 0x90, 0x99, 0xd8, 0x1b, 0xa4, 0xbd, 0x85, 0xd8, 0x49, 0x9c, 0x81, 0x60, 0x9b,
+	0xb1, 0xe1, 0x00, 0x87, 0x56, 0xbb, 0xa7, 0x3c, 0xd3, 0x8a, 0xa7, 0xab, 0xb7, 0xbb, 0x26, 0xc9,
+	0x64, 0xb5, 0x5a, 0x84, 0x00, 0xe5, 0x88, 0x84, 0xf8, 0x73, 0x5a, 0x0e, 0x08, 0x24, 0xc4, 0x09,
+	0x21, 0x21, 0xc1, 0x27, 0xd8, 0x63, 0xa4, 0x45, 0x08, 0x71, 0x58, 0xa1, 0x84, 0x1b, 0x7c, 0x08,
+	0xd4, 0x55, 0xaf, 0xa7, 0xbb, 0xa7, 0xbb, 0x67, 0x6c, 0x63, 0x81, 0x72, 0xab, 0x79, 0xf5, 0x5e,
+	0xbd, 0xdf, 0x7b, 0x55, 0xaf, 0xeb, 0xfd, 0x6a, 0x40, 0x3d, 0xb6, 0x9e, 0x52, 0xb3, 0x69, 0x58,
+	0x76, 0xc5, 0xf2, 0x4c, 0xbb, 0xf2, 0x61, 0x9b, 0xba, 0x9d, 0xb2, 0xe3, 0x32, 0xce, 0x48, 0xae,
+	0x3b, 0x57, 0xf6, 0xe7, 0xd4, 0x35, 0x93, 0x79, 0x2d, 0xe6, 0x55, 0x0e, 0x0d, 0x8f, 0x4a, 0xc5,
+	0xca, 0xb3, 0xf5, 0x43, 0xca, 0x8d, 0xf5, 0x8a, 0x63, 0x34, 0x2c, 0xdb, 0xe0, 0x16, 0xb3, 0xa5,
+	0xad, 0x3a, 0xd3, 0x60, 0x0d, 0x26, 0x86, 0x15, 0x7f, 0x84, 0xd2, 0x6b, 0x0d, 0xc6, 0x1a, 0xc7,
+	0xb4, 0x62, 0x38, 0x56, 0xc5, 0xb0, 0x6d, 0xc6, 0x85, 0x89, 0x87, 0xb3, 0x85, 0x1e, 0x2c, 0x8e,
+	0xe1, 0x1a, 0xad, 0x60, 0xb2, 0x17, 0xa8, 0xc7, 0x99, 0x4b, 0x71, 0x6e, 0x35, 0x9c, 0xf3, 0x47,
+	0x47, 0x94, 0x36, 0x5c, 0xc3, 0xe6, 0x95, 0x23, 0x4a, 0x75, 0x97, 0xb5, 0xb9, 0x65, 0x37, 0xa4,
+	0xa6, 0xb6, 0x07, 0xd3, 0xdf, 0xf4, 0x81, 0xd7, 0xa8, 0xe7, 0x30, 0xdb, 0xa3, 0x35, 0x6a, 0x32,
+	0xb7, 0x4e, 0x08, 0x8c, 0x5a, 0xce, 0x71, 0x3d, 0xaf, 0x2c, 0x2a, 0xab, 0xe3, 0x35, 0x31, 0x26,
+	0xcb, 0x30, 0x5a, 0x37, 0xb8, 0x91, 0x1f, 0x5e, 0x54, 0x56, 0x27, 0x37, 0xdf, 0xfb, 0xec, 0x8b,
+	0x85, 0xa1, 0xbf, 0x7f, 0xb1, 0x30, 0x5e, 0xf5, 0x4c, 0xbb, 0x6a, 0x3b, 0x6d, 0x5e, 0x13, 0xd3,
+	0x1a, 0x87, 0x39, 0x5c, 0xd1, 0x5f, 0xc9, 0xdb, 0xec, 0x54, 0xeb, 0x35, 0xfa, 0x61, 0x9b, 0x7a,
+	0x9c, 0xcc, 0xc1, 0x45, 0x1f, 0xaa, 0x6e, 0x05, 0x0b, 0x8f, 0xf9, 0x3f, 0xab, 0x75, 0xb2, 0x04,
+	0x93, 0x47, 0x2e, 0x6b, 0xe9, 0xcf, 0xa8, 0xeb, 0x59, 0xcc, 0x16, 0x2e, 0x46, 0x6b, 0x13, 0xbe,
+	0xec, 0xdb, 0x52, 0x44, 0xe6, 0x01, 0x38, 0xeb, 0x2a, 0x8c, 0x08, 0x85, 0x71, 0xce, 0x70, 0x5a,
+	0xfb, 0xf7, 0x30, 0xe4, 0x93, 0x6e, 0x65, 0x4c, 0x64, 0x06, 0x2e, 0xb0, 0xe7, 0x36, 0x75, 0xd1,
+	0xab, 0xfc, 0x41, 0x96, 0x21, 0x77, 0x6c, 0x70, 0xea, 0xf1, 0x1e, 0xb7, 0x53, 0x52, 0x1a, 0x38,
+	0xde, 0x82, 0x8b, 0xae, 0x5c, 0x33, 0x3f, 0xb2, 0x38, 0xb2, 0x3a, 0xb1, 0x51, 0x2a, 0xc7, 0x8f,
+	0x41, 0x39, 0x25, 0x81, 0x9b, 0xa3, 0x7e, 0x7a, 0x6a, 0x81, 0x25, 0xf9, 0x00, 0xc6, 0x3c, 0x6e,
+	0xf0, 0xb6, 0x97, 0x1f, 0x5d, 0x54, 0x56, 0x73, 0x1b, 0x8b, 0xbd, 0x6b, 0xf8, 0x59, 0x94, 0xa6,
+	0xfb, 0x42, 0xaf, 0x86, 0xfa, 0xa4, 0x04, 0x53, 0x5e, 0xdb, 0xa1, 0xae, 0x47, 0xeb, 0xb4, 0xae,
+	0x1f, 0x76, 0xf2, 0x17, 0x44, 0x0c, 0x93, 0xa1, 0x70, 0xb3, 0x43, 0xae, 0x43, 0x4e, 0xc4, 0xa4,
+	0x37, 0x5c, 0xd6, 0x76, 0xfc, 0xfc, 0x8e, 0x89, 0x50, 0x26, 0x85, 0x74, 0xdb, 0x17, 0x56, 0xeb,
+	0xa4, 0x0a, 0x93, 0x06, 0xf7, 0x43, 0x93, 0x87, 0x2c, 0x7f, 0x51, 0x84, 0xb3, 0x90, 0x06, 0xe5,
+	0x7e, 0xa8, 0x87, 0xa1, 0xc4, 0x4c, 0xb5, 0xdf, 0x2b, 0xb0, 0x18, 0x4f, 0xf7, 0x23, 0xcb, 0x6e,
+	0x50, 0xd7, 0x71, 0x2d, 0x9b, 0x07, 0xdb, 0xbd, 0x08, 0x13, 0x47, 0xa1, 0x14, 0x93, 0x1f, 0x15,
+	0xf9, 0xc1, 0x89, 0x7d, 0xf7, 0x7c, 0x0b, 0xdb, 0xa4, 0xb8, 0x03, 0xe2, 0x30, 0xec, 0xa3, 0x8c,
+	0x3c, 0x02, 0x08, 0xab, 0x49, 0xec, 0xfc, 0xc4, 0xc6, 0x8d, 0xb2, 0x2c, 0xbd, 0xb2, 0x5f, 0x7a,
+	0x65, 0x59, 0xa3, 0x58, 0x7a, 0xe5, 0x3d, 0xa3, 0x41, 0x11, 0x42, 0x2d, 0x62, 0xa9, 0xfd, 0x55,
+	0x81, 0xa5, 0x3e, 0x98, 0xf1, 0xac, 0x44, 0xb6, 0x5b, 0x39, 0xf3, 0x76, 0x97, 0x60, 0xca, 0xa6,
+	0x2f, 0x78, 0x22, 0x2e, 0x5f, 0xd8, 0x8d, 0x6b, 0x3b, 0x25, 0xae, 0x95, 0x81, 0x71, 0xa1, 0xdb,
+	0x68, 0x60, 0xbf, 0x54, 0x40, 0x8d, 0x07, 0xb6, 0xeb, 0x6f, 0x7b, 0xb0, 0x0d, 0xe9, 0xa7, 0xff,
+	0x7f, 0x9a, 0xfa, 0xd7, 0x0a, 0x14, 0x52, 0x11, 0xbe, 0xbb, 0x49, 0xff, 0x63, 0xa2, 0x02, 0xf6,
+	0xb9, 0xf1, 0x94, 0x36, 0xd9, 0x71, 0x3d, 0x4c, 0xfd, 0x32, 0xe4, 0xbc, 0x50, 0x1a, 0x7e, 0xf7,
+	0xa6, 0x22, 0xd2, 0x6a, 0xfd, 0xff, 0x5d, 0x06, 0x31, 0xe0, 0xef, 0xee, 0x8e, 0xfc, 0x79, 0x18,
+	0x8a, 0xf1, 0xc0, 0x0e, 0xac, 0x16, 0xad, 0x19, 0x76, 0x83, 0x46, 0xf6, 0x43, 0x24, 0x9a, 0x5b,
+	0x2d, 0xff, 0x5b, 0xd6, 0x72, 0xc4, 0x7e, 0x8c, 0xd4, 0x44, 0xfa, 0x0f, 0x02, 0xa1, 0x7f, 0x1d,
+	0x71, 0x16, 0x51, 0x1a, 0x16, 0x4a, 0x13, 0x9c, 0x85, 0x2a, 0x0b, 0x20, 0x6e, 0x27, 0xbd, 0x49,
+	0xad, 0x46, 0x93, 0xe3, 0x7d, 0x04, 0xbe, 0xe8, 0xb1, 0x90, 0x90, 0x02, 0x8c, 0x73, 0x16, 0x4c,
+	0x8f, 0x8a, 0xe9, 0x4b, 0x9c, 0xe1, 0x64, 0x09, 0xa6, 0x5c, 0xda, 0xb0, 0x3c, 0xee, 0x76, 0x74,
+	0xdb, 0x68, 0xd1, 0xe0, 0xa3, 0x1e, 0x08, 0x77, 0x8c, 0x16, 0x4d, 0x9e, 0x8a, 0xb1, 0x81, 0xa7,
+	0xe2, 0xe2, 0x99, 0x4f, 0xc5, 0x5f, 0x14, 0x58, 0xc8, 0x4c, 0xde, 0xbb, 0x7b, 0x26, 0xbe, 0xa7,
+	0xc0, 0x6c, 0x24, 0xac, 0x07, 0xd6, 0xd1, 0x51, 0x70, 0x16, 0xae, 0x43, 0x0e, 0x9b, 0x11, 0xdd,
+	0x71, 0xe9, 0x91, 0xf5, 0x02, 0x6b, 0x73, 0x52, 0xf6, 0x24, 0x7b, 0x42, 0x76, 0x0e, 0x9d, 0x89,
+	0x01, 0xe4, 0x6b, 0x1e, 0xb3, 0xf7, 0x0c, 0x6e, 0x36, 0x77, 0x1d, 0xea, 0x0a, 0x60, 0x24, 0x07,
+	0xc3, 0xcc, 0x41, 0x8f, 0xc3, 0xcc, 0xf1, 0x1b, 0x2e, 0xc7, 0xe0, 0x4d, 0xb1, 0xfe, 0x78, 0x4d,
+	0x8c, 0xc9, 0x0a, 0x5c, 0x78, 0x66, 0x1c, 0xb7, 0x69, 0x7e, 0x24, 0xab, 0xe3, 0x92, 0xf3, 0xda,
+	0xaf, 0x15, 0x98, 0x4b, 0x44, 0x89, 0x9b, 0xd6, 0x1b, 0x80, 0x32, 0x28, 0x80, 0xe1, 0x9e, 0x00,
+	0xc8, 0x63, 0x00, 0x16, 0xe0, 0x0e, 0x7a, 0x20, 0xad, 0x77, 0xe7, 0x93, 0x21, 0xe2, 0xc6, 0x47,
+	0x6c, 0xb5, 0x7b, 0x70, 0x5d, 0xc0, 0xdc, 0xa3, 0x76, 0xdd, 0xb2, 0x1b, 0xe2, 0x0e, 0xf0, 0x9a,
+	0x96, 0x73, 0xe0, 0x1a, 0xb6, 0x77, 0x44, 0xdd, 0x41, 0x7d, 0xa2, 0xf6, 0x53, 0x05, 0x96, 0x07,
+	0xac, 0x80, 0x61, 0x1f, 0x83, 0xea, 0x48, 0x1d, 0x9d, 0x05, 0x4a, 0x3a, 0x47, 0x2d, 0xb1, 0xea,
+	0xc4, 0xc6, 0x6a, 0x6f, 0x10, 0x59, 0xab, 0x62, 0x28, 0x79, 0x27, 0x63, 0x5e, 0xfb, 0x99, 0x02,
+	0x95, 0xbe, 0xb8, 0xbc, 0xcd, 0xce, 0x0e, 0x7d, 0x1e, 0xbb, 0x96, 0x0b, 0x30, 0x6e, 0xd3, 0xe7,
+	0x7a, 0xf4, 0x6a, 0xbe, 0x64, 0xa3, 0x4e, 0x4f, 0x59, 0x0f, 0x9f, 0xb9, 0xac, 0xff, 0xa5, 0xc0,
+	0x9d, 0x93, 0x03, 0xc3, 0xdc, 0xd9, 0x50, 0xc8, 0xce, 0x5d, 0x50, 0xfb, 0xa7, 0x4d, 0xde, 0xd5,
+	0xac, 0xe4, 0x79, 0x64, 0x3b, 0x25, 0xd8, 0x33, 0x55, 0xfb, 0x0c, 0x10, 0x19, 0xac, 0xe0, 0x49,
+	0x98, 0x0f, 0xed, 0xeb, 0x30, 0x1d, 0x93, 0x62, 0x94, 0x77, 0x61, 0x4c, 0xf2, 0x29, 0x3c, 0x0d,
+	0xb3, 0x89, 0x80, 0xc4, 0x2c, 0xc2, 0x47, 0x5d, 0xed, 0x0e, 0xb6, 0x5a, 0x5b, 0xcc, 0xe6, 0xd4,
+	0xe6, 0xfb, 0x66, 0x93, 0xb6, 0x8c, 0xc0, 0x95, 0x5f, 0xc5, 0xbc, 0xe3, 0xd0, 0x80, 0x36, 0xf9,
+	0x63, 0xed, 0x29, 0x14, 0x52, 0x2d, 0x10, 0xc6, 0x13, 0xf8, 0x92, 0x29, 0x67, 0x74, 0x4f, 0x4e,
+	0x61, 0x82, 0xe7, 0x7b, 0xf1, 0xc4, 0x16, 0x40, 0x58, 0x39, 0x33, 0xb6, 0xaa, 0x56, 0x80, 0xab,
+	0xe8, 0xec, 0xf8, 0x98, 0x9a, 0x9c, 0xd6, 0x1f, 0x51, 0xda, 0x4d, 0x84, 0x03, 0x6a, 0xda, 0x24,
+	0x02, 0xa9, 0x41, 0xce, 0x0c, 0x26, 0xf4, 0x23, 0x4a, 0x83, 0xbc, 0x2c, 0x47, 0x70, 0x44, 0xc9,
+	0x64, 0x39, 0xb6, 0x0c, 0xe2, 0x99, 0x32, 0xa3, 0x42, 0xed, 0x03, 0xfc, 0xfa, 0x6e, 0xb1, 0x56,
+	0xcb, 0xe2, 0x2d, 0x1a, 0x72, 0x83, 0x22, 0x80, 0xd9, 0x15, 0x62, 0xbe, 0x22, 0x12, 0x4d, 0x87,
+	0xb9, 0x84, 0x25, 0x02, 0x7d, 0x90, 0x30, 0x9d, 0xd8, 0x28, 0xa6, 0x91, 0x98, 0xd0, 0x36, 0xf8,
+	0x16, 0x45, 0x1c, 0xbc, 0x52, 0x40, 0x13, 0x1e, 0x22, 0x54, 0xc7, 0xdb, 0xc4, 0x5f, 0x61, 0x95,
+	0xaa, 0x70, 0xc9, 0x40, 0x51, 0x50, 0xa4, 0xc1, 0xef, 0x73, 0x2b, 0xd2, 0x3f, 0x29, 0x50, 0xea,
+	0x0b, 0x05, 0x03, 0xef, 0xe5, 0x6f, 0xca, 0x99, 0xf9, 0xdb, 0xf9, 0x95, 0xdc, 0x27, 0x70, 0x4d,
+	0x40, 0xef, 0x96, 0xf5, 0x63, 0xcb, 0xe3, 0xcc, 0xed, 0x60, 0x9c, 0xd9, 0x94, 0xff, 0xbc, 0x92,
+	0xf7, 0x07, 0x05, 0xe6, 0x33, 0x10, 0x74, 0xd3, 0x76, 0x91, 0xda, 0xdc, 0xb5, 0x68, 0x90, 0xb1,
+	0x9b, 0x69, 0x19, 0xeb, 0x35, 0x7f, 0x68, 0x73, 0xb7, 0x13, 0x34, 0x2f, 0x68, 0x7f, 0x7e, 0x69,
+	0x7b, 0x1b, 0x52, 0x36, 0xff, 0xb5, 0xa1, 0x8e, 0x5d, 0xd7, 0xc0, 0xac, 0xdd, 0x83, 0xf1, 0xba,
+	0xe5, 0x52, 0xb3, 0xeb, 0x3f, 0xb7, 0xb1, 0xd4, 0x1b, 0x8d, 0x58, 0xd2, 0x62, 0xf6, 0x83, 0x40,
+	0xb1, 0x16, 0xda, 0xc8, 0xce, 0x53, 0xce, 0xeb, 0xe2, 0x53, 0x35, 0x12, 0x74, 0x9e, 0x52, 0x78,
+	0xd0, 0x71, 0x7a, 0x9b, 0xca, 0xd1, 0xff, 0x86, 0xf6, 0x4d, 0xc5, 0x02, 0x4c, 0xba, 0x57, 0x52,
+	0xdc, 0x5f, 0x87, 0x9c, 0xc7, 0xda, 0xae, 0x49, 0xf5, 0x20, 0x09, 0xb2, 0x2b, 0x9a, 0x94, 0xd2,
+	0xaa, 0x4c, 0xc5, 0x3a, 0x5c, 0xe1, 0x86, 0xdb, 0xa0, 0x5c, 0xef, 0x69, 0xe3, 0x64, 0x44, 0x44,
+	0x4e, 0x56, 0xa3, 0xcd, 0xdc, 0x7d, 0x18, 0x93, 0x6d, 0x28, 0xc6, 0x74, 0x8a, 0xfe, 0x15, 0x0d,
+	0xb5, 0xdf, 0x86, 0x4c, 0x36, 0xbe, 0x71, 0x78, 0xd8, 0xee, 0xc3, 0x78, 0x10, 0x4b, 0xe6, 0x87,
+	0x3c, 0x66, 0x8a, 0xeb, 0x87, 0x56, 0xe7, 0x77, 0xc8, 0x6e, 0xe0, 0x75, 0xb8, 0x4d, 0xf9, 0x96,
+	0xd5, 0x7d, 0x84, 0xbb, 0x0c, 0x23, 0x66, 0xf7, 0x5c, 0xf9, 0x43, 0xed, 0x26, 0x4c, 0xc7, 0xf4,
+	0x30, 0x14, 0x82, 0xef, 0x7d, 0xbe, 0xe6, 0x24, 0x3e, 0xee, 0xad, 0xc1, 0x6c, 0x44, 0x75, 0xdf,
+	0x7a, 0x49, 0xb3, 0x97, 0xbd, 0x0d, 0x73, 0x09, 0xdd, 0x70, 0x69, 0xcf, 0x7a, 0x49, 0xb1, 0x19,
+	0x15, 0xe3, 0x2e, 0xda, 0xc7, 0x86, 0xd7, 0x17, 0xed, 0x2d, 0x98, 0x8e, 0xe9, 0x85, 0x6f, 0x7c,
+	0xf4, 0x85, 0xe5, 0xc9, 0x0b, 0xe1, 0x52, 0x4d, 0xfe, 0x58, 0xfb, 0x2e, 0xbc, 0x97, 0x28, 0x07,
+	0xb2, 0x00, 0x85, 0xda, 0xc3, 0x27, 0xf7, 0x0f, 0xaa, 0xbb, 0x3b, 0xfa, 0x83, 0x6a, 0xed, 0xe1,
+	0x96, 0x18, 0xed, 0x7e, 0xeb, 0x60, 0x7b, 0xb7, 0xba, 0xb3, 0x7d, 0x79, 0x28, 0x43, 0xa1, 0xba,
+	0xb3, 0xb5, 0xfb, 0x0d, 0x5f, 0x41, 0x51, 0x47, 0x5f, 0xfd, 0xaa, 0x38, 0xb4, 0xf1, 0xe9, 0x0c,
+	0x5c, 0x10, 0x50, 0xc8, 0x4b, 0x98, 0x88, 0xbc, 0x3b, 0x92, 0x95, 0x8c, 0x73, 0xd5, 0xfb, 0x20,
+	0xaa, 0xae, 0x0e, 0x56, 0x94, 0xe1, 0x69, 0xf9, 0xef, 0x7f, 0xfe, 0xcf, 0x9f, 0x0c, 0x13, 0x72,
+	0x59, 0x3e, 0xf6, 0x22, 0x9b, 0xaa, 0x58, 0x75, 0xf2, 0xa9, 0x02, 0x33, 0x69, 0x2f, 0x5a, 0xe4,
+	0x4e, 0xff, 0xc5, 0x93, 0x0f, 0x76, 0xea, 0xfa, 0x29, 0x2c, 0x10, 0xd7, 0x92, 0xc0, 0x55, 0x20,
+	0x57, 0xe3, 0xb8, 0xa2, 0x8f, 0x7c, 0x3f, 0x52, 0x20, 0x17, 0x7f, 0xf7, 0x21, 0x6b, 0xfd, 0x1d,
+	0x45, 0xfb, 0x64, 0xf5, 0xd6, 0x89, 0x74, 0x11, 0x4e, 0x41, 0xc0, 0xb9, 0x42, 0xa6, 0xe3, 0x70,
+	0xe4, 0x93, 0x57, 0x2c, 0x53, 0x91, 0x47, 0x8f, 0x41, 0x99, 0x4a, 0x3e, 0xec, 0xa8, 0xeb, 0xa7,
+	0xb0, 0xe8, 0x9f, 0xa9, 0xc8, 0x4b, 0x10, 0xf9, 0xb9, 0x02, 0x24, 0xc9, 0xbf, 0x49, 0xb9, 0xbf,
+	0xb3, 0xde, 0x57, 0x0e, 0xb5, 0x72, 0x62, 0x7d, 0x84, 0xb6, 0x28, 0xa0, 0xa9, 0x24, 0x1f, 0x87,
+	0xc6, 0xad, 0x16, 0xd5, 0x5d, 0x01, 0xa1, 0x03, 0x10, 0x72, 0x4b, 0x72, 0xa3, 0x8f, 0x83, 0x08,
+	0xc5, 0x56, 0x57, 0x06, 0xea, 0x21, 0x00, 0x55, 0x00, 0x98, 0x21, 0x24, 0x0e, 0xa0, 0xee, 0x3b,
+	0xfb, 0x9d, 0x02, 0xf9, 0x2c, 0x6e, 0x41, 0xee, 0xa6, 0x7a, 0x18, 0xc0, 0x2f, 0xd5, 0xaf, 0x9c,
+	0xd2, 0x0a, 0x51, 0x6a, 0x02, 0xe5, 0x35, 0xa2, 0xe2, 0xbf, 0x31, 0xc8, 0x91, 0xba, 0xcc, 0xc8,
+	0xaf, 0xc6, 0xcf, 0x15, 0x28, 0x9d, 0x80, 0x6b, 0x91, 0x7b, 0xa7, 0x82, 0x90, 0xa4, 0x8f, 0xea,
+	0x57, 0xcf, 0xbe, 0x00, 0x86, 0x73, 0x53, 0x84, 0x53, 0x22, 0x4b, 0x59, 0xe1, 0x74, 0xe9, 0x29,
+	0x79, 0x0a, 0x63, 0x92, 0x0d, 0x11, 0x2d, 0xdd, 0x6d, 0x94, 0x70, 0xa9, 0xa5, 0xbe, 0x3a, 0xe9,
+	0x1f, 0x34, 0x41, 0xaf, 0x28, 0xa7, 0xae, 0x47, 0x5e, 0x29, 0x90, 0x8b, 0x93, 0xa5, 0x8c, 0xef,
+	0x45, 0x2a, 0x07, 0x53, 0x6f, 0x9d, 0x48, 0x17, 0x51, 0xcc, 0x0b, 0x14, 0x73, 0xe4, 0x8a, 0x44,
+	0xd1, 0xc3, 0xc4, 0xc8, 0x0f, 0x14, 0x98, 0x8a, 0xd1, 0x1c, 0x72, 0x33, 0x63, 0xf5, 0x24, 0xdd,
+	0x52, 0xd7, 0x4e, 0xa2, 0x8a, 0x38, 0xae, 0x09, 0x1c, 0xb3, 0x64, 0x26, 0xc0, 0x11, 0x25, 0x62,
+	0xe4, 0x87, 0x0a, 0x40, 0x48, 0x66, 0x32, 0xca, 0x2f, 0xc1, 0xb1, 0xd4, 0x95, 0x81, 0x7a, 0xe8,
+	0xfd, 0x86, 0xf0, 0xbe, 0x48, 0x8a, 0x81, 0xf7, 0x40, 0xc3, 0xab, 0x7c, 0x14, 0xfe, 0xf8, 0x98,
+	0xfc, 0x46, 0x81, 0xd9, 0x74, 0x8e, 0x42, 0x36, 0x52, 0x7d, 0xf5, 0xe5, 0x56, 0xea, 0xfb, 0xa7,
+	0xb2, 0x41, 0xac, 0x25, 0x81, 0x75, 0x9e, 0x14, 0x24, 0xd6, 0x28, 0xab, 0xa9, 0x74, 0x99, 0xd9,
+	0x2f, 0x14, 0xb8, 0xdc, 0xdb, 0xd0, 0x93, 0x2f, 0xa7, 0xba, 0xcb, 0x20, 0x2e, 0xea, 0xed, 0x13,
+	0x6a, 0x23, 0xac, 0x15, 0x01, 0x6b, 0x89, 0x2c, 0xa4, 0x5c, 0x3c, 0xbe, 0xbe, 0xde, 0x44, 0x14,
+	0xaf, 0xc4, 0x6d, 0x18, 0xed, 0x1d, 0x33, 0x6f, 0xc3, 0x14, 0x66, 0xa0, 0xde, 0x3a, 0x91, 0x6e,
+	0xfa, 0xe9, 0x0e, 0x40, 0xb9, 0x52, 0x9b, 0xd8, 0x30, 0x26, 0x7b, 0xb3, 0x8c, 0xaa, 0x8e, 0xf5,
+	0x8d, 0x6a, 0xa9, 0xaf, 0x4e, 0xfa, 0xfd, 0xeb, 0x77, 0xe7, 0xa6, 0x55, 0xaf, 0x7c, 0x64, 0x5a,
+	0xf5, 0x8f, 0x7d, 0x7f, 0xb2, 0x69, 0xcb, 0xf0, 0x17, 0xeb, 0xfc, 0xd4, 0x52, 0x5f, 0x9d, 0x74,
+	0x7f, 0x4d, 0xc3, 0x8b, 0xf8, 0xfb, 0x04, 0x20, 0xec, 0x3d, 0x33, 0xaa, 0x26, 0xd1, 0xc8, 0xaa,
+	0x2b, 0x03, 0xf5, 0xd2, 0x6f, 0x4d, 0x8c, 0x55, 0xf7, 0x9b, 0x59, 0x09, 0x60, 0xf3, 0xc9, 0x67,
+	0x6f, 0x8a, 0xca, 0xeb, 0x37, 0x45, 0xe5, 0x1f, 0x6f, 0x8a, 0xca, 0x8f, 0xdf, 0x16, 0x87, 0x5e,
+	0xbf, 0x2d, 0x0e, 0xfd, 0xed, 0x6d, 0x71, 0xe8, 0x3b, 0x1b, 0x0d, 0x8b, 0x37, 0xdb, 0x87, 0x65,
+	0x93, 0xb5, 0xc4, 0x3f, 0xf4, 0x26, 0xb3, 0xec, 0xee, 0xe0, 0xb6, 0xfc, 0xe7, 0xfe, 0xd9, 0xdd,
+	0xca, 0x0b, 0xb9, 0xb4, 0xcf, 0x94, 0xbc, 0xc3, 0x31, 0xf1, 0x8f, 0xfd, 0xfb, 0xff, 0x19, 0x00,
+	0x9a, 0x48, 0xe9, 0xa3, 0xa2, 0x20, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	RecordsById(ctx context.Context, in *QueryRecordsByIdRequest, opts ...grpc.CallOption) (*QueryRecordsByIdResponse, error)
+	RecordsByFingerprint(ctx context.Context, in *QueryRecordsByFingerprintRequest, opts ...grpc.CallOption) (*QueryRecordsByFingerprintResponse, error)
+	RecordsByOwner(ctx context.Context, in *QueryRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryRecordsByOwnerResponse, error)
+	RecordsByStakeholder(ctx context.Context, in *QueryRecordsByStakeholderRequest, opts ...grpc.CallOption) (*QueryRecordsByStakeholderResponse, error)
+	RecordsByTimeRange(ctx context.Context, in *QueryRecordsByTimeRangeRequest, opts ...grpc.CallOption) (*QueryRecordsByTimeRangeResponse, error)
+	RecordDiff(ctx context.Context, in *QueryRecordDiffRequest, opts ...grpc.CallOption) (*QueryRecordDiffResponse, error)
+	PendingOwnershipTransfer(ctx context.Context, in *QueryPendingOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryPendingOwnershipTransferResponse, error)
+	PendingOwnershipTransfersByNewOwner(ctx context.Context, in *QueryPendingOwnershipTransfersByNewOwnerRequest, opts ...grpc.CallOption) (*QueryPendingOwnershipTransfersByNewOwnerResponse, error)
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	ContentSchemas(ctx context.Context, in *QueryContentSchemasRequest, opts ...grpc.CallOption) (*QueryContentSchemasResponse, error)
+	// CollectedFees returns the total ISCN per-byte fees routed to each destination
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
+	// Commitment returns the ISCN commitment with the given hash, proving the time when the commitment was made
+	Commitment(ctx context.Context, in *QueryCommitmentRequest, opts ...grpc.CallOption) (*QueryCommitmentResponse, error)
+	// AttestationsByAttester returns the ISCN record attestations made by the attester
+	AttestationsByAttester(ctx context.Context, in *QueryAttestationsByAttesterRequest, opts ...grpc.CallOption) (*QueryAttestationsByAttesterResponse, error)
+	// OwnershipHistory returns the owners of the ISCN record from the oldest to the newest
+	OwnershipHistory(ctx context.Context, in *QueryOwnershipHistoryRequest, opts ...grpc.CallOption) (*QueryOwnershipHistoryResponse, error)
+	// RelatedRecords returns the records related to the ISCN record, in either direction
+	RelatedRecords(ctx context.Context, in *QueryRelatedRecordsRequest, opts ...grpc.CallOption) (*QueryRelatedRecordsResponse, error)
+	GetCid(ctx context.Context, in *QueryGetCidRequest, opts ...grpc.CallOption) (*QueryGetCidResponse, error)
+	HasCid(ctx context.Context, in *QueryHasCidRequest, opts ...grpc.CallOption) (*QueryHasCidResponse, error)
+	GetCidSize(ctx context.Context, in *QueryGetCidSizeRequest, opts ...grpc.CallOption) (*QueryGetCidSizeResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) RecordsById(ctx context.Context, in *QueryRecordsByIdRequest, opts ...grpc.CallOption) (*QueryRecordsByIdResponse, error) {
+	out := new(QueryRecordsByIdResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsById", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordsByFingerprint(ctx context.Context, in *QueryRecordsByFingerprintRequest, opts ...grpc.CallOption) (*QueryRecordsByFingerprintResponse, error) {
+	out := new(QueryRecordsByFingerprintResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsByFingerprint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordsByOwner(ctx context.Context, in *QueryRecordsByOwnerRequest, opts ...grpc.CallOption) (*QueryRecordsByOwnerResponse, error) {
+	out := new(QueryRecordsByOwnerResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsByOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordsByStakeholder(ctx context.Context, in *QueryRecordsByStakeholderRequest, opts ...grpc.CallOption) (*QueryRecordsByStakeholderResponse, error) {
+	out := new(QueryRecordsByStakeholderResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsByStakeholder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordsByTimeRange(ctx context.Context, in *QueryRecordsByTimeRangeRequest, opts ...grpc.CallOption) (*QueryRecordsByTimeRangeResponse, error) {
+	out := new(QueryRecordsByTimeRangeResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordsByTimeRange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecordDiff(ctx context.Context, in *QueryRecordDiffRequest, opts ...grpc.CallOption) (*QueryRecordDiffResponse, error) {
+	out := new(QueryRecordDiffResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RecordDiff", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOwnershipTransfer(ctx context.Context, in *QueryPendingOwnershipTransferRequest, opts ...grpc.CallOption) (*QueryPendingOwnershipTransferResponse, error) {
+	out := new(QueryPendingOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/PendingOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingOwnershipTransfersByNewOwner(ctx context.Context, in *QueryPendingOwnershipTransfersByNewOwnerRequest, opts ...grpc.CallOption) (*QueryPendingOwnershipTransfersByNewOwnerResponse, error) {
+	out := new(QueryPendingOwnershipTransfersByNewOwnerResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/PendingOwnershipTransfersByNewOwner", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ContentSchemas(ctx context.Context, in *QueryContentSchemasRequest, opts ...grpc.CallOption) (*QueryContentSchemasResponse, error) {
+	out := new(QueryContentSchemasResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/ContentSchemas", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/CollectedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Commitment(ctx context.Context, in *QueryCommitmentRequest, opts ...grpc.CallOption) (*QueryCommitmentResponse, error) {
+	out := new(QueryCommitmentResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/Commitment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestationsByAttester(ctx context.Context, in *QueryAttestationsByAttesterRequest, opts ...grpc.CallOption) (*QueryAttestationsByAttesterResponse, error) {
+	out := new(QueryAttestationsByAttesterResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/AttestationsByAttester", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OwnershipHistory(ctx context.Context, in *QueryOwnershipHistoryRequest, opts ...grpc.CallOption) (*QueryOwnershipHistoryResponse, error) {
+	out := new(QueryOwnershipHistoryResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/OwnershipHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RelatedRecords(ctx context.Context, in *QueryRelatedRecordsRequest, opts ...grpc.CallOption) (*QueryRelatedRecordsResponse, error) {
+	out := new(QueryRelatedRecordsResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/RelatedRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCid(ctx context.Context, in *QueryGetCidRequest, opts ...grpc.CallOption) (*QueryGetCidResponse, error) {
+	out := new(QueryGetCidResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/GetCid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) HasCid(ctx context.Context, in *QueryHasCidRequest, opts ...grpc.CallOption) (*QueryHasCidResponse, error) {
+	out := new(QueryHasCidResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/HasCid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCidSize(ctx context.Context, in *QueryGetCidSizeRequest, opts ...grpc.CallOption) (*QueryGetCidSizeResponse, error) {
+	out := new(QueryGetCidSizeResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Query/GetCidSize", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	RecordsById(context.Context, *QueryRecordsByIdRequest) (*QueryRecordsByIdResponse, error)
+	RecordsByFingerprint(context.Context, *QueryRecordsByFingerprintRequest) (*QueryRecordsByFingerprintResponse, error)
+	RecordsByOwner(context.Context, *QueryRecordsByOwnerRequest) (*QueryRecordsByOwnerResponse, error)
+	RecordsByStakeholder(context.Context, *QueryRecordsByStakeholderRequest) (*QueryRecordsByStakeholderResponse, error)
+	RecordsByTimeRange(context.Context, *QueryRecordsByTimeRangeRequest) (*QueryRecordsByTimeRangeResponse, error)
+	RecordDiff(context.Context, *QueryRecordDiffRequest) (*QueryRecordDiffResponse, error)
+	PendingOwnershipTransfer(context.Context, *QueryPendingOwnershipTransferRequest) (*QueryPendingOwnershipTransferResponse, error)
+	PendingOwnershipTransfersByNewOwner(context.Context, *QueryPendingOwnershipTransfersByNewOwnerRequest) (*QueryPendingOwnershipTransfersByNewOwnerResponse, error)
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	ContentSchemas(context.Context, *QueryContentSchemasRequest) (*QueryContentSchemasResponse, error)
+	// CollectedFees returns the total ISCN per-byte fees routed to each destination
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
+	// Commitment returns the ISCN commitment with the given hash, proving the time when the commitment was made
+	Commitment(context.Context, *QueryCommitmentRequest) (*QueryCommitmentResponse, error)
+	// AttestationsByAttester returns the ISCN record attestations made by the attester
+	AttestationsByAttester(context.Context, *QueryAttestationsByAttesterRequest) (*QueryAttestationsByAttesterResponse, error)
+	// OwnershipHistory returns the owners of the ISCN record from the oldest to the newest
+	OwnershipHistory(context.Context, *QueryOwnershipHistoryRequest) (*QueryOwnershipHistoryResponse, error)
+	// RelatedRecords returns the records related to the ISCN record, in either direction
+	RelatedRecords(context.Context, *QueryRelatedRecordsRequest) (*QueryRelatedRecordsResponse, error)
+	GetCid(context.Context, *QueryGetCidRequest) (*QueryGetCidResponse, error)
+	HasCid(context.Context, *QueryHasCidRequest) (*QueryHasCidResponse, error)
+	GetCidSize(context.Context, *QueryGetCidSizeRequest) (*QueryGetCidSizeResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) RecordsById(ctx context.Context, req *QueryRecordsByIdRequest) (*QueryRecordsByIdResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsById not implemented")
+}
+func (*UnimplementedQueryServer) RecordsByFingerprint(ctx context.Context, req *QueryRecordsByFingerprintRequest) (*QueryRecordsByFingerprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsByFingerprint not implemented")
+}
+func (*UnimplementedQueryServer) RecordsByOwner(ctx context.Context, req *QueryRecordsByOwnerRequest) (*QueryRecordsByOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsByOwner not implemented")
+}
+func (*UnimplementedQueryServer) RecordsByStakeholder(ctx context.Context, req *QueryRecordsByStakeholderRequest) (*QueryRecordsByStakeholderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsByStakeholder not implemented")
+}
+func (*UnimplementedQueryServer) RecordsByTimeRange(ctx context.Context, req *QueryRecordsByTimeRangeRequest) (*QueryRecordsByTimeRangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordsByTimeRange not implemented")
+}
+func (*UnimplementedQueryServer) RecordDiff(ctx context.Context, req *QueryRecordDiffRequest) (*QueryRecordDiffResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordDiff not implemented")
+}
+func (*UnimplementedQueryServer) PendingOwnershipTransfer(ctx context.Context, req *QueryPendingOwnershipTransferRequest) (*QueryPendingOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwnershipTransfer not implemented")
+}
+func (*UnimplementedQueryServer) PendingOwnershipTransfersByNewOwner(ctx context.Context, req *QueryPendingOwnershipTransfersByNewOwnerRequest) (*QueryPendingOwnershipTransfersByNewOwnerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingOwnershipTransfersByNewOwner not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) ContentSchemas(ctx context.Context, req *QueryContentSchemasRequest) (*QueryContentSchemasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContentSchemas not implemented")
+}
+func (*UnimplementedQueryServer) CollectedFees(ctx context.Context, req *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
+func (*UnimplementedQueryServer) Commitment(ctx context.Context, req *QueryCommitmentRequest) (*QueryCommitmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Commitment not implemented")
+}
+func (*UnimplementedQueryServer) AttestationsByAttester(ctx context.Context, req *QueryAttestationsByAttesterRequest) (*QueryAttestationsByAttesterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestationsByAttester not implemented")
+}
+func (*UnimplementedQueryServer) OwnershipHistory(ctx context.Context, req *QueryOwnershipHistoryRequest) (*QueryOwnershipHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OwnershipHistory not implemented")
+}
+func (*UnimplementedQueryServer) RelatedRecords(ctx context.Context, req *QueryRelatedRecordsRequest) (*QueryRelatedRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelatedRecords not implemented")
+}
+func (*UnimplementedQueryServer) GetCid(ctx context.Context, req *QueryGetCidRequest) (*QueryGetCidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCid not implemented")
+}
+func (*UnimplementedQueryServer) HasCid(ctx context.Context, req *QueryHasCidRequest) (*QueryHasCidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasCid not implemented")
+}
+func (*UnimplementedQueryServer) GetCidSize(ctx context.Context, req *QueryGetCidSizeRequest) (*QueryGetCidSizeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCidSize not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_RecordsById_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsByIdRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsById(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RecordsById",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsById(ctx, req.(*QueryRecordsByIdRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordsByFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsByFingerprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsByFingerprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RecordsByFingerprint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsByFingerprint(ctx, req.(*QueryRecordsByFingerprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordsByOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsByOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsByOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RecordsByOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsByOwner(ctx, req.(*QueryRecordsByOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordsByStakeholder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsByStakeholderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsByStakeholder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RecordsByStakeholder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsByStakeholder(ctx, req.(*QueryRecordsByStakeholderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordsByTimeRange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordsByTimeRangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordsByTimeRange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RecordsByTimeRange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordsByTimeRange(ctx, req.(*QueryRecordsByTimeRangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecordDiff_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecordDiffRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecordDiff(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RecordDiff",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecordDiff(ctx, req.(*QueryRecordDiffRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOwnershipTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/PendingOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwnershipTransfer(ctx, req.(*QueryPendingOwnershipTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingOwnershipTransfersByNewOwner_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingOwnershipTransfersByNewOwnerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingOwnershipTransfersByNewOwner(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/PendingOwnershipTransfersByNewOwner",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingOwnershipTransfersByNewOwner(ctx, req.(*QueryPendingOwnershipTransfersByNewOwnerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ContentSchemas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryContentSchemasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContentSchemas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/ContentSchemas",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContentSchemas(ctx, req.(*QueryContentSchemasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/CollectedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Commitment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCommitmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Commitment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/Commitment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Commitment(ctx, req.(*QueryCommitmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestationsByAttester_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestationsByAttesterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestationsByAttester(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/AttestationsByAttester",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestationsByAttester(ctx, req.(*QueryAttestationsByAttesterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OwnershipHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOwnershipHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OwnershipHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/OwnershipHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OwnershipHistory(ctx, req.(*QueryOwnershipHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RelatedRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRelatedRecordsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RelatedRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/RelatedRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RelatedRecords(ctx, req.(*QueryRelatedRecordsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/GetCid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCid(ctx, req.(*QueryGetCidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_HasCid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasCidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HasCid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/HasCid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasCid(ctx, req.(*QueryHasCidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCidSize_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCidSizeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCidSize(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Query/GetCidSize",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCidSize(ctx, req.(*QueryGetCidSizeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "likechain.iscn.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RecordsById",
+			Handler:    _Query_RecordsById_Handler,
+		},
+		{
+			MethodName: "RecordsByFingerprint",
+			Handler:    _Query_RecordsByFingerprint_Handler,
+		},
+		{
+			MethodName: "RecordsByOwner",
+			Handler:    _Query_RecordsByOwner_Handler,
+		},
+		{
+			MethodName: "RecordsByStakeholder",
+			Handler:    _Query_RecordsByStakeholder_Handler,
+		},
+		{
+			MethodName: "RecordsByTimeRange",
+			Handler:    _Query_RecordsByTimeRange_Handler,
+		},
+		{
+			MethodName: "RecordDiff",
+			Handler:    _Query_RecordDiff_Handler,
+		},
+		{
+			MethodName: "PendingOwnershipTransfer",
+			Handler:    _Query_PendingOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "PendingOwnershipTransfersByNewOwner",
+			Handler:    _Query_PendingOwnershipTransfersByNewOwner_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ContentSchemas",
+			Handler:    _Query_ContentSchemas_Handler,
+		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
+		{
+			MethodName: "Commitment",
+			Handler:    _Query_Commitment_Handler,
+		},
+		{
+			MethodName: "AttestationsByAttester",
+			Handler:    _Query_AttestationsByAttester_Handler,
+		},
+		{
+			MethodName: "OwnershipHistory",
+			Handler:    _Query_OwnershipHistory_Handler,
+		},
+		{
+			MethodName: "RelatedRecords",
+			Handler:    _Query_RelatedRecords_Handler,
+		},
+		{
+			MethodName: "GetCid",
+			Handler:    _Query_GetCid_Handler,
+		},
+		{
+			MethodName: "HasCid",
+			Handler:    _Query_HasCid_Handler,
+		},
+		{
+			MethodName: "GetCidSize",
+			Handler:    _Query_GetCidSize_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "likechain/iscn/query.proto",
+}
+
+func (m *QueryResponseRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResponseRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResponseRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Data.Size()
+		i -= size
+		if _, err := m.Data.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Ipld) > 0 {
+		i -= len(m.Ipld)
+		copy(dAtA[i:], m.Ipld)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Ipld)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByIdRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByIdRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByIdRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByIdResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByIdResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByIdResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.OwnerGroupId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OwnerGroupId))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.LatestVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByFingerprintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByFingerprintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByFingerprintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByFingerprintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByFingerprintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByFingerprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByStakeholderRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByStakeholderRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByStakeholderRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.FromSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.StakeholderId) > 0 {
+		i -= len(m.StakeholderId)
+		copy(dAtA[i:], m.StakeholderId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StakeholderId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByStakeholderResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByStakeholderResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByStakeholderResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByTimeRangeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByTimeRangeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByTimeRangeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FromSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromSequence))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RegistryName) > 0 {
+		i -= len(m.RegistryName)
+		copy(dAtA[i:], m.RegistryName)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RegistryName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ToHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.FromHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ToTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToTimestamp))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromTimestamp))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordsByTimeRangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordsByTimeRangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordsByTimeRangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.NextSequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextSequence))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordDiffRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordDiffRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordDiffRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ToVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *JsonPatchOperation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *JsonPatchOperation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *JsonPatchOperation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Op) > 0 {
+		i -= len(m.Op)
+		copy(dAtA[i:], m.Op)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Op)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecordDiffResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecordDiffResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecordDiffResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Operations) > 0 {
+		for iNdEx := len(m.Operations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Operations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ToVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ToVersion))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.FromVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.FromVersion))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnershipTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnershipTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnershipTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingOwnershipTransfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingOwnershipTransfers) > 0 {
+		for iNdEx := len(m.PendingOwnershipTransfers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingOwnershipTransfers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContentSchemasRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentSchemasRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentSchemasRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryContentSchemasResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryContentSchemasResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryContentSchemasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ContentSchemas) > 0 {
+		for iNdEx := len(m.ContentSchemas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ContentSchemas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollectedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCommitmentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCommitmentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCommitmentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Commitment.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsByAttesterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsByAttesterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsByAttesterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestationsByAttesterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestationsByAttesterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestationsByAttesterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOwnershipHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOwnershipHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOwnershipHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelatedRecordsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelatedRecordsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelatedRecordsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.RelationType) > 0 {
+		i -= len(m.RelationType)
+		copy(dAtA[i:], m.RelationType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelationType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Direction != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RelatedRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RelatedRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RelatedRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.TargetIscnIdPrefix) > 0 {
+		i -= len(m.TargetIscnIdPrefix)
+		copy(dAtA[i:], m.TargetIscnIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetIscnIdPrefix)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SourceIscnId) > 0 {
+		i -= len(m.SourceIscnId)
+		copy(dAtA[i:], m.SourceIscnId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SourceIscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RelationType) > 0 {
+		i -= len(m.RelationType)
+		copy(dAtA[i:], m.RelationType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.RelationType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRelatedRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRelatedRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRelatedRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCidSizeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCidSizeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCidSizeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCidSizeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCidSizeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCidSizeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Size_))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasCidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasCidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasCidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Cid) > 0 {
+		i -= len(m.Cid)
+		copy(dAtA[i:], m.Cid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Cid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasCidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasCidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasCidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Exist {
+		i--
+		if m.Exist {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryResponseRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ipld)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecordsByIdRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromVersion != 0 {
+		n += 1 + sovQuery(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ToVersion))
+	}
+	return n
+}
+
+func (m *QueryRecordsByIdResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovQuery(uint64(m.LatestVersion))
+	}
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.OwnerGroupId != 0 {
+		n += 1 + sovQuery(uint64(m.OwnerGroupId))
+	}
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryRecordsByFingerprintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromSequence != 0 {
+		n += 1 + sovQuery(uint64(m.FromSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByFingerprintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromSequence != 0 {
+		n += 1 + sovQuery(uint64(m.FromSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByStakeholderRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StakeholderId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromSequence != 0 {
+		n += 1 + sovQuery(uint64(m.FromSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByStakeholderResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByTimeRangeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.FromTimestamp))
+	}
+	if m.ToTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.ToTimestamp))
+	}
+	if m.FromHeight != 0 {
+		n += 1 + sovQuery(uint64(m.FromHeight))
+	}
+	if m.ToHeight != 0 {
+		n += 1 + sovQuery(uint64(m.ToHeight))
+	}
+	l = len(m.RegistryName)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromSequence != 0 {
+		n += 1 + sovQuery(uint64(m.FromSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordsByTimeRangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.NextSequence != 0 {
+		n += 1 + sovQuery(uint64(m.NextSequence))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecordDiffRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.FromVersion != 0 {
+		n += 1 + sovQuery(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ToVersion))
+	}
+	return n
+}
+
+func (m *JsonPatchOperation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Op)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRecordDiffResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FromVersion != 0 {
+		n += 1 + sovQuery(uint64(m.FromVersion))
+	}
+	if m.ToVersion != 0 {
+		n += 1 + sovQuery(uint64(m.ToVersion))
+	}
+	if len(m.Operations) > 0 {
+		for _, e := range m.Operations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingOwnershipTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingOwnershipTransfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingOwnershipTransfers) > 0 {
+		for _, e := range m.PendingOwnershipTransfers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryContentSchemasRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryContentSchemasResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ContentSchemas) > 0 {
+		for _, e := range m.ContentSchemas {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryCollectedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCollectedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCommitmentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCommitmentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Commitment.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAttestationsByAttesterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestationsByAttesterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for _, e := range m.Attestations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOwnershipHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRelatedRecordsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovQuery(uint64(m.Direction))
+	}
+	l = len(m.RelationType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RelatedRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RelationType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SourceIscnId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TargetIscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRelatedRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCidSizeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
 
 func (m *QueryGetCidSizeResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if m.Size_ != 0 {
-		n += 1 + sovQuery(uint64(m.Size_))
+	var l int
+	_ = l
+	if m.Size_ != 0 {
+		n += 1 + sovQuery(uint64(m.Size_))
+	}
+	return n
+}
+
+func (m *QueryHasCidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Cid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasCidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Exist {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryResponseRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResponseRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResponseRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ipld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ipld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByIdRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByIdRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByIdResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, QueryResponseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IscnRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OwnerGroupId", wireType)
+			}
+			m.OwnerGroupId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OwnerGroupId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, IscnAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByFingerprintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByFingerprintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByFingerprintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+			}
+			m.FromSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByFingerprintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByFingerprintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByFingerprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, QueryResponseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+			}
+			m.FromSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, QueryResponseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByStakeholderRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByStakeholderRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByStakeholderRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StakeholderId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StakeholderId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+			}
+			m.FromSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByStakeholderResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByStakeholderResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByStakeholderResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, QueryResponseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByTimeRangeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByTimeRangeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByTimeRangeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromTimestamp", wireType)
+			}
+			m.FromTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToTimestamp", wireType)
+			}
+			m.ToTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromHeight", wireType)
+			}
+			m.FromHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToHeight", wireType)
+			}
+			m.ToHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistryName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RegistryName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+			}
+			m.FromSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordsByTimeRangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordsByTimeRangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordsByTimeRangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, QueryResponseRecord{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			}
+			m.NextSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordDiffRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordDiffRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordDiffRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *JsonPatchOperation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: JsonPatchOperation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: JsonPatchOperation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Op", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Op = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecordDiffResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecordDiffResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecordDiffResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			}
+			m.FromVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FromVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			}
+			m.ToVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ToVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Operations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Operations = append(m.Operations, JsonPatchOperation{})
+			if err := m.Operations[len(m.Operations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingOwnershipTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnershipTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingOwnershipTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransfersByNewOwnerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransfersByNewOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingOwnershipTransfersByNewOwnerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransfersByNewOwnerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingOwnershipTransfersByNewOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingOwnershipTransfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingOwnershipTransfers = append(m.PendingOwnershipTransfers, PendingOwnershipTransfer{})
+			if err := m.PendingOwnershipTransfers[len(m.PendingOwnershipTransfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContentSchemasRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentSchemasRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentSchemasRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryContentSchemasResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContentSchemasResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContentSchemasResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentSchemas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentSchemas = append(m.ContentSchemas, ContentSchema{})
+			if err := m.ContentSchemas[len(m.ContentSchemas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryCollectedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *QueryHasCidRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = len(m.Cid)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+func (m *QueryCommitmentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCommitmentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCommitmentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCommitmentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Commitment.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryHasCidResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Exist {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryResponseRecord) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsByAttesterRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1915,15 +8483,15 @@ func (m *QueryResponseRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryResponseRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsByAttesterRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryResponseRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsByAttesterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ipld", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1951,13 +8519,13 @@ func (m *QueryResponseRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ipld = string(dAtA[iNdEx:postIndex])
+			m.Attester = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1967,22 +8535,25 @@ func (m *QueryResponseRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2007,7 +8578,7 @@ func (m *QueryResponseRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsByIdRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestationsByAttesterResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2030,17 +8601,17 @@ func (m *QueryRecordsByIdRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsByIdRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestationsByAttesterResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsByIdRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestationsByAttesterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2050,29 +8621,31 @@ func (m *QueryRecordsByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IscnId = string(dAtA[iNdEx:postIndex])
+			m.Attestations = append(m.Attestations, IscnAttestation{})
+			if err := m.Attestations[len(m.Attestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.FromVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2082,30 +8655,28 @@ func (m *QueryRecordsByIdRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromVersion |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ToVersion", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			m.ToVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ToVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2127,7 +8698,7 @@ func (m *QueryRecordsByIdRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOwnershipHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2150,15 +8721,15 @@ func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsByIdResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOwnershipHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsByIdResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOwnershipHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2186,13 +8757,13 @@ func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.IscnId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.LatestVersion = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2202,14 +8773,81 @@ func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestVersion |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOwnershipHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOwnershipHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOwnershipHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2236,8 +8874,44 @@ func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, QueryResponseRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Entries = append(m.Entries, IscnOwnershipHistoryEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2262,7 +8936,7 @@ func (m *QueryRecordsByIdResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsByFingerprintRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRelatedRecordsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2285,15 +8959,66 @@ func (m *QueryRecordsByFingerprintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsByFingerprintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelatedRecordsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsByFingerprintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelatedRecordsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= RelationDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2321,13 +9046,13 @@ func (m *QueryRecordsByFingerprintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			m.RelationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			m.FromSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2337,11 +9062,28 @@ func (m *QueryRecordsByFingerprintRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2363,7 +9105,7 @@ func (m *QueryRecordsByFingerprintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsByFingerprintResponse) Unmarshal(dAtA []byte) error {
+func (m *RelatedRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2386,17 +9128,17 @@ func (m *QueryRecordsByFingerprintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsByFingerprintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: RelatedRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsByFingerprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RelatedRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RelationType", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2406,31 +9148,29 @@ func (m *QueryRecordsByFingerprintResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, QueryResponseRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RelationType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SourceIscnId", wireType)
 			}
-			m.NextSequence = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2440,64 +9180,27 @@ func (m *QueryRecordsByFingerprintResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryRecordsByOwnerRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsByOwnerRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsByOwnerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.SourceIscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetIscnIdPrefix", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2525,13 +9228,13 @@ func (m *QueryRecordsByOwnerRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.TargetIscnIdPrefix = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FromSequence", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
 			}
-			m.FromSequence = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -2541,11 +9244,25 @@ func (m *QueryRecordsByOwnerRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.FromSequence |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -2567,7 +9284,7 @@ func (m *QueryRecordsByOwnerRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRecordsByOwnerResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRelatedRecordsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2590,15 +9307,15 @@ func (m *QueryRecordsByOwnerResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRecordsByOwnerResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRelatedRecordsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRecordsByOwnerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRelatedRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2625,133 +9342,14 @@ func (m *QueryRecordsByOwnerResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Records = append(m.Records, QueryResponseRecord{})
-			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Relations = append(m.Relations, RelatedRecord{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequence", wireType)
-			}
-			m.NextSequence = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.NextSequence |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2778,7 +9376,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
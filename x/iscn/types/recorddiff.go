@@ -0,0 +1,177 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+const (
+	JsonPatchOpAdd     = "add"
+	JsonPatchOpRemove  = "remove"
+	JsonPatchOpReplace = "replace"
+)
+
+// RecordDiffFields are the fields in the ISCN record JSON-LD compared by RecordDiff, the other fields (e.g. `@id`,
+// `recordTimestamp`) are generated by the chain and always differ between versions
+var RecordDiffFields = []string{"contentMetadata", "stakeholders", "contentFingerprints", "recordNotes"}
+
+func decodeJsonWithNumber(data []byte) (interface{}, error) {
+	// keep the numbers as json.Number, so they are compared and output as they are
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var v interface{}
+	err := decoder.Decode(&v)
+	if err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+func getRecordDiffFields(record []byte) (map[string]interface{}, error) {
+	v, err := decodeJsonWithNumber(record)
+	if err != nil {
+		return nil, err
+	}
+	recordMap, ok := v.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("ISCN record is not a JSON object")
+	}
+	fields := map[string]interface{}{}
+	for _, field := range RecordDiffFields {
+		value, ok := recordMap[field]
+		if ok {
+			fields[field] = value
+		}
+	}
+	return fields, nil
+}
+
+// GetRecordDiff computes the JSON Patch (RFC 6902) which transforms the user provided fields of the ISCN record
+// JSON-LD `from` into those of `to`
+func GetRecordDiff(from []byte, to []byte) ([]JsonPatchOperation, error) {
+	fromFields, err := getRecordDiffFields(from)
+	if err != nil {
+		return nil, err
+	}
+	toFields, err := getRecordDiffFields(to)
+	if err != nil {
+		return nil, err
+	}
+	return DiffJson(fromFields, toFields)
+}
+
+// DiffJson computes the JSON Patch which transforms the decoded JSON value `from` into `to`.
+// Objects are compared key by key in sorted order, arrays are compared index by index, with the extra elements added
+// or removed at the end.
+func DiffJson(from interface{}, to interface{}) ([]JsonPatchOperation, error) {
+	ops := []JsonPatchOperation{}
+	err := diffJson(&ops, "", from, to)
+	if err != nil {
+		return nil, err
+	}
+	return ops, nil
+}
+
+func newJsonPatchOperation(op string, path string, value interface{}) (JsonPatchOperation, error) {
+	operation := JsonPatchOperation{
+		Op:   op,
+		Path: path,
+	}
+	if op != JsonPatchOpRemove {
+		bz, err := json.Marshal(value)
+		if err != nil {
+			return JsonPatchOperation{}, err
+		}
+		operation.Value = bz
+	}
+	return operation, nil
+}
+
+func escapeJsonPointerToken(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+func diffJson(ops *[]JsonPatchOperation, path string, from interface{}, to interface{}) error {
+	switch fromValue := from.(type) {
+	case map[string]interface{}:
+		toValue, ok := to.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(fromValue)+len(toValue))
+		for key := range fromValue {
+			keys = append(keys, key)
+		}
+		for key := range toValue {
+			if _, ok := fromValue[key]; !ok {
+				keys = append(keys, key)
+			}
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			subpath := path + "/" + escapeJsonPointerToken(key)
+			fromSubvalue, inFrom := fromValue[key]
+			toSubvalue, inTo := toValue[key]
+			var err error
+			switch {
+			case !inTo:
+				err = appendJsonPatchOperation(ops, JsonPatchOpRemove, subpath, nil)
+			case !inFrom:
+				err = appendJsonPatchOperation(ops, JsonPatchOpAdd, subpath, toSubvalue)
+			default:
+				err = diffJson(ops, subpath, fromSubvalue, toSubvalue)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		toValue, ok := to.([]interface{})
+		if !ok {
+			break
+		}
+		commonLen := len(fromValue)
+		if len(toValue) < commonLen {
+			commonLen = len(toValue)
+		}
+		for i := 0; i < commonLen; i++ {
+			err := diffJson(ops, fmt.Sprintf("%s/%d", path, i), fromValue[i], toValue[i])
+			if err != nil {
+				return err
+			}
+		}
+		// remove from the end, so the indices of the remaining elements are not shifted
+		for i := len(fromValue) - 1; i >= commonLen; i-- {
+			err := appendJsonPatchOperation(ops, JsonPatchOpRemove, fmt.Sprintf("%s/%d", path, i), nil)
+			if err != nil {
+				return err
+			}
+		}
+		for i := commonLen; i < len(toValue); i++ {
+			err := appendJsonPatchOperation(ops, JsonPatchOpAdd, fmt.Sprintf("%s/%d", path, i), toValue[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	default:
+		if reflect.DeepEqual(from, to) {
+			return nil
+		}
+	}
+	return appendJsonPatchOperation(ops, JsonPatchOpReplace, path, to)
+}
+
+func appendJsonPatchOperation(ops *[]JsonPatchOperation, op string, path string, value interface{}) error {
+	operation, err := newJsonPatchOperation(op, path, value)
+	if err != nil {
+		return err
+	}
+	*ops = append(*ops, operation)
+	return nil
+}
@@ -0,0 +1,75 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func requireOperation(t *testing.T, op JsonPatchOperation, expectedOp string, expectedPath string, expectedValue string) {
+	require.Equal(t, expectedOp, op.Op)
+	require.Equal(t, expectedPath, op.Path)
+	if expectedOp == JsonPatchOpRemove {
+		require.Empty(t, op.Value)
+	} else {
+		require.JSONEq(t, expectedValue, string(op.Value))
+	}
+}
+
+func TestGetRecordDiff(t *testing.T) {
+	from := []byte(`{
+		"@id": "iscn://likecoin-chain/abc/1",
+		"recordTimestamp": "2021-01-01T00:00:00+00:00",
+		"recordNotes": "",
+		"contentFingerprints": ["hash://sha256/1", "hash://sha256/2"],
+		"stakeholders": [{"entity": {"@id": "did:cosmos:1", "name": "Alice"}, "rewardProportion": 1}],
+		"contentMetadata": {"@type": "Book", "name": "a book", "a/b~c": 1, "version": 1.50, "keywords": "x"}
+	}`)
+	to := []byte(`{
+		"@id": "iscn://likecoin-chain/abc/2",
+		"recordTimestamp": "2021-01-02T00:00:00+00:00",
+		"recordParentIPLD": {"/": "baguqeeraabc"},
+		"recordNotes": "fix typo",
+		"contentFingerprints": ["hash://sha256/1"],
+		"stakeholders": [
+			{"entity": {"@id": "did:cosmos:1", "name": "Alice"}, "rewardProportion": 2},
+			{"entity": {"@id": "did:cosmos:2"}, "rewardProportion": 1}
+		],
+		"contentMetadata": {"@type": "Book", "name": "a good book", "a/b~c": 1, "version": 1.50, "author": {"name": "Bob"}}
+	}`)
+
+	ops, err := GetRecordDiff(from, to)
+	require.NoError(t, err)
+	require.Len(t, ops, 7)
+	requireOperation(t, ops[0], JsonPatchOpRemove, "/contentFingerprints/1", "")
+	requireOperation(t, ops[1], JsonPatchOpAdd, "/contentMetadata/author", `{"name":"Bob"}`)
+	requireOperation(t, ops[2], JsonPatchOpRemove, "/contentMetadata/keywords", "")
+	requireOperation(t, ops[3], JsonPatchOpReplace, "/contentMetadata/name", `"a good book"`)
+	requireOperation(t, ops[4], JsonPatchOpReplace, "/recordNotes", `"fix typo"`)
+	requireOperation(t, ops[5], JsonPatchOpReplace, "/stakeholders/0/rewardProportion", `2`)
+	requireOperation(t, ops[6], JsonPatchOpAdd, "/stakeholders/1", `{"entity":{"@id":"did:cosmos:2"},"rewardProportion":1}`)
+
+	ops, err = GetRecordDiff(to, to)
+	require.NoError(t, err)
+	require.Empty(t, ops)
+
+	_, err = GetRecordDiff(from, []byte(`[]`))
+	require.Error(t, err)
+}
+
+func TestDiffJson(t *testing.T) {
+	ops, err := DiffJson(
+		map[string]interface{}{"a/b": []interface{}{"x", "y", "z"}, "~": "1"},
+		map[string]interface{}{"a/b": []interface{}{"x"}, "~": map[string]interface{}{"k": "v"}},
+	)
+	require.NoError(t, err)
+	require.Len(t, ops, 3)
+	requireOperation(t, ops[0], JsonPatchOpRemove, "/a~1b/2", "")
+	requireOperation(t, ops[1], JsonPatchOpRemove, "/a~1b/1", "")
+	requireOperation(t, ops[2], JsonPatchOpReplace, "/~0", `{"k":"v"}`)
+
+	ops, err = DiffJson("a", []interface{}{"a"})
+	require.NoError(t, err)
+	require.Len(t, ops, 1)
+	requireOperation(t, ops[0], JsonPatchOpReplace, "", `["a"]`)
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+const (
+	RelationTypeDerivedFrom = "derivedFrom"
+	RelationTypeCitation    = "citation"
+	RelationTypePartOf      = "partOf"
+)
+
+func IsValidRelationType(relationType string) bool {
+	switch relationType {
+	case RelationTypeDerivedFrom, RelationTypeCitation, RelationTypePartOf:
+		return true
+	}
+	return false
+}
+
+// ParseTarget parses the target of the relation, which must be an ISCN ID prefix without version
+func (relation IscnRelation) ParseTarget() (IscnIdPrefix, error) {
+	id, err := ParseIscnId(relation.Target)
+	if err != nil {
+		return IscnIdPrefix{}, fmt.Errorf("invalid relation target %s: %w", relation.Target, err)
+	}
+	if id.Version != 0 {
+		return IscnIdPrefix{}, fmt.Errorf("relation target %s should not contain version", relation.Target)
+	}
+	return id.Prefix, nil
+}
+
+func (relation IscnRelation) Validate() error {
+	if !IsValidRelationType(relation.Type) {
+		return fmt.Errorf("invalid relation type %s", relation.Type)
+	}
+	_, err := relation.ParseTarget()
+	return err
+}
+
+// ValidateRelations checks that each relation is valid, and that no two relations have the same type and target
+func ValidateRelations(relations []*IscnRelation) error {
+	usedRelation := map[IscnRelation]struct{}{}
+	for _, relation := range relations {
+		if relation == nil {
+			return fmt.Errorf("empty relation entry")
+		}
+		err := relation.Validate()
+		if err != nil {
+			return err
+		}
+		_, ok := usedRelation[*relation]
+		if ok {
+			return fmt.Errorf("repeated relation entry")
+		}
+		usedRelation[*relation] = struct{}{}
+	}
+	return nil
+}
+
+// GetRecordRelations extracts the `relations` field in the ISCN record JSON-LD.
+// Invalid relations are skipped.
+func GetRecordRelations(record []byte) []IscnRelation {
+	recordMap := struct {
+		Relations []json.RawMessage `json:"relations"`
+	}{}
+	err := json.Unmarshal(record, &recordMap)
+	if err != nil {
+		return nil
+	}
+	relations := []IscnRelation{}
+	for _, rawRelation := range recordMap.Relations {
+		relation := IscnRelation{}
+		err := json.Unmarshal(rawRelation, &relation)
+		if err != nil || relation.Validate() != nil {
+			continue
+		}
+		relations = append(relations, relation)
+	}
+	return relations
+}
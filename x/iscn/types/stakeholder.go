@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// GetStakeholderEntityIds extracts the distinct `entity.@id` fields of the stakeholders in the ISCN record JSON-LD.
+// Stakeholders without a string `entity.@id` field are skipped.
+func GetStakeholderEntityIds(record []byte) []string {
+	recordMap := struct {
+		Stakeholders []json.RawMessage `json:"stakeholders"`
+	}{}
+	err := json.Unmarshal(record, &recordMap)
+	if err != nil {
+		return nil
+	}
+	ids := []string{}
+	seen := map[string]struct{}{}
+	for _, stakeholder := range recordMap.Stakeholders {
+		stakeholderMap := struct {
+			Entity struct {
+				Id interface{} `json:"@id"`
+			} `json:"entity"`
+		}{}
+		err := json.Unmarshal(stakeholder, &stakeholderMap)
+		if err != nil {
+			continue
+		}
+		id, ok := stakeholderMap.Entity.Id.(string)
+		if !ok || len(id) == 0 {
+			continue
+		}
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func TestGetStakeholderEntityIds(t *testing.T) {
+	ids := GetStakeholderEntityIds([]byte(`{"stakeholders":[{"entity":{"@id":"did:cosmos:1"}},{"entity":{"name":"no ID"}},{"footprint":"https://example.com"},{"entity":{"@id":"did:cosmos:2"}},{"entity":{"@id":"did:cosmos:1"}}]}`))
+	require.Equal(t, []string{"did:cosmos:1", "did:cosmos:2"}, ids)
+
+	ids = GetStakeholderEntityIds([]byte(`{"stakeholders":[{"entity":{"@id":123}},{"entity":"did:cosmos:1"},{"entity":{"@id":""}},"string"]}`))
+	require.Empty(t, ids, "should skip stakeholders with invalid entity ID")
+
+	ids = GetStakeholderEntityIds([]byte(`{"contentFingerprints":[]}`))
+	require.Empty(t, ids)
+
+	ids = GetStakeholderEntityIds([]byte(`{"stakeholders":{"entity":{"@id":"did:cosmos:1"}}}`))
+	require.Empty(t, ids, "should not accept non-array stakeholders")
+
+	ids = GetStakeholderEntityIds([]byte(`not JSON`))
+	require.Empty(t, ids)
+}
+
+func TestIsStakeholderAddress(t *testing.T) {
+	SetAddressPrefixes()
+	addr := sdk.AccAddress([]byte("stakeholder_address_"))
+	other := sdk.AccAddress([]byte("other_address_______"))
+	likeAddr, err := sdk.Bech32ifyAddressBytes("like", addr)
+	require.NoError(t, err)
+
+	parsed, ok := ParseStakeholderAddress(addr.String())
+	require.True(t, ok)
+	require.Equal(t, addr, parsed)
+	parsed, ok = ParseStakeholderAddress("did:cosmos:" + addr.String())
+	require.True(t, ok)
+	require.Equal(t, addr, parsed)
+	parsed, ok = ParseStakeholderAddress("did:like:" + likeAddr)
+	require.True(t, ok)
+	require.Equal(t, addr, parsed)
+	_, ok = ParseStakeholderAddress("did:cosmos:5sy29r37gfxvxz21rh4r0ktpuc46pzjrmz29g45")
+	require.False(t, ok)
+	_, ok = ParseStakeholderAddress("https://example.com/" + addr.String())
+	require.False(t, ok)
+
+	record := []byte(`{"stakeholders":[{"entity":{"@id":"did:cosmos:` + addr.String() + `"}},{"entity":{"name":"no ID"}}]}`)
+	require.True(t, IsStakeholderAddress(record, addr))
+	require.False(t, IsStakeholderAddress(record, other))
+	record = []byte(`{"stakeholders":[{"entity":{"@id":"` + likeAddr + `"}}]}`)
+	require.True(t, IsStakeholderAddress(record, addr))
+	require.False(t, IsStakeholderAddress([]byte(`{"stakeholders":[]}`), addr))
+}
@@ -7,15 +7,19 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,6 +27,41 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+type IscnRecordStatus int32
+
+const (
+	// The record accepts new versions
+	ISCN_RECORD_STATUS_ACTIVE IscnRecordStatus = 0
+	// The record will never accept new versions
+	ISCN_RECORD_STATUS_FROZEN IscnRecordStatus = 1
+	// The record is withdrawn by its owner
+	ISCN_RECORD_STATUS_DEPRECATED IscnRecordStatus = 2
+	// The record is replaced by another record, see `superseded_by`
+	ISCN_RECORD_STATUS_SUPERSEDED IscnRecordStatus = 3
+)
+
+var IscnRecordStatus_name = map[int32]string{
+	0: "ISCN_RECORD_STATUS_ACTIVE",
+	1: "ISCN_RECORD_STATUS_FROZEN",
+	2: "ISCN_RECORD_STATUS_DEPRECATED",
+	3: "ISCN_RECORD_STATUS_SUPERSEDED",
+}
+
+var IscnRecordStatus_value = map[string]int32{
+	"ISCN_RECORD_STATUS_ACTIVE":     0,
+	"ISCN_RECORD_STATUS_FROZEN":     1,
+	"ISCN_RECORD_STATUS_DEPRECATED": 2,
+	"ISCN_RECORD_STATUS_SUPERSEDED": 3,
+}
+
+func (x IscnRecordStatus) String() string {
+	return proto.EnumName(IscnRecordStatus_name, int32(x))
+}
+
+func (IscnRecordStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9d9faad3d449872, []int{0}
+}
+
 type StoreRecord struct {
 	IscnId   IscnId    `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id"`
 	CidBytes []byte    `protobuf:"bytes,2,opt,name=cid_bytes,json=cidBytes,proto3" json:"cid_bytes,omitempty"`
@@ -77,8 +116,11 @@ func (m *StoreRecord) GetCidBytes() []byte {
 }
 
 type ContentIdRecord struct {
-	OwnerAddressBytes []byte `protobuf:"bytes,1,opt,name=owner_address_bytes,json=ownerAddressBytes,proto3" json:"owner_address_bytes,omitempty"`
-	LatestVersion     uint64 `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	OwnerAddressBytes []byte           `protobuf:"bytes,1,opt,name=owner_address_bytes,json=ownerAddressBytes,proto3" json:"owner_address_bytes,omitempty"`
+	LatestVersion     uint64           `protobuf:"varint,2,opt,name=latest_version,json=latestVersion,proto3" json:"latest_version,omitempty"`
+	Status            IscnRecordStatus `protobuf:"varint,3,opt,name=status,proto3,enum=likechain.iscn.IscnRecordStatus" json:"status,omitempty"`
+	// ISCN ID prefix of the record superseding this record, only set when status is superseded
+	SupersededBy string `protobuf:"bytes,4,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
 }
 
 func (m *ContentIdRecord) Reset()         { *m = ContentIdRecord{} }
@@ -128,35 +170,389 @@ func (m *ContentIdRecord) GetLatestVersion() uint64 {
 	return 0
 }
 
+func (m *ContentIdRecord) GetStatus() IscnRecordStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ISCN_RECORD_STATUS_ACTIVE
+}
+
+func (m *ContentIdRecord) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
+	}
+	return ""
+}
+
+// PendingOwnershipTransfer is an ownership transfer proposed by the owner, waiting for the new owner to accept
+type PendingOwnershipTransfer struct {
+	IscnIdPrefix string    `protobuf:"bytes,1,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	Owner        string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	NewOwner     string    `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Expiration   time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *PendingOwnershipTransfer) Reset()         { *m = PendingOwnershipTransfer{} }
+func (m *PendingOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingOwnershipTransfer) ProtoMessage()    {}
+func (*PendingOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d9faad3d449872, []int{2}
+}
+func (m *PendingOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingOwnershipTransfer.Merge(m, src)
+}
+func (m *PendingOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingOwnershipTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingOwnershipTransfer proto.InternalMessageInfo
+
+func (m *PendingOwnershipTransfer) GetIscnIdPrefix() string {
+	if m != nil {
+		return m.IscnIdPrefix
+	}
+	return ""
+}
+
+func (m *PendingOwnershipTransfer) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *PendingOwnershipTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
+	}
+	return ""
+}
+
+func (m *PendingOwnershipTransfer) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+// IscnCommitment is a salted hash commitment of an ISCN record, proving the existence of the record at the commit time
+// without revealing it
+type IscnCommitment struct {
+	// SHA-256 hash of the salt, the committer address bytes and the protobuf encoded IscnRecord
+	Commitment   []byte    `protobuf:"bytes,1,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	Committer    string    `protobuf:"bytes,2,opt,name=committer,proto3" json:"committer,omitempty"`
+	CommitTime   time.Time `protobuf:"bytes,3,opt,name=commit_time,json=commitTime,proto3,stdtime" json:"commit_time"`
+	CommitHeight int64     `protobuf:"varint,4,opt,name=commit_height,json=commitHeight,proto3" json:"commit_height,omitempty"`
+	// unrevealed commitments are removed at expiration
+	Expiration time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	// ISCN ID of the record created by revealing the commitment, empty if not yet revealed
+	RevealedIscnId string `protobuf:"bytes,6,opt,name=revealed_iscn_id,json=revealedIscnId,proto3" json:"revealed_iscn_id,omitempty"`
+}
+
+func (m *IscnCommitment) Reset()         { *m = IscnCommitment{} }
+func (m *IscnCommitment) String() string { return proto.CompactTextString(m) }
+func (*IscnCommitment) ProtoMessage()    {}
+func (*IscnCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d9faad3d449872, []int{3}
+}
+func (m *IscnCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IscnCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IscnCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IscnCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IscnCommitment.Merge(m, src)
+}
+func (m *IscnCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *IscnCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_IscnCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IscnCommitment proto.InternalMessageInfo
+
+func (m *IscnCommitment) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+func (m *IscnCommitment) GetCommitter() string {
+	if m != nil {
+		return m.Committer
+	}
+	return ""
+}
+
+func (m *IscnCommitment) GetCommitTime() time.Time {
+	if m != nil {
+		return m.CommitTime
+	}
+	return time.Time{}
+}
+
+func (m *IscnCommitment) GetCommitHeight() int64 {
+	if m != nil {
+		return m.CommitHeight
+	}
+	return 0
+}
+
+func (m *IscnCommitment) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func (m *IscnCommitment) GetRevealedIscnId() string {
+	if m != nil {
+		return m.RevealedIscnId
+	}
+	return ""
+}
+
+// IscnAttestation is a confirmation by a stakeholder of an ISCN record version that the stakeholder entry is authentic
+type IscnAttestation struct {
+	// ISCN ID with version
+	IscnId       string    `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	Attester     string    `protobuf:"bytes,2,opt,name=attester,proto3" json:"attester,omitempty"`
+	AttestTime   time.Time `protobuf:"bytes,3,opt,name=attest_time,json=attestTime,proto3,stdtime" json:"attest_time"`
+	AttestHeight int64     `protobuf:"varint,4,opt,name=attest_height,json=attestHeight,proto3" json:"attest_height,omitempty"`
+}
+
+func (m *IscnAttestation) Reset()         { *m = IscnAttestation{} }
+func (m *IscnAttestation) String() string { return proto.CompactTextString(m) }
+func (*IscnAttestation) ProtoMessage()    {}
+func (*IscnAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d9faad3d449872, []int{4}
+}
+func (m *IscnAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IscnAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IscnAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IscnAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IscnAttestation.Merge(m, src)
+}
+func (m *IscnAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *IscnAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IscnAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IscnAttestation proto.InternalMessageInfo
+
+func (m *IscnAttestation) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+func (m *IscnAttestation) GetAttester() string {
+	if m != nil {
+		return m.Attester
+	}
+	return ""
+}
+
+func (m *IscnAttestation) GetAttestTime() time.Time {
+	if m != nil {
+		return m.AttestTime
+	}
+	return time.Time{}
+}
+
+func (m *IscnAttestation) GetAttestHeight() int64 {
+	if m != nil {
+		return m.AttestHeight
+	}
+	return 0
+}
+
+// IscnOwnershipHistoryEntry records an owner of an ISCN record, with the block and the message which set the owner
+type IscnOwnershipHistoryEntry struct {
+	IscnIdPrefix string    `protobuf:"bytes,1,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
+	Owner        string    `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	Height       int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time         time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// type URL of the message which set the owner, empty for the owners recorded when the history is introduced
+	MsgTypeUrl string `protobuf:"bytes,5,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+}
+
+func (m *IscnOwnershipHistoryEntry) Reset()         { *m = IscnOwnershipHistoryEntry{} }
+func (m *IscnOwnershipHistoryEntry) String() string { return proto.CompactTextString(m) }
+func (*IscnOwnershipHistoryEntry) ProtoMessage()    {}
+func (*IscnOwnershipHistoryEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9d9faad3d449872, []int{5}
+}
+func (m *IscnOwnershipHistoryEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IscnOwnershipHistoryEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IscnOwnershipHistoryEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IscnOwnershipHistoryEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IscnOwnershipHistoryEntry.Merge(m, src)
+}
+func (m *IscnOwnershipHistoryEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *IscnOwnershipHistoryEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_IscnOwnershipHistoryEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IscnOwnershipHistoryEntry proto.InternalMessageInfo
+
+func (m *IscnOwnershipHistoryEntry) GetIscnIdPrefix() string {
+	if m != nil {
+		return m.IscnIdPrefix
+	}
+	return ""
+}
+
+func (m *IscnOwnershipHistoryEntry) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *IscnOwnershipHistoryEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *IscnOwnershipHistoryEntry) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *IscnOwnershipHistoryEntry) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("likechain.iscn.IscnRecordStatus", IscnRecordStatus_name, IscnRecordStatus_value)
 	proto.RegisterType((*StoreRecord)(nil), "likechain.iscn.StoreRecord")
 	proto.RegisterType((*ContentIdRecord)(nil), "likechain.iscn.ContentIdRecord")
+	proto.RegisterType((*PendingOwnershipTransfer)(nil), "likechain.iscn.PendingOwnershipTransfer")
+	proto.RegisterType((*IscnCommitment)(nil), "likechain.iscn.IscnCommitment")
+	proto.RegisterType((*IscnAttestation)(nil), "likechain.iscn.IscnAttestation")
+	proto.RegisterType((*IscnOwnershipHistoryEntry)(nil), "likechain.iscn.IscnOwnershipHistoryEntry")
 }
 
 func init() { proto.RegisterFile("likechain/iscn/store.proto", fileDescriptor_c9d9faad3d449872) }
 
 var fileDescriptor_c9d9faad3d449872 = []byte{
-	// 319 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0x41, 0x4a, 0x03, 0x31,
-	0x14, 0x86, 0x27, 0x5a, 0xaa, 0x4d, 0xb5, 0xd2, 0x51, 0xa4, 0xb4, 0x90, 0x96, 0x82, 0xd0, 0x8d,
-	0x19, 0xa8, 0x7a, 0x00, 0xc7, 0x55, 0xc1, 0xd5, 0x08, 0x2e, 0xdc, 0x0c, 0xd3, 0x24, 0xb4, 0xc1,
-	0x9a, 0x0c, 0x49, 0x5a, 0xed, 0x0d, 0x5c, 0x7a, 0xac, 0x2e, 0xbb, 0x14, 0x17, 0x45, 0x66, 0x2e,
-	0x22, 0x49, 0x6a, 0x41, 0x37, 0xe1, 0xbd, 0xf7, 0x25, 0xff, 0xff, 0xf2, 0xc3, 0xf6, 0x8c, 0x3f,
-	0x33, 0x32, 0xcd, 0xb8, 0x88, 0xb8, 0x26, 0x22, 0xd2, 0x46, 0x2a, 0x86, 0x73, 0x25, 0x8d, 0x0c,
-	0x1b, 0x3b, 0x86, 0x2d, 0x6b, 0x9f, 0x4d, 0xe4, 0x44, 0x3a, 0x14, 0xd9, 0xca, 0xdf, 0x6a, 0x77,
-	0xfe, 0x29, 0xd8, 0x83, 0x53, 0x0f, 0xfb, 0xef, 0x00, 0xd6, 0x1f, 0xac, 0x64, 0xc2, 0x88, 0x54,
-	0x34, 0xbc, 0x81, 0x07, 0x96, 0xa7, 0x9c, 0xb6, 0x40, 0x0f, 0x0c, 0xea, 0xc3, 0x73, 0xfc, 0xd7,
-	0x04, 0x8f, 0x34, 0x11, 0x23, 0x1a, 0x57, 0x56, 0x9b, 0x6e, 0x90, 0x54, 0xb9, 0xeb, 0xc2, 0x0e,
-	0xac, 0x11, 0x4e, 0xd3, 0xf1, 0xd2, 0x30, 0xdd, 0xda, 0xeb, 0x81, 0xc1, 0x51, 0x72, 0x48, 0x38,
-	0x8d, 0x6d, 0x1f, 0x5e, 0xc0, 0x0a, 0xcd, 0x4c, 0xd6, 0xda, 0xb7, 0xf3, 0xb8, 0x69, 0x1f, 0x7e,
-	0x6d, 0xba, 0x35, 0x27, 0x24, 0xf2, 0xb9, 0x49, 0x1c, 0xee, 0x4f, 0xe1, 0xc9, 0x9d, 0x14, 0x86,
-	0x09, 0x33, 0xa2, 0xdb, 0x6d, 0x30, 0x3c, 0x95, 0xaf, 0x82, 0xa9, 0x34, 0xa3, 0x54, 0x31, 0xad,
-	0xb7, 0x06, 0xc0, 0x19, 0x34, 0x1d, 0xba, 0xf5, 0xe4, 0xd7, 0xa9, 0x31, 0xcb, 0x0c, 0xd3, 0x26,
-	0x5d, 0x30, 0xa5, 0xb9, 0x14, 0x6e, 0x97, 0x4a, 0x72, 0xec, 0xa7, 0x8f, 0x7e, 0x18, 0xdf, 0xaf,
-	0x0a, 0x04, 0xd6, 0x05, 0x02, 0xdf, 0x05, 0x02, 0x1f, 0x25, 0x0a, 0xd6, 0x25, 0x0a, 0x3e, 0x4b,
-	0x14, 0x3c, 0x0d, 0x27, 0xdc, 0x4c, 0xe7, 0x63, 0x4c, 0xe4, 0x4b, 0xe4, 0xfe, 0x2d, 0xb9, 0xd8,
-	0x15, 0x97, 0x3e, 0xc4, 0xc5, 0x75, 0xf4, 0xe6, 0x93, 0x34, 0xcb, 0x9c, 0xe9, 0x71, 0xd5, 0x25,
-	0x79, 0xf5, 0x13, 0x00, 0x00, 0xff, 0xff, 0xe1, 0x59, 0xf0, 0x6f, 0xaa, 0x01, 0x00, 0x00,
+	// 783 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xe3, 0x36,
+	0x10, 0x36, 0x63, 0xaf, 0x6b, 0x31, 0x5e, 0xaf, 0x97, 0x5d, 0x6c, 0xbd, 0x4e, 0x23, 0xbb, 0x6e,
+	0x0b, 0x18, 0x05, 0x2a, 0x03, 0x69, 0x0b, 0xe4, 0xea, 0x1f, 0x15, 0x31, 0x50, 0x24, 0x06, 0xed,
+	0xe4, 0x90, 0x8b, 0x20, 0x4b, 0x8c, 0x4c, 0xd4, 0xa2, 0x04, 0x91, 0x4e, 0xe2, 0x37, 0xc8, 0x31,
+	0x0f, 0xd0, 0x5b, 0x6f, 0x3d, 0xf6, 0xd6, 0x27, 0x68, 0x80, 0x5e, 0x72, 0x2c, 0x7a, 0x48, 0x8b,
+	0xe4, 0x45, 0x0a, 0x92, 0xb2, 0xf2, 0x83, 0xe4, 0x90, 0xec, 0x45, 0xe0, 0x7c, 0xdf, 0x8c, 0x66,
+	0xe6, 0xe3, 0x0c, 0x61, 0x7d, 0x4e, 0x7f, 0x26, 0xde, 0xcc, 0xa5, 0xac, 0x43, 0xb9, 0xc7, 0x3a,
+	0x5c, 0x44, 0x09, 0xb1, 0xe2, 0x24, 0x12, 0x11, 0xaa, 0x64, 0x9c, 0x25, 0xb9, 0xfa, 0xbb, 0x20,
+	0x0a, 0x22, 0x45, 0x75, 0xe4, 0x49, 0x7b, 0xd5, 0x1b, 0x41, 0x14, 0x05, 0x73, 0xd2, 0x51, 0xd6,
+	0x74, 0x71, 0xd4, 0x11, 0x34, 0x24, 0x5c, 0xb8, 0x61, 0x9c, 0x3a, 0x6c, 0x3c, 0x48, 0x21, 0x3f,
+	0xd4, 0xd7, 0x64, 0xeb, 0x0c, 0xc0, 0xf5, 0xb1, 0xcc, 0x89, 0x89, 0x17, 0x25, 0x3e, 0xfa, 0x01,
+	0x7e, 0x22, 0x79, 0x87, 0xfa, 0x35, 0xd0, 0x04, 0xed, 0xf5, 0xad, 0xf7, 0xd6, 0xfd, 0x2a, 0xac,
+	0x21, 0xf7, 0xd8, 0xd0, 0xef, 0x15, 0x2e, 0xae, 0x1a, 0x39, 0x5c, 0xa4, 0xca, 0x42, 0x1b, 0xd0,
+	0xf0, 0xa8, 0xef, 0x4c, 0x97, 0x82, 0xf0, 0xda, 0x5a, 0x13, 0xb4, 0xcb, 0xb8, 0xe4, 0x51, 0xbf,
+	0x27, 0x6d, 0xf4, 0x35, 0x2c, 0xf8, 0xae, 0x70, 0x6b, 0x79, 0x89, 0xf7, 0xde, 0xca, 0xc0, 0x7f,
+	0xae, 0x1a, 0x86, 0xfa, 0x11, 0x8b, 0x17, 0x02, 0x2b, 0xba, 0xf5, 0x27, 0x80, 0x6f, 0xfa, 0x11,
+	0x13, 0x84, 0x89, 0xa1, 0x9f, 0x96, 0x63, 0xc1, 0x4f, 0xa3, 0x13, 0x46, 0x12, 0xc7, 0xf5, 0xfd,
+	0x84, 0x70, 0x9e, 0x66, 0x00, 0x2a, 0xc3, 0x5b, 0x45, 0x75, 0x35, 0xb3, 0x4a, 0x55, 0x99, 0xbb,
+	0x82, 0x70, 0xe1, 0x1c, 0x93, 0x84, 0xd3, 0x88, 0xa9, 0x62, 0x0a, 0xf8, 0xb5, 0x46, 0x0f, 0x34,
+	0x88, 0xb6, 0x61, 0x91, 0x0b, 0x57, 0x2c, 0xb8, 0xaa, 0xa9, 0xb2, 0xd5, 0x7c, 0xac, 0x49, 0x5d,
+	0xc2, 0x58, 0xf9, 0xe1, 0xd4, 0x1f, 0x7d, 0x09, 0x5f, 0xf3, 0x45, 0x4c, 0x12, 0x4e, 0x7c, 0x22,
+	0xfb, 0xad, 0x15, 0x9a, 0xa0, 0x6d, 0xe0, 0xf2, 0x2d, 0xd8, 0x5b, 0xb6, 0xfe, 0x00, 0xb0, 0x36,
+	0x22, 0xcc, 0xa7, 0x2c, 0xd8, 0x93, 0x25, 0xf2, 0x19, 0x8d, 0x27, 0x89, 0xcb, 0xf8, 0x11, 0x49,
+	0xd0, 0x57, 0xb0, 0x92, 0x2a, 0xec, 0xc4, 0x09, 0x39, 0xa2, 0xa7, 0xaa, 0x1b, 0x03, 0x97, 0xb5,
+	0x94, 0x23, 0x85, 0xa1, 0x77, 0xf0, 0x95, 0xea, 0x4e, 0xd5, 0x6f, 0x60, 0x6d, 0x48, 0x99, 0x19,
+	0x39, 0x71, 0x34, 0x93, 0x57, 0x4c, 0x89, 0x91, 0x13, 0x95, 0x04, 0x0d, 0x20, 0x24, 0xa7, 0x31,
+	0x4d, 0x5c, 0x21, 0xfb, 0x2e, 0xa8, 0xdb, 0xab, 0x5b, 0x7a, 0x3a, 0xac, 0xd5, 0x74, 0x58, 0x93,
+	0xd5, 0x74, 0xf4, 0x4a, 0xf2, 0x22, 0xce, 0xff, 0x6d, 0x00, 0x7c, 0x27, 0xae, 0xf5, 0xdb, 0x1a,
+	0xac, 0xc8, 0xee, 0xfb, 0x51, 0x18, 0x52, 0x11, 0x12, 0x26, 0x90, 0x09, 0xa1, 0x97, 0x59, 0xa9,
+	0xf6, 0x77, 0x10, 0xf4, 0x39, 0x34, 0xb4, 0x25, 0xb2, 0x7a, 0x6f, 0x01, 0x64, 0xc3, 0x75, 0x6d,
+	0x38, 0x72, 0x30, 0x6b, 0xf9, 0xe7, 0xd4, 0xa5, 0x03, 0x25, 0x25, 0x85, 0x4f, 0x7f, 0x33, 0x23,
+	0x34, 0x98, 0x09, 0xd5, 0x60, 0x1e, 0x97, 0x35, 0xb8, 0xa3, 0xb0, 0x07, 0x12, 0xbc, 0x7a, 0x99,
+	0x04, 0xa8, 0x0d, 0xab, 0x09, 0x39, 0x26, 0xee, 0x9c, 0xf8, 0xce, 0x6a, 0x19, 0x8a, 0xaa, 0xad,
+	0xca, 0x0a, 0xd7, 0x4b, 0xd0, 0xfa, 0x1d, 0xc0, 0x37, 0xf2, 0xd8, 0x15, 0x72, 0xba, 0x74, 0xf4,
+	0x67, 0xf7, 0x37, 0xc8, 0xc8, 0x76, 0xa4, 0x0e, 0x4b, 0xae, 0xf2, 0xcb, 0x54, 0xca, 0x6c, 0x29,
+	0x92, 0x3e, 0xbf, 0x40, 0x24, 0x1d, 0xb8, 0x12, 0x29, 0xfd, 0xcd, 0x7d, 0x91, 0x34, 0xa8, 0x45,
+	0x6a, 0xfd, 0x05, 0xe0, 0x07, 0x59, 0x74, 0x36, 0x9a, 0x3b, 0x54, 0xbe, 0x3a, 0x4b, 0x9b, 0x89,
+	0x64, 0xf9, 0x51, 0xe3, 0xf9, 0x1e, 0x16, 0xd3, 0xbc, 0x79, 0x95, 0x37, 0xb5, 0xd0, 0x36, 0x2c,
+	0xa8, 0xb6, 0x9e, 0x33, 0x93, 0x2a, 0x02, 0x35, 0x61, 0x39, 0xe4, 0x81, 0x23, 0x96, 0x31, 0x71,
+	0x16, 0xc9, 0x5c, 0x5d, 0xa9, 0x81, 0x61, 0xc8, 0x83, 0xc9, 0x32, 0x26, 0xfb, 0xc9, 0xfc, 0x9b,
+	0x5f, 0x00, 0xac, 0x3e, 0xdc, 0x56, 0xb4, 0x09, 0x3f, 0x0c, 0xc7, 0xfd, 0x5d, 0x07, 0xdb, 0xfd,
+	0x3d, 0x3c, 0x70, 0xc6, 0x93, 0xee, 0x64, 0x7f, 0xec, 0x74, 0xfb, 0x93, 0xe1, 0x81, 0x5d, 0xcd,
+	0x3d, 0x41, 0xff, 0x88, 0xf7, 0x0e, 0xed, 0xdd, 0x2a, 0x40, 0x5f, 0xc0, 0xcd, 0x47, 0xe8, 0x81,
+	0x3d, 0xc2, 0x76, 0xbf, 0x3b, 0xb1, 0x07, 0xd5, 0xb5, 0x27, 0x5c, 0xc6, 0xfb, 0x23, 0x1b, 0x8f,
+	0xed, 0x81, 0x3d, 0xa8, 0xe6, 0xeb, 0x85, 0xb3, 0x5f, 0xcd, 0x5c, 0xef, 0xa7, 0x8b, 0x6b, 0x13,
+	0x5c, 0x5e, 0x9b, 0xe0, 0xbf, 0x6b, 0x13, 0x9c, 0xdf, 0x98, 0xb9, 0xcb, 0x1b, 0x33, 0xf7, 0xf7,
+	0x8d, 0x99, 0x3b, 0xdc, 0x0a, 0xa8, 0x98, 0x2d, 0xa6, 0x96, 0x17, 0x85, 0x1d, 0xf5, 0xfa, 0x44,
+	0x94, 0x65, 0x87, 0x6f, 0xf5, 0x7b, 0x7d, 0xfc, 0x7d, 0xe7, 0x54, 0x3f, 0xda, 0x52, 0x01, 0x3e,
+	0x2d, 0x2a, 0xc9, 0xbe, 0xfb, 0x7f, 0x00, 0xa4, 0xd6, 0xc5, 0x63, 0x36, 0x06, 0x00, 0x00,
 }
 
 func (m *StoreRecord) Marshal() (dAtA []byte, err error) {
@@ -229,6 +625,18 @@ func (m *ContentIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.LatestVersion != 0 {
 		i = encodeVarintStore(dAtA, i, uint64(m.LatestVersion))
 		i--
@@ -244,46 +652,376 @@ func (m *ContentIdRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
-	offset -= sovStore(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *PendingOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *StoreRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *PendingOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.IscnId.Size()
-	n += 1 + l + sovStore(uint64(l))
-	l = len(m.CidBytes)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
 	}
-	l = m.Data.Size()
-	n += 1 + l + sovStore(uint64(l))
-	return n
+	i -= n2
+	i = encodeVarintStore(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *ContentIdRecord) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *IscnCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *IscnCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IscnCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.OwnerAddressBytes)
-	if l > 0 {
-		n += 1 + l + sovStore(uint64(l))
+	if len(m.RevealedIscnId) > 0 {
+		i -= len(m.RevealedIscnId)
+		copy(dAtA[i:], m.RevealedIscnId)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.RevealedIscnId)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.LatestVersion != 0 {
-		n += 1 + sovStore(uint64(m.LatestVersion))
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintStore(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.CommitHeight != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.CommitHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.CommitTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitTime):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintStore(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Committer) > 0 {
+		i -= len(m.Committer)
+		copy(dAtA[i:], m.Committer)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Committer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IscnAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IscnAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IscnAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestHeight != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.AttestHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.AttestTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.AttestTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintStore(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Attester) > 0 {
+		i -= len(m.Attester)
+		copy(dAtA[i:], m.Attester)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Attester)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IscnOwnershipHistoryEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IscnOwnershipHistoryEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IscnOwnershipHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintStore(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintStore(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintStore(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintStore(dAtA []byte, offset int, v uint64) int {
+	offset -= sovStore(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *StoreRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.IscnId.Size()
+	n += 1 + l + sovStore(uint64(l))
+	l = len(m.CidBytes)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = m.Data.Size()
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *ContentIdRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OwnerAddressBytes)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovStore(uint64(m.LatestVersion))
+	}
+	if m.Status != 0 {
+		n += 1 + sovStore(uint64(m.Status))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func (m *PendingOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovStore(uint64(l))
+	return n
+}
+
+func (m *IscnCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Committer)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.CommitTime)
+	n += 1 + l + sovStore(uint64(l))
+	if m.CommitHeight != 0 {
+		n += 1 + sovStore(uint64(m.CommitHeight))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovStore(uint64(l))
+	l = len(m.RevealedIscnId)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	return n
+}
+
+func (m *IscnAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Attester)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.AttestTime)
+	n += 1 + l + sovStore(uint64(l))
+	if m.AttestHeight != 0 {
+		n += 1 + sovStore(uint64(m.AttestHeight))
+	}
+	return n
+}
+
+func (m *IscnOwnershipHistoryEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStore(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStore(uint64(l))
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovStore(uint64(l))
 	}
 	return n
 }
@@ -526,6 +1264,833 @@ func (m *ContentIdRecord) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= IscnRecordStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IscnCommitment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IscnCommitment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IscnCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.CommitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitHeight", wireType)
+			}
+			m.CommitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CommitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedIscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevealedIscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IscnAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IscnAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IscnAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.AttestTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestHeight", wireType)
+			}
+			m.AttestHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStore(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStore
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IscnOwnershipHistoryEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStore
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IscnOwnershipHistoryEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IscnOwnershipHistoryEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStore
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStore
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStore
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStore(dAtA[iNdEx:])
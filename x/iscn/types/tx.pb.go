@@ -9,18 +9,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -35,6 +39,9 @@ type IscnRecord struct {
 	// Here, `IscnInput` is JSON encoded bytes
 	Stakeholders    []IscnInput `protobuf:"bytes,3,rep,name=stakeholders,proto3,customtype=IscnInput" json:"stakeholders,omitempty"`
 	ContentMetadata IscnInput   `protobuf:"bytes,4,opt,name=contentMetadata,proto3,customtype=IscnInput" json:"contentMetadata"`
+	// Nullable so the field is omitted in the sign bytes when empty, which keeps the ISCN IDs derived from the sign
+	// bytes of records without relations unchanged
+	Relations []*IscnRelation `protobuf:"bytes,5,rep,name=relations,proto3" json:"relations,omitempty"`
 }
 
 func (m *IscnRecord) Reset()         { *m = IscnRecord{} }
@@ -84,6 +91,68 @@ func (m *IscnRecord) GetContentFingerprints() []string {
 	return nil
 }
 
+func (m *IscnRecord) GetRelations() []*IscnRelation {
+	if m != nil {
+		return m.Relations
+	}
+	return nil
+}
+
+// IscnRelation points from the record to another existing ISCN record
+type IscnRelation struct {
+	// One of `derivedFrom`, `citation` and `partOf`
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// The ISCN ID prefix of the related record, i.e. iscn://REGISTRY_NAME/CONTENT_ID
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *IscnRelation) Reset()         { *m = IscnRelation{} }
+func (m *IscnRelation) String() string { return proto.CompactTextString(m) }
+func (*IscnRelation) ProtoMessage()    {}
+func (*IscnRelation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{1}
+}
+func (m *IscnRelation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IscnRelation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IscnRelation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IscnRelation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IscnRelation.Merge(m, src)
+}
+func (m *IscnRelation) XXX_Size() int {
+	return m.Size()
+}
+func (m *IscnRelation) XXX_DiscardUnknown() {
+	xxx_messageInfo_IscnRelation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IscnRelation proto.InternalMessageInfo
+
+func (m *IscnRelation) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *IscnRelation) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
 type MsgCreateIscnRecord struct {
 	From   string     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
 	Record IscnRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
@@ -94,7 +163,7 @@ func (m *MsgCreateIscnRecord) Reset()         { *m = MsgCreateIscnRecord{} }
 func (m *MsgCreateIscnRecord) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateIscnRecord) ProtoMessage()    {}
 func (*MsgCreateIscnRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d83c904dc785ba6, []int{1}
+	return fileDescriptor_1d83c904dc785ba6, []int{2}
 }
 func (m *MsgCreateIscnRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -153,7 +222,7 @@ func (m *MsgCreateIscnRecordResponse) Reset()         { *m = MsgCreateIscnRecord
 func (m *MsgCreateIscnRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateIscnRecordResponse) ProtoMessage()    {}
 func (*MsgCreateIscnRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d83c904dc785ba6, []int{2}
+	return fileDescriptor_1d83c904dc785ba6, []int{3}
 }
 func (m *MsgCreateIscnRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -206,7 +275,7 @@ func (m *MsgUpdateIscnRecord) Reset()         { *m = MsgUpdateIscnRecord{} }
 func (m *MsgUpdateIscnRecord) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateIscnRecord) ProtoMessage()    {}
 func (*MsgUpdateIscnRecord) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d83c904dc785ba6, []int{3}
+	return fileDescriptor_1d83c904dc785ba6, []int{4}
 }
 func (m *MsgUpdateIscnRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -265,7 +334,7 @@ func (m *MsgUpdateIscnRecordResponse) Reset()         { *m = MsgUpdateIscnRecord
 func (m *MsgUpdateIscnRecordResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateIscnRecordResponse) ProtoMessage()    {}
 func (*MsgUpdateIscnRecordResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d83c904dc785ba6, []int{4}
+	return fileDescriptor_1d83c904dc785ba6, []int{5}
 }
 func (m *MsgUpdateIscnRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +387,7 @@ func (m *MsgChangeIscnRecordOwnership) Reset()         { *m = MsgChangeIscnRecor
 func (m *MsgChangeIscnRecordOwnership) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeIscnRecordOwnership) ProtoMessage()    {}
 func (*MsgChangeIscnRecordOwnership) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d83c904dc785ba6, []int{5}
+	return fileDescriptor_1d83c904dc785ba6, []int{6}
 }
 func (m *MsgChangeIscnRecordOwnership) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -375,7 +444,7 @@ func (m *MsgChangeIscnRecordOwnershipResponse) Reset()         { *m = MsgChangeI
 func (m *MsgChangeIscnRecordOwnershipResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgChangeIscnRecordOwnershipResponse) ProtoMessage()    {}
 func (*MsgChangeIscnRecordOwnershipResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1d83c904dc785ba6, []int{6}
+	return fileDescriptor_1d83c904dc785ba6, []int{7}
 }
 func (m *MsgChangeIscnRecordOwnershipResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -404,655 +473,5683 @@ func (m *MsgChangeIscnRecordOwnershipResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgChangeIscnRecordOwnershipResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*IscnRecord)(nil), "likechain.iscn.IscnRecord")
-	proto.RegisterType((*MsgCreateIscnRecord)(nil), "likechain.iscn.MsgCreateIscnRecord")
-	proto.RegisterType((*MsgCreateIscnRecordResponse)(nil), "likechain.iscn.MsgCreateIscnRecordResponse")
-	proto.RegisterType((*MsgUpdateIscnRecord)(nil), "likechain.iscn.MsgUpdateIscnRecord")
-	proto.RegisterType((*MsgUpdateIscnRecordResponse)(nil), "likechain.iscn.MsgUpdateIscnRecordResponse")
-	proto.RegisterType((*MsgChangeIscnRecordOwnership)(nil), "likechain.iscn.MsgChangeIscnRecordOwnership")
-	proto.RegisterType((*MsgChangeIscnRecordOwnershipResponse)(nil), "likechain.iscn.MsgChangeIscnRecordOwnershipResponse")
+type MsgFreezeIscnRecord struct {
+	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	IscnId string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("likechain/iscn/tx.proto", fileDescriptor_1d83c904dc785ba6) }
-
-var fileDescriptor_1d83c904dc785ba6 = []byte{
-	// 518 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xdf, 0x8a, 0xd3, 0x40,
-	0x14, 0xc6, 0x9b, 0xa6, 0x56, 0x73, 0xb6, 0xea, 0x3a, 0xbb, 0xb0, 0xb1, 0x2b, 0x69, 0xa8, 0x22,
-	0x01, 0x35, 0xd1, 0xba, 0x17, 0x82, 0x77, 0x15, 0x84, 0x82, 0x55, 0x08, 0x88, 0xe0, 0x4d, 0xc9,
-	0x26, 0x63, 0x32, 0x6c, 0x77, 0x66, 0x98, 0x99, 0xb5, 0xbb, 0x20, 0xf8, 0x0a, 0x3e, 0xd6, 0x5e,
-	0x16, 0xaf, 0xc4, 0x8b, 0x45, 0xda, 0x17, 0x91, 0xfc, 0x69, 0xb7, 0xed, 0xa6, 0x6a, 0xc1, 0xbb,
-	0x93, 0x39, 0xdf, 0x9c, 0xef, 0xfc, 0xce, 0x84, 0x03, 0x7b, 0x43, 0x72, 0x84, 0xc3, 0x24, 0x20,
-	0xd4, 0x23, 0x32, 0xa4, 0x9e, 0x3a, 0x75, 0xb9, 0x60, 0x8a, 0xa1, 0x5b, 0xf3, 0x84, 0x9b, 0x26,
-	0x9a, 0xbb, 0x31, 0x8b, 0x59, 0x96, 0xf2, 0xd2, 0x28, 0x57, 0xb5, 0xc7, 0x1a, 0x40, 0x4f, 0x86,
-	0xd4, 0xc7, 0x21, 0x13, 0x11, 0xb2, 0x61, 0x4b, 0x64, 0xd1, 0x5b, 0xa6, 0xb0, 0x34, 0x35, 0x5b,
-	0x73, 0x0c, 0x7f, 0xf1, 0x08, 0x3d, 0x85, 0x9d, 0x90, 0x51, 0x85, 0xa9, 0x7a, 0x4d, 0x68, 0x8c,
-	0x05, 0x17, 0x84, 0x2a, 0x69, 0x56, 0x6d, 0xdd, 0x31, 0xfc, 0xb2, 0x14, 0x7a, 0x06, 0x0d, 0xa9,
-	0x82, 0x23, 0x9c, 0xb0, 0x61, 0x84, 0x85, 0x34, 0x75, 0x5b, 0x77, 0x1a, 0xdd, 0x9b, 0x3f, 0x2f,
-	0x5a, 0x46, 0xea, 0xdc, 0xa3, 0xfc, 0x44, 0xf9, 0x4b, 0x12, 0xf4, 0x12, 0x6e, 0x17, 0x95, 0xfa,
-	0x58, 0x05, 0x51, 0xa0, 0x02, 0xb3, 0x66, 0x6b, 0x4e, 0xa3, 0x7b, 0xe7, 0xfc, 0xa2, 0x55, 0x59,
-	0xbe, 0xb9, 0xaa, 0x6c, 0x9f, 0xc1, 0x4e, 0x5f, 0xc6, 0xaf, 0x04, 0x0e, 0x14, 0x5e, 0x40, 0x43,
-	0x50, 0xfb, 0x24, 0xd8, 0x71, 0xc1, 0x94, 0xc5, 0xe8, 0x05, 0xd4, 0x73, 0x36, 0xb3, 0x6a, 0x6b,
-	0xce, 0x56, 0xa7, 0xe9, 0x2e, 0x0f, 0xcd, 0xbd, 0xbc, 0xdf, 0xad, 0xa5, 0xd6, 0x7e, 0xa1, 0x47,
-	0xbb, 0x70, 0x8d, 0x32, 0x1a, 0x62, 0x53, 0xb7, 0x35, 0xa7, 0xe6, 0xe7, 0x1f, 0xed, 0x0f, 0xb0,
-	0x5f, 0x62, 0xed, 0x63, 0xc9, 0x19, 0x95, 0x18, 0xed, 0xc1, 0xf5, 0xb4, 0xea, 0x80, 0x44, 0x45,
-	0x17, 0xf5, 0xf4, 0xb3, 0x17, 0xa1, 0xd6, 0x6c, 0xec, 0x03, 0xc2, 0x87, 0x79, 0x33, 0x86, 0x0f,
-	0xf9, 0x51, 0x8f, 0x0f, 0xa3, 0xf6, 0x97, 0x8c, 0xe9, 0x3d, 0x8f, 0xfe, 0xce, 0xb4, 0x60, 0x52,
-	0x5d, 0x32, 0xb9, 0x84, 0xd5, 0x37, 0x83, 0x2d, 0xb0, 0x56, 0xdd, 0xff, 0x03, 0x56, 0x02, 0xf7,
-	0xd2, 0x79, 0x25, 0x01, 0x8d, 0x17, 0x0a, 0xbf, 0x1b, 0x51, 0x2c, 0x64, 0x42, 0xf8, 0x66, 0x7c,
-	0xfb, 0x60, 0x50, 0x3c, 0x1a, 0xb0, 0xf4, 0x76, 0x86, 0x68, 0xf8, 0x37, 0x28, 0x1e, 0x65, 0xd5,
-	0xda, 0x0f, 0xe1, 0xc1, 0x9f, 0x9c, 0x66, 0x2c, 0x9d, 0xef, 0x55, 0xd0, 0xfb, 0x32, 0x46, 0x11,
-	0x6c, 0x5f, 0xf9, 0x83, 0xee, 0xaf, 0x0e, 0xac, 0xe4, 0xad, 0x9b, 0x8f, 0xfe, 0x41, 0x34, 0x9f,
-	0x5c, 0x04, 0xdb, 0x57, 0xde, 0xb4, 0xcc, 0x65, 0x55, 0x54, 0xea, 0xb2, 0xf6, 0x7d, 0xbe, 0xc2,
-	0xdd, 0xf5, 0x23, 0x7e, 0x5c, 0xd6, 0xef, 0x3a, 0x75, 0xf3, 0x60, 0x13, 0xf5, 0xac, 0x81, 0xee,
-	0x9b, 0xf3, 0x89, 0xa5, 0x8d, 0x27, 0x96, 0xf6, 0x6b, 0x62, 0x69, 0xdf, 0xa6, 0x56, 0x65, 0x3c,
-	0xb5, 0x2a, 0x3f, 0xa6, 0x56, 0xe5, 0x63, 0x27, 0x26, 0x2a, 0x39, 0x39, 0x74, 0x43, 0x76, 0xec,
-	0x65, 0x95, 0x19, 0xa1, 0xf3, 0xe0, 0x49, 0xbe, 0xd6, 0x3e, 0x1f, 0x78, 0xa7, 0xc5, 0x6e, 0x3b,
-	0xe3, 0x58, 0x1e, 0xd6, 0xb3, 0xcd, 0xf5, 0xfc, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0x82, 0x90,
-	0xdb, 0x52, 0xfa, 0x04, 0x00, 0x00,
+func (m *MsgFreezeIscnRecord) Reset()         { *m = MsgFreezeIscnRecord{} }
+func (m *MsgFreezeIscnRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeIscnRecord) ProtoMessage()    {}
+func (*MsgFreezeIscnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{8}
+}
+func (m *MsgFreezeIscnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeIscnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeIscnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFreezeIscnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeIscnRecord.Merge(m, src)
+}
+func (m *MsgFreezeIscnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeIscnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeIscnRecord.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgFreezeIscnRecord proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateIscnRecord defines a method to create ISCN metadata
-	CreateIscnRecord(ctx context.Context, in *MsgCreateIscnRecord, opts ...grpc.CallOption) (*MsgCreateIscnRecordResponse, error)
-	// UpdateIscnRecord defines a method to update existing ISCN metadata
-	UpdateIscnRecord(ctx context.Context, in *MsgUpdateIscnRecord, opts ...grpc.CallOption) (*MsgUpdateIscnRecordResponse, error)
-	// ChangeIscnRecordOwnership defines a method to update the ownership of existing ISCN metadata
-	ChangeIscnRecordOwnership(ctx context.Context, in *MsgChangeIscnRecordOwnership, opts ...grpc.CallOption) (*MsgChangeIscnRecordOwnershipResponse, error)
+func (m *MsgFreezeIscnRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgFreezeIscnRecord) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgFreezeIscnRecordResponse struct {
 }
 
-func (c *msgClient) CreateIscnRecord(ctx context.Context, in *MsgCreateIscnRecord, opts ...grpc.CallOption) (*MsgCreateIscnRecordResponse, error) {
-	out := new(MsgCreateIscnRecordResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/CreateIscnRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgFreezeIscnRecordResponse) Reset()         { *m = MsgFreezeIscnRecordResponse{} }
+func (m *MsgFreezeIscnRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFreezeIscnRecordResponse) ProtoMessage()    {}
+func (*MsgFreezeIscnRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{9}
+}
+func (m *MsgFreezeIscnRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFreezeIscnRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFreezeIscnRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgFreezeIscnRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFreezeIscnRecordResponse.Merge(m, src)
+}
+func (m *MsgFreezeIscnRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFreezeIscnRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFreezeIscnRecordResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateIscnRecord(ctx context.Context, in *MsgUpdateIscnRecord, opts ...grpc.CallOption) (*MsgUpdateIscnRecordResponse, error) {
-	out := new(MsgUpdateIscnRecordResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/UpdateIscnRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFreezeIscnRecordResponse proto.InternalMessageInfo
+
+type MsgDeprecateIscnRecord struct {
+	From   string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	IscnId string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+}
+
+func (m *MsgDeprecateIscnRecord) Reset()         { *m = MsgDeprecateIscnRecord{} }
+func (m *MsgDeprecateIscnRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateIscnRecord) ProtoMessage()    {}
+func (*MsgDeprecateIscnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{10}
+}
+func (m *MsgDeprecateIscnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeprecateIscnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateIscnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgDeprecateIscnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateIscnRecord.Merge(m, src)
+}
+func (m *MsgDeprecateIscnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateIscnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateIscnRecord.DiscardUnknown(m)
 }
 
-func (c *msgClient) ChangeIscnRecordOwnership(ctx context.Context, in *MsgChangeIscnRecordOwnership, opts ...grpc.CallOption) (*MsgChangeIscnRecordOwnershipResponse, error) {
-	out := new(MsgChangeIscnRecordOwnershipResponse)
-	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/ChangeIscnRecordOwnership", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgDeprecateIscnRecord proto.InternalMessageInfo
+
+func (m *MsgDeprecateIscnRecord) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateIscnRecord defines a method to create ISCN metadata
-	CreateIscnRecord(context.Context, *MsgCreateIscnRecord) (*MsgCreateIscnRecordResponse, error)
-	// UpdateIscnRecord defines a method to update existing ISCN metadata
-	UpdateIscnRecord(context.Context, *MsgUpdateIscnRecord) (*MsgUpdateIscnRecordResponse, error)
-	// ChangeIscnRecordOwnership defines a method to update the ownership of existing ISCN metadata
-	ChangeIscnRecordOwnership(context.Context, *MsgChangeIscnRecordOwnership) (*MsgChangeIscnRecordOwnershipResponse, error)
+func (m *MsgDeprecateIscnRecord) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgDeprecateIscnRecordResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateIscnRecord(ctx context.Context, req *MsgCreateIscnRecord) (*MsgCreateIscnRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateIscnRecord not implemented")
+func (m *MsgDeprecateIscnRecordResponse) Reset()         { *m = MsgDeprecateIscnRecordResponse{} }
+func (m *MsgDeprecateIscnRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeprecateIscnRecordResponse) ProtoMessage()    {}
+func (*MsgDeprecateIscnRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{11}
 }
-func (*UnimplementedMsgServer) UpdateIscnRecord(ctx context.Context, req *MsgUpdateIscnRecord) (*MsgUpdateIscnRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateIscnRecord not implemented")
+func (m *MsgDeprecateIscnRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ChangeIscnRecordOwnership(ctx context.Context, req *MsgChangeIscnRecordOwnership) (*MsgChangeIscnRecordOwnershipResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ChangeIscnRecordOwnership not implemented")
+func (m *MsgDeprecateIscnRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeprecateIscnRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDeprecateIscnRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeprecateIscnRecordResponse.Merge(m, src)
+}
+func (m *MsgDeprecateIscnRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeprecateIscnRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeprecateIscnRecordResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgDeprecateIscnRecordResponse proto.InternalMessageInfo
+
+type MsgSupersedeIscnRecord struct {
+	From         string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	IscnId       string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	SupersededBy string `protobuf:"bytes,3,opt,name=superseded_by,json=supersededBy,proto3" json:"superseded_by,omitempty"`
 }
 
-func _Msg_CreateIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateIscnRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateIscnRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Msg/CreateIscnRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateIscnRecord(ctx, req.(*MsgCreateIscnRecord))
+func (m *MsgSupersedeIscnRecord) Reset()         { *m = MsgSupersedeIscnRecord{} }
+func (m *MsgSupersedeIscnRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedeIscnRecord) ProtoMessage()    {}
+func (*MsgSupersedeIscnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{12}
+}
+func (m *MsgSupersedeIscnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedeIscnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedeIscnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSupersedeIscnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedeIscnRecord.Merge(m, src)
+}
+func (m *MsgSupersedeIscnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedeIscnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedeIscnRecord.DiscardUnknown(m)
 }
 
-func _Msg_UpdateIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateIscnRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateIscnRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Msg/UpdateIscnRecord",
+var xxx_messageInfo_MsgSupersedeIscnRecord proto.InternalMessageInfo
+
+func (m *MsgSupersedeIscnRecord) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateIscnRecord(ctx, req.(*MsgUpdateIscnRecord))
+	return ""
+}
+
+func (m *MsgSupersedeIscnRecord) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ChangeIscnRecordOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgChangeIscnRecordOwnership)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgSupersedeIscnRecord) GetSupersededBy() string {
+	if m != nil {
+		return m.SupersededBy
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ChangeIscnRecordOwnership(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.iscn.Msg/ChangeIscnRecordOwnership",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ChangeIscnRecordOwnership(ctx, req.(*MsgChangeIscnRecordOwnership))
-	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "likechain.iscn.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateIscnRecord",
-			Handler:    _Msg_CreateIscnRecord_Handler,
-		},
-		{
-			MethodName: "UpdateIscnRecord",
-			Handler:    _Msg_UpdateIscnRecord_Handler,
-		},
-		{
-			MethodName: "ChangeIscnRecordOwnership",
-			Handler:    _Msg_ChangeIscnRecordOwnership_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "likechain/iscn/tx.proto",
+type MsgSupersedeIscnRecordResponse struct {
 }
 
-func (m *IscnRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSupersedeIscnRecordResponse) Reset()         { *m = MsgSupersedeIscnRecordResponse{} }
+func (m *MsgSupersedeIscnRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSupersedeIscnRecordResponse) ProtoMessage()    {}
+func (*MsgSupersedeIscnRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{13}
+}
+func (m *MsgSupersedeIscnRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSupersedeIscnRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSupersedeIscnRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgSupersedeIscnRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSupersedeIscnRecordResponse.Merge(m, src)
+}
+func (m *MsgSupersedeIscnRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSupersedeIscnRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSupersedeIscnRecordResponse.DiscardUnknown(m)
 }
 
-func (m *IscnRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgSupersedeIscnRecordResponse proto.InternalMessageInfo
+
+type BatchCreateIscnRecordEntry struct {
+	Record IscnRecord `protobuf:"bytes,1,opt,name=record,proto3" json:"record"`
+	Nonce  uint64     `protobuf:"varint,2,opt,name=nonce,proto3" json:"nonce,omitempty"`
 }
 
-func (m *IscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ContentMetadata.Size()
-		i -= size
-		if _, err := m.ContentMetadata.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.Stakeholders) > 0 {
-		for iNdEx := len(m.Stakeholders) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size := m.Stakeholders[iNdEx].Size()
-				i -= size
-				if _, err := m.Stakeholders[iNdEx].MarshalTo(dAtA[i:]); err != nil {
-					return 0, err
-				}
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.ContentFingerprints) > 0 {
-		for iNdEx := len(m.ContentFingerprints) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.ContentFingerprints[iNdEx])
-			copy(dAtA[i:], m.ContentFingerprints[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.ContentFingerprints[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *BatchCreateIscnRecordEntry) Reset()         { *m = BatchCreateIscnRecordEntry{} }
+func (m *BatchCreateIscnRecordEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateIscnRecordEntry) ProtoMessage()    {}
+func (*BatchCreateIscnRecordEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{14}
+}
+func (m *BatchCreateIscnRecordEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BatchCreateIscnRecordEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateIscnRecordEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.RecordNotes) > 0 {
-		i -= len(m.RecordNotes)
-		copy(dAtA[i:], m.RecordNotes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordNotes)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *BatchCreateIscnRecordEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateIscnRecordEntry.Merge(m, src)
+}
+func (m *BatchCreateIscnRecordEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCreateIscnRecordEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateIscnRecordEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BatchCreateIscnRecordEntry proto.InternalMessageInfo
+
+func (m *BatchCreateIscnRecordEntry) GetRecord() IscnRecord {
+	if m != nil {
+		return m.Record
 	}
-	return len(dAtA) - i, nil
+	return IscnRecord{}
 }
 
-func (m *MsgCreateIscnRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BatchCreateIscnRecordEntry) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgCreateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchCreateIscnRecords struct {
+	From    string                       `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Entries []BatchCreateIscnRecordEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
 }
 
-func (m *MsgCreateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgBatchCreateIscnRecords) Reset()         { *m = MsgBatchCreateIscnRecords{} }
+func (m *MsgBatchCreateIscnRecords) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateIscnRecords) ProtoMessage()    {}
+func (*MsgBatchCreateIscnRecords) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{15}
+}
+func (m *MsgBatchCreateIscnRecords) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCreateIscnRecords) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateIscnRecords.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgBatchCreateIscnRecords) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateIscnRecords.Merge(m, src)
+}
+func (m *MsgBatchCreateIscnRecords) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCreateIscnRecords) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateIscnRecords.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBatchCreateIscnRecords proto.InternalMessageInfo
+
+func (m *MsgBatchCreateIscnRecords) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateIscnRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgBatchCreateIscnRecords) GetEntries() []BatchCreateIscnRecordEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgCreateIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgBatchCreateIscnRecordsResponse struct {
+	Records []MsgCreateIscnRecordResponse `protobuf:"bytes,1,rep,name=records,proto3" json:"records"`
 }
 
-func (m *MsgCreateIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordIpld) > 0 {
-		i -= len(m.RecordIpld)
-		copy(dAtA[i:], m.RecordIpld)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordIpld)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IscnId) > 0 {
-		i -= len(m.IscnId)
-		copy(dAtA[i:], m.IscnId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgBatchCreateIscnRecordsResponse) Reset()         { *m = MsgBatchCreateIscnRecordsResponse{} }
+func (m *MsgBatchCreateIscnRecordsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateIscnRecordsResponse) ProtoMessage()    {}
+func (*MsgBatchCreateIscnRecordsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{16}
+}
+func (m *MsgBatchCreateIscnRecordsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCreateIscnRecordsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateIscnRecordsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchCreateIscnRecordsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateIscnRecordsResponse.Merge(m, src)
+}
+func (m *MsgBatchCreateIscnRecordsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCreateIscnRecordsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateIscnRecordsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateIscnRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchCreateIscnRecordsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchCreateIscnRecordsResponse) GetRecords() []MsgCreateIscnRecordResponse {
+	if m != nil {
+		return m.Records
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *MsgUpdateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgProposeIscnOwnershipTransfer struct {
+	From       string    `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	IscnId     string    `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	NewOwner   string    `protobuf:"bytes,3,opt,name=new_owner,json=newOwner,proto3" json:"new_owner,omitempty"`
+	Expiration time.Time `protobuf:"bytes,4,opt,name=expiration,proto3,stdtime" json:"expiration"`
 }
 
-func (m *MsgUpdateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgProposeIscnOwnershipTransfer) Reset()         { *m = MsgProposeIscnOwnershipTransfer{} }
+func (m *MsgProposeIscnOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeIscnOwnershipTransfer) ProtoMessage()    {}
+func (*MsgProposeIscnOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{17}
+}
+func (m *MsgProposeIscnOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeIscnOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeIscnOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.IscnId) > 0 {
-		i -= len(m.IscnId)
-		copy(dAtA[i:], m.IscnId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgProposeIscnOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeIscnOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgProposeIscnOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeIscnOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeIscnOwnershipTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateIscnRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgProposeIscnOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgProposeIscnOwnershipTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgProposeIscnOwnershipTransfer) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
 }
 
-func (m *MsgUpdateIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RecordIpld) > 0 {
-		i -= len(m.RecordIpld)
-		copy(dAtA[i:], m.RecordIpld)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordIpld)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IscnId) > 0 {
-		i -= len(m.IscnId)
-		copy(dAtA[i:], m.IscnId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgProposeIscnOwnershipTransfer) GetNewOwner() string {
+	if m != nil {
+		return m.NewOwner
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgChangeIscnRecordOwnership) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgProposeIscnOwnershipTransfer) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
 	}
-	return dAtA[:n], nil
+	return time.Time{}
 }
 
-func (m *MsgChangeIscnRecordOwnership) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type MsgProposeIscnOwnershipTransferResponse struct {
 }
 
-func (m *MsgChangeIscnRecordOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewOwner) > 0 {
-		i -= len(m.NewOwner)
-		copy(dAtA[i:], m.NewOwner)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.IscnId) > 0 {
-		i -= len(m.IscnId)
-		copy(dAtA[i:], m.IscnId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgProposeIscnOwnershipTransferResponse) Reset() {
+	*m = MsgProposeIscnOwnershipTransferResponse{}
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeIscnOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgProposeIscnOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{18}
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeIscnOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.From) > 0 {
-		i -= len(m.From)
-		copy(dAtA[i:], m.From)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeIscnOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeIscnOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeIscnOwnershipTransferResponse proto.InternalMessageInfo
+
+type MsgAcceptIscnOwnershipTransfer struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// version part is ignored
+	IscnId string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+}
+
+func (m *MsgAcceptIscnOwnershipTransfer) Reset()         { *m = MsgAcceptIscnOwnershipTransfer{} }
+func (m *MsgAcceptIscnOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptIscnOwnershipTransfer) ProtoMessage()    {}
+func (*MsgAcceptIscnOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{19}
+}
+func (m *MsgAcceptIscnOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptIscnOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptIscnOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgAcceptIscnOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptIscnOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgAcceptIscnOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptIscnOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptIscnOwnershipTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgChangeIscnRecordOwnershipResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgAcceptIscnOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptIscnOwnershipTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgChangeIscnRecordOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgAcceptIscnOwnershipTransfer) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
 }
 
-func (m *MsgChangeIscnRecordOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgAcceptIscnOwnershipTransferResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgAcceptIscnOwnershipTransferResponse) Reset() {
+	*m = MsgAcceptIscnOwnershipTransferResponse{}
 }
-func (m *IscnRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RecordNotes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.ContentFingerprints) > 0 {
-		for _, s := range m.ContentFingerprints {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgAcceptIscnOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptIscnOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptIscnOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{20}
+}
+func (m *MsgAcceptIscnOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptIscnOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptIscnOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Stakeholders) > 0 {
-		for _, e := range m.Stakeholders {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgAcceptIscnOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptIscnOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptIscnOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptIscnOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptIscnOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptIscnOwnershipTransferResponse proto.InternalMessageInfo
+
+type MsgCancelIscnOwnershipTransfer struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// version part is ignored
+	IscnId string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+}
+
+func (m *MsgCancelIscnOwnershipTransfer) Reset()         { *m = MsgCancelIscnOwnershipTransfer{} }
+func (m *MsgCancelIscnOwnershipTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelIscnOwnershipTransfer) ProtoMessage()    {}
+func (*MsgCancelIscnOwnershipTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{21}
+}
+func (m *MsgCancelIscnOwnershipTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelIscnOwnershipTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelIscnOwnershipTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	l = m.ContentMetadata.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgCancelIscnOwnershipTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelIscnOwnershipTransfer.Merge(m, src)
+}
+func (m *MsgCancelIscnOwnershipTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelIscnOwnershipTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelIscnOwnershipTransfer.DiscardUnknown(m)
 }
 
-func (m *MsgCreateIscnRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
+var xxx_messageInfo_MsgCancelIscnOwnershipTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelIscnOwnershipTransfer) GetFrom() string {
+	if m != nil {
+		return m.From
 	}
-	return n
+	return ""
 }
 
-func (m *MsgCreateIscnRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IscnId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecordIpld)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgCancelIscnOwnershipTransfer) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateIscnRecord) Size() (n int) {
-	if m == nil {
-		return 0
+type MsgCancelIscnOwnershipTransferResponse struct {
+}
+
+func (m *MsgCancelIscnOwnershipTransferResponse) Reset() {
+	*m = MsgCancelIscnOwnershipTransferResponse{}
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelIscnOwnershipTransferResponse) ProtoMessage()    {}
+func (*MsgCancelIscnOwnershipTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{22}
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelIscnOwnershipTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelIscnOwnershipTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelIscnOwnershipTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelIscnOwnershipTransferResponse proto.InternalMessageInfo
+
+type MsgCommitIscn struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// SHA-256 hash of the salt, the sender address bytes and the protobuf encoded IscnRecord to be revealed
+	Commitment []byte `protobuf:"bytes,2,opt,name=commitment,proto3" json:"commitment,omitempty"`
+}
+
+func (m *MsgCommitIscn) Reset()         { *m = MsgCommitIscn{} }
+func (m *MsgCommitIscn) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitIscn) ProtoMessage()    {}
+func (*MsgCommitIscn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{23}
+}
+func (m *MsgCommitIscn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitIscn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitIscn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitIscn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitIscn.Merge(m, src)
+}
+func (m *MsgCommitIscn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitIscn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitIscn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitIscn proto.InternalMessageInfo
+
+func (m *MsgCommitIscn) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgCommitIscn) GetCommitment() []byte {
+	if m != nil {
+		return m.Commitment
+	}
+	return nil
+}
+
+type MsgCommitIscnResponse struct {
+	Expiration time.Time `protobuf:"bytes,1,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *MsgCommitIscnResponse) Reset()         { *m = MsgCommitIscnResponse{} }
+func (m *MsgCommitIscnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCommitIscnResponse) ProtoMessage()    {}
+func (*MsgCommitIscnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{24}
+}
+func (m *MsgCommitIscnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCommitIscnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCommitIscnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCommitIscnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCommitIscnResponse.Merge(m, src)
+}
+func (m *MsgCommitIscnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCommitIscnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCommitIscnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCommitIscnResponse proto.InternalMessageInfo
+
+func (m *MsgCommitIscnResponse) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+type MsgRevealIscn struct {
+	From   string     `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	Record IscnRecord `protobuf:"bytes,2,opt,name=record,proto3" json:"record"`
+	Salt   []byte     `protobuf:"bytes,3,opt,name=salt,proto3" json:"salt,omitempty"`
+	Nonce  uint64     `protobuf:"varint,4,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *MsgRevealIscn) Reset()         { *m = MsgRevealIscn{} }
+func (m *MsgRevealIscn) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealIscn) ProtoMessage()    {}
+func (*MsgRevealIscn) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{25}
+}
+func (m *MsgRevealIscn) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealIscn) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealIscn.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealIscn) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealIscn.Merge(m, src)
+}
+func (m *MsgRevealIscn) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealIscn) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealIscn.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealIscn proto.InternalMessageInfo
+
+func (m *MsgRevealIscn) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgRevealIscn) GetRecord() IscnRecord {
+	if m != nil {
+		return m.Record
+	}
+	return IscnRecord{}
+}
+
+func (m *MsgRevealIscn) GetSalt() []byte {
+	if m != nil {
+		return m.Salt
+	}
+	return nil
+}
+
+func (m *MsgRevealIscn) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
+type MsgRevealIscnResponse struct {
+	IscnId     string `protobuf:"bytes,1,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+	RecordIpld string `protobuf:"bytes,2,opt,name=record_ipld,json=recordIpld,proto3" json:"record_ipld,omitempty"`
+}
+
+func (m *MsgRevealIscnResponse) Reset()         { *m = MsgRevealIscnResponse{} }
+func (m *MsgRevealIscnResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevealIscnResponse) ProtoMessage()    {}
+func (*MsgRevealIscnResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{26}
+}
+func (m *MsgRevealIscnResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevealIscnResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevealIscnResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevealIscnResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevealIscnResponse.Merge(m, src)
+}
+func (m *MsgRevealIscnResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevealIscnResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevealIscnResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevealIscnResponse proto.InternalMessageInfo
+
+func (m *MsgRevealIscnResponse) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+func (m *MsgRevealIscnResponse) GetRecordIpld() string {
+	if m != nil {
+		return m.RecordIpld
+	}
+	return ""
+}
+
+type MsgAttestIscnRecord struct {
+	// address named in the `entity.@id` of a stakeholder of the record
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// ISCN ID with version
+	IscnId string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+}
+
+func (m *MsgAttestIscnRecord) Reset()         { *m = MsgAttestIscnRecord{} }
+func (m *MsgAttestIscnRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestIscnRecord) ProtoMessage()    {}
+func (*MsgAttestIscnRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{27}
+}
+func (m *MsgAttestIscnRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestIscnRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestIscnRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestIscnRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestIscnRecord.Merge(m, src)
+}
+func (m *MsgAttestIscnRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestIscnRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestIscnRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestIscnRecord proto.InternalMessageInfo
+
+func (m *MsgAttestIscnRecord) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgAttestIscnRecord) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+type MsgAttestIscnRecordResponse struct {
+}
+
+func (m *MsgAttestIscnRecordResponse) Reset()         { *m = MsgAttestIscnRecordResponse{} }
+func (m *MsgAttestIscnRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAttestIscnRecordResponse) ProtoMessage()    {}
+func (*MsgAttestIscnRecordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{28}
+}
+func (m *MsgAttestIscnRecordResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAttestIscnRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAttestIscnRecordResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAttestIscnRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAttestIscnRecordResponse.Merge(m, src)
+}
+func (m *MsgAttestIscnRecordResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAttestIscnRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAttestIscnRecordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAttestIscnRecordResponse proto.InternalMessageInfo
+
+type MsgRevokeIscnRecordAttestation struct {
+	From string `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	// ISCN ID with version
+	IscnId string `protobuf:"bytes,2,opt,name=iscn_id,json=iscnId,proto3" json:"iscn_id,omitempty"`
+}
+
+func (m *MsgRevokeIscnRecordAttestation) Reset()         { *m = MsgRevokeIscnRecordAttestation{} }
+func (m *MsgRevokeIscnRecordAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeIscnRecordAttestation) ProtoMessage()    {}
+func (*MsgRevokeIscnRecordAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{29}
+}
+func (m *MsgRevokeIscnRecordAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeIscnRecordAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeIscnRecordAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeIscnRecordAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeIscnRecordAttestation.Merge(m, src)
+}
+func (m *MsgRevokeIscnRecordAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeIscnRecordAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeIscnRecordAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeIscnRecordAttestation proto.InternalMessageInfo
+
+func (m *MsgRevokeIscnRecordAttestation) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *MsgRevokeIscnRecordAttestation) GetIscnId() string {
+	if m != nil {
+		return m.IscnId
+	}
+	return ""
+}
+
+type MsgRevokeIscnRecordAttestationResponse struct {
+}
+
+func (m *MsgRevokeIscnRecordAttestationResponse) Reset() {
+	*m = MsgRevokeIscnRecordAttestationResponse{}
+}
+func (m *MsgRevokeIscnRecordAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeIscnRecordAttestationResponse) ProtoMessage()    {}
+func (*MsgRevokeIscnRecordAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1d83c904dc785ba6, []int{30}
+}
+func (m *MsgRevokeIscnRecordAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeIscnRecordAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeIscnRecordAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeIscnRecordAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeIscnRecordAttestationResponse.Merge(m, src)
+}
+func (m *MsgRevokeIscnRecordAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeIscnRecordAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeIscnRecordAttestationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeIscnRecordAttestationResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*IscnRecord)(nil), "likechain.iscn.IscnRecord")
+	proto.RegisterType((*IscnRelation)(nil), "likechain.iscn.IscnRelation")
+	proto.RegisterType((*MsgCreateIscnRecord)(nil), "likechain.iscn.MsgCreateIscnRecord")
+	proto.RegisterType((*MsgCreateIscnRecordResponse)(nil), "likechain.iscn.MsgCreateIscnRecordResponse")
+	proto.RegisterType((*MsgUpdateIscnRecord)(nil), "likechain.iscn.MsgUpdateIscnRecord")
+	proto.RegisterType((*MsgUpdateIscnRecordResponse)(nil), "likechain.iscn.MsgUpdateIscnRecordResponse")
+	proto.RegisterType((*MsgChangeIscnRecordOwnership)(nil), "likechain.iscn.MsgChangeIscnRecordOwnership")
+	proto.RegisterType((*MsgChangeIscnRecordOwnershipResponse)(nil), "likechain.iscn.MsgChangeIscnRecordOwnershipResponse")
+	proto.RegisterType((*MsgFreezeIscnRecord)(nil), "likechain.iscn.MsgFreezeIscnRecord")
+	proto.RegisterType((*MsgFreezeIscnRecordResponse)(nil), "likechain.iscn.MsgFreezeIscnRecordResponse")
+	proto.RegisterType((*MsgDeprecateIscnRecord)(nil), "likechain.iscn.MsgDeprecateIscnRecord")
+	proto.RegisterType((*MsgDeprecateIscnRecordResponse)(nil), "likechain.iscn.MsgDeprecateIscnRecordResponse")
+	proto.RegisterType((*MsgSupersedeIscnRecord)(nil), "likechain.iscn.MsgSupersedeIscnRecord")
+	proto.RegisterType((*MsgSupersedeIscnRecordResponse)(nil), "likechain.iscn.MsgSupersedeIscnRecordResponse")
+	proto.RegisterType((*BatchCreateIscnRecordEntry)(nil), "likechain.iscn.BatchCreateIscnRecordEntry")
+	proto.RegisterType((*MsgBatchCreateIscnRecords)(nil), "likechain.iscn.MsgBatchCreateIscnRecords")
+	proto.RegisterType((*MsgBatchCreateIscnRecordsResponse)(nil), "likechain.iscn.MsgBatchCreateIscnRecordsResponse")
+	proto.RegisterType((*MsgProposeIscnOwnershipTransfer)(nil), "likechain.iscn.MsgProposeIscnOwnershipTransfer")
+	proto.RegisterType((*MsgProposeIscnOwnershipTransferResponse)(nil), "likechain.iscn.MsgProposeIscnOwnershipTransferResponse")
+	proto.RegisterType((*MsgAcceptIscnOwnershipTransfer)(nil), "likechain.iscn.MsgAcceptIscnOwnershipTransfer")
+	proto.RegisterType((*MsgAcceptIscnOwnershipTransferResponse)(nil), "likechain.iscn.MsgAcceptIscnOwnershipTransferResponse")
+	proto.RegisterType((*MsgCancelIscnOwnershipTransfer)(nil), "likechain.iscn.MsgCancelIscnOwnershipTransfer")
+	proto.RegisterType((*MsgCancelIscnOwnershipTransferResponse)(nil), "likechain.iscn.MsgCancelIscnOwnershipTransferResponse")
+	proto.RegisterType((*MsgCommitIscn)(nil), "likechain.iscn.MsgCommitIscn")
+	proto.RegisterType((*MsgCommitIscnResponse)(nil), "likechain.iscn.MsgCommitIscnResponse")
+	proto.RegisterType((*MsgRevealIscn)(nil), "likechain.iscn.MsgRevealIscn")
+	proto.RegisterType((*MsgRevealIscnResponse)(nil), "likechain.iscn.MsgRevealIscnResponse")
+	proto.RegisterType((*MsgAttestIscnRecord)(nil), "likechain.iscn.MsgAttestIscnRecord")
+	proto.RegisterType((*MsgAttestIscnRecordResponse)(nil), "likechain.iscn.MsgAttestIscnRecordResponse")
+	proto.RegisterType((*MsgRevokeIscnRecordAttestation)(nil), "likechain.iscn.MsgRevokeIscnRecordAttestation")
+	proto.RegisterType((*MsgRevokeIscnRecordAttestationResponse)(nil), "likechain.iscn.MsgRevokeIscnRecordAttestationResponse")
+}
+
+func init() { proto.RegisterFile("likechain/iscn/tx.proto", fileDescriptor_1d83c904dc785ba6) }
+
+var fileDescriptor_1d83c904dc785ba6 = []byte{
+	// 1112 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x73, 0xdb, 0x44,
+	0x18, 0x8e, 0x62, 0x37, 0xa9, 0xdf, 0x38, 0x7c, 0x28, 0x6d, 0xea, 0x2a, 0xa9, 0x6d, 0x54, 0x1a,
+	0x5c, 0x02, 0x32, 0x35, 0x9d, 0xc0, 0x94, 0x53, 0x9d, 0xb6, 0x33, 0x01, 0xcc, 0x87, 0x28, 0xc3,
+	0x0c, 0x33, 0x4c, 0x46, 0x91, 0x36, 0xb2, 0x88, 0xbc, 0xab, 0xd9, 0xdd, 0x7c, 0x18, 0x98, 0xe1,
+	0xc4, 0xc0, 0x81, 0x43, 0xff, 0x0a, 0xbf, 0x80, 0x6b, 0x8f, 0x3d, 0x32, 0x1c, 0x0a, 0x93, 0xfc,
+	0x91, 0x8e, 0x56, 0xb2, 0x2c, 0x2b, 0x6b, 0xd5, 0x76, 0x73, 0x5b, 0xed, 0x3e, 0xfb, 0x3c, 0xcf,
+	0xbb, 0xbb, 0xef, 0xbe, 0x3b, 0x82, 0x6b, 0xbe, 0x77, 0x80, 0xec, 0xae, 0xe5, 0xe1, 0xa6, 0xc7,
+	0x6c, 0xdc, 0xe4, 0x27, 0x46, 0x40, 0x09, 0x27, 0xea, 0x6b, 0xc9, 0x80, 0x11, 0x0e, 0x68, 0x57,
+	0x5c, 0xe2, 0x12, 0x31, 0xd4, 0x0c, 0x5b, 0x11, 0x4a, 0xab, 0xb9, 0x84, 0xb8, 0x3e, 0x6a, 0x8a,
+	0xaf, 0xbd, 0xc3, 0xfd, 0x26, 0xf7, 0x7a, 0x88, 0x71, 0xab, 0x17, 0x44, 0x00, 0xfd, 0xcf, 0x79,
+	0x80, 0x1d, 0x66, 0x63, 0x13, 0xd9, 0x84, 0x3a, 0x6a, 0x1d, 0x96, 0xa8, 0x68, 0x7d, 0x41, 0x38,
+	0x62, 0x15, 0xa5, 0xae, 0x34, 0x4a, 0x66, 0xba, 0x4b, 0xfd, 0x00, 0x56, 0x6c, 0x82, 0x39, 0xc2,
+	0xfc, 0x91, 0x87, 0x5d, 0x44, 0x03, 0xea, 0x61, 0xce, 0x2a, 0xf3, 0xf5, 0x42, 0xa3, 0x64, 0xca,
+	0x86, 0xd4, 0x3b, 0x50, 0x66, 0xdc, 0x3a, 0x40, 0x5d, 0xe2, 0x3b, 0x88, 0xb2, 0x4a, 0xa1, 0x5e,
+	0x68, 0x94, 0xdb, 0xcb, 0xff, 0x3e, 0xaf, 0x95, 0x42, 0xe5, 0x1d, 0x1c, 0x1c, 0x72, 0x73, 0x04,
+	0xa2, 0x7e, 0x02, 0xaf, 0xc7, 0x4c, 0x1d, 0xc4, 0x2d, 0xc7, 0xe2, 0x56, 0xa5, 0x58, 0x57, 0x1a,
+	0xe5, 0xf6, 0x9b, 0x4f, 0x9f, 0xd7, 0xe6, 0x46, 0x67, 0x66, 0x91, 0xea, 0x3d, 0x28, 0x51, 0xe4,
+	0x5b, 0xdc, 0x23, 0x98, 0x55, 0x2e, 0xd5, 0x0b, 0x8d, 0xa5, 0xd6, 0xba, 0x31, 0xba, 0x5a, 0x46,
+	0x14, 0x72, 0x04, 0x32, 0x87, 0x70, 0xfd, 0x1e, 0x94, 0xd3, 0x43, 0xaa, 0x0a, 0x45, 0xde, 0x0f,
+	0x50, 0xbc, 0x10, 0xa2, 0xad, 0xae, 0xc2, 0x02, 0xb7, 0xa8, 0x8b, 0x78, 0x65, 0x5e, 0xf4, 0xc6,
+	0x5f, 0x7a, 0x1f, 0x56, 0x3a, 0xcc, 0xdd, 0xa6, 0xc8, 0xe2, 0x28, 0xb5, 0xa4, 0x2a, 0x14, 0xf7,
+	0x29, 0xe9, 0x0d, 0x28, 0xc2, 0xb6, 0xfa, 0x31, 0x2c, 0x44, 0x6b, 0x2a, 0x28, 0x96, 0x5a, 0x9a,
+	0xdc, 0x5f, 0x88, 0x68, 0x17, 0xc3, 0x90, 0xcd, 0x18, 0xaf, 0x5e, 0x81, 0x4b, 0x98, 0x60, 0x1b,
+	0x55, 0x0a, 0x75, 0xa5, 0x51, 0x34, 0xa3, 0x0f, 0xfd, 0x3b, 0x58, 0x93, 0x48, 0x9b, 0x88, 0x05,
+	0x04, 0x33, 0xa4, 0x5e, 0x83, 0xc5, 0x90, 0x75, 0xd7, 0x73, 0x62, 0x17, 0x0b, 0xe1, 0xe7, 0x8e,
+	0xa3, 0xd6, 0x06, 0xdb, 0xbd, 0xeb, 0x05, 0xbe, 0x13, 0xc7, 0x03, 0x51, 0xd7, 0x4e, 0xe0, 0x3b,
+	0xfa, 0x2f, 0x22, 0xa6, 0x6f, 0x03, 0xe7, 0xe5, 0x31, 0xa5, 0x44, 0xe6, 0x47, 0x44, 0x86, 0xc1,
+	0x16, 0xa6, 0x0b, 0x36, 0x0e, 0x2b, 0xab, 0x7e, 0x01, 0x61, 0x75, 0x61, 0x3d, 0x5c, 0xaf, 0xae,
+	0x85, 0xdd, 0x14, 0xf1, 0x97, 0xc7, 0x18, 0x51, 0xd6, 0xf5, 0x82, 0xe9, 0xe2, 0x5b, 0x83, 0x12,
+	0x46, 0xc7, 0xbb, 0x24, 0x9c, 0x2d, 0x42, 0x2c, 0x99, 0x97, 0x31, 0x3a, 0x16, 0x6c, 0xfa, 0x06,
+	0xbc, 0x9d, 0xa7, 0x34, 0x88, 0x45, 0x6f, 0x8b, 0x85, 0x7e, 0x44, 0x11, 0xfa, 0x69, 0xd6, 0x85,
+	0xd6, 0x6f, 0xc0, 0x9a, 0x84, 0x23, 0x91, 0x78, 0x08, 0xab, 0x1d, 0xe6, 0x3e, 0x40, 0x01, 0x45,
+	0xf6, 0xec, 0xdb, 0xa9, 0xd7, 0xa1, 0x2a, 0xa7, 0x49, 0x84, 0x7e, 0x14, 0x42, 0xdf, 0x1c, 0x06,
+	0x88, 0x32, 0xe4, 0xcc, 0x7c, 0x6e, 0x6e, 0xc2, 0x32, 0x1b, 0x70, 0x38, 0xbb, 0x7b, 0xfd, 0x78,
+	0x6d, 0xcb, 0xc3, 0xce, 0x76, 0x3f, 0x76, 0x23, 0xd1, 0x4a, 0xdc, 0xf8, 0xa0, 0xb5, 0x2d, 0x6e,
+	0x77, 0xb3, 0xd9, 0xf1, 0x10, 0x73, 0xda, 0x4f, 0x1d, 0x4e, 0x65, 0xd6, 0x4c, 0x9c, 0x4f, 0x67,
+	0xe2, 0xcf, 0x70, 0xbd, 0xc3, 0x5c, 0xa9, 0x20, 0x93, 0x86, 0xff, 0x29, 0x2c, 0x22, 0xcc, 0xa9,
+	0x87, 0xa2, 0x3b, 0x74, 0xa9, 0xf5, 0x6e, 0xd6, 0xc1, 0x78, 0xf7, 0xb1, 0xa3, 0x01, 0x81, 0x1e,
+	0xc0, 0x5b, 0x63, 0xc5, 0x93, 0xac, 0xf9, 0x0c, 0x16, 0xa3, 0x08, 0xc2, 0xeb, 0x3d, 0x14, 0xdc,
+	0xcc, 0x0a, 0xe6, 0x5c, 0x25, 0x03, 0xc5, 0x98, 0x41, 0xff, 0x4b, 0x81, 0x5a, 0x87, 0xb9, 0x5f,
+	0x51, 0x12, 0x10, 0x26, 0xf0, 0xc9, 0xd1, 0x7e, 0x4c, 0x2d, 0xcc, 0xf6, 0x11, 0xbd, 0xb8, 0x64,
+	0x52, 0x1f, 0x00, 0xa0, 0x93, 0xc0, 0xa3, 0xe2, 0x6e, 0xae, 0x14, 0xe3, 0x0d, 0x8b, 0x4a, 0x9c,
+	0x31, 0x28, 0x71, 0xc6, 0xe3, 0x41, 0x89, 0x6b, 0x5f, 0x0e, 0xcd, 0x3e, 0xf9, 0xaf, 0xa6, 0x98,
+	0xa9, 0x79, 0xfa, 0x6d, 0x78, 0xe7, 0x25, 0x96, 0x93, 0xb3, 0xd3, 0x11, 0xa7, 0xeb, 0xbe, 0x6d,
+	0xa3, 0x80, 0xbf, 0x7a, 0x70, 0x7a, 0x03, 0x36, 0xf2, 0xe9, 0x32, 0xc2, 0xdb, 0x16, 0xb6, 0x91,
+	0x7f, 0x61, 0xc2, 0x39, 0x74, 0x89, 0xf0, 0x36, 0x2c, 0x87, 0x48, 0xd2, 0xeb, 0x79, 0xc2, 0xa2,
+	0x54, 0xa7, 0x0a, 0x60, 0x0b, 0x44, 0x0f, 0xe1, 0xa8, 0x0a, 0x96, 0xcd, 0x54, 0x8f, 0xfe, 0x03,
+	0x5c, 0x1d, 0x21, 0x49, 0xce, 0xde, 0xe8, 0x06, 0x2a, 0x33, 0x6e, 0xe0, 0xef, 0x8a, 0x30, 0x69,
+	0xa2, 0x23, 0x64, 0xf9, 0x63, 0x4d, 0xce, 0x5e, 0x63, 0x55, 0x28, 0x32, 0xcb, 0xe7, 0xe2, 0xf8,
+	0x95, 0x4d, 0xd1, 0x1e, 0x66, 0x7b, 0x31, 0x9d, 0xed, 0x5f, 0xc3, 0xd5, 0x11, 0x23, 0x17, 0x50,
+	0x9a, 0xa2, 0x42, 0x70, 0x9f, 0x73, 0xc4, 0xf8, 0xab, 0x15, 0x82, 0x2c, 0x47, 0xe6, 0x70, 0x99,
+	0xe8, 0x88, 0x1c, 0xa4, 0x52, 0x3c, 0x82, 0x27, 0xcf, 0x9e, 0x69, 0x0f, 0x57, 0x0e, 0xdd, 0x40,
+	0xb8, 0xf5, 0x77, 0x19, 0x0a, 0x1d, 0xe6, 0xaa, 0x0e, 0xbc, 0x71, 0xee, 0x99, 0x74, 0x73, 0x82,
+	0x5b, 0x48, 0x9b, 0xe6, 0xaa, 0x0a, 0x55, 0xce, 0x3d, 0x5c, 0x64, 0x2a, 0x59, 0x90, 0xb6, 0x39,
+	0x01, 0x28, 0x51, 0xf9, 0x15, 0xae, 0x8f, 0x7f, 0x47, 0xbc, 0x27, 0xf3, 0x3b, 0x0e, 0xad, 0xdd,
+	0x9d, 0x06, 0x9d, 0x0e, 0xf3, 0xdc, 0xb3, 0x41, 0x16, 0x66, 0x16, 0xa4, 0x6d, 0x4e, 0x00, 0x4a,
+	0x54, 0x7a, 0xb0, 0x22, 0x7b, 0x39, 0x6c, 0x48, 0x38, 0x24, 0x38, 0xcd, 0x98, 0x0c, 0x97, 0x96,
+	0x93, 0xbd, 0x1f, 0x64, 0x72, 0x12, 0x9c, 0x66, 0x4c, 0x86, 0x4b, 0xe4, 0x8e, 0x60, 0x75, 0x4c,
+	0xc9, 0xbe, 0x2d, 0x61, 0x92, 0x43, 0xb5, 0x3b, 0x13, 0x43, 0x13, 0xdd, 0x3f, 0x14, 0x58, 0xcf,
+	0xad, 0x9d, 0x4d, 0x09, 0x67, 0xde, 0x04, 0xed, 0xa3, 0x29, 0x27, 0x24, 0x56, 0x7e, 0x53, 0x60,
+	0x2d, 0xaf, 0xd0, 0xc9, 0x96, 0x34, 0x07, 0xaf, 0x6d, 0x4d, 0x87, 0x1f, 0xf1, 0x91, 0x57, 0xf7,
+	0x64, 0x3e, 0x72, 0xf0, 0xda, 0xd6, 0x74, 0xf8, 0xc4, 0x87, 0x09, 0x90, 0xaa, 0x82, 0x37, 0x64,
+	0x2c, 0xc9, 0xb0, 0x76, 0x2b, 0x77, 0x38, 0xcd, 0x99, 0x2a, 0x5a, 0x32, 0xce, 0xe1, 0xb0, 0x76,
+	0x2b, 0x77, 0x38, 0x9d, 0xfe, 0xe7, 0x8a, 0x85, 0x2c, 0xfd, 0xb3, 0x20, 0x6d, 0x73, 0x02, 0xd0,
+	0xc8, 0xae, 0xe4, 0x15, 0x0c, 0x43, 0x6e, 0x76, 0x1c, 0x5e, 0xdb, 0x9a, 0x0e, 0x9f, 0x3c, 0x3f,
+	0x3f, 0x7f, 0x7a, 0x5a, 0x55, 0x9e, 0x9d, 0x56, 0x95, 0xff, 0x4f, 0xab, 0xca, 0x93, 0xb3, 0xea,
+	0xdc, 0xb3, 0xb3, 0xea, 0xdc, 0x3f, 0x67, 0xd5, 0xb9, 0xef, 0x5b, 0xae, 0xc7, 0xbb, 0x87, 0x7b,
+	0x86, 0x4d, 0x7a, 0x4d, 0xc1, 0x4d, 0x3c, 0x9c, 0x34, 0xde, 0x8f, 0xfe, 0xa0, 0x1c, 0xdd, 0x6d,
+	0x9e, 0xc4, 0xbf, 0x51, 0xfa, 0x01, 0x62, 0x7b, 0x0b, 0xe2, 0xc9, 0xf1, 0xe1, 0x8b, 0x01, 0x00,
+	0x55, 0xd7, 0x23, 0x9a, 0x65, 0x11, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateIscnRecord defines a method to create ISCN metadata
+	CreateIscnRecord(ctx context.Context, in *MsgCreateIscnRecord, opts ...grpc.CallOption) (*MsgCreateIscnRecordResponse, error)
+	// UpdateIscnRecord defines a method to update existing ISCN metadata
+	UpdateIscnRecord(ctx context.Context, in *MsgUpdateIscnRecord, opts ...grpc.CallOption) (*MsgUpdateIscnRecordResponse, error)
+	// ChangeIscnRecordOwnership defines a method to update the ownership of existing ISCN metadata
+	ChangeIscnRecordOwnership(ctx context.Context, in *MsgChangeIscnRecordOwnership, opts ...grpc.CallOption) (*MsgChangeIscnRecordOwnershipResponse, error)
+	// FreezeIscnRecord defines a method to stop existing ISCN metadata from accepting new versions
+	FreezeIscnRecord(ctx context.Context, in *MsgFreezeIscnRecord, opts ...grpc.CallOption) (*MsgFreezeIscnRecordResponse, error)
+	// DeprecateIscnRecord defines a method to mark existing ISCN metadata as withdrawn
+	DeprecateIscnRecord(ctx context.Context, in *MsgDeprecateIscnRecord, opts ...grpc.CallOption) (*MsgDeprecateIscnRecordResponse, error)
+	// SupersedeIscnRecord defines a method to mark existing ISCN metadata as replaced by another ISCN record
+	SupersedeIscnRecord(ctx context.Context, in *MsgSupersedeIscnRecord, opts ...grpc.CallOption) (*MsgSupersedeIscnRecordResponse, error)
+	// BatchCreateIscnRecords defines a method to create multiple ISCN metadata atomically
+	BatchCreateIscnRecords(ctx context.Context, in *MsgBatchCreateIscnRecords, opts ...grpc.CallOption) (*MsgBatchCreateIscnRecordsResponse, error)
+	// ProposeIscnOwnershipTransfer defines a method to propose transferring the ownership of existing ISCN metadata,
+	// which takes effect after the new owner accepts
+	ProposeIscnOwnershipTransfer(ctx context.Context, in *MsgProposeIscnOwnershipTransfer, opts ...grpc.CallOption) (*MsgProposeIscnOwnershipTransferResponse, error)
+	// AcceptIscnOwnershipTransfer defines a method for the new owner to accept a pending ownership transfer
+	AcceptIscnOwnershipTransfer(ctx context.Context, in *MsgAcceptIscnOwnershipTransfer, opts ...grpc.CallOption) (*MsgAcceptIscnOwnershipTransferResponse, error)
+	// CancelIscnOwnershipTransfer defines a method for the owner to cancel a pending ownership transfer
+	CancelIscnOwnershipTransfer(ctx context.Context, in *MsgCancelIscnOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelIscnOwnershipTransferResponse, error)
+	// CommitIscn defines a method to store a salted hash commitment of ISCN metadata, proving its existence without
+	// revealing it
+	CommitIscn(ctx context.Context, in *MsgCommitIscn, opts ...grpc.CallOption) (*MsgCommitIscnResponse, error)
+	// RevealIscn defines a method to create ISCN metadata from a previous commitment, carrying the commit time
+	RevealIscn(ctx context.Context, in *MsgRevealIscn, opts ...grpc.CallOption) (*MsgRevealIscnResponse, error)
+	// AttestIscnRecord defines a method for a stakeholder of a specific ISCN metadata version to confirm its stake
+	AttestIscnRecord(ctx context.Context, in *MsgAttestIscnRecord, opts ...grpc.CallOption) (*MsgAttestIscnRecordResponse, error)
+	// RevokeIscnRecordAttestation defines a method for a stakeholder to revoke a previous attestation
+	RevokeIscnRecordAttestation(ctx context.Context, in *MsgRevokeIscnRecordAttestation, opts ...grpc.CallOption) (*MsgRevokeIscnRecordAttestationResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateIscnRecord(ctx context.Context, in *MsgCreateIscnRecord, opts ...grpc.CallOption) (*MsgCreateIscnRecordResponse, error) {
+	out := new(MsgCreateIscnRecordResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/CreateIscnRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateIscnRecord(ctx context.Context, in *MsgUpdateIscnRecord, opts ...grpc.CallOption) (*MsgUpdateIscnRecordResponse, error) {
+	out := new(MsgUpdateIscnRecordResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/UpdateIscnRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ChangeIscnRecordOwnership(ctx context.Context, in *MsgChangeIscnRecordOwnership, opts ...grpc.CallOption) (*MsgChangeIscnRecordOwnershipResponse, error) {
+	out := new(MsgChangeIscnRecordOwnershipResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/ChangeIscnRecordOwnership", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FreezeIscnRecord(ctx context.Context, in *MsgFreezeIscnRecord, opts ...grpc.CallOption) (*MsgFreezeIscnRecordResponse, error) {
+	out := new(MsgFreezeIscnRecordResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/FreezeIscnRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeprecateIscnRecord(ctx context.Context, in *MsgDeprecateIscnRecord, opts ...grpc.CallOption) (*MsgDeprecateIscnRecordResponse, error) {
+	out := new(MsgDeprecateIscnRecordResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/DeprecateIscnRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SupersedeIscnRecord(ctx context.Context, in *MsgSupersedeIscnRecord, opts ...grpc.CallOption) (*MsgSupersedeIscnRecordResponse, error) {
+	out := new(MsgSupersedeIscnRecordResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/SupersedeIscnRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchCreateIscnRecords(ctx context.Context, in *MsgBatchCreateIscnRecords, opts ...grpc.CallOption) (*MsgBatchCreateIscnRecordsResponse, error) {
+	out := new(MsgBatchCreateIscnRecordsResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/BatchCreateIscnRecords", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeIscnOwnershipTransfer(ctx context.Context, in *MsgProposeIscnOwnershipTransfer, opts ...grpc.CallOption) (*MsgProposeIscnOwnershipTransferResponse, error) {
+	out := new(MsgProposeIscnOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/ProposeIscnOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptIscnOwnershipTransfer(ctx context.Context, in *MsgAcceptIscnOwnershipTransfer, opts ...grpc.CallOption) (*MsgAcceptIscnOwnershipTransferResponse, error) {
+	out := new(MsgAcceptIscnOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/AcceptIscnOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelIscnOwnershipTransfer(ctx context.Context, in *MsgCancelIscnOwnershipTransfer, opts ...grpc.CallOption) (*MsgCancelIscnOwnershipTransferResponse, error) {
+	out := new(MsgCancelIscnOwnershipTransferResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/CancelIscnOwnershipTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CommitIscn(ctx context.Context, in *MsgCommitIscn, opts ...grpc.CallOption) (*MsgCommitIscnResponse, error) {
+	out := new(MsgCommitIscnResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/CommitIscn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevealIscn(ctx context.Context, in *MsgRevealIscn, opts ...grpc.CallOption) (*MsgRevealIscnResponse, error) {
+	out := new(MsgRevealIscnResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/RevealIscn", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AttestIscnRecord(ctx context.Context, in *MsgAttestIscnRecord, opts ...grpc.CallOption) (*MsgAttestIscnRecordResponse, error) {
+	out := new(MsgAttestIscnRecordResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/AttestIscnRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeIscnRecordAttestation(ctx context.Context, in *MsgRevokeIscnRecordAttestation, opts ...grpc.CallOption) (*MsgRevokeIscnRecordAttestationResponse, error) {
+	out := new(MsgRevokeIscnRecordAttestationResponse)
+	err := c.cc.Invoke(ctx, "/likechain.iscn.Msg/RevokeIscnRecordAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateIscnRecord defines a method to create ISCN metadata
+	CreateIscnRecord(context.Context, *MsgCreateIscnRecord) (*MsgCreateIscnRecordResponse, error)
+	// UpdateIscnRecord defines a method to update existing ISCN metadata
+	UpdateIscnRecord(context.Context, *MsgUpdateIscnRecord) (*MsgUpdateIscnRecordResponse, error)
+	// ChangeIscnRecordOwnership defines a method to update the ownership of existing ISCN metadata
+	ChangeIscnRecordOwnership(context.Context, *MsgChangeIscnRecordOwnership) (*MsgChangeIscnRecordOwnershipResponse, error)
+	// FreezeIscnRecord defines a method to stop existing ISCN metadata from accepting new versions
+	FreezeIscnRecord(context.Context, *MsgFreezeIscnRecord) (*MsgFreezeIscnRecordResponse, error)
+	// DeprecateIscnRecord defines a method to mark existing ISCN metadata as withdrawn
+	DeprecateIscnRecord(context.Context, *MsgDeprecateIscnRecord) (*MsgDeprecateIscnRecordResponse, error)
+	// SupersedeIscnRecord defines a method to mark existing ISCN metadata as replaced by another ISCN record
+	SupersedeIscnRecord(context.Context, *MsgSupersedeIscnRecord) (*MsgSupersedeIscnRecordResponse, error)
+	// BatchCreateIscnRecords defines a method to create multiple ISCN metadata atomically
+	BatchCreateIscnRecords(context.Context, *MsgBatchCreateIscnRecords) (*MsgBatchCreateIscnRecordsResponse, error)
+	// ProposeIscnOwnershipTransfer defines a method to propose transferring the ownership of existing ISCN metadata,
+	// which takes effect after the new owner accepts
+	ProposeIscnOwnershipTransfer(context.Context, *MsgProposeIscnOwnershipTransfer) (*MsgProposeIscnOwnershipTransferResponse, error)
+	// AcceptIscnOwnershipTransfer defines a method for the new owner to accept a pending ownership transfer
+	AcceptIscnOwnershipTransfer(context.Context, *MsgAcceptIscnOwnershipTransfer) (*MsgAcceptIscnOwnershipTransferResponse, error)
+	// CancelIscnOwnershipTransfer defines a method for the owner to cancel a pending ownership transfer
+	CancelIscnOwnershipTransfer(context.Context, *MsgCancelIscnOwnershipTransfer) (*MsgCancelIscnOwnershipTransferResponse, error)
+	// CommitIscn defines a method to store a salted hash commitment of ISCN metadata, proving its existence without
+	// revealing it
+	CommitIscn(context.Context, *MsgCommitIscn) (*MsgCommitIscnResponse, error)
+	// RevealIscn defines a method to create ISCN metadata from a previous commitment, carrying the commit time
+	RevealIscn(context.Context, *MsgRevealIscn) (*MsgRevealIscnResponse, error)
+	// AttestIscnRecord defines a method for a stakeholder of a specific ISCN metadata version to confirm its stake
+	AttestIscnRecord(context.Context, *MsgAttestIscnRecord) (*MsgAttestIscnRecordResponse, error)
+	// RevokeIscnRecordAttestation defines a method for a stakeholder to revoke a previous attestation
+	RevokeIscnRecordAttestation(context.Context, *MsgRevokeIscnRecordAttestation) (*MsgRevokeIscnRecordAttestationResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) CreateIscnRecord(ctx context.Context, req *MsgCreateIscnRecord) (*MsgCreateIscnRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateIscnRecord not implemented")
+}
+func (*UnimplementedMsgServer) UpdateIscnRecord(ctx context.Context, req *MsgUpdateIscnRecord) (*MsgUpdateIscnRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateIscnRecord not implemented")
+}
+func (*UnimplementedMsgServer) ChangeIscnRecordOwnership(ctx context.Context, req *MsgChangeIscnRecordOwnership) (*MsgChangeIscnRecordOwnershipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeIscnRecordOwnership not implemented")
+}
+func (*UnimplementedMsgServer) FreezeIscnRecord(ctx context.Context, req *MsgFreezeIscnRecord) (*MsgFreezeIscnRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FreezeIscnRecord not implemented")
+}
+func (*UnimplementedMsgServer) DeprecateIscnRecord(ctx context.Context, req *MsgDeprecateIscnRecord) (*MsgDeprecateIscnRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeprecateIscnRecord not implemented")
+}
+func (*UnimplementedMsgServer) SupersedeIscnRecord(ctx context.Context, req *MsgSupersedeIscnRecord) (*MsgSupersedeIscnRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupersedeIscnRecord not implemented")
+}
+func (*UnimplementedMsgServer) BatchCreateIscnRecords(ctx context.Context, req *MsgBatchCreateIscnRecords) (*MsgBatchCreateIscnRecordsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateIscnRecords not implemented")
+}
+func (*UnimplementedMsgServer) ProposeIscnOwnershipTransfer(ctx context.Context, req *MsgProposeIscnOwnershipTransfer) (*MsgProposeIscnOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeIscnOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptIscnOwnershipTransfer(ctx context.Context, req *MsgAcceptIscnOwnershipTransfer) (*MsgAcceptIscnOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptIscnOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelIscnOwnershipTransfer(ctx context.Context, req *MsgCancelIscnOwnershipTransfer) (*MsgCancelIscnOwnershipTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelIscnOwnershipTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CommitIscn(ctx context.Context, req *MsgCommitIscn) (*MsgCommitIscnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommitIscn not implemented")
+}
+func (*UnimplementedMsgServer) RevealIscn(ctx context.Context, req *MsgRevealIscn) (*MsgRevealIscnResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevealIscn not implemented")
+}
+func (*UnimplementedMsgServer) AttestIscnRecord(ctx context.Context, req *MsgAttestIscnRecord) (*MsgAttestIscnRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestIscnRecord not implemented")
+}
+func (*UnimplementedMsgServer) RevokeIscnRecordAttestation(ctx context.Context, req *MsgRevokeIscnRecordAttestation) (*MsgRevokeIscnRecordAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeIscnRecordAttestation not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_CreateIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateIscnRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateIscnRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/CreateIscnRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateIscnRecord(ctx, req.(*MsgCreateIscnRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateIscnRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateIscnRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/UpdateIscnRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateIscnRecord(ctx, req.(*MsgUpdateIscnRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ChangeIscnRecordOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgChangeIscnRecordOwnership)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ChangeIscnRecordOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/ChangeIscnRecordOwnership",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ChangeIscnRecordOwnership(ctx, req.(*MsgChangeIscnRecordOwnership))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FreezeIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFreezeIscnRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FreezeIscnRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/FreezeIscnRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FreezeIscnRecord(ctx, req.(*MsgFreezeIscnRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeprecateIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeprecateIscnRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeprecateIscnRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/DeprecateIscnRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeprecateIscnRecord(ctx, req.(*MsgDeprecateIscnRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SupersedeIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSupersedeIscnRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SupersedeIscnRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/SupersedeIscnRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SupersedeIscnRecord(ctx, req.(*MsgSupersedeIscnRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchCreateIscnRecords_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateIscnRecords)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateIscnRecords(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/BatchCreateIscnRecords",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateIscnRecords(ctx, req.(*MsgBatchCreateIscnRecords))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeIscnOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeIscnOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeIscnOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/ProposeIscnOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeIscnOwnershipTransfer(ctx, req.(*MsgProposeIscnOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptIscnOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptIscnOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptIscnOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/AcceptIscnOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptIscnOwnershipTransfer(ctx, req.(*MsgAcceptIscnOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelIscnOwnershipTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelIscnOwnershipTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelIscnOwnershipTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/CancelIscnOwnershipTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelIscnOwnershipTransfer(ctx, req.(*MsgCancelIscnOwnershipTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CommitIscn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCommitIscn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CommitIscn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/CommitIscn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CommitIscn(ctx, req.(*MsgCommitIscn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevealIscn_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevealIscn)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevealIscn(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/RevealIscn",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevealIscn(ctx, req.(*MsgRevealIscn))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AttestIscnRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAttestIscnRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AttestIscnRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/AttestIscnRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AttestIscnRecord(ctx, req.(*MsgAttestIscnRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeIscnRecordAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeIscnRecordAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeIscnRecordAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.iscn.Msg/RevokeIscnRecordAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeIscnRecordAttestation(ctx, req.(*MsgRevokeIscnRecordAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "likechain.iscn.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateIscnRecord",
+			Handler:    _Msg_CreateIscnRecord_Handler,
+		},
+		{
+			MethodName: "UpdateIscnRecord",
+			Handler:    _Msg_UpdateIscnRecord_Handler,
+		},
+		{
+			MethodName: "ChangeIscnRecordOwnership",
+			Handler:    _Msg_ChangeIscnRecordOwnership_Handler,
+		},
+		{
+			MethodName: "FreezeIscnRecord",
+			Handler:    _Msg_FreezeIscnRecord_Handler,
+		},
+		{
+			MethodName: "DeprecateIscnRecord",
+			Handler:    _Msg_DeprecateIscnRecord_Handler,
+		},
+		{
+			MethodName: "SupersedeIscnRecord",
+			Handler:    _Msg_SupersedeIscnRecord_Handler,
+		},
+		{
+			MethodName: "BatchCreateIscnRecords",
+			Handler:    _Msg_BatchCreateIscnRecords_Handler,
+		},
+		{
+			MethodName: "ProposeIscnOwnershipTransfer",
+			Handler:    _Msg_ProposeIscnOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptIscnOwnershipTransfer",
+			Handler:    _Msg_AcceptIscnOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CancelIscnOwnershipTransfer",
+			Handler:    _Msg_CancelIscnOwnershipTransfer_Handler,
+		},
+		{
+			MethodName: "CommitIscn",
+			Handler:    _Msg_CommitIscn_Handler,
+		},
+		{
+			MethodName: "RevealIscn",
+			Handler:    _Msg_RevealIscn_Handler,
+		},
+		{
+			MethodName: "AttestIscnRecord",
+			Handler:    _Msg_AttestIscnRecord_Handler,
+		},
+		{
+			MethodName: "RevokeIscnRecordAttestation",
+			Handler:    _Msg_RevokeIscnRecordAttestation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "likechain/iscn/tx.proto",
+}
+
+func (m *IscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Relations) > 0 {
+		for iNdEx := len(m.Relations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Relations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size := m.ContentMetadata.Size()
+		i -= size
+		if _, err := m.ContentMetadata.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Stakeholders) > 0 {
+		for iNdEx := len(m.Stakeholders) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.Stakeholders[iNdEx].Size()
+				i -= size
+				if _, err := m.Stakeholders[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ContentFingerprints) > 0 {
+		for iNdEx := len(m.ContentFingerprints) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ContentFingerprints[iNdEx])
+			copy(dAtA[i:], m.ContentFingerprints[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ContentFingerprints[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecordNotes) > 0 {
+		i -= len(m.RecordNotes)
+		copy(dAtA[i:], m.RecordNotes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordNotes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IscnRelation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IscnRelation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IscnRelation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateIscnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordIpld) > 0 {
+		i -= len(m.RecordIpld)
+		copy(dAtA[i:], m.RecordIpld)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordIpld)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateIscnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordIpld) > 0 {
+		i -= len(m.RecordIpld)
+		copy(dAtA[i:], m.RecordIpld)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordIpld)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeIscnRecordOwnership) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeIscnRecordOwnership) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeIscnRecordOwnership) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgChangeIscnRecordOwnershipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgChangeIscnRecordOwnershipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgChangeIscnRecordOwnershipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFreezeIscnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFreezeIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFreezeIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeprecateIscnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeprecateIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeprecateIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedeIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedeIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedeIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SupersededBy) > 0 {
+		i -= len(m.SupersededBy)
+		copy(dAtA[i:], m.SupersededBy)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SupersededBy)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSupersedeIscnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSupersedeIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSupersedeIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateIscnRecordEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateIscnRecordEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateIscnRecordEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCreateIscnRecords) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCreateIscnRecords) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCreateIscnRecords) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCreateIscnRecordsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCreateIscnRecordsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCreateIscnRecordsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for iNdEx := len(m.Records) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Records[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeIscnOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeIscnOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeIscnOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintTx(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewOwner) > 0 {
+		i -= len(m.NewOwner)
+		copy(dAtA[i:], m.NewOwner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewOwner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeIscnOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeIscnOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeIscnOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptIscnOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptIscnOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptIscnOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptIscnOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptIscnOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptIscnOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelIscnOwnershipTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelIscnOwnershipTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelIscnOwnershipTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelIscnOwnershipTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelIscnOwnershipTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelIscnOwnershipTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitIscn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitIscn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitIscn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCommitIscnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCommitIscnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCommitIscnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealIscn) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealIscn) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealIscn) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Salt) > 0 {
+		i -= len(m.Salt)
+		copy(dAtA[i:], m.Salt)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Salt)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Record.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevealIscnResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevealIscnResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevealIscnResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RecordIpld) > 0 {
+		i -= len(m.RecordIpld)
+		copy(dAtA[i:], m.RecordIpld)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.RecordIpld)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestIscnRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestIscnRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestIscnRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAttestIscnRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAttestIscnRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAttestIscnRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeIscnRecordAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeIscnRecordAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeIscnRecordAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnId) > 0 {
+		i -= len(m.IscnId)
+		copy(dAtA[i:], m.IscnId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.IscnId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.From) > 0 {
+		i -= len(m.From)
+		copy(dAtA[i:], m.From)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.From)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeIscnRecordAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeIscnRecordAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeIscnRecordAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.RecordNotes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ContentFingerprints) > 0 {
+		for _, s := range m.ContentFingerprints {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Stakeholders) > 0 {
+		for _, e := range m.Stakeholders {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.ContentMetadata.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Relations) > 0 {
+		for _, e := range m.Relations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *IscnRelation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgCreateIscnRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordIpld)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateIscnRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordIpld)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeIscnRecordOwnership) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgChangeIscnRecordOwnershipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFreezeIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgFreezeIscnRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeprecateIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeprecateIscnRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSupersedeIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SupersededBy)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSupersedeIscnRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *BatchCreateIscnRecordEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgBatchCreateIscnRecords) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBatchCreateIscnRecordsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Records) > 0 {
+		for _, e := range m.Records {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgProposeIscnOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewOwner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgProposeIscnOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAcceptIscnOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptIscnOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelIscnOwnershipTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelIscnOwnershipTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCommitIscn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCommitIscnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRevealIscn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Record.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Salt)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *MsgRevealIscnResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.RecordIpld)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestIscnRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAttestIscnRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeIscnRecordAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.IscnId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeIscnRecordAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordNotes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordNotes = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentFingerprints", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContentFingerprints = append(m.ContentFingerprints, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stakeholders", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v IscnInput
+			m.Stakeholders = append(m.Stakeholders, v)
+			if err := m.Stakeholders[len(m.Stakeholders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContentMetadata", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Relations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Relations = append(m.Relations, &IscnRelation{})
+			if err := m.Relations[len(m.Relations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IscnRelation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IscnRelation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IscnRelation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateIscnRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateIscnRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIpld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordIpld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateIscnRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateIscnRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordIpld", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecordIpld = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeIscnRecordOwnership) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeIscnRecordOwnership: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeIscnRecordOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgChangeIscnRecordOwnershipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgChangeIscnRecordOwnershipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgChangeIscnRecordOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFreezeIscnRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFreezeIscnRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFreezeIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeprecateIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeprecateIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeprecateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeprecateIscnRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeprecateIscnRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeprecateIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedeIscnRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedeIscnRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedeIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SupersededBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SupersededBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSupersedeIscnRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSupersedeIscnRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSupersedeIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCreateIscnRecordEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateIscnRecordEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateIscnRecordEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateIscnRecords) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateIscnRecords: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateIscnRecords: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BatchCreateIscnRecordEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateIscnRecordsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateIscnRecordsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateIscnRecordsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Records", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Records = append(m.Records, MsgCreateIscnRecordResponse{})
+			if err := m.Records[len(m.Records)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeIscnOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeIscnOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeIscnOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewOwner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = len(m.IscnId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgProposeIscnOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeIscnOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeIscnOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptIscnOwnershipTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptIscnOwnershipTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptIscnOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Record.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateIscnRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IscnId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.RecordIpld)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *MsgChangeIscnRecordOwnership) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.IscnId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.NewOwner)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgAcceptIscnOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptIscnOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptIscnOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgChangeIscnRecordOwnershipResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *IscnRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelIscnOwnershipTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1075,15 +6172,15 @@ func (m *IscnRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: IscnRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelIscnOwnershipTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: IscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelIscnOwnershipTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordNotes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1111,11 +6208,143 @@ func (m *IscnRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecordNotes = string(dAtA[iNdEx:postIndex])
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentFingerprints", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelIscnOwnershipTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelIscnOwnershipTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelIscnOwnershipTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitIscn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitIscn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitIscn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1143,11 +6372,11 @@ func (m *IscnRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ContentFingerprints = append(m.ContentFingerprints, string(dAtA[iNdEx:postIndex]))
+			m.From = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stakeholders", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -1174,17 +6403,66 @@ func (m *IscnRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v IscnInput
-			m.Stakeholders = append(m.Stakeholders, v)
-			if err := m.Stakeholders[len(m.Stakeholders)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Commitment = append(m.Commitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.Commitment == nil {
+				m.Commitment = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCommitIscnResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCommitIscnResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCommitIscnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContentMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1194,22 +6472,22 @@ func (m *IscnRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContentMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1234,7 +6512,7 @@ func (m *IscnRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIscnRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealIscn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1257,10 +6535,10 @@ func (m *MsgCreateIscnRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIscnRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealIscn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealIscn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1329,6 +6607,40 @@ func (m *MsgCreateIscnRecord) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Salt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Salt = append(m.Salt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Salt == nil {
+				m.Salt = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -1368,7 +6680,7 @@ func (m *MsgCreateIscnRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateIscnRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevealIscnResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1391,10 +6703,10 @@ func (m *MsgCreateIscnRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateIscnRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevealIscnResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevealIscnResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1482,7 +6794,7 @@ func (m *MsgCreateIscnRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateIscnRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestIscnRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1505,10 +6817,10 @@ func (m *MsgUpdateIscnRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateIscnRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestIscnRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestIscnRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1575,39 +6887,6 @@ func (m *MsgUpdateIscnRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.IscnId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Record", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Record.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1629,7 +6908,7 @@ func (m *MsgUpdateIscnRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateIscnRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgAttestIscnRecordResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1652,76 +6931,12 @@ func (m *MsgUpdateIscnRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateIscnRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgAttestIscnRecordResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgAttestIscnRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IscnId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.IscnId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecordIpld", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RecordIpld = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1743,7 +6958,7 @@ func (m *MsgUpdateIscnRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeIscnRecordOwnership) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeIscnRecordAttestation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1766,10 +6981,10 @@ func (m *MsgChangeIscnRecordOwnership) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeIscnRecordOwnership: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeIscnRecordAttestation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeIscnRecordOwnership: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeIscnRecordAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1836,38 +7051,6 @@ func (m *MsgChangeIscnRecordOwnership) Unmarshal(dAtA []byte) error {
 			}
 			m.IscnId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewOwner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.NewOwner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1889,7 +7072,7 @@ func (m *MsgChangeIscnRecordOwnership) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgChangeIscnRecordOwnershipResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeIscnRecordAttestationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1912,10 +7095,10 @@ func (m *MsgChangeIscnRecordOwnershipResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgChangeIscnRecordOwnershipResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeIscnRecordAttestationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgChangeIscnRecordOwnershipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeIscnRecordAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -11,7 +11,9 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/authz"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 
+	likeapp "github.com/likecoin/likecoin-chain/v4/app"
 	"github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 
 	testutil "github.com/likecoin/likecoin-chain/v4/testutil"
 )
@@ -133,3 +135,115 @@ func TestFeeGrant(t *testing.T) {
 	require.True(t, balanceBefore1.Sub(balanceAfter1...).IsZero(), "Address 1 should not pay any fee: balanceBefore1=%v, balanceAfter1=%v, diff=%v", balanceBefore1, balanceAfter1, balanceBefore1.Sub(balanceAfter1...))
 	require.False(t, balanceBefore2.Sub(balanceAfter2...).IsZero(), "Address 2 should pay the ISCN fee: balanceBefore2=%v, balanceAfter2=%v, diff=%v", balanceBefore2, balanceAfter2, balanceBefore2.Sub(balanceAfter2...))
 }
+
+func TestFeeGrantBatchCreate(t *testing.T) {
+	app := testutil.SetupTestApp([]testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+		{addr2.String(), "1000000000000000000nanolike"},
+	})
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	records := []types.IscnRecord{
+		{
+			RecordNotes:         "batch record 1",
+			ContentFingerprints: []string{fingerprint1},
+			Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+			ContentMetadata:     contentMetadata1,
+		},
+		{
+			RecordNotes:         "batch record 2",
+			ContentFingerprints: []string{fingerprint1, fingerprint2},
+			Stakeholders:        []types.IscnInput{stakeholder1},
+			ContentMetadata:     contentMetadata2,
+		},
+	}
+	msg := types.NewMsgBatchCreateIscnRecords(addr1, records, nil)
+
+	expiration := time.Unix(2000000000, 0)
+	allowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{
+		SpendLimit: sdk.NewCoins(sdk.NewInt64Coin("nanolike", 1000000000000000000)),
+		Expiration: &expiration,
+	}, []string{sdk.MsgTypeURL(msg)})
+	require.NoError(t, err)
+	feegrantMsg, err := feegrant.NewMsgGrantAllowance(allowance, addr2, addr1)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, feegrantMsg, priv2)
+
+	balanceBefore1 := app.BankKeeper.GetAllBalances(app.Context, addr1)
+	balanceBefore2 := app.BankKeeper.GetAllBalances(app.Context, addr2)
+
+	_, err, simErr, deliverErr := app.DeliverMsgsWithFeeGranter([]sdk.Msg{msg}, priv1, addr2)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+	require.NoError(t, deliverErr)
+
+	balanceAfter1 := app.BankKeeper.GetAllBalances(app.Context, addr1)
+	balanceAfter2 := app.BankKeeper.GetAllBalances(app.Context, addr2)
+
+	require.True(t, balanceBefore1.Sub(balanceAfter1...).IsZero(), "Address 1 should not pay any fee: balanceBefore1=%v, balanceAfter1=%v, diff=%v", balanceBefore1, balanceAfter1, balanceBefore1.Sub(balanceAfter1...))
+	require.False(t, balanceBefore2.Sub(balanceAfter2...).IsZero(), "Address 2 should pay the ISCN fee: balanceBefore2=%v, balanceAfter2=%v, diff=%v", balanceBefore2, balanceAfter2, balanceBefore2.Sub(balanceAfter2...))
+}
+
+func TestFeeRouting(t *testing.T) {
+	iscnGenesis := types.DefaultGenesisState()
+	iscnGenesis.Params.FeeRouting = likefeegranttypes.FeeRouting{
+		FeeCollectorRatio:  sdk.NewDecWithPrec(4, 1),
+		CommunityPoolRatio: sdk.NewDecWithPrec(2, 1),
+		BurnRatio:          sdk.NewDecWithPrec(2, 1),
+		TreasuryRatio:      sdk.NewDecWithPrec(2, 1),
+		TreasuryAddress:    addr3.String(),
+	}
+	iscnGenesisJson := likeapp.MakeEncodingConfig().Marshaler.MustMarshalJSON(iscnGenesis)
+	app := testutil.SetupTestAppWithIscnGenesis([]testutil.GenesisBalance{
+		{addr1.String(), "1000000000000000000nanolike"},
+	}, iscnGenesisJson)
+
+	app.NextHeader(1234567890)
+	app.SetForTx()
+	record := types.IscnRecord{
+		RecordNotes:         "some update",
+		ContentFingerprints: []string{fingerprint1},
+		Stakeholders:        []types.IscnInput{stakeholder1, stakeholder2},
+		ContentMetadata:     contentMetadata1,
+	}
+	msg := types.NewMsgCreateIscnRecord(addr1, &record, 0)
+
+	balanceBefore1 := app.BankKeeper.GetAllBalances(app.Context, addr1)
+	balanceBefore3 := app.BankKeeper.GetAllBalances(app.Context, addr3)
+	supplyBefore := app.BankKeeper.GetSupply(app.Context, "nanolike")
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(app.Context)
+
+	app.DeliverMsgNoError(t, msg, priv1)
+
+	balanceAfter1 := app.BankKeeper.GetAllBalances(app.Context, addr1)
+	balanceAfter3 := app.BankKeeper.GetAllBalances(app.Context, addr3)
+	supplyAfter := app.BankKeeper.GetSupply(app.Context, "nanolike")
+	communityPoolAfter := app.DistrKeeper.GetFeePoolCommunityCoins(app.Context)
+
+	fees := balanceBefore1.Sub(balanceAfter1...)
+	require.False(t, fees.IsZero())
+
+	res, err := app.IscnKeeper.CollectedFees(sdk.WrapSDKContext(app.Context), types.NewQueryCollectedFeesRequest())
+	require.NoError(t, err)
+	collected := res.CollectedFees
+	require.Equal(t, fees, collected.FeeCollector.Add(collected.CommunityPool...).Add(collected.Burned...).Add(collected.Treasury...))
+	require.False(t, collected.Treasury.IsZero())
+	require.False(t, collected.Burned.IsZero())
+	require.False(t, collected.CommunityPool.IsZero())
+
+	require.Equal(t, collected.Treasury, balanceAfter3.Sub(balanceBefore3...))
+	require.Equal(t, collected.Burned.AmountOf("nanolike"), supplyBefore.Sub(supplyAfter).Amount)
+	_, hasNeg := communityPoolAfter.Sub(communityPoolBefore).SafeSub(sdk.NewDecCoinsFromCoins(collected.CommunityPool...))
+	require.False(t, hasNeg)
+
+	msg = types.NewMsgCreateIscnRecord(addr1, &record, 1)
+	app.DeliverMsgNoError(t, msg, priv1)
+	res, err = app.IscnKeeper.CollectedFees(sdk.WrapSDKContext(app.Context), types.NewQueryCollectedFeesRequest())
+	require.NoError(t, err)
+	require.Equal(t, collected.Add(collected), res.CollectedFees)
+
+	exported := app.IscnKeeper.ExportGenesis(app.Context)
+	require.Equal(t, res.CollectedFees, exported.CollectedFees)
+	require.Equal(t, iscnGenesis.Params.FeeRouting, exported.Params.FeeRouting)
+}
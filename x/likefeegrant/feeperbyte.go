@@ -5,19 +5,35 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 )
 
 type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
 }
 
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+}
+
+type DistributionKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// DeductFeePerByte deducts the fees from the message sender (or the fee granter) and routes them according to the
+// fee routing, where the burnt portion is burnt through the module account of `moduleName`.
+// Returns the amount routed to each destination.
 func DeductFeePerByte(
 	ctx sdk.Context,
-	accountKeeper AccountKeeper, bankKeeper authtypes.BankKeeper, feegrantKeeper ante.FeegrantKeeper,
+	accountKeeper AccountKeeper, bankKeeper BankKeeper, feegrantKeeper ante.FeegrantKeeper, distrKeeper DistributionKeeper,
+	moduleName string, routing types.FeeRouting,
 	msgSender sdk.AccAddress, fees sdk.Coins, msg sdk.Msg,
-) error {
+) (types.CollectedFees, error) {
 	if fees.IsZero() {
-		return nil
+		return types.CollectedFees{}, nil
 	}
 
 	feeTx := GetFeeTx(ctx)
@@ -30,20 +46,66 @@ func DeductFeePerByte(
 	}
 	if !granter.Equals(msgSender) {
 		if feegrantKeeper == nil {
-			return sdkerrors.ErrInvalidRequest.Wrap("error when deducting fee per byte: fee grants are not enabled")
+			return types.CollectedFees{}, sdkerrors.ErrInvalidRequest.Wrap("error when deducting fee per byte: fee grants are not enabled")
 		}
 		err := feegrantKeeper.UseGrantedFees(ctx, granter, msgSender, fees, []sdk.Msg{msg})
 		if err != nil {
-			return sdkerrors.Wrapf(err, "error when deducting fee per byte: %s does not not allow to pay fees for %s", granter, msgSender)
+			return types.CollectedFees{}, sdkerrors.Wrapf(err, "error when deducting fee per byte: %s does not not allow to pay fees for %s", granter, msgSender)
 		}
 	}
 	acc := accountKeeper.GetAccount(ctx, granter)
 	if acc == nil {
-		return sdkerrors.ErrInvalidAddress.Wrapf("error when deducting fee per byte: account %s not found", msgSender.String())
+		return types.CollectedFees{}, sdkerrors.ErrInvalidAddress.Wrapf("error when deducting fee per byte: account %s not found", msgSender.String())
+	}
+	if !fees.IsValid() {
+		return types.CollectedFees{}, sdkerrors.ErrInsufficientFee.Wrapf("invalid fee amount: %s", fees)
 	}
-	err := ante.DeductFees(bankKeeper, ctx, acc, fees)
+	routed := routing.Split(fees)
+	err := routeFees(ctx, bankKeeper, distrKeeper, moduleName, routing, acc.GetAddress(), routed)
 	if err != nil {
-		return sdkerrors.ErrInsufficientFee.Wrapf(err.Error())
+		return types.CollectedFees{}, sdkerrors.ErrInsufficientFee.Wrapf(err.Error())
+	}
+	return routed, nil
+}
+
+func routeFees(
+	ctx sdk.Context, bankKeeper BankKeeper, distrKeeper DistributionKeeper,
+	moduleName string, routing types.FeeRouting, payer sdk.AccAddress, routed types.CollectedFees,
+) error {
+	if !routed.FeeCollector.IsZero() {
+		err := bankKeeper.SendCoinsFromAccountToModule(ctx, payer, authtypes.FeeCollectorName, routed.FeeCollector)
+		if err != nil {
+			return err
+		}
+	}
+	if !routed.CommunityPool.IsZero() {
+		if distrKeeper == nil {
+			return sdkerrors.ErrLogic.Wrap("distribution keeper is not set for funding community pool")
+		}
+		err := distrKeeper.FundCommunityPool(ctx, routed.CommunityPool, payer)
+		if err != nil {
+			return err
+		}
+	}
+	if !routed.Burned.IsZero() {
+		err := bankKeeper.SendCoinsFromAccountToModule(ctx, payer, moduleName, routed.Burned)
+		if err != nil {
+			return err
+		}
+		err = bankKeeper.BurnCoins(ctx, moduleName, routed.Burned)
+		if err != nil {
+			return err
+		}
+	}
+	if !routed.Treasury.IsZero() {
+		treasury, err := sdk.AccAddressFromBech32(routing.TreasuryAddress)
+		if err != nil {
+			return sdkerrors.ErrInvalidAddress.Wrapf("invalid treasury address: %v", err)
+		}
+		err = bankKeeper.SendCoins(ctx, payer, treasury, routed.Treasury)
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
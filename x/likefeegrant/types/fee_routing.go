@@ -0,0 +1,118 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// DefaultFeeRouting sends all the fees to the fee collector, i.e. distributed as staking rewards
+func DefaultFeeRouting() FeeRouting {
+	return FeeRouting{
+		FeeCollectorRatio:  sdk.OneDec(),
+		CommunityPoolRatio: sdk.ZeroDec(),
+		BurnRatio:          sdk.ZeroDec(),
+		TreasuryRatio:      sdk.ZeroDec(),
+	}
+}
+
+// IsEmpty returns whether the fee routing is not configured, which is treated as the default fee routing
+func (r FeeRouting) IsEmpty() bool {
+	for _, ratio := range []sdk.Dec{r.FeeCollectorRatio, r.CommunityPoolRatio, r.BurnRatio, r.TreasuryRatio} {
+		if !ratio.IsNil() && !ratio.IsZero() {
+			return false
+		}
+	}
+	return r.TreasuryAddress == ""
+}
+
+// OrDefault returns the default fee routing if the fee routing is not configured, e.g. on chains upgraded from before
+// the parameter is added
+func (r FeeRouting) OrDefault() FeeRouting {
+	if r.IsEmpty() {
+		return DefaultFeeRouting()
+	}
+	return r
+}
+
+func validateRatio(name string, ratio sdk.Dec) error {
+	if ratio.IsNil() {
+		return fmt.Errorf("%s ratio is empty", name)
+	}
+	if ratio.IsNegative() || ratio.GT(sdk.OneDec()) {
+		return fmt.Errorf("%s ratio must be between 0 and 1, got %s", name, ratio.String())
+	}
+	return nil
+}
+
+func (r FeeRouting) Validate() error {
+	for _, entry := range []struct {
+		name  string
+		ratio sdk.Dec
+	}{
+		{"fee collector", r.FeeCollectorRatio},
+		{"community pool", r.CommunityPoolRatio},
+		{"burn", r.BurnRatio},
+		{"treasury", r.TreasuryRatio},
+	} {
+		err := validateRatio(entry.name, entry.ratio)
+		if err != nil {
+			return err
+		}
+	}
+	sum := r.FeeCollectorRatio.Add(r.CommunityPoolRatio).Add(r.BurnRatio).Add(r.TreasuryRatio)
+	if !sum.Equal(sdk.OneDec()) {
+		return fmt.Errorf("fee routing ratios must sum up to 1, got %s", sum.String())
+	}
+	if r.TreasuryAddress != "" {
+		_, err := sdk.AccAddressFromBech32(r.TreasuryAddress)
+		if err != nil {
+			return fmt.Errorf("invalid treasury address %s: %w", r.TreasuryAddress, err)
+		}
+	} else if r.TreasuryRatio.IsPositive() {
+		return fmt.Errorf("treasury address is required when treasury ratio is non-zero")
+	}
+	return nil
+}
+
+func mulCoins(coins sdk.Coins, ratio sdk.Dec) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		amount := ratio.MulInt(coin.Amount).TruncateInt()
+		res = res.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return res
+}
+
+// Split splits the fees into the destinations, the rounding remainder goes to the fee collector
+func (r FeeRouting) Split(fees sdk.Coins) CollectedFees {
+	communityPool := mulCoins(fees, r.CommunityPoolRatio)
+	burned := mulCoins(fees, r.BurnRatio)
+	treasury := mulCoins(fees, r.TreasuryRatio)
+	feeCollector := fees.Sub(communityPool...).Sub(burned...).Sub(treasury...)
+	return CollectedFees{
+		FeeCollector:  feeCollector,
+		CommunityPool: communityPool,
+		Burned:        burned,
+		Treasury:      treasury,
+	}
+}
+
+func (c CollectedFees) Add(other CollectedFees) CollectedFees {
+	return CollectedFees{
+		FeeCollector:  c.FeeCollector.Add(other.FeeCollector...),
+		CommunityPool: c.CommunityPool.Add(other.CommunityPool...),
+		Burned:        c.Burned.Add(other.Burned...),
+		Treasury:      c.Treasury.Add(other.Treasury...),
+	}
+}
+
+func (c CollectedFees) Validate() error {
+	for _, coins := range []sdk.Coins{c.FeeCollector, c.CommunityPool, c.Burned, c.Treasury} {
+		err := coins.Validate()
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
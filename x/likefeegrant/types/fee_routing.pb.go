@@ -0,0 +1,895 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: likechain/likefeegrant/fee_routing.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// FeeRouting defines how the per-byte fees are split between the destinations, the ratios must sum up to 1
+type FeeRouting struct {
+	FeeCollectorRatio  github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=fee_collector_ratio,json=feeCollectorRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"fee_collector_ratio"`
+	CommunityPoolRatio github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=community_pool_ratio,json=communityPoolRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"community_pool_ratio"`
+	BurnRatio          github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=burn_ratio,json=burnRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"burn_ratio"`
+	TreasuryRatio      github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=treasury_ratio,json=treasuryRatio,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"treasury_ratio"`
+	// treasury_address is the registry treasury account, required if treasury_ratio is non-zero
+	TreasuryAddress string `protobuf:"bytes,5,opt,name=treasury_address,json=treasuryAddress,proto3" json:"treasury_address,omitempty"`
+}
+
+func (m *FeeRouting) Reset()         { *m = FeeRouting{} }
+func (m *FeeRouting) String() string { return proto.CompactTextString(m) }
+func (*FeeRouting) ProtoMessage()    {}
+func (*FeeRouting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b6875674392fe41, []int{0}
+}
+func (m *FeeRouting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeRouting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeRouting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeRouting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeRouting.Merge(m, src)
+}
+func (m *FeeRouting) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeRouting) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeRouting.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeRouting proto.InternalMessageInfo
+
+func (m *FeeRouting) GetTreasuryAddress() string {
+	if m != nil {
+		return m.TreasuryAddress
+	}
+	return ""
+}
+
+// CollectedFees records the total per-byte fees routed to each destination
+type CollectedFees struct {
+	FeeCollector  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fee_collector,json=feeCollector,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee_collector"`
+	CommunityPool github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=community_pool,json=communityPool,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"community_pool"`
+	Burned        github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	Treasury      github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=treasury,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"treasury"`
+}
+
+func (m *CollectedFees) Reset()         { *m = CollectedFees{} }
+func (m *CollectedFees) String() string { return proto.CompactTextString(m) }
+func (*CollectedFees) ProtoMessage()    {}
+func (*CollectedFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4b6875674392fe41, []int{1}
+}
+func (m *CollectedFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CollectedFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CollectedFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CollectedFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectedFees.Merge(m, src)
+}
+func (m *CollectedFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *CollectedFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectedFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectedFees proto.InternalMessageInfo
+
+func (m *CollectedFees) GetFeeCollector() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.FeeCollector
+	}
+	return nil
+}
+
+func (m *CollectedFees) GetCommunityPool() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CommunityPool
+	}
+	return nil
+}
+
+func (m *CollectedFees) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
+	}
+	return nil
+}
+
+func (m *CollectedFees) GetTreasury() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Treasury
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*FeeRouting)(nil), "likechain.likefeegrant.FeeRouting")
+	proto.RegisterType((*CollectedFees)(nil), "likechain.likefeegrant.CollectedFees")
+}
+
+func init() {
+	proto.RegisterFile("likechain/likefeegrant/fee_routing.proto", fileDescriptor_4b6875674392fe41)
+}
+
+var fileDescriptor_4b6875674392fe41 = []byte{
+	// 437 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x94, 0xb1, 0x6e, 0x13, 0x31,
+	0x18, 0xc7, 0x73, 0xbd, 0x52, 0x51, 0x43, 0x0a, 0x98, 0x0a, 0x1d, 0x1d, 0x2e, 0x55, 0x07, 0x14,
+	0x86, 0xda, 0x14, 0x98, 0xd8, 0x48, 0x51, 0x37, 0x24, 0x74, 0x82, 0x85, 0x81, 0x70, 0xe7, 0xfb,
+	0x72, 0xb5, 0x7a, 0xf1, 0x17, 0xd9, 0xbe, 0x8a, 0xbc, 0x05, 0x6f, 0xc0, 0xce, 0x6b, 0xb0, 0x74,
+	0xec, 0x88, 0x18, 0x0a, 0x4a, 0x5e, 0x04, 0x39, 0xbe, 0x9c, 0x72, 0x5b, 0x15, 0x65, 0xf2, 0xa7,
+	0x4f, 0xf6, 0xef, 0x67, 0xf9, 0x6f, 0x9b, 0xf4, 0x4b, 0x79, 0x01, 0xe2, 0x3c, 0x95, 0x8a, 0xbb,
+	0x6a, 0x04, 0x50, 0xe8, 0x54, 0x59, 0x3e, 0x02, 0x18, 0x6a, 0xac, 0xac, 0x54, 0x05, 0x9b, 0x68,
+	0xb4, 0x48, 0x9f, 0x34, 0x33, 0xd9, 0xea, 0xcc, 0x83, 0x58, 0xa0, 0x19, 0xa3, 0xe1, 0x59, 0x6a,
+	0x80, 0x5f, 0x9e, 0x64, 0x60, 0xd3, 0x13, 0x2e, 0x50, 0x2a, 0xbf, 0xee, 0x60, 0xbf, 0xc0, 0x02,
+	0x17, 0x25, 0x77, 0x95, 0xef, 0x1e, 0xfd, 0x08, 0x09, 0x39, 0x03, 0x48, 0xbc, 0x82, 0x7e, 0x21,
+	0x8f, 0x9d, 0x51, 0x60, 0x59, 0x82, 0xb0, 0xa8, 0x87, 0x3a, 0xb5, 0x12, 0xa3, 0xe0, 0x30, 0xe8,
+	0xef, 0x0e, 0xd8, 0xd5, 0x4d, 0xaf, 0xf3, 0xe7, 0xa6, 0xf7, 0xac, 0x90, 0xf6, 0xbc, 0xca, 0x98,
+	0xc0, 0x31, 0xaf, 0xa5, 0x7e, 0x38, 0x36, 0xf9, 0x05, 0xb7, 0xd3, 0x09, 0x18, 0xf6, 0x0e, 0x44,
+	0xf2, 0x68, 0x04, 0x70, 0xba, 0x24, 0x25, 0x0e, 0x44, 0xbf, 0x92, 0x7d, 0x81, 0xe3, 0x71, 0xa5,
+	0xa4, 0x9d, 0x0e, 0x27, 0x88, 0x65, 0x2d, 0xd8, 0x5a, 0x4b, 0x40, 0x1b, 0xd6, 0x07, 0xc4, 0xd2,
+	0x1b, 0xde, 0x13, 0x92, 0x55, 0x5a, 0xd5, 0xdc, 0x70, 0x2d, 0xee, 0xae, 0x23, 0x78, 0xdc, 0x27,
+	0xb2, 0x67, 0x35, 0xa4, 0xa6, 0xd2, 0xd3, 0x1a, 0xb9, 0xbd, 0x16, 0xb2, 0xbb, 0xa4, 0x78, 0xec,
+	0x73, 0xf2, 0xb0, 0xc1, 0xa6, 0x79, 0xae, 0xc1, 0x98, 0xe8, 0x8e, 0x03, 0x27, 0x0f, 0x96, 0xfd,
+	0xb7, 0xbe, 0x7d, 0xf4, 0x2b, 0x24, 0xdd, 0xfa, 0x14, 0x21, 0x3f, 0x03, 0x30, 0x74, 0x42, 0xba,
+	0xad, 0x90, 0xa2, 0xe0, 0x30, 0xec, 0xdf, 0x7b, 0xf9, 0x94, 0x79, 0x33, 0x73, 0x37, 0x80, 0xd5,
+	0x37, 0x80, 0x9d, 0xa2, 0x54, 0x83, 0x17, 0x6e, 0xb7, 0x3f, 0xff, 0xf6, 0xfa, 0xb7, 0xd8, 0xad,
+	0x5b, 0x60, 0x92, 0xfb, 0xab, 0xd9, 0x51, 0x4d, 0xf6, 0xda, 0xb1, 0x45, 0x5b, 0x9b, 0x57, 0x76,
+	0x5b, 0x69, 0x52, 0x41, 0x76, 0x5c, 0x0c, 0x90, 0x47, 0xe1, 0xe6, 0x5d, 0x35, 0x9a, 0x16, 0xe4,
+	0xee, 0xf2, 0xbc, 0xa3, 0xed, 0xcd, 0x6b, 0x1a, 0xf8, 0xe0, 0xe3, 0xd5, 0x2c, 0x0e, 0xae, 0x67,
+	0x71, 0xf0, 0x6f, 0x16, 0x07, 0xdf, 0xe7, 0x71, 0xe7, 0x7a, 0x1e, 0x77, 0x7e, 0xcf, 0xe3, 0xce,
+	0xe7, 0x37, 0x2b, 0xb4, 0xc5, 0xd3, 0x46, 0xa9, 0x9a, 0xe2, 0xd8, 0x7f, 0x09, 0x97, 0xaf, 0xf9,
+	0xb7, 0xf6, 0xbf, 0xb0, 0xb0, 0x64, 0x3b, 0x8b, 0x47, 0xfc, 0xea, 0xff, 0x00, 0xef, 0x13, 0x95,
+	0xe1, 0x3e, 0x04, 0x00, 0x00,
+}
+
+func (m *FeeRouting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeRouting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeRouting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TreasuryAddress) > 0 {
+		i -= len(m.TreasuryAddress)
+		copy(dAtA[i:], m.TreasuryAddress)
+		i = encodeVarintFeeRouting(dAtA, i, uint64(len(m.TreasuryAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.TreasuryRatio.Size()
+		i -= size
+		if _, err := m.TreasuryRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.BurnRatio.Size()
+		i -= size
+		if _, err := m.BurnRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.CommunityPoolRatio.Size()
+		i -= size
+		if _, err := m.CommunityPoolRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.FeeCollectorRatio.Size()
+		i -= size
+		if _, err := m.FeeCollectorRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CollectedFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CollectedFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CollectedFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Treasury) > 0 {
+		for iNdEx := len(m.Treasury) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Treasury[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for iNdEx := len(m.CommunityPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CommunityPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FeeCollector) > 0 {
+		for iNdEx := len(m.FeeCollector) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeCollector[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeRouting(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeRouting(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeRouting(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeRouting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeCollectorRatio.Size()
+	n += 1 + l + sovFeeRouting(uint64(l))
+	l = m.CommunityPoolRatio.Size()
+	n += 1 + l + sovFeeRouting(uint64(l))
+	l = m.BurnRatio.Size()
+	n += 1 + l + sovFeeRouting(uint64(l))
+	l = m.TreasuryRatio.Size()
+	n += 1 + l + sovFeeRouting(uint64(l))
+	l = len(m.TreasuryAddress)
+	if l > 0 {
+		n += 1 + l + sovFeeRouting(uint64(l))
+	}
+	return n
+}
+
+func (m *CollectedFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeCollector) > 0 {
+		for _, e := range m.FeeCollector {
+			l = e.Size()
+			n += 1 + l + sovFeeRouting(uint64(l))
+		}
+	}
+	if len(m.CommunityPool) > 0 {
+		for _, e := range m.CommunityPool {
+			l = e.Size()
+			n += 1 + l + sovFeeRouting(uint64(l))
+		}
+	}
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovFeeRouting(uint64(l))
+		}
+	}
+	if len(m.Treasury) > 0 {
+		for _, e := range m.Treasury {
+			l = e.Size()
+			n += 1 + l + sovFeeRouting(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeeRouting(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeRouting(x uint64) (n int) {
+	return sovFeeRouting(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeRouting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeRouting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeRouting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeRouting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollectorRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeCollectorRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TreasuryRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TreasuryAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TreasuryAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeRouting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CollectedFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeRouting
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CollectedFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CollectedFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeCollector", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeCollector = append(m.FeeCollector, types.Coin{})
+			if err := m.FeeCollector[len(m.FeeCollector)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CommunityPool = append(m.CommunityPool, types.Coin{})
+			if err := m.CommunityPool[len(m.CommunityPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Treasury", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Treasury = append(m.Treasury, types.Coin{})
+			if err := m.Treasury[len(m.Treasury)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeRouting(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeRouting
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeRouting(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeRouting
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeRouting
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeRouting
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeRouting
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeRouting
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeRouting        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeRouting          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeRouting = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,77 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
+)
+
+func TestFeeRoutingValidate(t *testing.T) {
+	treasury := sdk.AccAddress([]byte("treasury____________")).String()
+
+	routing := types.DefaultFeeRouting()
+	require.NoError(t, routing.Validate())
+	require.False(t, routing.IsEmpty())
+	require.True(t, types.FeeRouting{}.IsEmpty())
+	require.Equal(t, types.DefaultFeeRouting(), types.FeeRouting{}.OrDefault())
+	require.Equal(t, routing, routing.OrDefault())
+
+	routing = types.FeeRouting{
+		FeeCollectorRatio:  sdk.NewDecWithPrec(4, 1),
+		CommunityPoolRatio: sdk.NewDecWithPrec(2, 1),
+		BurnRatio:          sdk.NewDecWithPrec(2, 1),
+		TreasuryRatio:      sdk.NewDecWithPrec(2, 1),
+		TreasuryAddress:    treasury,
+	}
+	require.NoError(t, routing.Validate())
+
+	routing.TreasuryAddress = ""
+	require.Error(t, routing.Validate(), "should not accept treasury ratio without treasury address")
+
+	routing.TreasuryAddress = "invalid"
+	require.Error(t, routing.Validate(), "should not accept invalid treasury address")
+
+	routing.TreasuryAddress = treasury
+	routing.BurnRatio = sdk.NewDecWithPrec(3, 1)
+	require.Error(t, routing.Validate(), "should not accept ratios not summing up to 1")
+
+	routing = types.DefaultFeeRouting()
+	routing.FeeCollectorRatio = sdk.NewDec(2)
+	routing.BurnRatio = sdk.NewDec(-1)
+	require.Error(t, routing.Validate(), "should not accept negative ratio")
+
+	routing = types.DefaultFeeRouting()
+	routing.BurnRatio = sdk.Dec{}
+	require.Error(t, routing.Validate(), "should not accept empty ratio")
+}
+
+func TestFeeRoutingSplit(t *testing.T) {
+	routing := types.FeeRouting{
+		FeeCollectorRatio:  sdk.NewDecWithPrec(4, 1),
+		CommunityPoolRatio: sdk.NewDecWithPrec(2, 1),
+		BurnRatio:          sdk.NewDecWithPrec(2, 1),
+		TreasuryRatio:      sdk.NewDecWithPrec(2, 1),
+	}
+	fees := sdk.NewCoins(sdk.NewInt64Coin("nanolike", 1003), sdk.NewInt64Coin("nanoekil", 10))
+	routed := routing.Split(fees)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 200), sdk.NewInt64Coin("nanoekil", 2)), routed.CommunityPool)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 200), sdk.NewInt64Coin("nanoekil", 2)), routed.Burned)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 200), sdk.NewInt64Coin("nanoekil", 2)), routed.Treasury)
+	// rounding remainder goes to the fee collector
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 403), sdk.NewInt64Coin("nanoekil", 4)), routed.FeeCollector)
+
+	routed = types.DefaultFeeRouting().Split(fees)
+	require.Equal(t, fees, routed.FeeCollector)
+	require.True(t, routed.CommunityPool.IsZero())
+	require.True(t, routed.Burned.IsZero())
+	require.True(t, routed.Treasury.IsZero())
+
+	total := routed.Add(routing.Split(fees))
+	require.Equal(t, fees.Add(sdk.NewCoins(sdk.NewInt64Coin("nanolike", 403), sdk.NewInt64Coin("nanoekil", 4))...), total.FeeCollector)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 200), sdk.NewInt64Coin("nanoekil", 2)), total.Burned)
+	require.NoError(t, total.Validate())
+}
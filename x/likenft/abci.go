@@ -87,6 +87,48 @@ func processOfferExpireQueue(ctx sdk.Context, keeper keeper.Keeper) {
 	})
 }
 
+func tryExpireClassOfferCatchPanic(ctx sdk.Context, keeper keeper.Keeper, classOffer types.ClassOfferStoreRecord) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s", r)
+		}
+	}()
+	err = keeper.ExpireClassOffer(ctx, classOffer)
+	return
+}
+
+func processClassOfferExpireQueue(ctx sdk.Context, keeper keeper.Keeper) {
+	// Expire class offers with expiration time < current block header time
+	keeper.IterateClassOfferExpireQueueByTime(ctx, ctx.BlockHeader().Time, func(val types.ClassOfferExpireQueueEntry) (stop bool) {
+		// Get class offer
+		classOffer, found := keeper.GetClassOfferByKeyBytes(ctx, val.ClassOfferKey)
+		if !found {
+			// class offer not found, dequeue and continue
+			keeper.RemoveClassOfferExpireQueueEntry(ctx, val.ExpireTime, val.ClassOfferKey)
+			return false
+		}
+
+		err := tryExpireClassOfferCatchPanic(ctx, keeper, classOffer)
+		if err != nil {
+			ctx.EventManager().EmitTypedEvent(&types.EventExpireClassOffer{
+				ClassId: classOffer.ClassId,
+				Buyer:   classOffer.Buyer.String(),
+				Success: false,
+				Error:   err.Error(),
+			})
+		} else {
+			ctx.EventManager().EmitTypedEvent(&types.EventExpireClassOffer{
+				ClassId: classOffer.ClassId,
+				Buyer:   classOffer.Buyer.String(),
+				Success: true,
+			})
+		}
+
+		keeper.RemoveClassOfferExpireQueueEntry(ctx, val.ExpireTime, val.ClassOfferKey)
+		return false
+	})
+}
+
 func tryExpireListingCatchPanic(ctx sdk.Context, keeper keeper.Keeper, listing types.ListingStoreRecord) (err error) {
 	defer func() {
 		if r := recover(); r != nil {
@@ -131,10 +173,87 @@ func processListingExpireQueue(ctx sdk.Context, keeper keeper.Keeper) {
 	})
 }
 
+func trySettleAuctionCatchPanic(ctx sdk.Context, keeper keeper.Keeper, auction types.AuctionStoreRecord) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s", r)
+		}
+	}()
+	// Settle on a cached context, so a failed settlement does not leave the escrow partially paid out
+	cacheCtx, write := ctx.CacheContext()
+	err = keeper.SettleAuction(cacheCtx, auction)
+	if err == nil {
+		write()
+	}
+	return
+}
+
+func tryRefundAuctionCatchPanic(ctx sdk.Context, keeper keeper.Keeper, auction types.AuctionStoreRecord) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("%s", r)
+		}
+	}()
+	cacheCtx, write := ctx.CacheContext()
+	err = keeper.RefundAuction(cacheCtx, auction)
+	if err == nil {
+		write()
+	}
+	return
+}
+
+func processAuctionEndQueue(ctx sdk.Context, keeper keeper.Keeper) {
+	// Settle auctions with end time < current block header time
+	keeper.IterateAuctionEndQueueByTime(ctx, ctx.BlockHeader().Time, func(val types.AuctionEndQueueEntry) (stop bool) {
+		// Get auction
+		auction, found := keeper.GetAuctionByKeyBytes(ctx, val.AuctionKey)
+		if !found {
+			// auction not found, dequeue and continue
+			keeper.RemoveAuctionEndQueueEntry(ctx, val.EndTime, val.AuctionKey)
+			return false
+		}
+
+		err := trySettleAuctionCatchPanic(ctx, keeper, auction)
+		if err != nil {
+			// Return the escrowed nft and bid instead, so they are not locked in the module account
+			refundErr := tryRefundAuctionCatchPanic(ctx, keeper, auction)
+			if refundErr != nil {
+				err = fmt.Errorf("%s; refund failed: %s", err.Error(), refundErr.Error())
+			}
+			ctx.EventManager().EmitTypedEvent(&types.EventSettleAuction{
+				ClassId: auction.ClassId,
+				NftId:   auction.NftId,
+				Seller:  auction.Seller.String(),
+				Price:   auction.HighestBid,
+				Success: false,
+				Error:   err.Error(),
+			})
+		} else {
+			var winner string
+			if auction.HasBid() {
+				winner = auction.HighestBidder.String()
+			}
+			ctx.EventManager().EmitTypedEvent(&types.EventSettleAuction{
+				ClassId: auction.ClassId,
+				NftId:   auction.NftId,
+				Seller:  auction.Seller.String(),
+				Winner:  winner,
+				Price:   auction.HighestBid,
+				Success: true,
+			})
+		}
+
+		keeper.RemoveAuctionEndQueueEntry(ctx, val.EndTime, val.AuctionKey)
+		return false
+	})
+}
+
 // EndBlocker called every block, process class reveal queue.
 func EndBlocker(ctx sdk.Context, keeper keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
 	processClassRevealQueue(ctx, keeper)
 	processOfferExpireQueue(ctx, keeper)
+	processClassOfferExpireQueue(ctx, keeper)
 	processListingExpireQueue(ctx, keeper)
+	processAuctionEndQueue(ctx, keeper)
 }
@@ -0,0 +1,106 @@
+package likenft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestAuctionLifecycle(t *testing.T) {
+	setup := setupAppAndNfts(t)
+	app := setup.App
+
+	seller := setup.Owners[0]
+	bidder1 := setup.Owners[1]
+	bidder2 := setup.OtherAddrs[0]
+	classId := seller.Iscns[0].Classes[0].ClassId
+	nftId := seller.Iscns[0].Classes[0].NftIds[0]
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	endTime := time.Unix(1234568000, 0)
+
+	msg := types.NewMsgCreateAuction(seller.Addr.String(), classId, nftId, sdk.NewInt64Coin("nanolike", 1000), sdk.NewInt64Coin("nanolike", 100), endTime, false)
+	app.DeliverMsgNoError(t, msg, seller.PrivKey)
+
+	ctx := app.SetForQuery()
+	require.Equal(t, moduleAddr, app.NftKeeper.GetOwner(ctx, classId, nftId))
+	sellerBalance := app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike")
+	bidder1Balance := app.BankKeeper.GetBalance(ctx, bidder1.Addr, "nanolike")
+	bidder2Balance := app.BankKeeper.GetBalance(ctx, bidder2.Addr, "nanolike")
+	app.SetForTx()
+
+	// Below reserve price
+	app.DeliverMsgSimError(t, types.NewMsgPlaceBid(bidder1.Addr.String(), classId, nftId, sdk.NewInt64Coin("nanolike", 999)), bidder1.PrivKey, "Bid is too low")
+	app.DeliverMsgNoError(t, types.NewMsgPlaceBid(bidder1.Addr.String(), classId, nftId, sdk.NewInt64Coin("nanolike", 1000)), bidder1.PrivKey)
+	// Below highest bid + min increment
+	app.DeliverMsgSimError(t, types.NewMsgPlaceBid(bidder2.Addr.String(), classId, nftId, sdk.NewInt64Coin("nanolike", 1099)), bidder2.PrivKey, "Bid is too low")
+	// Seller cannot cancel once bid
+	app.DeliverMsgSimError(t, types.NewMsgCancelAuction(seller.Addr.String(), classId, nftId), seller.PrivKey, "Auction already has bids")
+
+	ctx = app.SetForQuery()
+	require.Equal(t, bidder1Balance.SubAmount(sdk.NewInt(1000)), app.BankKeeper.GetBalance(ctx, bidder1.Addr, "nanolike"))
+	app.SetForTx()
+
+	app.DeliverMsgNoError(t, types.NewMsgPlaceBid(bidder2.Addr.String(), classId, nftId, sdk.NewInt64Coin("nanolike", 1100)), bidder2.PrivKey)
+
+	// Outbid bidder is refunded
+	ctx = app.SetForQuery()
+	require.Equal(t, bidder1Balance, app.BankKeeper.GetBalance(ctx, bidder1.Addr, "nanolike"))
+	require.Equal(t, bidder2Balance.SubAmount(sdk.NewInt(1100)), app.BankKeeper.GetBalance(ctx, bidder2.Addr, "nanolike"))
+	auction, found := app.LikeNftKeeper.GetAuction(ctx, classId, nftId)
+	require.True(t, found)
+	require.Equal(t, bidder2.Addr, auction.HighestBidder)
+	require.Equal(t, sdk.NewInt64Coin("nanolike", 1100), auction.HighestBid)
+	app.SetForTx()
+
+	// Settle after end time
+	app.NextHeader(1234568001)
+	app.SetForQuery()
+	app.SetForTx()
+
+	ctx = app.SetForQuery()
+	require.Equal(t, bidder2.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId))
+	require.Equal(t, sellerBalance.AddAmount(sdk.NewInt(1100)), app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike"))
+	require.Equal(t, bidder1Balance, app.BankKeeper.GetBalance(ctx, bidder1.Addr, "nanolike"))
+	_, found = app.LikeNftKeeper.GetAuction(ctx, classId, nftId)
+	require.False(t, found)
+	require.Empty(t, app.LikeNftKeeper.GetAuctionEndQueue(ctx))
+}
+
+func TestAuctionCancelAndNoBid(t *testing.T) {
+	setup := setupAppAndNfts(t)
+	app := setup.App
+
+	seller := setup.Owners[0]
+	classId := seller.Iscns[0].Classes[0].ClassId
+	nftId0 := seller.Iscns[0].Classes[0].NftIds[0]
+	nftId1 := seller.Iscns[0].Classes[0].NftIds[1]
+	endTime := time.Unix(1234568000, 0)
+
+	app.DeliverMsgNoError(t, types.NewMsgCreateAuction(seller.Addr.String(), classId, nftId0, sdk.NewInt64Coin("nanolike", 1000), sdk.NewInt64Coin("nanolike", 100), endTime, false), seller.PrivKey)
+	app.DeliverMsgNoError(t, types.NewMsgCreateAuction(seller.Addr.String(), classId, nftId1, sdk.NewInt64Coin("nanolike", 1000), sdk.NewInt64Coin("nanolike", 100), endTime, false), seller.PrivKey)
+
+	// Cancel returns the NFT right away
+	app.DeliverMsgNoError(t, types.NewMsgCancelAuction(seller.Addr.String(), classId, nftId0), seller.PrivKey)
+	ctx := app.SetForQuery()
+	require.Equal(t, seller.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId0))
+	_, found := app.LikeNftKeeper.GetAuction(ctx, classId, nftId0)
+	require.False(t, found)
+	app.SetForTx()
+
+	// Ended without bid returns the NFT on settlement
+	app.NextHeader(1234568001)
+	app.SetForQuery()
+	app.SetForTx()
+
+	ctx = app.SetForQuery()
+	require.Equal(t, seller.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId1))
+	_, found = app.LikeNftKeeper.GetAuction(ctx, classId, nftId1)
+	require.False(t, found)
+	require.Empty(t, app.LikeNftKeeper.GetAuctionEndQueue(ctx))
+}
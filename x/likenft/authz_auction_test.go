@@ -0,0 +1,102 @@
+package likenft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestAuctionAuthorizations(t *testing.T) {
+	var msg sdk.Msg
+	var msgGrant *authz.MsgGrant
+	var msgExec authz.MsgExec
+	var err error
+
+	setup := setupAppAndNfts(t)
+	app := setup.App
+
+	granter := setup.Owners[0]
+	grantee := setup.OtherAddrs[0]
+	bidder := setup.Owners[1]
+
+	grantedClassId := setup.Owners[0].Iscns[0].Classes[0].ClassId
+	ungrantedClassId := setup.Owners[0].Iscns[0].Classes[1].ClassId
+	grantedNftId := setup.Owners[0].Iscns[0].Classes[0].NftIds[0]
+	ungrantedNftId := setup.Owners[0].Iscns[0].Classes[0].NftIds[1]
+
+	reservePrice := sdk.NewInt64Coin("nanolike", 1000)
+	minIncrement := sdk.NewInt64Coin("nanolike", 100)
+	endTime := time.Unix(1234569999, 0)
+
+	expiration := time.Unix(1300000000, 0)
+	msgGrant, err = authz.NewMsgGrant(granter.Addr, grantee.Addr, &types.CreateAuctionAuthorization{
+		ClassId: grantedClassId,
+		NftId:   grantedNftId,
+	}, &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
+
+	msg = types.NewMsgCreateAuction(granter.Addr.String(), grantedClassId, ungrantedNftId, reservePrice, minIncrement, endTime, false)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "NFT ID mismatch")
+
+	msg = types.NewMsgCreateAuction(granter.Addr.String(), ungrantedClassId, grantedNftId, reservePrice, minIncrement, endTime, false)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
+
+	msg = types.NewMsgCreateAuction(granter.Addr.String(), grantedClassId, grantedNftId, reservePrice, minIncrement, endTime, false)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
+
+	expiration = time.Unix(1300000000, 0)
+	msgGrant, err = authz.NewMsgGrant(granter.Addr, grantee.Addr, &types.CancelAuctionAuthorization{
+		ClassId: grantedClassId,
+		NftId:   grantedNftId,
+	}, &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
+
+	msg = types.NewMsgCancelAuction(granter.Addr.String(), grantedClassId, ungrantedNftId)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "NFT ID mismatch")
+
+	msg = types.NewMsgCancelAuction(granter.Addr.String(), ungrantedClassId, grantedNftId)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
+
+	msg = types.NewMsgCancelAuction(granter.Addr.String(), grantedClassId, grantedNftId)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
+
+	// Bid on behalf of another account
+	msg = types.NewMsgCreateAuction(granter.Addr.String(), grantedClassId, grantedNftId, reservePrice, minIncrement, endTime, false)
+	app.DeliverMsgNoError(t, msg, granter.PrivKey)
+	msg = types.NewMsgCreateAuction(granter.Addr.String(), grantedClassId, ungrantedNftId, reservePrice, minIncrement, endTime, false)
+	app.DeliverMsgNoError(t, msg, granter.PrivKey)
+
+	expiration = time.Unix(1300000000, 0)
+	msgGrant, err = authz.NewMsgGrant(bidder.Addr, grantee.Addr, &types.PlaceBidAuthorization{
+		ClassId: grantedClassId,
+		NftId:   grantedNftId,
+	}, &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msgGrant, bidder.PrivKey)
+
+	msg = types.NewMsgPlaceBid(bidder.Addr.String(), grantedClassId, ungrantedNftId, reservePrice)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "NFT ID mismatch")
+
+	msg = types.NewMsgPlaceBid(bidder.Addr.String(), ungrantedClassId, grantedNftId, reservePrice)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
+
+	msg = types.NewMsgPlaceBid(bidder.Addr.String(), grantedClassId, grantedNftId, reservePrice)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
+}
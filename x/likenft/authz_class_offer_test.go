@@ -0,0 +1,77 @@
+package likenft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestClassOfferAuthorizations(t *testing.T) {
+	var msg sdk.Msg
+	var msgGrant *authz.MsgGrant
+	var msgExec authz.MsgExec
+	var err error
+
+	setup := setupAppAndNfts(t)
+	app := setup.App
+
+	granter := setup.Owners[1]
+	grantee := setup.OtherAddrs[0]
+
+	grantedClassId := setup.Owners[0].Iscns[0].Classes[0].ClassId
+	ungrantedClassId := setup.Owners[0].Iscns[0].Classes[1].ClassId
+
+	price := sdk.NewInt64Coin("nanolike", 1000)
+	offerExpiration := time.Unix(1234569999, 0)
+
+	expiration := time.Unix(1300000000, 0)
+	msgGrant, err = authz.NewMsgGrant(granter.Addr, grantee.Addr, &types.CreateClassOfferAuthorization{
+		ClassId: grantedClassId,
+	}, &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
+
+	msg = types.NewMsgCreateClassOffer(granter.Addr.String(), ungrantedClassId, price, 2, offerExpiration)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
+
+	msg = types.NewMsgCreateClassOffer(granter.Addr.String(), grantedClassId, price, 2, offerExpiration)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
+
+	expiration = time.Unix(1300000000, 0)
+	msgGrant, err = authz.NewMsgGrant(granter.Addr, grantee.Addr, &types.UpdateClassOfferAuthorization{
+		ClassId: grantedClassId,
+	}, &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
+
+	msg = types.NewMsgUpdateClassOffer(granter.Addr.String(), ungrantedClassId, price, 3, offerExpiration)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
+
+	msg = types.NewMsgUpdateClassOffer(granter.Addr.String(), grantedClassId, price, 3, offerExpiration)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
+
+	expiration = time.Unix(1300000000, 0)
+	msgGrant, err = authz.NewMsgGrant(granter.Addr, grantee.Addr, &types.DeleteClassOfferAuthorization{
+		ClassId: grantedClassId,
+	}, &expiration)
+	require.NoError(t, err)
+	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
+
+	msg = types.NewMsgDeleteClassOffer(granter.Addr.String(), ungrantedClassId)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
+
+	msg = types.NewMsgDeleteClassOffer(granter.Addr.String(), grantedClassId)
+	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
+	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
+}
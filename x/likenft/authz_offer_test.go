@@ -37,15 +37,15 @@ func TestOfferAuthorizations(t *testing.T) {
 	require.NoError(t, err)
 	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
 
-	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, grantedNftId, 1, time.Unix(1234569999, 0))
+	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, grantedNftId, sdk.NewInt64Coin("nanolike", 1), time.Unix(1234569999, 0), false)
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
 
-	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, ungrantedNftId, 1, time.Unix(1234569999, 0))
+	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, ungrantedNftId, sdk.NewInt64Coin("nanolike", 1), time.Unix(1234569999, 0), false)
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "NFT ID mismatch")
 
-	msg = types.NewMsgCreateOffer(granter.Addr.String(), ungrantedClassId, grantedNftId, 1, time.Unix(1234569999, 0))
+	msg = types.NewMsgCreateOffer(granter.Addr.String(), ungrantedClassId, grantedNftId, sdk.NewInt64Coin("nanolike", 1), time.Unix(1234569999, 0), false)
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
 
@@ -57,15 +57,15 @@ func TestOfferAuthorizations(t *testing.T) {
 	require.NoError(t, err)
 	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
 
-	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, grantedNftId, 2, time.Unix(1234569999, 0))
+	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, grantedNftId, sdk.NewInt64Coin("nanolike", 2), time.Unix(1234569999, 0))
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
 
-	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, ungrantedNftId, 2, time.Unix(1234569999, 0))
+	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, ungrantedNftId, sdk.NewInt64Coin("nanolike", 2), time.Unix(1234569999, 0))
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "NFT ID mismatch")
 
-	msg = types.NewMsgUpdateOffer(granter.Addr.String(), ungrantedClassId, grantedNftId, 2, time.Unix(1234569999, 0))
+	msg = types.NewMsgUpdateOffer(granter.Addr.String(), ungrantedClassId, grantedNftId, sdk.NewInt64Coin("nanolike", 2), time.Unix(1234569999, 0))
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
 
@@ -114,15 +114,15 @@ func TestOfferAuthorizationsForAllNftIds(t *testing.T) {
 	require.NoError(t, err)
 	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
 
-	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, nftId0, 1, time.Unix(1234569999, 0))
+	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, nftId0, sdk.NewInt64Coin("nanolike", 1), time.Unix(1234569999, 0), false)
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
 
-	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, nftId1, 1, time.Unix(1234569999, 0))
+	msg = types.NewMsgCreateOffer(granter.Addr.String(), grantedClassId, nftId1, sdk.NewInt64Coin("nanolike", 1), time.Unix(1234569999, 0), false)
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
 
-	msg = types.NewMsgCreateOffer(granter.Addr.String(), ungrantedClassId, nftId0, 1, time.Unix(1234569999, 0))
+	msg = types.NewMsgCreateOffer(granter.Addr.String(), ungrantedClassId, nftId0, sdk.NewInt64Coin("nanolike", 1), time.Unix(1234569999, 0), false)
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
 
@@ -133,15 +133,15 @@ func TestOfferAuthorizationsForAllNftIds(t *testing.T) {
 	require.NoError(t, err)
 	app.DeliverMsgNoError(t, msgGrant, granter.PrivKey)
 
-	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, nftId0, 2, time.Unix(1234569999, 0))
+	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, nftId0, sdk.NewInt64Coin("nanolike", 2), time.Unix(1234569999, 0))
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
 
-	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, nftId1, 2, time.Unix(1234569999, 0))
+	msg = types.NewMsgUpdateOffer(granter.Addr.String(), grantedClassId, nftId1, sdk.NewInt64Coin("nanolike", 2), time.Unix(1234569999, 0))
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgNoError(t, &msgExec, grantee.PrivKey)
 
-	msg = types.NewMsgUpdateOffer(granter.Addr.String(), ungrantedClassId, nftId0, 2, time.Unix(1234569999, 0))
+	msg = types.NewMsgUpdateOffer(granter.Addr.String(), ungrantedClassId, nftId0, sdk.NewInt64Coin("nanolike", 2), time.Unix(1234569999, 0))
 	msgExec = authz.NewMsgExec(grantee.Addr, []sdk.Msg{msg})
 	app.DeliverMsgSimError(t, &msgExec, grantee.PrivKey, "class ID mismatch")
 
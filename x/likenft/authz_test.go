@@ -351,10 +351,10 @@ func TestAuthorization(t *testing.T) {
 	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
 	require.ErrorContains(t, simErr, "authorization not found")
 
-	msg = types.NewMsgCreateOffer(addr1.String(), classId1, "token-1-by-2", 1, time.Unix(1240000000, 0))
+	msg = types.NewMsgCreateOffer(addr1.String(), classId1, "token-1-by-2", sdk.NewInt64Coin("nanolike", 1), time.Unix(1240000000, 0), false)
 	app.DeliverMsgNoError(t, msg, priv1)
 
-	msg = types.NewMsgCreateOffer(addr3.String(), classId3, "token-3-by-3", 1, time.Unix(1240000000, 0))
+	msg = types.NewMsgCreateOffer(addr3.String(), classId3, "token-3-by-3", sdk.NewInt64Coin("nanolike", 1), time.Unix(1240000000, 0), false)
 	app.DeliverMsgNoError(t, msg, priv3)
 
 	expiration = time.Unix(2000000000, 0)
@@ -362,27 +362,27 @@ func TestAuthorization(t *testing.T) {
 	require.NoError(t, err)
 	app.DeliverMsgNoError(t, msg, priv1)
 
-	msgUpdateOffer := types.NewMsgUpdateOffer(addr1.String(), classId1, "token-1-by-2", 2, time.Unix(1240000001, 0))
+	msgUpdateOffer := types.NewMsgUpdateOffer(addr1.String(), classId1, "token-1-by-2", sdk.NewInt64Coin("nanolike", 2), time.Unix(1240000001, 0))
 	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{msgUpdateOffer})
 	msg = &msgExec
 	app.DeliverMsgNoError(t, msg, priv2)
 
-	msgUpdateOffer = types.NewMsgUpdateOffer(addr1.String(), classId1, "token-1-by-2", 3, time.Unix(1240000002, 0))
+	msgUpdateOffer = types.NewMsgUpdateOffer(addr1.String(), classId1, "token-1-by-2", sdk.NewInt64Coin("nanolike", 3), time.Unix(1240000002, 0))
 	msgExec = authz.NewMsgExec(addr3, []sdk.Msg{msgUpdateOffer})
 	msg = &msgExec
 	_, _, simErr, _ = app.DeliverMsg(msg, priv3)
 	require.ErrorContains(t, simErr, "authorization not found")
 
-	msgUpdateOffer = types.NewMsgUpdateOffer(addr3.String(), classId1, "token-3-by-3", 3, time.Unix(1240000002, 0))
+	msgUpdateOffer = types.NewMsgUpdateOffer(addr3.String(), classId1, "token-3-by-3", sdk.NewInt64Coin("nanolike", 3), time.Unix(1240000002, 0))
 	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{msgUpdateOffer})
 	msg = &msgExec
 	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
 	require.ErrorContains(t, simErr, "authorization not found")
 
-	msg = types.NewMsgCreateListing(addr1.String(), classId1, "token-1-by-2", 1, time.Unix(1240000000, 0), false)
+	msg = types.NewMsgCreateListing(addr1.String(), classId1, "token-1-by-2", sdk.NewInt64Coin("nanolike", 1), time.Unix(1240000000, 0), false, nil, false)
 	app.DeliverMsgNoError(t, msg, priv1)
 
-	msg = types.NewMsgCreateListing(addr3.String(), classId3, "token-3-by-3", 1, time.Unix(1240000000, 0), false)
+	msg = types.NewMsgCreateListing(addr3.String(), classId3, "token-3-by-3", sdk.NewInt64Coin("nanolike", 1), time.Unix(1240000000, 0), false, nil, false)
 	app.DeliverMsgNoError(t, msg, priv3)
 
 	expiration = time.Unix(2000000000, 0)
@@ -390,18 +390,18 @@ func TestAuthorization(t *testing.T) {
 	require.NoError(t, err)
 	app.DeliverMsgNoError(t, msg, priv1)
 
-	msgUpdateListing := types.NewMsgUpdateListing(addr1.String(), classId1, "token-1-by-2", 2, time.Unix(1240000001, 0), false)
+	msgUpdateListing := types.NewMsgUpdateListing(addr1.String(), classId1, "token-1-by-2", sdk.NewInt64Coin("nanolike", 2), time.Unix(1240000001, 0), false, nil)
 	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{msgUpdateListing})
 	msg = &msgExec
 	app.DeliverMsgNoError(t, msg, priv2)
 
-	msgUpdateListing = types.NewMsgUpdateListing(addr1.String(), classId1, "token-1-by-2", 3, time.Unix(1240000002, 0), false)
+	msgUpdateListing = types.NewMsgUpdateListing(addr1.String(), classId1, "token-1-by-2", sdk.NewInt64Coin("nanolike", 3), time.Unix(1240000002, 0), false, nil)
 	msgExec = authz.NewMsgExec(addr3, []sdk.Msg{msgUpdateListing})
 	msg = &msgExec
 	_, _, simErr, _ = app.DeliverMsg(msg, priv3)
 	require.ErrorContains(t, simErr, "authorization not found")
 
-	msgUpdateListing = types.NewMsgUpdateListing(addr3.String(), classId1, "token-3-by-3", 3, time.Unix(1240000002, 0), false)
+	msgUpdateListing = types.NewMsgUpdateListing(addr3.String(), classId1, "token-3-by-3", sdk.NewInt64Coin("nanolike", 3), time.Unix(1240000002, 0), false, nil)
 	msgExec = authz.NewMsgExec(addr2, []sdk.Msg{msgUpdateListing})
 	msg = &msgExec
 	_, _, simErr, _ = app.DeliverMsg(msg, priv2)
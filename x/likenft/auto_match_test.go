@@ -0,0 +1,52 @@
+package likenft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestAutoMatch(t *testing.T) {
+	setup := setupAppAndNfts(t)
+	app := setup.App
+
+	seller := setup.Owners[0]
+	buyer := setup.Owners[1]
+	classId := seller.Iscns[0].Classes[0].ClassId
+	nftId0 := seller.Iscns[0].Classes[0].NftIds[0]
+	nftId1 := seller.Iscns[0].Classes[0].NftIds[1]
+	expiration := time.Unix(1234568000, 0)
+
+	ctx := app.SetForQuery()
+	sellerBalance := app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike")
+	buyerBalance := app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike")
+	app.SetForTx()
+
+	// New listing sells to the existing offer at the offered price
+	app.DeliverMsgNoError(t, types.NewMsgCreateOffer(buyer.Addr.String(), classId, nftId0, sdk.NewInt64Coin("nanolike", 1000), expiration, false), buyer.PrivKey)
+	app.DeliverMsgNoError(t, types.NewMsgCreateListing(seller.Addr.String(), classId, nftId0, sdk.NewInt64Coin("nanolike", 800), expiration, false, nil, true), seller.PrivKey)
+
+	ctx = app.SetForQuery()
+	require.Equal(t, buyer.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId0))
+	require.Equal(t, sellerBalance.AddAmount(sdk.NewInt(1000)), app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike"))
+	require.Equal(t, buyerBalance.SubAmount(sdk.NewInt(1000)), app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike"))
+	require.Empty(t, app.LikeNftKeeper.GetListingsByNFT(ctx, classId, nftId0))
+	require.Empty(t, app.LikeNftKeeper.GetOffersByNFT(ctx, classId, nftId0))
+	app.SetForTx()
+
+	// New offer buys from the existing listing at the listed price
+	app.DeliverMsgNoError(t, types.NewMsgCreateListing(seller.Addr.String(), classId, nftId1, sdk.NewInt64Coin("nanolike", 500), expiration, false, nil, false), seller.PrivKey)
+	app.DeliverMsgNoError(t, types.NewMsgCreateOffer(buyer.Addr.String(), classId, nftId1, sdk.NewInt64Coin("nanolike", 700), expiration, true), buyer.PrivKey)
+
+	ctx = app.SetForQuery()
+	require.Equal(t, buyer.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId1))
+	require.Equal(t, sellerBalance.AddAmount(sdk.NewInt(1500)), app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike"))
+	require.Equal(t, buyerBalance.SubAmount(sdk.NewInt(1500)), app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike"))
+	require.Empty(t, app.LikeNftKeeper.GetListingsByNFT(ctx, classId, nftId1))
+	require.Empty(t, app.LikeNftKeeper.GetOffersByNFT(ctx, classId, nftId1))
+}
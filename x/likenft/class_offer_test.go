@@ -0,0 +1,65 @@
+package likenft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestClassOfferLifecycle(t *testing.T) {
+	setup := setupAppAndNfts(t)
+	app := setup.App
+
+	seller := setup.Owners[0]
+	buyer := setup.Owners[1]
+	classId := seller.Iscns[0].Classes[0].ClassId
+	nftId0 := seller.Iscns[0].Classes[0].NftIds[0]
+	nftId1 := seller.Iscns[0].Classes[0].NftIds[1]
+	otherClassNftId := seller.Iscns[0].Classes[1].NftIds[0]
+	otherClassId := seller.Iscns[0].Classes[1].ClassId
+	expiration := time.Unix(1234568000, 0)
+
+	ctx := app.SetForQuery()
+	sellerBalance := app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike")
+	buyerBalance := app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike")
+	app.SetForTx()
+
+	// Escrow the price of the whole quantity
+	app.DeliverMsgNoError(t, types.NewMsgCreateClassOffer(buyer.Addr.String(), classId, sdk.NewInt64Coin("nanolike", 1000), 3, expiration), buyer.PrivKey)
+	ctx = app.SetForQuery()
+	require.Equal(t, buyerBalance.SubAmount(sdk.NewInt(3000)), app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike"))
+	app.SetForTx()
+
+	// Any NFT in the class can fill the offer, but not NFTs in other classes
+	app.DeliverMsgSimError(t, types.NewMsgSellNFT(seller.Addr.String(), otherClassId, otherClassNftId, buyer.Addr.String(), sdk.NewInt64Coin("nanolike", 1000), false, true), seller.PrivKey, "Existing class offer not found")
+	app.DeliverMsgSimError(t, types.NewMsgSellNFT(seller.Addr.String(), classId, nftId0, buyer.Addr.String(), sdk.NewInt64Coin("nanolike", 1001), false, true), seller.PrivKey, "Price is too high")
+	app.DeliverMsgNoError(t, types.NewMsgSellNFT(seller.Addr.String(), classId, nftId0, buyer.Addr.String(), sdk.NewInt64Coin("nanolike", 1000), false, true), seller.PrivKey)
+	app.DeliverMsgNoError(t, types.NewMsgSellNFT(seller.Addr.String(), classId, nftId1, buyer.Addr.String(), sdk.NewInt64Coin("nanolike", 800), false, true), seller.PrivKey)
+
+	ctx = app.SetForQuery()
+	require.Equal(t, buyer.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId0))
+	require.Equal(t, buyer.Addr, app.NftKeeper.GetOwner(ctx, classId, nftId1))
+	require.Equal(t, sellerBalance.AddAmount(sdk.NewInt(1800)), app.BankKeeper.GetBalance(ctx, seller.Addr, "nanolike"))
+	// Unused price of the second fill is refunded right away
+	require.Equal(t, buyerBalance.SubAmount(sdk.NewInt(2800)), app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike"))
+	classOffer, found := app.LikeNftKeeper.GetClassOffer(ctx, classId, buyer.Addr)
+	require.True(t, found)
+	require.Equal(t, uint64(1), classOffer.Quantity)
+	app.SetForTx()
+
+	// Remaining quantity is refunded on expiry
+	app.NextHeader(1234568001)
+	app.SetForQuery()
+	app.SetForTx()
+
+	ctx = app.SetForQuery()
+	require.Equal(t, buyerBalance.SubAmount(sdk.NewInt(1800)), app.BankKeeper.GetBalance(ctx, buyer.Addr, "nanolike"))
+	_, found = app.LikeNftKeeper.GetClassOffer(ctx, classId, buyer.Addr)
+	require.False(t, found)
+	require.Empty(t, app.LikeNftKeeper.GetClassOfferExpireQueue(ctx))
+}
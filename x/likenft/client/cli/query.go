@@ -51,6 +51,15 @@ func GetQueryCmd(queryRoute string) *cobra.Command {
 
 	cmd.AddCommand(CmdListRoyaltyConfig())
 	cmd.AddCommand(CmdShowRoyaltyConfig())
+
+	cmd.AddCommand(CmdQueryCollectedFees())
+
+	cmd.AddCommand(CmdListAuction())
+	cmd.AddCommand(CmdShowAuction())
+	cmd.AddCommand(CmdAuctionsByClass())
+	cmd.AddCommand(CmdListClassOffer())
+	cmd.AddCommand(CmdShowClassOffer())
+	cmd.AddCommand(CmdClassOffers())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,34 @@
+package cli
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdQueryCollectedFees() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "collected-fees",
+		Short: "Shows the total fees routed to the fee collector, the community pool, burning and the registry treasury",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.CollectedFees(context.Background(), &types.QueryCollectedFeesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -48,6 +48,12 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(CmdCreateRoyaltyConfig())
 	cmd.AddCommand(CmdUpdateRoyaltyConfig())
 	cmd.AddCommand(CmdDeleteRoyaltyConfig())
+	cmd.AddCommand(CmdCreateAuction())
+	cmd.AddCommand(CmdCancelAuction())
+	cmd.AddCommand(CmdPlaceBid())
+	cmd.AddCommand(CmdCreateClassOffer())
+	cmd.AddCommand(CmdUpdateClassOffer())
+	cmd.AddCommand(CmdDeleteClassOffer())
 	// this line is used by starport scaffolding # 1
 
 	return cmd
@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-auction [class-id] [nft-id] [reserve-price] [min-increment] [end-time] (--full-pay-to-royalty)",
+		Short: "Create a new auction, escrowing the NFT until it is settled",
+		Args:  cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Get indexes
+			indexClassId := args[0]
+			indexNftId := args[1]
+
+			// Get value arguments
+			argReservePrice, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+			argMinIncrement, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+			argEndTime, err := time.Parse(time.RFC3339, args[4])
+			if err != nil {
+				return err
+			}
+
+			flagFullPayToRoyalty, err := cmd.Flags().GetBool("full-pay-to-royalty")
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateAuction(
+				clientCtx.GetFromAddress().String(),
+				indexClassId,
+				indexNftId,
+				argReservePrice,
+				argMinIncrement,
+				argEndTime,
+				flagFullPayToRoyalty,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool("full-pay-to-royalty", false, "Pay full price to royalty")
+
+	return cmd
+}
+
+func CmdCancelAuction() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-auction [class-id] [nft-id]",
+		Short: "Cancel an auction without bids",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			indexClassId := args[0]
+			indexNftId := args[1]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelAuction(
+				clientCtx.GetFromAddress().String(),
+				indexClassId,
+				indexNftId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPlaceBid() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "place-bid [class-id] [nft-id] [bid]",
+		Short: "Place a bid on an auction, escrowing the bid until outbid or settled",
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			indexClassId := args[0]
+			indexNftId := args[1]
+
+			argBid, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgPlaceBid(
+				clientCtx.GetFromAddress().String(),
+				indexClassId,
+				indexNftId,
+				argBid,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 	"github.com/spf13/cobra"
 )
@@ -21,7 +22,7 @@ func CmdBuyNFT() *cobra.Command {
 			argClassId := args[0]
 			argNftId := args[1]
 			argSeller := args[2]
-			argPrice, err := strconv.ParseUint(args[3], 10, 64)
+			argPrice, err := sdk.ParseCoinNormalized(args[3])
 			if err != nil {
 				return err
 			}
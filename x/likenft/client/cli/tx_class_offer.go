@@ -0,0 +1,136 @@
+package cli
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/spf13/cobra"
+)
+
+func CmdCreateClassOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "create-class-offer [class-id] [price] [quantity] [expiration]",
+		Short: "Create a new class offer to buy any NFTs in the class",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Get indexes
+			indexClassId := args[0]
+
+			// Get value arguments
+			argPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argQuantity, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argExpiration, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCreateClassOffer(
+				clientCtx.GetFromAddress().String(),
+				indexClassId,
+				argPrice,
+				argQuantity,
+				argExpiration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateClassOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-class-offer [class-id] [price] [quantity] [expiration]",
+		Short: "Update a class offer",
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			// Get indexes
+			indexClassId := args[0]
+
+			// Get value arguments
+			argPrice, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+			argQuantity, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+			argExpiration, err := time.Parse(time.RFC3339, args[3])
+			if err != nil {
+				return err
+			}
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgUpdateClassOffer(
+				clientCtx.GetFromAddress().String(),
+				indexClassId,
+				argPrice,
+				argQuantity,
+				argExpiration,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdDeleteClassOffer() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delete-class-offer [class-id]",
+		Short: "Delete a class offer",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			indexClassId := args[0]
+
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgDeleteClassOffer(
+				clientCtx.GetFromAddress().String(),
+				indexClassId,
+			)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
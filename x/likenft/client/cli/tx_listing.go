@@ -1,19 +1,19 @@
 package cli
 
 import (
-	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 	"github.com/spf13/cobra"
 )
 
 func CmdCreateListing() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-listing [class-id] [nft-id] [price] [expiration] (--full-pay-to-royalty)",
+		Use:   "create-listing [class-id] [nft-id] [price] [expiration] (--full-pay-to-royalty) (--auto-match) (--dutch-floor-price [floor-price] --dutch-start-time [start-time] --dutch-end-time [end-time] --dutch-step-seconds [step-seconds])",
 		Short: "Create a new listing",
 		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -22,7 +22,7 @@ func CmdCreateListing() *cobra.Command {
 			indexNftId := args[1]
 
 			// Get value arguments
-			argPrice, err := strconv.ParseUint(args[2], 10, 64)
+			argPrice, err := sdk.ParseCoinNormalized(args[2])
 			if err != nil {
 				return err
 			}
@@ -36,6 +36,16 @@ func CmdCreateListing() *cobra.Command {
 				return err
 			}
 
+			flagAutoMatch, err := cmd.Flags().GetBool("auto-match")
+			if err != nil {
+				return err
+			}
+
+			dutchAuction, err := parseDutchAuctionFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -48,6 +58,8 @@ func CmdCreateListing() *cobra.Command {
 				argPrice,
 				argExpiration,
 				flagFullPayToRoyalty,
+				dutchAuction,
+				flagAutoMatch,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -58,13 +70,15 @@ func CmdCreateListing() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().Bool("full-pay-to-royalty", false, "Pay full price to royalty")
+	cmd.Flags().Bool("auto-match", false, "Sell to the highest matching offer right away instead of listing")
+	addDutchAuctionFlags(cmd)
 
 	return cmd
 }
 
 func CmdUpdateListing() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "update-listing [class-id] [nft-id] [price] [expiration] (--full-pay-to-royalty)",
+		Use:   "update-listing [class-id] [nft-id] [price] [expiration] (--full-pay-to-royalty) (--dutch-floor-price [floor-price] --dutch-start-time [start-time] --dutch-end-time [end-time] --dutch-step-seconds [step-seconds])",
 		Short: "Update a listing",
 		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
@@ -73,7 +87,7 @@ func CmdUpdateListing() *cobra.Command {
 			indexNftId := args[1]
 
 			// Get value arguments
-			argPrice, err := strconv.ParseUint(args[2], 10, 64)
+			argPrice, err := sdk.ParseCoinNormalized(args[2])
 			if err != nil {
 				return err
 			}
@@ -92,6 +106,11 @@ func CmdUpdateListing() *cobra.Command {
 				return err
 			}
 
+			dutchAuction, err := parseDutchAuctionFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			msg := types.NewMsgUpdateListing(
 				clientCtx.GetFromAddress().String(),
 				indexClassId,
@@ -99,6 +118,7 @@ func CmdUpdateListing() *cobra.Command {
 				argPrice,
 				argExpiration,
 				flagFullPayToRoyalty,
+				dutchAuction,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -109,6 +129,7 @@ func CmdUpdateListing() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().Bool("full-pay-to-royalty", false, "Pay full price to royalty")
+	addDutchAuctionFlags(cmd)
 
 	return cmd
 }
@@ -143,3 +164,50 @@ func CmdDeleteListing() *cobra.Command {
 
 	return cmd
 }
+
+func addDutchAuctionFlags(cmd *cobra.Command) {
+	cmd.Flags().String("dutch-floor-price", "", "Floor price of the dutch auction, enables declining price if set")
+	cmd.Flags().String("dutch-start-time", "", "Time the price starts to decline, in RFC3339")
+	cmd.Flags().String("dutch-end-time", "", "Time the price reaches the floor price, in RFC3339")
+	cmd.Flags().Uint64("dutch-step-seconds", 0, "Interval of each price drop in seconds, 0 for continuous decline")
+}
+
+func parseDutchAuctionFlags(cmd *cobra.Command) (*types.DutchAuctionConfig, error) {
+	flagFloorPrice, err := cmd.Flags().GetString("dutch-floor-price")
+	if err != nil {
+		return nil, err
+	}
+	if flagFloorPrice == "" {
+		return nil, nil
+	}
+	floorPrice, err := sdk.ParseCoinNormalized(flagFloorPrice)
+	if err != nil {
+		return nil, err
+	}
+	flagStartTime, err := cmd.Flags().GetString("dutch-start-time")
+	if err != nil {
+		return nil, err
+	}
+	startTime, err := time.Parse(time.RFC3339, flagStartTime)
+	if err != nil {
+		return nil, err
+	}
+	flagEndTime, err := cmd.Flags().GetString("dutch-end-time")
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := time.Parse(time.RFC3339, flagEndTime)
+	if err != nil {
+		return nil, err
+	}
+	stepSeconds, err := cmd.Flags().GetUint64("dutch-step-seconds")
+	if err != nil {
+		return nil, err
+	}
+	return &types.DutchAuctionConfig{
+		FloorPrice:  floorPrice,
+		StartTime:   startTime,
+		EndTime:     endTime,
+		StepSeconds: stepSeconds,
+	}, nil
+}
@@ -1,19 +1,19 @@
 package cli
 
 import (
-	"strconv"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 	"github.com/spf13/cobra"
 )
 
 func CmdCreateOffer() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "create-offer [class-id] [nft-id] [price] [expiration]",
+		Use:   "create-offer [class-id] [nft-id] [price] [expiration] (--auto-match)",
 		Short: "Create a new offer",
 		// todo add example
 		Args: cobra.ExactArgs(4),
@@ -23,7 +23,7 @@ func CmdCreateOffer() *cobra.Command {
 			indexNftId := args[1]
 
 			// Get value arguments
-			argPrice, err := strconv.ParseUint(args[2], 10, 64)
+			argPrice, err := sdk.ParseCoinNormalized(args[2])
 			if err != nil {
 				return err
 			}
@@ -32,6 +32,11 @@ func CmdCreateOffer() *cobra.Command {
 				return nil
 			}
 
+			flagAutoMatch, err := cmd.Flags().GetBool("auto-match")
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
@@ -43,6 +48,7 @@ func CmdCreateOffer() *cobra.Command {
 				indexNftId,
 				argPrice,
 				argExpiration,
+				flagAutoMatch,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -52,6 +58,7 @@ func CmdCreateOffer() *cobra.Command {
 	}
 
 	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Bool("auto-match", false, "Buy from the cheapest matching listing right away instead of offering")
 
 	return cmd
 }
@@ -66,7 +73,7 @@ func CmdUpdateOffer() *cobra.Command {
 			indexClassId := args[0]
 			indexNftId := args[1]
 			// Get value arguments
-			argPrice, err := strconv.ParseUint(args[2], 10, 64)
+			argPrice, err := sdk.ParseCoinNormalized(args[2])
 			if err != nil {
 				return err
 			}
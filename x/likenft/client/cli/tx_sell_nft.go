@@ -6,6 +6,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 	"github.com/spf13/cobra"
 )
@@ -14,14 +15,14 @@ var _ = strconv.Itoa(0)
 
 func CmdSellNFT() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "sell-nft [class-id] [nft-id] [buyer] [price] (--full-pay-to-royalty)",
+		Use:   "sell-nft [class-id] [nft-id] [buyer] [price] (--full-pay-to-royalty) (--class-offer)",
 		Short: "Broadcast message SellNFT",
 		Args:  cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			argClassId := args[0]
 			argNftId := args[1]
 			argBuyer := args[2]
-			argPrice, err := strconv.ParseUint(args[3], 10, 64)
+			argPrice, err := sdk.ParseCoinNormalized(args[3])
 			if err != nil {
 				return err
 			}
@@ -29,6 +30,10 @@ func CmdSellNFT() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			flagClassOffer, err := cmd.Flags().GetBool("class-offer")
+			if err != nil {
+				return err
+			}
 
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -42,6 +47,7 @@ func CmdSellNFT() *cobra.Command {
 				argBuyer,
 				argPrice,
 				flagFullPayToRoyalty,
+				flagClassOffer,
 			)
 			if err := msg.ValidateBasic(); err != nil {
 				return err
@@ -52,6 +58,7 @@ func CmdSellNFT() *cobra.Command {
 
 	flags.AddTxFlagsToCmd(cmd)
 	cmd.Flags().Bool("full-pay-to-royalty", false, "Pay full price to royalty")
+	cmd.Flags().Bool("class-offer", false, "Fill the class offer of the buyer instead of the offer on the NFT")
 
 	return cmd
 }
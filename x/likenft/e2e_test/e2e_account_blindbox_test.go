@@ -97,7 +97,10 @@ func TestEndToEndAccountBlindBox(t *testing.T) {
 				{
 					"start_time": "2022-01-01T00:00:00Z",
 					"allowed_addresses": [],
-					"mint_price": 1000000000
+					"mint_price": {
+						"denom": "stake",
+						"amount": "1000000000"
+					}
 				}
 			],
 			"reveal_time": "2050-01-01T00:00:00Z"
@@ -331,7 +334,7 @@ func TestEndToEndAccountBlindBox(t *testing.T) {
 				{
 					StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 					AllowedAddresses: nil,
-					MintPrice:        1000000000,
+					MintPrice:        sdk.NewInt64Coin(cfg.BondDenom, 1000000000),
 				},
 			},
 			RevealTime: time.Date(2050, 1, 1, 0, 0, 0, 0, time.UTC),
@@ -156,7 +156,7 @@ func TestEndToEndListing(t *testing.T) {
 	out, err = clitestutil.ExecTestCLICmd(
 		ctx,
 		cli.CmdCreateListing(),
-		append([]string{classId, nftId, fmt.Sprintf("%d", price), expiration.Format(time.RFC3339Nano)}, append(txArgs, "--full-pay-to-royalty")...),
+		append([]string{classId, nftId, fmt.Sprintf("%d%s", price, cfg.BondDenom), expiration.Format(time.RFC3339Nano)}, append(txArgs, "--full-pay-to-royalty")...),
 	)
 	require.NoError(t, err)
 
@@ -185,7 +185,7 @@ func TestEndToEndListing(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddress.String(),
-		Price:            uint64(price),
+		Price:            sdk.NewInt64Coin(cfg.BondDenom, int64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	}, listingRes.Listings[0])
@@ -198,7 +198,7 @@ func TestEndToEndListing(t *testing.T) {
 	out, err = clitestutil.ExecTestCLICmd(
 		ctx,
 		cli.CmdUpdateListing(),
-		append([]string{classId, nftId, fmt.Sprintf("%d", newPrice), newExpiration.Format(time.RFC3339Nano)}, txArgs...),
+		append([]string{classId, nftId, fmt.Sprintf("%d%s", newPrice, cfg.BondDenom), newExpiration.Format(time.RFC3339Nano)}, txArgs...),
 	)
 	require.NoError(t, err)
 
@@ -226,7 +226,7 @@ func TestEndToEndListing(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddress.String(),
-		Price:            uint64(newPrice),
+		Price:            sdk.NewInt64Coin(cfg.BondDenom, int64(newPrice)),
 		Expiration:       newExpiration,
 		FullPayToRoyalty: newFullPayToRoyalty,
 	}, listingRes.Listings[0])
@@ -235,7 +235,7 @@ func TestEndToEndListing(t *testing.T) {
 	out, err = clitestutil.ExecTestCLICmd(
 		ctx,
 		cli.CmdBuyNFT(),
-		append([]string{classId, nftId, userAddress.String(), fmt.Sprintf("%d", newPrice)}, user2TxArgs...),
+		append([]string{classId, nftId, userAddress.String(), fmt.Sprintf("%d%s", newPrice, cfg.BondDenom)}, user2TxArgs...),
 	)
 	require.NoError(t, err)
 
@@ -249,7 +249,7 @@ func TestEndToEndListing(t *testing.T) {
 		NftId:   nftId,
 		Seller:  userAddress.String(),
 		Buyer:   user2Address,
-		Price:   uint64(newPrice),
+		Price:   sdk.NewInt64Coin(cfg.BondDenom, int64(newPrice)),
 	}, actualBuyEvent)
 
 	// Query owner
@@ -154,7 +154,7 @@ func TestEndToEndOffer(t *testing.T) {
 	out, err = clitestutil.ExecTestCLICmd(
 		ctx,
 		cli.CmdCreateOffer(),
-		append([]string{classId, nftId, fmt.Sprintf("%d", price), expiration.Format(time.RFC3339Nano)}, user2TxArgs...),
+		append([]string{classId, nftId, fmt.Sprintf("%d%s", price, cfg.BondDenom), expiration.Format(time.RFC3339Nano)}, user2TxArgs...),
 	)
 	require.NoError(t, err)
 
@@ -183,7 +183,7 @@ func TestEndToEndOffer(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      user2Address,
-		Price:      uint64(price),
+		Price:      sdk.NewInt64Coin(cfg.BondDenom, int64(price)),
 		Expiration: expiration,
 	}, offerRes.Offers[0])
 
@@ -194,7 +194,7 @@ func TestEndToEndOffer(t *testing.T) {
 	out, err = clitestutil.ExecTestCLICmd(
 		ctx,
 		cli.CmdUpdateOffer(),
-		append([]string{classId, nftId, fmt.Sprintf("%d", newPrice), newExpiration.Format(time.RFC3339Nano)}, user2TxArgs...),
+		append([]string{classId, nftId, fmt.Sprintf("%d%s", newPrice, cfg.BondDenom), newExpiration.Format(time.RFC3339Nano)}, user2TxArgs...),
 	)
 	require.NoError(t, err)
 
@@ -223,7 +223,7 @@ func TestEndToEndOffer(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      user2Address,
-		Price:      uint64(newPrice),
+		Price:      sdk.NewInt64Coin(cfg.BondDenom, int64(newPrice)),
 		Expiration: newExpiration,
 	}, offerRes.Offers[0])
 
@@ -231,7 +231,7 @@ func TestEndToEndOffer(t *testing.T) {
 	out, err = clitestutil.ExecTestCLICmd(
 		ctx,
 		cli.CmdSellNFT(),
-		append([]string{classId, nftId, user2Address, fmt.Sprintf("%d", newPrice)}, append(txArgs, "--full-pay-to-royalty")...),
+		append([]string{classId, nftId, user2Address, fmt.Sprintf("%d%s", newPrice, cfg.BondDenom)}, append(txArgs, "--full-pay-to-royalty")...),
 	)
 	require.NoError(t, err)
 
@@ -245,7 +245,7 @@ func TestEndToEndOffer(t *testing.T) {
 		NftId:            nftId,
 		Seller:           userAddress.String(),
 		Buyer:            user2Address,
-		Price:            uint64(newPrice),
+		Price:            sdk.NewInt64Coin(cfg.BondDenom, int64(newPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	}, actualSellEvent)
 }
@@ -1,6 +1,7 @@
 package e2e_test
 
 import (
+	"encoding/json"
 	"strconv"
 	"strings"
 
@@ -284,11 +285,10 @@ ParseEventBuyNFT:
 						actualEvent.Buyer = strings.Trim(attr.Value, "\"")
 					}
 					if attr.Key == "price" {
-						price, err := strconv.ParseUint(strings.Trim(attr.Value, "\""), 10, 64)
+						err := json.Unmarshal([]byte(attr.Value), &actualEvent.Price)
 						if err != nil {
 							panic(err)
 						}
-						actualEvent.Price = price
 					}
 				}
 				break ParseEventBuyNFT
@@ -371,11 +371,10 @@ ParseEventSellNFT:
 						actualEvent.Buyer = strings.Trim(attr.Value, "\"")
 					}
 					if attr.Key == "price" {
-						price, err := strconv.ParseUint(strings.Trim(attr.Value, "\""), 10, 64)
+						err := json.Unmarshal([]byte(attr.Value), &actualEvent.Price)
 						if err != nil {
 							panic(err)
 						}
-						actualEvent.Price = price
 					}
 					if attr.Key == "full_pay_to_royalty" {
 						fullPayToRoyalty, err := strconv.ParseBool(strings.Trim(attr.Value, "\""))
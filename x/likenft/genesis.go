@@ -46,6 +46,23 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, genState types.GenesisState)
 	for _, elem := range genState.RoyaltyConfigByClassList {
 		k.SetRoyaltyConfig(ctx, elem)
 	}
+	k.SetCollectedFees(ctx, genState.CollectedFees)
+	// Set all the auction
+	for _, elem := range genState.AuctionList {
+		k.SetAuction(ctx, elem.ToStoreRecord())
+	}
+	// Set all the auctionEndQueueEntry
+	for _, elem := range genState.AuctionEndQueue {
+		k.SetAuctionEndQueueEntry(ctx, elem)
+	}
+	// Set all the classOffer
+	for _, elem := range genState.ClassOfferList {
+		k.SetClassOffer(ctx, elem.ToStoreRecord())
+	}
+	// Set all the classOfferExpireQueueEntry
+	for _, elem := range genState.ClassOfferExpireQueue {
+		k.SetClassOfferExpireQueueEntry(ctx, elem)
+	}
 	// this line is used by starport scaffolding # genesis/module/init
 	k.SetParams(ctx, genState.Params)
 }
@@ -64,6 +81,11 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	genesis.OfferExpireQueue = k.GetOfferExpireQueue(ctx)
 	genesis.ListingExpireQueue = k.GetListingExpireQueue(ctx)
 	genesis.RoyaltyConfigByClassList = k.GetAllRoyaltyConfig(ctx)
+	genesis.CollectedFees = k.GetCollectedFees(ctx)
+	genesis.AuctionList = types.MapAuctionsToPublicRecords(k.GetAllAuction(ctx))
+	genesis.AuctionEndQueue = k.GetAuctionEndQueue(ctx)
+	genesis.ClassOfferList = types.MapClassOffersToPublicRecords(k.GetAllClassOffer(ctx))
+	genesis.ClassOfferExpireQueue = k.GetClassOfferExpireQueue(ctx)
 	// this line is used by starport scaffolding # genesis/module/export
 
 	return genesis
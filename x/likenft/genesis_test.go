@@ -107,6 +107,49 @@ func TestGenesis(t *testing.T) {
 				ClassId: "1",
 			},
 		},
+		AuctionList: []types.Auction{
+			{
+				ClassId: "0",
+				NftId:   "0",
+				Seller:  accounts[0].String(),
+			},
+			{
+				ClassId:       "1",
+				NftId:         "1",
+				Seller:        accounts[1].String(),
+				HighestBidder: accounts[0].String(),
+			},
+		},
+		AuctionEndQueue: []types.AuctionEndQueueEntry{
+			{
+				EndTime:    nowTime,
+				AuctionKey: []byte("0"),
+			},
+			{
+				EndTime:    nowTime,
+				AuctionKey: []byte("1"),
+			},
+		},
+		ClassOfferList: []types.ClassOffer{
+			{
+				ClassId: "0",
+				Buyer:   accounts[0].String(),
+			},
+			{
+				ClassId: "1",
+				Buyer:   accounts[1].String(),
+			},
+		},
+		ClassOfferExpireQueue: []types.ClassOfferExpireQueueEntry{
+			{
+				ExpireTime:    nowTime,
+				ClassOfferKey: []byte("0"),
+			},
+			{
+				ExpireTime:    nowTime,
+				ClassOfferKey: []byte("1"),
+			},
+		},
 		// this line is used by starport scaffolding # genesis/test/state
 	}
 
@@ -129,5 +172,9 @@ func TestGenesis(t *testing.T) {
 	require.ElementsMatch(t, genesisState.OfferExpireQueue, got.OfferExpireQueue)
 	require.ElementsMatch(t, genesisState.ListingExpireQueue, got.ListingExpireQueue)
 	require.ElementsMatch(t, genesisState.RoyaltyConfigByClassList, got.RoyaltyConfigByClassList)
+	require.ElementsMatch(t, genesisState.AuctionList, got.AuctionList)
+	require.ElementsMatch(t, genesisState.AuctionEndQueue, got.AuctionEndQueue)
+	require.ElementsMatch(t, genesisState.ClassOfferList, got.ClassOfferList)
+	require.ElementsMatch(t, genesisState.ClassOfferExpireQueue, got.ClassOfferExpireQueue)
 	// this line is used by starport scaffolding # genesis/test/assert
 }
@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// SetAuction set a specific auction in the store from its index
+func (k Keeper) SetAuction(ctx sdk.Context, auction types.AuctionStoreRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	b := k.cdc.MustMarshal(&auction)
+	store.Set(types.AuctionKey(
+		auction.ClassId,
+		auction.NftId,
+	), b)
+}
+
+// GetAuction returns an auction from its index
+func (k Keeper) GetAuction(
+	ctx sdk.Context,
+	classId string,
+	nftId string,
+
+) (val types.AuctionStoreRecord, found bool) {
+	return k.GetAuctionByKeyBytes(ctx, types.AuctionKey(
+		classId,
+		nftId,
+	))
+}
+
+func (k Keeper) GetAuctionByKeyBytes(
+	ctx sdk.Context,
+	key []byte,
+) (val types.AuctionStoreRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	var storeRecord types.AuctionStoreRecord
+	k.cdc.MustUnmarshal(b, &storeRecord)
+	return storeRecord, true
+}
+
+func (k Keeper) GetAuctionsByClass(
+	ctx sdk.Context,
+	classId string,
+) (list []types.AuctionStoreRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.AuctionsByClassKey(classId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionStoreRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveAuction removes an auction from the store
+func (k Keeper) RemoveAuction(
+	ctx sdk.Context,
+	classId string,
+	nftId string,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	store.Delete(types.AuctionKey(
+		classId,
+		nftId,
+	))
+}
+
+// GetAllAuction returns all auction
+func (k Keeper) GetAllAuction(ctx sdk.Context) (list []types.AuctionStoreRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionStoreRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
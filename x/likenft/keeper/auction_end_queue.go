@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// SetAuctionEndQueueEntry set a specific auctionEndQueueEntry in the store from its index
+func (k Keeper) SetAuctionEndQueueEntry(ctx sdk.Context, auctionEndQueueEntry types.AuctionEndQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndQueueKeyPrefix))
+	b := k.cdc.MustMarshal(&auctionEndQueueEntry)
+	store.Set(types.AuctionEndQueueKey(
+		auctionEndQueueEntry.EndTime,
+		auctionEndQueueEntry.AuctionKey,
+	), b)
+}
+
+// GetAuctionEndQueueEntry returns an auctionEndQueueEntry from its index
+func (k Keeper) GetAuctionEndQueueEntry(
+	ctx sdk.Context,
+	endTime time.Time,
+	auctionKey []byte,
+) (val types.AuctionEndQueueEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndQueueKeyPrefix))
+
+	b := store.Get(types.AuctionEndQueueKey(
+		endTime,
+		auctionKey,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveAuctionEndQueueEntry removes an auctionEndQueueEntry from the store
+func (k Keeper) RemoveAuctionEndQueueEntry(
+	ctx sdk.Context,
+	endTime time.Time,
+	auctionKey []byte,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndQueueKeyPrefix))
+	store.Delete(types.AuctionEndQueueKey(
+		endTime,
+		auctionKey,
+	))
+}
+
+func (k Keeper) AuctionEndQueueByTimeIterator(ctx sdk.Context, endTime time.Time) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndQueueKeyPrefix))
+	iterator := store.Iterator(types.AuctionEndByTimeKey(time.Time{}), types.AuctionEndByTimeKey(endTime))
+	return iterator
+}
+
+func (k Keeper) IterateAuctionEndQueueByTime(ctx sdk.Context, endTime time.Time, cb func(val types.AuctionEndQueueEntry) (stop bool)) {
+	iterator := k.AuctionEndQueueByTimeIterator(ctx, endTime)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionEndQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		if cb(val) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetAuctionEndQueueByTime(ctx sdk.Context, endTime time.Time) (list []types.AuctionEndQueueEntry) {
+	k.IterateAuctionEndQueueByTime(ctx, endTime, func(val types.AuctionEndQueueEntry) bool {
+		list = append(list, val)
+		return false
+	})
+	return
+}
+
+func (k Keeper) AuctionEndQueueIterator(ctx sdk.Context) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.AuctionEndQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	return iterator
+}
+
+func (k Keeper) IterateAuctionEndQueue(ctx sdk.Context, cb func(val types.AuctionEndQueueEntry) (stop bool)) {
+	iterator := k.AuctionEndQueueIterator(ctx)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.AuctionEndQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		if cb(val) {
+			break
+		}
+	}
+}
+
+// GetAuctionEndQueue returns all auctionEndQueueEntry
+func (k Keeper) GetAuctionEndQueue(ctx sdk.Context) (list []types.AuctionEndQueueEntry) {
+	k.IterateAuctionEndQueue(ctx, func(val types.AuctionEndQueueEntry) (stop bool) {
+		list = append(list, val)
+		return false
+	})
+	return
+}
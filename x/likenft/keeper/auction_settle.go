@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// SettleAuction closes an ended auction. The escrowed NFT goes to the highest bidder and the escrowed bid is split
+// between the royalty stakeholders and the seller. Without any bid the NFT is returned to the seller.
+func (k Keeper) SettleAuction(ctx sdk.Context, auction types.AuctionStoreRecord) error {
+	// Check auction is actually ended
+	if !auction.EndTime.Before(ctx.BlockTime()) {
+		return types.ErrFailedToSettleAuction.Wrap("Auction is not ended on record")
+	}
+
+	if !auction.HasBid() {
+		return k.RefundAuction(ctx, auction)
+	}
+
+	// all amounts are settled in the denom of the bid
+	denom := auction.HighestBid.Denom
+	price := auction.HighestBid.Amount.Uint64()
+	// calculate royalty
+	royaltyConfig, found := k.GetRoyaltyConfig(ctx, auction.ClassId)
+	var royaltyAmount uint64
+	if found {
+		_royaltyAmount, allocations, err := k.ComputeRoyaltyAllocation(ctx, price, auction.FullPayToRoyalty, royaltyConfig)
+		if err != nil {
+			return err
+		}
+		royaltyAmount = _royaltyAmount
+		for _, allocation := range allocations {
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(allocation.Amount)))
+			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, allocation.Account, coins)
+			if err != nil {
+				return types.ErrFailedToSettleAuction.Wrapf(err.Error())
+			}
+		}
+	}
+	// pay seller
+	netAmount := price - royaltyAmount
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(netAmount)))
+	err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.Seller, netAmountCoins)
+	if err != nil {
+		return types.ErrFailedToSettleAuction.Wrapf(err.Error())
+	}
+	// sanity check
+	if royaltyAmount+netAmount != price {
+		return types.ErrFailedToSettleAuction.Wrapf("Price split calculation error")
+	}
+	// transfer nft to winner
+	err = k.nftKeeper.Transfer(ctx, auction.ClassId, auction.NftId, auction.HighestBidder)
+	if err != nil {
+		return types.ErrFailedToSettleAuction.Wrapf(err.Error())
+	}
+
+	k.RemoveAuction(ctx, auction.ClassId, auction.NftId)
+
+	return nil
+}
+
+// RefundAuction closes an auction without a sale, returning the escrowed NFT to the seller and the escrowed bid, if
+// any, to the highest bidder
+func (k Keeper) RefundAuction(ctx sdk.Context, auction types.AuctionStoreRecord) error {
+	if auction.HasBid() {
+		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.HighestBidder, sdk.NewCoins(auction.HighestBid))
+		if err != nil {
+			return types.ErrFailedToSettleAuction.Wrapf(err.Error())
+		}
+	}
+	err := k.nftKeeper.Transfer(ctx, auction.ClassId, auction.NftId, auction.Seller)
+	if err != nil {
+		return types.ErrFailedToSettleAuction.Wrapf(err.Error())
+	}
+
+	k.RemoveAuction(ctx, auction.ClassId, auction.NftId)
+
+	return nil
+}
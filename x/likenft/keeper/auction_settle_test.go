@@ -0,0 +1,125 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSettleAuctionRoyalty(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	_, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 4, 1, 0, 0, 1, 0, time.UTC))
+
+	// Data
+	creatorAddressBytes := []byte{1, 1, 1, 1, 0, 0, 0, 0}
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	winnerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(200000)
+	royaltyBasisPoints := uint64(234)
+
+	auction := types.AuctionStoreRecord{
+		ClassId:       classId,
+		NftId:         nftId,
+		Seller:        sellerAddressBytes,
+		ReservePrice:  sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement:  sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:       time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+		HighestBidder: winnerAddressBytes,
+		HighestBid:    sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+	}
+	k.SetAuction(ctx, auction)
+
+	// Seed royalty config
+	k.SetRoyaltyConfig(ctx, types.RoyaltyConfigByClass{
+		ClassId: classId,
+		RoyaltyConfig: types.RoyaltyConfig{
+			RateBasisPoints: royaltyBasisPoints,
+			Stakeholders: []types.RoyaltyStakeholder{
+				{
+					Account: creatorAddressBytes,
+					Weight:  uint64(1),
+				},
+			},
+		},
+	})
+	royaltyAmount := uint64(math.Floor(float64(price) / 10000 * float64(royaltyBasisPoints)))
+	royaltyAmountCoins := sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(royaltyAmount)))
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price-royaltyAmount)))
+
+	// Mock
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(creatorAddressBytes), royaltyAmountCoins).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(sellerAddressBytes), netAmountCoins).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, sdk.AccAddress(winnerAddressBytes)).Return(nil)
+
+	// Call
+	err := k.SettleAuction(ctx, auction)
+	require.NoError(t, err)
+
+	// Check state
+	_, found := k.GetAuction(ctx, classId, nftId)
+	require.False(t, found)
+
+	ctrl.Finish()
+}
+
+func TestSettleAuctionNotEnded(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	_, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	// Data
+	auction := types.AuctionStoreRecord{
+		ClassId:       "likenft1abcdef",
+		NftId:         "nft1",
+		Seller:        []byte{0, 1, 0, 1, 0, 1, 0, 1},
+		ReservePrice:  sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement:  sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:       time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+		HighestBidder: []byte{1, 0, 1, 0, 1, 0, 1, 0},
+		HighestBid:    sdk.NewInt64Coin("nanolike", 200000),
+	}
+	k.SetAuction(ctx, auction)
+
+	// Call
+	err := k.SettleAuction(ctx, auction)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), types.ErrFailedToSettleAuction.Error())
+
+	// Check state
+	_, found := k.GetAuction(ctx, auction.ClassId, auction.NftId)
+	require.True(t, found)
+
+	ctrl.Finish()
+}
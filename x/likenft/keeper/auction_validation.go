@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k Keeper) validateAuctionEndTime(ctx sdk.Context, endTime time.Time) error {
+	if endTime.Before(ctx.BlockTime()) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("End time is in the past")
+	}
+
+	// Auctions are bounded by the same duration as listings
+	if endTime.After(ctx.BlockTime().Add(k.MaxListingDuration(ctx))) {
+		return sdkerrors.ErrInvalidRequest.Wrapf("End time is too far in the future. Max listing duration is %s.", k.MaxListingDurationText(ctx))
+	}
+
+	return nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// FindBestOfferForListing returns the highest unexpired offer on the listed NFT or class offer on its class that meets
+// the current price of the listing, excluding offers from the seller. A class offer is returned as an offer on the
+// listed NFT at the price per NFT, with classOffer set. Offers on the NFT are preferred over class offers at the same
+// price.
+func (k Keeper) FindBestOfferForListing(ctx sdk.Context, listing types.ListingStoreRecord) (best types.OfferStoreRecord, classOffer bool, found bool) {
+	askPrice := listing.CurrentPrice(ctx.BlockTime())
+	isMatch := func(buyer sdk.AccAddress, price sdk.Coin, expiration time.Time) bool {
+		return !buyer.Equals(listing.Seller) &&
+			!expiration.Before(ctx.BlockTime()) &&
+			price.Denom == askPrice.Denom &&
+			price.Amount.GTE(askPrice.Amount)
+	}
+	k.IterateOffersByNFT(ctx, listing.ClassId, listing.NftId, func(offer types.OfferStoreRecord) {
+		if !isMatch(offer.Buyer, offer.Price, offer.Expiration) {
+			return
+		}
+		if !found || offer.Price.Amount.GT(best.Price.Amount) {
+			best = offer
+			found = true
+		}
+	})
+	for _, offer := range k.GetClassOffersByClass(ctx, listing.ClassId) {
+		if offer.Quantity == 0 || !isMatch(offer.Buyer, offer.Price, offer.Expiration) {
+			continue
+		}
+		if !found || offer.Price.Amount.GT(best.Price.Amount) {
+			best = types.OfferStoreRecord{
+				ClassId:    offer.ClassId,
+				NftId:      listing.NftId,
+				Buyer:      offer.Buyer,
+				Price:      offer.Price,
+				Expiration: offer.Expiration,
+			}
+			classOffer = true
+			found = true
+		}
+	}
+	return best, classOffer, found
+}
+
+// FindBestListingForOffer returns the cheapest unexpired listing by the current owner of the NFT whose current price
+// is covered by the offer, excluding listings by the buyer
+func (k Keeper) FindBestListingForOffer(ctx sdk.Context, offer types.OfferStoreRecord) (best types.ListingStoreRecord, found bool) {
+	owner := k.nftKeeper.GetOwner(ctx, offer.ClassId, offer.NftId)
+	var bestPrice sdk.Coin
+	k.IterateListingsByNFT(ctx, offer.ClassId, offer.NftId, func(listing types.ListingStoreRecord) {
+		if listing.Seller.Equals(offer.Buyer) ||
+			!listing.Seller.Equals(owner) ||
+			listing.Expiration.Before(ctx.BlockTime()) {
+			return
+		}
+		askPrice := listing.CurrentPrice(ctx.BlockTime())
+		if askPrice.Denom != offer.Price.Denom || askPrice.Amount.GT(offer.Price.Amount) {
+			return
+		}
+		if !found || askPrice.Amount.LT(bestPrice.Amount) {
+			best = listing
+			bestPrice = askPrice
+			found = true
+		}
+	})
+	return best, found
+}
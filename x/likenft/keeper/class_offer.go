@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// SetClassOffer set a specific classOffer in the store from its index
+func (k Keeper) SetClassOffer(ctx sdk.Context, classOffer types.ClassOfferStoreRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferKeyPrefix))
+	b := k.cdc.MustMarshal(&classOffer)
+	store.Set(types.ClassOfferKey(
+		classOffer.ClassId,
+		classOffer.Buyer,
+	), b)
+}
+
+// GetClassOffer returns a classOffer from its index
+func (k Keeper) GetClassOffer(
+	ctx sdk.Context,
+	classId string,
+	buyer sdk.AccAddress,
+
+) (val types.ClassOfferStoreRecord, found bool) {
+	return k.GetClassOfferByKeyBytes(ctx, types.ClassOfferKey(classId, buyer))
+}
+
+func (k Keeper) GetClassOfferByKeyBytes(
+	ctx sdk.Context,
+	key []byte,
+) (val types.ClassOfferStoreRecord, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferKeyPrefix))
+
+	b := store.Get(key)
+	if b == nil {
+		return val, false
+	}
+
+	var storeRecord types.ClassOfferStoreRecord
+	k.cdc.MustUnmarshal(b, &storeRecord)
+	return storeRecord, true
+}
+
+func (k Keeper) GetClassOffersByClass(
+	ctx sdk.Context,
+	classId string,
+) (list []types.ClassOfferStoreRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, types.ClassOffersByClassKey(classId))
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ClassOfferStoreRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
+
+// RemoveClassOffer removes a classOffer from the store
+func (k Keeper) RemoveClassOffer(
+	ctx sdk.Context,
+	classId string,
+	buyer sdk.AccAddress,
+
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferKeyPrefix))
+	store.Delete(types.ClassOfferKey(
+		classId,
+		buyer,
+	))
+}
+
+// GetAllClassOffer returns all classOffer
+func (k Keeper) GetAllClassOffer(ctx sdk.Context) (list []types.ClassOfferStoreRecord) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ClassOfferStoreRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+		list = append(list, val)
+	}
+
+	return
+}
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func (k Keeper) ExpireClassOffer(ctx sdk.Context, classOffer types.ClassOfferStoreRecord) error {
+	// Check class offer is actually expired
+	if !classOffer.Expiration.Before(ctx.BlockTime()) {
+		return types.ErrFailedToExpireClassOffer.Wrap("Class offer is not expired on record")
+	}
+
+	// Refund deposit of the unfilled quantity if needed
+	deposit := classOffer.Deposit()
+	if deposit.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, classOffer.Buyer, sdk.NewCoins(deposit)); err != nil {
+			return types.ErrFailedToExpireClassOffer.Wrapf(err.Error())
+		}
+	}
+
+	// Delete class offer
+	k.RemoveClassOffer(ctx, classOffer.ClassId, classOffer.Buyer)
+
+	return nil
+}
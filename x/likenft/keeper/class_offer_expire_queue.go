@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// SetClassOfferExpireQueueEntry set a specific classOfferExpireQueueEntry in the store from its index
+func (k Keeper) SetClassOfferExpireQueueEntry(ctx sdk.Context, classOfferExpireQueueEntry types.ClassOfferExpireQueueEntry) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferExpireQueueKeyPrefix))
+	b := k.cdc.MustMarshal(&classOfferExpireQueueEntry)
+	store.Set(types.ClassOfferExpireQueueKey(
+		classOfferExpireQueueEntry.ExpireTime,
+		classOfferExpireQueueEntry.ClassOfferKey,
+	), b)
+}
+
+// GetClassOfferExpireQueueEntry returns a classOfferExpireQueueEntry from its index
+func (k Keeper) GetClassOfferExpireQueueEntry(
+	ctx sdk.Context,
+	expireTime time.Time,
+	classOfferKey []byte,
+) (val types.ClassOfferExpireQueueEntry, found bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferExpireQueueKeyPrefix))
+
+	b := store.Get(types.ClassOfferExpireQueueKey(
+		expireTime,
+		classOfferKey,
+	))
+	if b == nil {
+		return val, false
+	}
+
+	k.cdc.MustUnmarshal(b, &val)
+	return val, true
+}
+
+// RemoveClassOfferExpireQueueEntry removes a classOfferExpireQueueEntry from the store
+func (k Keeper) RemoveClassOfferExpireQueueEntry(
+	ctx sdk.Context,
+	expireTime time.Time,
+	classOfferKey []byte,
+) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferExpireQueueKeyPrefix))
+	store.Delete(types.ClassOfferExpireQueueKey(
+		expireTime,
+		classOfferKey,
+	))
+}
+
+func (k Keeper) UpdateClassOfferExpireQueueEntry(ctx sdk.Context, originalExpireTime time.Time, classOfferKey []byte, updatedExpireTime time.Time) {
+	k.RemoveClassOfferExpireQueueEntry(ctx, originalExpireTime, classOfferKey)
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    updatedExpireTime,
+		ClassOfferKey: classOfferKey,
+	})
+}
+
+func (k Keeper) ClassOfferExpireQueueByTimeIterator(ctx sdk.Context, expireTime time.Time) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferExpireQueueKeyPrefix))
+	iterator := store.Iterator(types.ClassOfferExpireByTimeKey(time.Time{}), types.ClassOfferExpireByTimeKey(expireTime))
+	return iterator
+}
+
+func (k Keeper) IterateClassOfferExpireQueueByTime(ctx sdk.Context, endTime time.Time, cb func(val types.ClassOfferExpireQueueEntry) (stop bool)) {
+	iterator := k.ClassOfferExpireQueueByTimeIterator(ctx, endTime)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ClassOfferExpireQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		if cb(val) {
+			break
+		}
+	}
+}
+
+func (k Keeper) GetClassOfferExpireQueueByTime(ctx sdk.Context, endTime time.Time) (list []types.ClassOfferExpireQueueEntry) {
+	k.IterateClassOfferExpireQueueByTime(ctx, endTime, func(val types.ClassOfferExpireQueueEntry) bool {
+		list = append(list, val)
+		return false
+	})
+	return
+}
+
+func (k Keeper) ClassOfferExpireQueueIterator(ctx sdk.Context) sdk.Iterator {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ClassOfferExpireQueueKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	return iterator
+}
+
+func (k Keeper) IterateClassOfferExpireQueue(ctx sdk.Context, cb func(val types.ClassOfferExpireQueueEntry) (stop bool)) {
+	iterator := k.ClassOfferExpireQueueIterator(ctx)
+
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var val types.ClassOfferExpireQueueEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &val)
+
+		if cb(val) {
+			break
+		}
+	}
+}
+
+// GetClassOfferExpireQueue returns all classOfferExpireQueueEntry
+func (k Keeper) GetClassOfferExpireQueue(ctx sdk.Context) (list []types.ClassOfferExpireQueueEntry) {
+	k.IterateClassOfferExpireQueue(ctx, func(val types.ClassOfferExpireQueueEntry) (stop bool) {
+		list = append(list, val)
+		return false
+	})
+	return
+}
@@ -0,0 +1,98 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	keepertest "github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+)
+
+func TestExpireClassOfferNormalRefund(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	keeper, ctx := keepertest.LikenftKeeperOverrideDependedKeepers(t, keepertest.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx = ctx.WithBlockHeader(tmproto.Header{
+		Time: time.Date(2022, 2, 1, 0, 0, 0, 1, time.UTC),
+	})
+
+	// seed class offer to be expired
+	classId := "likenft11"
+	buyer := sdk.AccAddress([]byte{0, 1, 0, 1, 0, 1, 0, 1})
+	expireTime := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	classOffer := types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      buyer,
+		Price:      sdk.NewInt64Coin("nanolike", 123456),
+		Quantity:   2,
+		Expiration: expireTime,
+	}
+	keeper.SetClassOffer(ctx, classOffer)
+
+	// Mock
+	coins := sdk.NewCoins(sdk.NewInt64Coin("nanolike", 246912))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, buyer, coins).Return(nil)
+
+	// Call
+	err := keeper.ExpireClassOffer(ctx, classOffer)
+	require.NoError(t, err)
+
+	// Check state
+	// Expect class offer deleted
+	_, found := keeper.GetClassOffer(ctx, classId, buyer)
+	require.False(t, found)
+
+	ctrl.Finish()
+}
+
+func TestExpireClassOfferNotExpired(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	keeper, ctx := keepertest.LikenftKeeperOverrideDependedKeepers(t, keepertest.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx = ctx.WithBlockHeader(tmproto.Header{
+		Time: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	classId := "likenft11"
+	buyer := sdk.AccAddress([]byte{0, 1, 0, 1, 0, 1, 0, 1})
+	classOffer := types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      buyer,
+		Price:      sdk.NewInt64Coin("nanolike", 123456),
+		Quantity:   2,
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	keeper.SetClassOffer(ctx, classOffer)
+
+	// Call
+	err := keeper.ExpireClassOffer(ctx, classOffer)
+	require.ErrorIs(t, err, types.ErrFailedToExpireClassOffer)
+
+	// Check state
+	// Expect class offer kept
+	_, found := keeper.GetClassOffer(ctx, classId, buyer)
+	require.True(t, found)
+
+	ctrl.Finish()
+}
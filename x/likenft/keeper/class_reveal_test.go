@@ -75,7 +75,7 @@ func TestRevealFeature(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        0,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -274,7 +274,7 @@ func TestRevealNormalMintToOwner(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        uint64(0),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 			},
@@ -376,7 +376,7 @@ func TestRevealNormalNoMintToOwner(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        uint64(0),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 			},
@@ -577,7 +577,7 @@ func TestRevealFailedToMint(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        uint64(0),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 			},
@@ -655,7 +655,7 @@ func TestRevealContentMismatch(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        uint64(0),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 			},
@@ -744,7 +744,7 @@ func TestRevealFailToUpdateToken(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 01, 01, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        uint64(0),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 			},
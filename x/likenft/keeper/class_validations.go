@@ -49,13 +49,22 @@ func (k Keeper) validateClassParentRelation(ctx sdk.Context, classId string, par
 	return nil
 }
 
-func (k msgServer) sanitizeBlindBoxConfig(blindBoxConfig *types.BlindBoxConfig) (*types.BlindBoxConfig, error) {
+func (k msgServer) sanitizeBlindBoxConfig(ctx sdk.Context, blindBoxConfig *types.BlindBoxConfig) (*types.BlindBoxConfig, error) {
 	if blindBoxConfig == nil {
 		return nil, nil
 	}
 	if len(blindBoxConfig.MintPeriods) <= 0 {
 		return nil, types.ErrInvalidNftClassConfig.Wrapf("Mint period cannot be empty")
 	}
+	// Ensure all mint prices are valid before sorting by them
+	for _, mintPeriod := range blindBoxConfig.MintPeriods {
+		if err := types.ValidatePrice(mintPeriod.MintPrice); err != nil {
+			return nil, types.ErrInvalidPrice.Wrapf("One of the mint periods' price is invalid: %s", err.Error())
+		}
+		if err := k.ValidatePriceDenom(ctx, mintPeriod.MintPrice.Denom); err != nil {
+			return nil, err
+		}
+	}
 	// Sort the mint period by start time
 	blindBoxConfig.MintPeriods = SortMintPeriod(blindBoxConfig.MintPeriods, true)
 	for _, mintPeriod := range blindBoxConfig.MintPeriods {
@@ -75,7 +84,7 @@ func (k msgServer) sanitizeBlindBoxConfig(blindBoxConfig *types.BlindBoxConfig)
 
 func (k msgServer) sanitizeClassConfig(ctx sdk.Context, classConfig types.ClassConfig, blindBoxContentCount uint64) (*types.ClassConfig, error) {
 	// Ensure mint periods and reveal time are set when blind box mode is enabled
-	cleanBlindBoxConfig, err := k.sanitizeBlindBoxConfig(classConfig.BlindBoxConfig)
+	cleanBlindBoxConfig, err := k.sanitizeBlindBoxConfig(ctx, classConfig.BlindBoxConfig)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// GetCollectedFees returns the total per-byte fees routed to each destination
+func (k Keeper) GetCollectedFees(ctx sdk.Context) (fees likefeegranttypes.CollectedFees) {
+	b := ctx.KVStore(k.storeKey).Get(types.KeyPrefix(types.CollectedFeesKey))
+	if b == nil {
+		return fees
+	}
+	k.cdc.MustUnmarshal(b, &fees)
+	return fees
+}
+
+// SetCollectedFees set the total per-byte fees routed to each destination
+func (k Keeper) SetCollectedFees(ctx sdk.Context, fees likefeegranttypes.CollectedFees) {
+	b := k.cdc.MustMarshal(&fees)
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefix(types.CollectedFeesKey), b)
+}
+
+// AddCollectedFees adds the routed fees to the totals
+func (k Keeper) AddCollectedFees(ctx sdk.Context, fees likefeegranttypes.CollectedFees) {
+	k.SetCollectedFees(ctx, k.GetCollectedFees(ctx).Add(fees))
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) AuctionIndex(c context.Context, req *types.QueryAuctionIndexRequest) (*types.QueryAuctionIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var auctions []types.Auction
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	auctionStore := prefix.NewStore(store, types.KeyPrefix(types.AuctionKeyPrefix))
+
+	pageRes, err := query.Paginate(auctionStore, req.Pagination, func(key []byte, value []byte) error {
+		var storeRecord types.AuctionStoreRecord
+		if err := k.cdc.Unmarshal(value, &storeRecord); err != nil {
+			return err
+		}
+
+		auctions = append(auctions, storeRecord.ToPublicRecord())
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuctionIndexResponse{Auctions: auctions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Auction(c context.Context, req *types.QueryAuctionRequest) (*types.QueryAuctionResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	val, found := k.GetAuction(
+		ctx,
+		req.ClassId,
+		req.NftId,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryAuctionResponse{Auction: val.ToPublicRecord()}, nil
+}
+
+func (k Keeper) AuctionsByClass(goCtx context.Context, req *types.QueryAuctionsByClassRequest) (*types.QueryAuctionsByClassResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var auctions []types.Auction
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	subStore := prefix.NewStore(store, append(types.KeyPrefix(types.AuctionKeyPrefix), types.AuctionsByClassKey(req.ClassId)...))
+
+	pageRes, err := query.Paginate(subStore, req.Pagination, func(key []byte, value []byte) error {
+		var storeRecord types.AuctionStoreRecord
+		if err := k.cdc.Unmarshal(value, &storeRecord); err != nil {
+			return err
+		}
+
+		auctions = append(auctions, storeRecord.ToPublicRecord())
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAuctionsByClassResponse{
+		Auctions:   auctions,
+		Pagination: pageRes,
+	}, nil
+}
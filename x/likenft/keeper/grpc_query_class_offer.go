@@ -0,0 +1,94 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (k Keeper) ClassOfferIndex(c context.Context, req *types.QueryClassOfferIndexRequest) (*types.QueryClassOfferIndexResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var classOffers []types.ClassOffer
+	ctx := sdk.UnwrapSDKContext(c)
+
+	store := ctx.KVStore(k.storeKey)
+	classOfferStore := prefix.NewStore(store, types.KeyPrefix(types.ClassOfferKeyPrefix))
+
+	pageRes, err := query.Paginate(classOfferStore, req.Pagination, func(key []byte, value []byte) error {
+		var storeRecord types.ClassOfferStoreRecord
+		if err := k.cdc.Unmarshal(value, &storeRecord); err != nil {
+			return err
+		}
+
+		classOffers = append(classOffers, storeRecord.ToPublicRecord())
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassOfferIndexResponse{ClassOffers: classOffers, Pagination: pageRes}, nil
+}
+
+func (k Keeper) ClassOffer(c context.Context, req *types.QueryClassOfferRequest) (*types.QueryClassOfferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	buyer, err := sdk.AccAddressFromBech32(req.Buyer)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	val, found := k.GetClassOffer(
+		ctx,
+		req.ClassId,
+		buyer,
+	)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryClassOfferResponse{ClassOffer: val.ToPublicRecord()}, nil
+}
+
+func (k Keeper) ClassOffers(goCtx context.Context, req *types.QueryClassOffersRequest) (*types.QueryClassOffersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var classOffers []types.ClassOffer
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	store := ctx.KVStore(k.storeKey)
+	subStore := prefix.NewStore(store, append(types.KeyPrefix(types.ClassOfferKeyPrefix), types.ClassOffersByClassKey(req.ClassId)...))
+
+	pageRes, err := query.Paginate(subStore, req.Pagination, func(key []byte, value []byte) error {
+		var storeRecord types.ClassOfferStoreRecord
+		if err := k.cdc.Unmarshal(value, &storeRecord); err != nil {
+			return err
+		}
+
+		classOffers = append(classOffers, storeRecord.ToPublicRecord())
+		return nil
+	})
+
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryClassOffersResponse{
+		ClassOffers: classOffers,
+		Pagination:  pageRes,
+	}, nil
+}
@@ -60,7 +60,10 @@ func (k Keeper) Listing(c context.Context, req *types.QueryListingRequest) (*typ
 		return nil, status.Error(codes.NotFound, "not found")
 	}
 
-	return &types.QueryListingResponse{Listing: val.ToPublicRecord()}, nil
+	return &types.QueryListingResponse{
+		Listing:      val.ToPublicRecord(),
+		CurrentPrice: val.CurrentPrice(ctx.BlockTime()),
+	}, nil
 }
 
 func (k Keeper) ListingsByClass(goCtx context.Context, req *types.QueryListingsByClassRequest) (*types.QueryListingsByClassResponse, error) {
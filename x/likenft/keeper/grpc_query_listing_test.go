@@ -36,7 +36,7 @@ func TestListingQuerySingle(t *testing.T) {
 				NftId:   msgs[0].NftId,
 				Seller:  msgs[0].Seller,
 			},
-			response: &types.QueryListingResponse{Listing: msgs[0]},
+			response: &types.QueryListingResponse{Listing: msgs[0], CurrentPrice: msgs[0].Price},
 		},
 		{
 			desc: "Second",
@@ -45,7 +45,7 @@ func TestListingQuerySingle(t *testing.T) {
 				NftId:   msgs[1].NftId,
 				Seller:  msgs[1].Seller,
 			},
-			response: &types.QueryListingResponse{Listing: msgs[1]},
+			response: &types.QueryListingResponse{Listing: msgs[1], CurrentPrice: msgs[1].Price},
 		},
 		{
 			desc: "KeyNotFound",
@@ -27,6 +27,7 @@ type (
 		iscnKeeper     types.IscnKeeper
 		nftKeeper      types.NftKeeper
 		feegrantKeeper ante.FeegrantKeeper
+		distrKeeper    types.DistributionKeeper
 	}
 )
 
@@ -37,6 +38,7 @@ func NewKeeper(
 	ps paramtypes.Subspace,
 
 	accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, iscnKeeper types.IscnKeeper, nftKeeper types.NftKeeper, feegrantKeeper ante.FeegrantKeeper,
+	distrKeeper types.DistributionKeeper,
 ) *Keeper {
 	// set KeyTable if it has not already been set
 	if !ps.HasKeyTable() {
@@ -51,6 +53,7 @@ func NewKeeper(
 		paramstore:    ps,
 		accountKeeper: accountKeeper, bankKeeper: bankKeeper, iscnKeeper: iscnKeeper, nftKeeper: nftKeeper,
 		feegrantKeeper: feegrantKeeper,
+		distrKeeper:    distrKeeper,
 	}
 }
 
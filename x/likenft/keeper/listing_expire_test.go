@@ -67,7 +67,7 @@ func TestListingExpireFeatureNormal(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           seller,
-		Price:            uint64(123456),
+		Price:            sdk.NewInt64Coin("nanolike", 123456),
 		Expiration:       expireTime,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -83,7 +83,7 @@ func TestListingExpireFeatureNormal(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           seller2,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       expireTime2,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -141,7 +141,7 @@ func TestListingExpireFeatureQueueMismatch(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           seller,
-		Price:            uint64(123456),
+		Price:            sdk.NewInt64Coin("nanolike", 123456),
 		Expiration:       expireTime,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
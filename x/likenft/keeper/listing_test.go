@@ -27,7 +27,7 @@ func createNListing(keeper *keeper.Keeper, ctx sdk.Context, nClass int, nNFT int
 					ClassId:          strconv.Itoa(i),
 					NftId:            strconv.Itoa(j),
 					Seller:           accounts[k],
-					Price:            uint64(k),
+					Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(uint64(k))),
 					Expiration:       time.Date(2022, 1, 1+k, 0, 0, 0, 0, time.UTC),
 					FullPayToRoyalty: false,
 				}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"google.golang.org/protobuf/encoding/protowire"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+// Field numbers of the bare uint64 prices before they were converted into coins
+const (
+	legacyOfferPriceField     protowire.Number = 4
+	legacyListingPriceField   protowire.Number = 4
+	legacyClassConfigField    protowire.Number = 3
+	legacyBlindBoxConfigField protowire.Number = 3
+	legacyMintPeriodsField    protowire.Number = 1
+	legacyMintPriceField      protowire.Number = 3
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 converts the prices of the existing offers, listings and mint periods from bare amounts into coins in
+// the price denom
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	denom := m.keeper.PriceDenom(ctx)
+	if err := m.keeper.migrateOfferPrices(ctx, denom); err != nil {
+		return err
+	}
+	if err := m.keeper.migrateListingPrices(ctx, denom); err != nil {
+		return err
+	}
+	return m.keeper.migrateMintPrices(ctx, denom)
+}
+
+func (k Keeper) migrateOfferPrices(ctx sdk.Context, denom string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.OfferKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	keys := [][]byte{}
+	offers := []types.OfferStoreRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		price, err := legacyVarintField(iterator.Value(), legacyOfferPriceField)
+		if err != nil {
+			iterator.Close()
+			return types.ErrFailedToUnmarshalData.Wrapf("%s", err.Error())
+		}
+		var offer types.OfferStoreRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &offer)
+		offer.Price = sdk.NewCoin(denom, sdk.NewIntFromUint64(price))
+		keys = append(keys, iterator.Key())
+		offers = append(offers, offer)
+	}
+	iterator.Close()
+	for i, offer := range offers {
+		store.Set(keys[i], k.cdc.MustMarshal(&offer))
+	}
+	return nil
+}
+
+func (k Keeper) migrateListingPrices(ctx sdk.Context, denom string) error {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefix(types.ListingKeyPrefix))
+	iterator := sdk.KVStorePrefixIterator(store, []byte{})
+	keys := [][]byte{}
+	listings := []types.ListingStoreRecord{}
+	for ; iterator.Valid(); iterator.Next() {
+		price, err := legacyVarintField(iterator.Value(), legacyListingPriceField)
+		if err != nil {
+			iterator.Close()
+			return types.ErrFailedToUnmarshalData.Wrapf("%s", err.Error())
+		}
+		var listing types.ListingStoreRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &listing)
+		listing.Price = sdk.NewCoin(denom, sdk.NewIntFromUint64(price))
+		keys = append(keys, iterator.Key())
+		listings = append(listings, listing)
+	}
+	iterator.Close()
+	for i, listing := range listings {
+		store.Set(keys[i], k.cdc.MustMarshal(&listing))
+	}
+	return nil
+}
+
+func (k Keeper) migrateMintPrices(ctx sdk.Context, denom string) error {
+	// Classes are indexed either by ISCN or by account, collect the IDs from both
+	classIds := []string{}
+	seen := map[string]struct{}{}
+	addClassIds := func(ids []string) {
+		for _, id := range ids {
+			if _, ok := seen[id]; !ok {
+				seen[id] = struct{}{}
+				classIds = append(classIds, id)
+			}
+		}
+	}
+	for _, entry := range k.GetAllClassesByISCN(ctx) {
+		addClassIds(entry.ClassIds)
+	}
+	for _, entry := range k.GetAllClassesByAccount(ctx) {
+		addClassIds(entry.ClassIds)
+	}
+
+	for _, classId := range classIds {
+		class, classData, err := k.GetClass(ctx, classId)
+		if err != nil {
+			return err
+		}
+		blindBoxConfig := classData.Config.BlindBoxConfig
+		if blindBoxConfig == nil || len(blindBoxConfig.MintPeriods) == 0 {
+			continue
+		}
+		prices, err := legacyMintPrices(class.Data.Value)
+		if err != nil {
+			return types.ErrFailedToUnmarshalData.Wrapf("%s", err.Error())
+		}
+		if len(prices) != len(blindBoxConfig.MintPeriods) {
+			return types.ErrFailedToUnmarshalData.Wrapf("Mint period count mismatch in class %s", classId)
+		}
+		for i, price := range prices {
+			blindBoxConfig.MintPeriods[i].MintPrice = sdk.NewCoin(denom, sdk.NewIntFromUint64(price))
+		}
+		classDataInAny, err := cdctypes.NewAnyWithValue(&classData)
+		if err != nil {
+			return types.ErrFailedToMarshalData.Wrapf("%s", err.Error())
+		}
+		class.Data = classDataInAny
+		if err := k.nftKeeper.UpdateClass(ctx, class); err != nil {
+			return types.ErrFailedToUpdateClass.Wrapf("%s", err.Error())
+		}
+	}
+	return nil
+}
+
+// legacyMintPrices extracts the bare mint prices of the mint periods in the encoded class data, in order
+func legacyMintPrices(classData []byte) ([]uint64, error) {
+	classConfig, err := legacyBytesField(classData, legacyClassConfigField)
+	if err != nil {
+		return nil, err
+	}
+	blindBoxConfig, err := legacyBytesField(classConfig, legacyBlindBoxConfigField)
+	if err != nil {
+		return nil, err
+	}
+	prices := []uint64{}
+	err = rangeFields(blindBoxConfig, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != legacyMintPeriodsField || typ != protowire.BytesType {
+			return nil
+		}
+		mintPeriod, n := protowire.ConsumeBytes(value)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		price, err := legacyVarintField(mintPeriod, legacyMintPriceField)
+		if err != nil {
+			return err
+		}
+		prices = append(prices, price)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return prices, nil
+}
+
+// rangeFields calls the callback with the number, wire type and encoded value of each field in the message
+func rangeFields(bz []byte, callback func(num protowire.Number, typ protowire.Type, value []byte) error) error {
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		bz = bz[n:]
+		n = protowire.ConsumeFieldValue(num, typ, bz)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		if err := callback(num, typ, bz[:n]); err != nil {
+			return err
+		}
+		bz = bz[n:]
+	}
+	return nil
+}
+
+// legacyVarintField returns the last value of the varint field in the message, or 0 if the field is absent
+func legacyVarintField(bz []byte, field protowire.Number) (res uint64, err error) {
+	err = rangeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != field || typ != protowire.VarintType {
+			return nil
+		}
+		v, n := protowire.ConsumeVarint(value)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		res = v
+		return nil
+	})
+	return res, err
+}
+
+// legacyBytesField returns the last value of the length-delimited field in the message, or nil if the field is absent
+func legacyBytesField(bz []byte, field protowire.Number) (res []byte, err error) {
+	err = rangeFields(bz, func(num protowire.Number, typ protowire.Type, value []byte) error {
+		if num != field || typ != protowire.BytesType {
+			return nil
+		}
+		v, n := protowire.ConsumeBytes(value)
+		if n < 0 {
+			return protowire.ParseError(n)
+		}
+		res = v
+		return nil
+	})
+	return res, err
+}
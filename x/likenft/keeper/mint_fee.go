@@ -4,15 +4,22 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/likecoin/likecoin-chain/v4/x/likefeegrant"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 )
 
 func (k Keeper) DeductFeePerByte(ctx sdk.Context, msgSender sdk.AccAddress, bytesLength int, msg sdk.Msg) error {
 	feePerByte := k.GetParams(ctx).FeePerByte
 	amount := feePerByte.Amount.MulInt64(int64(bytesLength))
 	fees := sdk.NewCoins(sdk.NewCoin(feePerByte.Denom, amount.Ceil().RoundInt()))
-	return likefeegrant.DeductFeePerByte(
+	routed, err := likefeegrant.DeductFeePerByte(
 		ctx,
-		k.accountKeeper, k.bankKeeper, k.feegrantKeeper,
+		k.accountKeeper, k.bankKeeper, k.feegrantKeeper, k.distrKeeper,
+		types.ModuleName, k.FeeRouting(ctx),
 		msgSender, fees, msg,
 	)
+	if err != nil {
+		return err
+	}
+	k.AddCollectedFees(ctx, routed)
+	return nil
 }
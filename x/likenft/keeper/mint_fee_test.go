@@ -8,6 +8,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/golang/mock/gomock"
 	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 	"github.com/stretchr/testify/require"
@@ -170,3 +171,63 @@ func TestMintFeeAccNotFound(t *testing.T) {
 
 	ctrl.Finish()
 }
+
+func TestMintFeeRouting(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	distrKeeper := testutil.NewMockDistributionKeeper(ctrl)
+	_, goCtx, keeper := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+		DistrKeeper:   distrKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	treasuryAddress := sdk.AccAddress([]byte{1, 0, 1, 0, 1, 0, 1, 0})
+	feePerByte := sdk.NewDecCoin("nanoekil", sdk.NewInt(10))
+	params := types.DefaultParams()
+	params.FeePerByte = feePerByte
+	params.FeeRouting = likefeegranttypes.FeeRouting{
+		FeeCollectorRatio:  sdk.NewDecWithPrec(4, 1),
+		CommunityPoolRatio: sdk.NewDecWithPrec(3, 1),
+		BurnRatio:          sdk.NewDecWithPrec(2, 1),
+		TreasuryRatio:      sdk.NewDecWithPrec(1, 1),
+		TreasuryAddress:    treasuryAddress.String(),
+	}
+	keeper.SetParams(ctx, params)
+
+	ownerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+
+	bytesLength := 100
+
+	// mock
+	accountKeeper.EXPECT().GetAccount(gomock.Any(), ownerAddressBytes).Return(authtypes.NewBaseAccountWithAddress(ownerAddressBytes))
+
+	feeCollectorCoins := sdk.NewCoins(sdk.NewInt64Coin("nanoekil", 400))
+	communityPoolCoins := sdk.NewCoins(sdk.NewInt64Coin("nanoekil", 300))
+	burnCoins := sdk.NewCoins(sdk.NewInt64Coin("nanoekil", 200))
+	treasuryCoins := sdk.NewCoins(sdk.NewInt64Coin("nanoekil", 100))
+
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(ownerAddressBytes), authtypes.FeeCollectorName, coinsMatcher(feeCollectorCoins)).Return(nil)
+	distrKeeper.EXPECT().FundCommunityPool(gomock.Any(), coinsMatcher(communityPoolCoins), sdk.AccAddress(ownerAddressBytes)).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(ownerAddressBytes), types.ModuleName, coinsMatcher(burnCoins)).Return(nil)
+	bankKeeper.EXPECT().BurnCoins(gomock.Any(), types.ModuleName, coinsMatcher(burnCoins)).Return(nil)
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), sdk.AccAddress(ownerAddressBytes), treasuryAddress, coinsMatcher(treasuryCoins)).Return(nil)
+
+	// call
+	err := keeper.DeductFeePerByte(ctx, ownerAddressBytes, bytesLength, nil)
+	require.NoError(t, err)
+
+	collectedFees := keeper.GetCollectedFees(ctx)
+	require.Equal(t, feeCollectorCoins, collectedFees.FeeCollector)
+	require.Equal(t, communityPoolCoins, collectedFees.CommunityPool)
+	require.Equal(t, burnCoins, collectedFees.Burned)
+	require.Equal(t, treasuryCoins, collectedFees.Treasury)
+
+	ctrl.Finish()
+}
@@ -51,7 +51,7 @@ func SortMintPeriod(mintPeriods []types.MintPeriod, descending bool) []types.Min
 		}
 
 		if mintPeriods[j].StartTime.Equal(mintPeriods[i].StartTime) {
-			return mintPeriods[j].MintPrice > mintPeriods[i].MintPrice
+			return mintPeriods[j].MintPrice.Amount.GT(mintPeriods[i].MintPrice.Amount)
 		}
 
 		return mintPeriods[j].StartTime.After(mintPeriods[i].StartTime)
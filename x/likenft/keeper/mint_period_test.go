@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/keeper"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
@@ -15,27 +16,27 @@ func TestMintPeriodSorter(t *testing.T) {
 
 	mintPeriod1 := types.MintPeriod{
 		StartTime:        *testutil.MustParseTime(time.RFC3339, "2019-01-01T00:00:00Z"),
-		MintPrice:        uint64(0),
+		MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		AllowedAddresses: []string{},
 	}
 	mintPeriod2 := types.MintPeriod{
 		StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
-		MintPrice:        uint64(0),
+		MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		AllowedAddresses: []string{},
 	}
 	mintPeriod3 := types.MintPeriod{
 		StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
-		MintPrice:        uint64(400),
+		MintPrice:        sdk.NewInt64Coin("nanolike", 400),
 		AllowedAddresses: []string{},
 	}
 	mintPeriod4 := types.MintPeriod{
 		StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-01-01T00:00:00Z"),
-		MintPrice:        uint64(0),
+		MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		AllowedAddresses: []string{},
 	}
 	mintPeriod5 := types.MintPeriod{
 		StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-01-01T00:00:00Z"),
-		MintPrice:        uint64(100),
+		MintPrice:        sdk.NewInt64Coin("nanolike", 100),
 		AllowedAddresses: []string{},
 	}
 
@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func (k msgServer) CreateAuction(goCtx context.Context, msg *types.MsgCreateAuction) (*types.MsgCreateAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf(err.Error())
+	}
+
+	// check user own the nft
+	if !k.nftKeeper.GetOwner(ctx, msg.ClassId, msg.NftId).Equals(userAddress) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("User do not own the NFT")
+	}
+
+	// Validate end time range
+	if err := k.validateAuctionEndTime(ctx, msg.EndTime); err != nil {
+		return nil, err
+	}
+
+	// Check price denom, the min increment is checked to be in the same denom in ValidateBasic
+	if err := k.ValidatePriceDenom(ctx, msg.ReservePrice.Denom); err != nil {
+		return nil, err
+	}
+
+	// Check if the value already exists
+	_, isFound := k.GetAuction(
+		ctx,
+		msg.ClassId,
+		msg.NftId,
+	)
+	if isFound {
+		return nil, types.ErrAuctionAlreadyExists
+	}
+
+	// Escrow the nft until the auction is settled
+	err = k.nftKeeper.Transfer(ctx, msg.ClassId, msg.NftId, authtypes.NewModuleAddress(types.ModuleName))
+	if err != nil {
+		return nil, types.ErrFailedToCreateAuction.Wrapf(err.Error())
+	}
+
+	// owner changed, remove all listings
+	k.PruneAllListingsForNFT(ctx, msg.ClassId, msg.NftId)
+
+	auction := types.AuctionStoreRecord{
+		ClassId:          msg.ClassId,
+		NftId:            msg.NftId,
+		Seller:           userAddress,
+		ReservePrice:     msg.ReservePrice,
+		MinIncrement:     msg.MinIncrement,
+		EndTime:          msg.EndTime,
+		FullPayToRoyalty: msg.FullPayToRoyalty,
+		HighestBid:       sdk.NewCoin(msg.ReservePrice.Denom, sdk.ZeroInt()),
+	}
+
+	k.SetAuction(
+		ctx,
+		auction,
+	)
+
+	k.SetAuctionEndQueueEntry(ctx, types.AuctionEndQueueEntry{
+		EndTime:    auction.EndTime,
+		AuctionKey: types.AuctionKey(auction.ClassId, auction.NftId),
+	})
+
+	pubAuction := auction.ToPublicRecord()
+
+	ctx.EventManager().EmitTypedEvent(&types.EventCreateAuction{
+		ClassId: pubAuction.ClassId,
+		NftId:   pubAuction.NftId,
+		Seller:  pubAuction.Seller,
+	})
+
+	return &types.MsgCreateAuctionResponse{
+		Auction: pubAuction,
+	}, nil
+}
+
+func (k msgServer) CancelAuction(goCtx context.Context, msg *types.MsgCancelAuction) (*types.MsgCancelAuctionResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf(err.Error())
+	}
+
+	// Check if the value exists
+	auction, isFound := k.GetAuction(
+		ctx,
+		msg.ClassId,
+		msg.NftId,
+	)
+	if !isFound {
+		return nil, types.ErrAuctionNotFound
+	}
+
+	// Check user is the seller
+	if !auction.Seller.Equals(userAddress) {
+		return nil, sdkerrors.ErrUnauthorized.Wrapf("User is not the seller of the auction")
+	}
+
+	// Ended auctions are left to the settlement
+	if auction.EndTime.Before(ctx.BlockTime()) {
+		return nil, types.ErrAuctionEnded
+	}
+
+	// Bidders are committed once the first bid is placed
+	if auction.HasBid() {
+		return nil, types.ErrFailedToCancelAuction.Wrapf("Auction already has bids")
+	}
+
+	// Return the nft to the seller
+	err = k.nftKeeper.Transfer(ctx, auction.ClassId, auction.NftId, auction.Seller)
+	if err != nil {
+		return nil, types.ErrFailedToCancelAuction.Wrapf(err.Error())
+	}
+
+	k.RemoveAuction(
+		ctx,
+		auction.ClassId,
+		auction.NftId,
+	)
+
+	k.RemoveAuctionEndQueueEntry(
+		ctx,
+		auction.EndTime,
+		types.AuctionKey(auction.ClassId, auction.NftId),
+	)
+
+	ctx.EventManager().EmitTypedEvent(&types.EventCancelAuction{
+		ClassId: auction.ClassId,
+		NftId:   auction.NftId,
+		Seller:  auction.Seller.String(),
+	})
+
+	return &types.MsgCancelAuctionResponse{}, nil
+}
+
+func (k msgServer) PlaceBid(goCtx context.Context, msg *types.MsgPlaceBid) (*types.MsgPlaceBidResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf(err.Error())
+	}
+
+	// Check if the value exists
+	auction, isFound := k.GetAuction(
+		ctx,
+		msg.ClassId,
+		msg.NftId,
+	)
+	if !isFound {
+		return nil, types.ErrAuctionNotFound
+	}
+
+	// check auction not ended
+	if auction.EndTime.Before(ctx.BlockTime()) {
+		return nil, types.ErrAuctionEnded
+	}
+
+	// check user is not the seller
+	if auction.Seller.Equals(userAddress) {
+		return nil, types.ErrFailedToPlaceBid.Wrapf("Seller cannot bid on own auction")
+	}
+
+	// check bid denom matches auction
+	if msg.Bid.Denom != auction.ReservePrice.Denom {
+		return nil, types.ErrFailedToPlaceBid.Wrapf("Bid denom %s does not match reserve price denom %s", msg.Bid.Denom, auction.ReservePrice.Denom)
+	}
+
+	// check bid >= reserve price, or highest bid + min increment
+	minBid := auction.MinNextBid()
+	if msg.Bid.IsLT(minBid) {
+		return nil, types.ErrFailedToPlaceBid.Wrapf("Bid is too low. Minimum bid is %s", minBid)
+	}
+
+	// check user has enough balance
+	if k.bankKeeper.GetBalance(ctx, userAddress, msg.Bid.Denom).IsLT(msg.Bid) {
+		return nil, types.ErrInsufficientFunds
+	}
+
+	// Take new bid
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, sdk.NewCoins(msg.Bid)); err != nil {
+		return nil, types.ErrFailedToPlaceBid.Wrapf(err.Error())
+	}
+
+	// Refund outbid bidder
+	if auction.HasBid() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, auction.HighestBidder, sdk.NewCoins(auction.HighestBid)); err != nil {
+			return nil, types.ErrFailedToPlaceBid.Wrapf(err.Error())
+		}
+	}
+
+	auction.HighestBidder = userAddress
+	auction.HighestBid = msg.Bid
+
+	k.SetAuction(ctx, auction)
+
+	pubAuction := auction.ToPublicRecord()
+
+	ctx.EventManager().EmitTypedEvent(&types.EventPlaceBid{
+		ClassId: pubAuction.ClassId,
+		NftId:   pubAuction.NftId,
+		Bidder:  pubAuction.HighestBidder,
+		Bid:     pubAuction.HighestBid,
+	})
+
+	return &types.MsgPlaceBidResponse{
+		Auction: pubAuction,
+	}, nil
+}
@@ -0,0 +1,188 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// outbid the highest bidder, who should be refunded
+func TestPlaceBidOutbid(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	prevBidderAddressBytes := []byte{1, 1, 1, 1, 0, 0, 0, 0}
+	bidderAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	bidderAddress, _ := sdk.Bech32ifyAddressBytes("like", bidderAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	prevBid := sdk.NewInt64Coin("nanolike", 200000)
+	bid := sdk.NewInt64Coin("nanolike", 201000)
+	endTime := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed auction
+	auction := types.AuctionStoreRecord{
+		ClassId:       classId,
+		NftId:         nftId,
+		Seller:        sellerAddressBytes,
+		ReservePrice:  sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement:  sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:       endTime,
+		HighestBidder: prevBidderAddressBytes,
+		HighestBid:    prevBid,
+	}
+	k.SetAuction(ctx, auction)
+
+	// Mock
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), sdk.AccAddress(bidderAddressBytes), "nanolike").Return(sdk.NewInt64Coin("nanolike", 999999))
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(bidderAddressBytes), types.ModuleName, sdk.NewCoins(bid)).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(prevBidderAddressBytes), sdk.NewCoins(prevBid)).Return(nil)
+
+	// Call
+	res, err := msgServer.PlaceBid(goCtx, &types.MsgPlaceBid{
+		Creator: bidderAddress,
+		ClassId: classId,
+		NftId:   nftId,
+		Bid:     bid,
+	})
+	require.NoError(t, err)
+	auction.HighestBidder = bidderAddressBytes
+	auction.HighestBid = bid
+	require.Equal(t, &types.MsgPlaceBidResponse{
+		Auction: auction.ToPublicRecord(),
+	}, res)
+
+	// Check state
+	storedAuction, found := k.GetAuction(ctx, classId, nftId)
+	require.True(t, found)
+	require.Equal(t, auction, storedAuction)
+
+	ctrl.Finish()
+}
+
+func TestPlaceBidTooLow(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	prevBidderAddressBytes := []byte{1, 1, 1, 1, 0, 0, 0, 0}
+	bidderAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	bidderAddress, _ := sdk.Bech32ifyAddressBytes("like", bidderAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+
+	// Seed auction
+	auction := types.AuctionStoreRecord{
+		ClassId:       classId,
+		NftId:         nftId,
+		Seller:        sellerAddressBytes,
+		ReservePrice:  sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement:  sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:       time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+		HighestBidder: prevBidderAddressBytes,
+		HighestBid:    sdk.NewInt64Coin("nanolike", 200000),
+	}
+	k.SetAuction(ctx, auction)
+
+	// Call
+	res, err := msgServer.PlaceBid(goCtx, &types.MsgPlaceBid{
+		Creator: bidderAddress,
+		ClassId: classId,
+		NftId:   nftId,
+		Bid:     sdk.NewInt64Coin("nanolike", 200999),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), types.ErrFailedToPlaceBid.Error())
+	require.Nil(t, res)
+
+	// Check state
+	storedAuction, found := k.GetAuction(ctx, classId, nftId)
+	require.True(t, found)
+	require.Equal(t, auction, storedAuction)
+
+	ctrl.Finish()
+}
+
+func TestPlaceBidAuctionEnded(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	bidderAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	bidderAddress, _ := sdk.Bech32ifyAddressBytes("like", bidderAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+
+	// Seed ended auction pending settlement
+	k.SetAuction(ctx, types.AuctionStoreRecord{
+		ClassId:      classId,
+		NftId:        nftId,
+		Seller:       sellerAddressBytes,
+		ReservePrice: sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement: sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:      time.Date(2021, 12, 31, 0, 0, 0, 0, time.UTC),
+		HighestBid:   sdk.NewInt64Coin("nanolike", 0),
+	})
+
+	// Call
+	res, err := msgServer.PlaceBid(goCtx, &types.MsgPlaceBid{
+		Creator: bidderAddress,
+		ClassId: classId,
+		NftId:   nftId,
+		Bid:     sdk.NewInt64Coin("nanolike", 200000),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), types.ErrAuctionEnded.Error())
+	require.Nil(t, res)
+
+	ctrl.Finish()
+}
@@ -0,0 +1,183 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/golang/mock/gomock"
+	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateAuctionNormal(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	reservePrice := sdk.NewInt64Coin("nanolike", 123456)
+	minIncrement := sdk.NewInt64Coin("nanolike", 1000)
+	endTime := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed listing to test deletion after escrow
+	k.SetListing(ctx, types.ListingStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Seller:     userAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 987654),
+		Expiration: time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(userAddressBytes)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, authtypes.NewModuleAddress(types.ModuleName)).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateAuction(goCtx, &types.MsgCreateAuction{
+		Creator:      userAddress,
+		ClassId:      classId,
+		NftId:        nftId,
+		ReservePrice: reservePrice,
+		MinIncrement: minIncrement,
+		EndTime:      endTime,
+	})
+	require.NoError(t, err)
+	expectedAuction := types.Auction{
+		ClassId:      classId,
+		NftId:        nftId,
+		Seller:       userAddress,
+		ReservePrice: reservePrice,
+		MinIncrement: minIncrement,
+		EndTime:      endTime,
+		HighestBid:   sdk.NewInt64Coin("nanolike", 0),
+	}
+	require.Equal(t, &types.MsgCreateAuctionResponse{
+		Auction: expectedAuction,
+	}, res)
+
+	// Check state
+	auction, found := k.GetAuction(ctx, classId, nftId)
+	require.True(t, found)
+	require.Equal(t, expectedAuction.ToStoreRecord(), auction)
+	_, found = k.GetAuctionEndQueueEntry(ctx, endTime, types.AuctionKey(classId, nftId))
+	require.True(t, found)
+	// expect listing of the escrowed nft to be deleted
+	_, found = k.GetListing(ctx, classId, nftId, userAddressBytes)
+	require.False(t, found)
+
+	ctrl.Finish()
+}
+
+func TestCreateAuctionUserNotOwner(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	notUserAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(notUserAddressBytes)
+
+	// Call
+	res, err := msgServer.CreateAuction(goCtx, &types.MsgCreateAuction{
+		Creator:      userAddress,
+		ClassId:      classId,
+		NftId:        nftId,
+		ReservePrice: sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement: sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:      time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), sdkerrors.ErrUnauthorized.Error())
+	require.Nil(t, res)
+
+	// Check state
+	_, found := k.GetAuction(ctx, classId, nftId)
+	require.False(t, found)
+
+	ctrl.Finish()
+}
+
+func TestCreateAuctionEndTimeTooLong(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(userAddressBytes)
+
+	// Call
+	res, err := msgServer.CreateAuction(goCtx, &types.MsgCreateAuction{
+		Creator:      userAddress,
+		ClassId:      classId,
+		NftId:        nftId,
+		ReservePrice: sdk.NewInt64Coin("nanolike", 123456),
+		MinIncrement: sdk.NewInt64Coin("nanolike", 1000),
+		EndTime:      time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), sdkerrors.ErrInvalidRequest.Error())
+	require.Nil(t, res)
+
+	// Check state
+	_, found := k.GetAuction(ctx, classId, nftId)
+	require.False(t, found)
+
+	ctrl.Finish()
+}
@@ -0,0 +1,582 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateListingAutoMatch(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	lowBuyerAddressBytes := []byte{1, 1, 1, 1, 0, 0, 0, 0}
+	expiredBuyerAddressBytes := []byte{0, 0, 0, 0, 1, 1, 1, 1}
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(400000)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed offers
+	bestOffer := types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 500000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	lowOffer := types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      lowBuyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 300000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	expiredOffer := types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      expiredBuyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 900000),
+		Expiration: time.Date(2021, 12, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, offer := range []types.OfferStoreRecord{bestOffer, lowOffer, expiredOffer} {
+		k.SetOffer(ctx, offer)
+		k.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
+			ExpireTime: offer.Expiration,
+			OfferKey:   types.OfferKey(offer.ClassId, offer.NftId, offer.Buyer),
+		})
+	}
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).MinTimes(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(sellerAddressBytes), sdk.NewCoins(bestOffer.Price)).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, sdk.AccAddress(buyerAddressBytes)).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateListing(goCtx, &types.MsgCreateListing{
+		Creator:    sellerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		AutoMatch:  true,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Matched)
+
+	// Check state
+	// expect no listing created
+	_, found := k.GetListing(ctx, classId, nftId, sdk.AccAddress(sellerAddressBytes))
+	require.False(t, found)
+	_, found = k.GetListingExpireQueueEntry(ctx, expiration, types.ListingKey(classId, nftId, sellerAddressBytes))
+	require.False(t, found)
+	// expect matched offer removed
+	_, found = k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.False(t, found)
+	_, found = k.GetOfferExpireQueueEntry(ctx, bestOffer.Expiration, types.OfferKey(classId, nftId, buyerAddressBytes))
+	require.False(t, found)
+	// expect other offers untouched
+	_, found = k.GetOffer(ctx, classId, nftId, lowBuyerAddressBytes)
+	require.True(t, found)
+	_, found = k.GetOffer(ctx, classId, nftId, expiredBuyerAddressBytes)
+	require.True(t, found)
+
+	// Check event
+	var matchEvent *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "likechain.likenft.v1.EventMatchOrder" {
+			e := event
+			matchEvent = &e
+		}
+	}
+	require.NotNil(t, matchEvent)
+	for _, attr := range matchEvent.Attributes {
+		switch string(attr.Key) {
+		case "buyer":
+			require.Equal(t, "\""+buyerAddress+"\"", string(attr.Value))
+		case "taker":
+			require.Equal(t, "\""+sellerAddress+"\"", string(attr.Value))
+		}
+	}
+
+	ctrl.Finish()
+}
+
+// Offers below the listing price are left alone and the listing is created
+func TestCreateListingAutoMatchNoOffer(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(400000)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed offer
+	k.SetOffer(ctx, types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 300000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).MinTimes(1)
+
+	// Call
+	res, err := msgServer.CreateListing(goCtx, &types.MsgCreateListing{
+		Creator:    sellerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		AutoMatch:  true,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Matched)
+
+	// Check state
+	// expect new listing
+	_, found := k.GetListing(ctx, classId, nftId, sdk.AccAddress(sellerAddressBytes))
+	require.True(t, found)
+	// expect offer untouched
+	_, found = k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.True(t, found)
+
+	ctrl.Finish()
+}
+
+func TestCreateOfferAutoMatch(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	prevOwnerAddressBytes := []byte{1, 1, 1, 1, 0, 0, 0, 0}
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(500000)
+	expiration := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	// Seed listings
+	listing := types.ListingStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Seller:     sellerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 400000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	// stale listing by previous owner is cheaper but not matchable
+	staleListing := types.ListingStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Seller:     prevOwnerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 100000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	for _, l := range []types.ListingStoreRecord{listing, staleListing} {
+		k.SetListing(ctx, l)
+		k.SetListingExpireQueueEntry(ctx, types.ListingExpireQueueEntry{
+			ExpireTime: l.Expiration,
+			ListingKey: types.ListingKey(l.ClassId, l.NftId, l.Seller),
+		})
+	}
+
+	// Mock
+	nftKeeper.EXPECT().HasNFT(gomock.Any(), classId, nftId).Return(true)
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).MinTimes(1)
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), sdk.AccAddress(buyerAddressBytes), "nanolike").Return(sdk.NewInt64Coin("nanolike", 1000000))
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), sdk.AccAddress(buyerAddressBytes), sdk.AccAddress(sellerAddressBytes), sdk.NewCoins(listing.Price)).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, sdk.AccAddress(buyerAddressBytes)).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateOffer(goCtx, &types.MsgCreateOffer{
+		Creator:    buyerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		AutoMatch:  true,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Matched)
+
+	// Check state
+	// expect no offer created
+	_, found := k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.False(t, found)
+	_, found = k.GetOfferExpireQueueEntry(ctx, expiration, types.OfferKey(classId, nftId, buyerAddressBytes))
+	require.False(t, found)
+	// expect all listings pruned as owner changed
+	require.Empty(t, k.GetListingsByNFT(ctx, classId, nftId))
+
+	ctrl.Finish()
+}
+
+// Listings above the offered price are left alone and the offer is created
+func TestCreateOfferAutoMatchNoListing(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(300000)
+	expiration := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	// Seed listing
+	k.SetListing(ctx, types.ListingStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Seller:     sellerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 400000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Mock
+	nftKeeper.EXPECT().HasNFT(gomock.Any(), classId, nftId).Return(true)
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).MinTimes(1)
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), sdk.AccAddress(buyerAddressBytes), "nanolike").Return(sdk.NewInt64Coin("nanolike", 1000000))
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(buyerAddressBytes), types.ModuleName, sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)))).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateOffer(goCtx, &types.MsgCreateOffer{
+		Creator:    buyerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		AutoMatch:  true,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Matched)
+
+	// Check state
+	// expect new offer
+	_, found := k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.True(t, found)
+	// expect listing untouched
+	_, found = k.GetListing(ctx, classId, nftId, sdk.AccAddress(sellerAddressBytes))
+	require.True(t, found)
+
+	ctrl.Finish()
+}
+
+// Class offer at a higher price wins over offer on the NFT
+func TestCreateListingAutoMatchClassOffer(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	classBuyerAddressBytes := []byte{1, 1, 1, 1, 0, 0, 0, 0}
+	classBuyerAddress, _ := sdk.Bech32ifyAddressBytes("like", classBuyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(400000)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed offers
+	offer := types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 500000),
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	k.SetOffer(ctx, offer)
+	classOffer := types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      classBuyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 600000),
+		Quantity:   2,
+		Expiration: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+	}
+	k.SetClassOffer(ctx, classOffer)
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    classOffer.Expiration,
+		ClassOfferKey: types.ClassOfferKey(classOffer.ClassId, classOffer.Buyer),
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).MinTimes(1)
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sdk.AccAddress(sellerAddressBytes), sdk.NewCoins(classOffer.Price)).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, sdk.AccAddress(classBuyerAddressBytes)).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateListing(goCtx, &types.MsgCreateListing{
+		Creator:    sellerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		AutoMatch:  true,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Matched)
+
+	// Check state
+	// expect class offer filled by one
+	updatedClassOffer, found := k.GetClassOffer(ctx, classId, classBuyerAddressBytes)
+	require.True(t, found)
+	require.Equal(t, uint64(1), updatedClassOffer.Quantity)
+	// expect offer on the NFT untouched
+	_, found = k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.True(t, found)
+	// expect no listing created
+	_, found = k.GetListing(ctx, classId, nftId, sdk.AccAddress(sellerAddressBytes))
+	require.False(t, found)
+
+	// Check event
+	var matchEvent *sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "likechain.likenft.v1.EventMatchOrder" {
+			e := event
+			matchEvent = &e
+		}
+	}
+	require.NotNil(t, matchEvent)
+	for _, attr := range matchEvent.Attributes {
+		switch string(attr.Key) {
+		case "buyer":
+			require.Equal(t, "\""+classBuyerAddress+"\"", string(attr.Value))
+		case "class_offer":
+			require.Equal(t, "true", string(attr.Value))
+		}
+	}
+
+	ctrl.Finish()
+}
+
+// Expired, lower priced or differently priced counterparties are not matched
+func TestAutoMatchUnmatchedCounterparty(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	askPrice := sdk.NewInt64Coin("nanolike", 400000)
+	expiration := time.Date(2022, 1, 15, 0, 0, 0, 0, time.UTC)
+
+	for _, tc := range []struct {
+		name           string
+		counterPrice   sdk.Coin
+		counterExpires time.Time
+	}{
+		{
+			name:           "expired",
+			counterPrice:   sdk.NewInt64Coin("nanolike", 500000),
+			counterExpires: blockTime.Add(-time.Second),
+		},
+		{
+			name:           "price not crossed",
+			counterPrice:   sdk.NewInt64Coin("nanolike", 300000),
+			counterExpires: blockTime.Add(time.Hour),
+		},
+		{
+			name:           "denom mismatch",
+			counterPrice:   sdk.NewInt64Coin(ibcDenom, 500000),
+			counterExpires: blockTime.Add(time.Hour),
+		},
+	} {
+		t.Run("listing with "+tc.name+" offers", func(t *testing.T) {
+			// Setup
+			ctrl := gomock.NewController(t)
+			bankKeeper := testutil.NewMockBankKeeper(ctrl)
+			nftKeeper := testutil.NewMockNftKeeper(ctrl)
+			msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+				AccountKeeper: testutil.NewMockAccountKeeper(ctrl),
+				BankKeeper:    bankKeeper,
+				IscnKeeper:    testutil.NewMockIscnKeeper(ctrl),
+				NftKeeper:     nftKeeper,
+			})
+			ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(blockTime)
+			goCtx = sdk.WrapSDKContext(ctx)
+			params := k.GetParams(ctx)
+			params.AllowedPriceDenoms = []string{ibcDenom}
+			k.SetParams(ctx, params)
+
+			// Seed counterparties
+			k.SetOffer(ctx, types.OfferStoreRecord{
+				ClassId:    classId,
+				NftId:      nftId,
+				Buyer:      buyerAddressBytes,
+				Price:      tc.counterPrice,
+				Expiration: tc.counterExpires,
+			})
+			k.SetClassOffer(ctx, types.ClassOfferStoreRecord{
+				ClassId:    classId,
+				Buyer:      buyerAddressBytes,
+				Price:      tc.counterPrice,
+				Quantity:   1,
+				Expiration: tc.counterExpires,
+			})
+
+			// Mock
+			nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).MinTimes(1)
+
+			// Call
+			res, err := msgServer.CreateListing(goCtx, &types.MsgCreateListing{
+				Creator:    sellerAddress,
+				ClassId:    classId,
+				NftId:      nftId,
+				Price:      askPrice,
+				Expiration: expiration,
+				AutoMatch:  true,
+			})
+			require.NoError(t, err)
+			require.False(t, res.Matched)
+			_, found := k.GetListing(ctx, classId, nftId, sdk.AccAddress(sellerAddressBytes))
+			require.True(t, found)
+
+			ctrl.Finish()
+		})
+
+		t.Run("offer with "+tc.name+" listings", func(t *testing.T) {
+			// Setup
+			ctrl := gomock.NewController(t)
+			bankKeeper := testutil.NewMockBankKeeper(ctrl)
+			nftKeeper := testutil.NewMockNftKeeper(ctrl)
+			msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+				AccountKeeper: testutil.NewMockAccountKeeper(ctrl),
+				BankKeeper:    bankKeeper,
+				IscnKeeper:    testutil.NewMockIscnKeeper(ctrl),
+				NftKeeper:     nftKeeper,
+			})
+			ctx := sdk.UnwrapSDKContext(goCtx).WithBlockTime(blockTime)
+			goCtx = sdk.WrapSDKContext(ctx)
+			params := k.GetParams(ctx)
+			params.AllowedPriceDenoms = []string{ibcDenom}
+			k.SetParams(ctx, params)
+
+			// the listing takes the counterparty role, asking the offered price of the other direction
+			bidPrice := sdk.NewInt64Coin("nanolike", 450000)
+			listingPrice := tc.counterPrice
+			if tc.name == "price not crossed" {
+				listingPrice = sdk.NewInt64Coin("nanolike", 500000)
+			}
+
+			// Seed counterparty
+			k.SetListing(ctx, types.ListingStoreRecord{
+				ClassId:    classId,
+				NftId:      nftId,
+				Seller:     sellerAddressBytes,
+				Price:      listingPrice,
+				Expiration: tc.counterExpires,
+			})
+
+			// Mock
+			nftKeeper.EXPECT().HasNFT(gomock.Any(), classId, nftId).Return(true)
+			nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes).AnyTimes()
+			bankKeeper.EXPECT().GetBalance(gomock.Any(), sdk.AccAddress(buyerAddressBytes), "nanolike").Return(sdk.NewInt64Coin("nanolike", 1000000))
+			bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), sdk.AccAddress(buyerAddressBytes), types.ModuleName, sdk.NewCoins(bidPrice)).Return(nil)
+
+			// Call
+			res, err := msgServer.CreateOffer(goCtx, &types.MsgCreateOffer{
+				Creator:    buyerAddress,
+				ClassId:    classId,
+				NftId:      nftId,
+				Price:      bidPrice,
+				Expiration: expiration,
+				AutoMatch:  true,
+			})
+			require.NoError(t, err)
+			require.False(t, res.Matched)
+			_, found := k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+			require.True(t, found)
+
+			ctrl.Finish()
+		})
+	}
+}
@@ -57,7 +57,7 @@ func TestCreateBlindBoxContentNormal(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -202,7 +202,7 @@ func TestCreateBlindBoxContentBadRelation(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -280,7 +280,7 @@ func TestCreateBlindBoxContentAlreadyMinted(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -364,7 +364,7 @@ func TestCreateBlindBoxContentMaxSupplyReached(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -449,7 +449,7 @@ func TestCreateBlindBoxContentNotOwner(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -535,7 +535,7 @@ func TestCreateBlindBoxContentAlreadyExist(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -51,7 +51,7 @@ func TestDeleteBlindBoxContentNormal(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -180,7 +180,7 @@ func TestDeleteBlindBoxContentBadRelation(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -252,7 +252,7 @@ func TestDeleteBlindBoxContentAlreadyMinted(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -330,7 +330,7 @@ func TestDeleteBlindBoxContentNotOwner(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -410,7 +410,7 @@ func TestDeleteBlindBoxContentDoNotExist(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -52,7 +52,7 @@ func TestUpdateBlindBoxContentNormal(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -202,7 +202,7 @@ func TestUpdateBlindBoxContentBadRelation(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -280,7 +280,7 @@ func TestUpdateBlindBoxContentAlreadyMinted(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -364,7 +364,7 @@ func TestUpdateBlindBoxContentNotOwner(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
@@ -450,7 +450,7 @@ func TestUpdateBlindBoxContentDoNotExist(t *testing.T) {
 					{
 						StartTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
 						AllowedAddresses: []string{},
-						MintPrice:        1000,
+						MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
 					},
 				},
 				RevealTime: time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
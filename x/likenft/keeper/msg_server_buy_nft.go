@@ -36,28 +36,46 @@ func (k msgServer) BuyNFT(goCtx context.Context, msg *types.MsgBuyNFT) (*types.M
 		return nil, types.ErrListingExpired
 	}
 
+	// check price denom matches listing
+	if msg.Price.Denom != listing.Price.Denom {
+		return nil, types.ErrFailedToBuyNFT.Wrapf("Price denom %s does not match listing price denom %s", msg.Price.Denom, listing.Price.Denom)
+	}
+
 	// check price >= listing price
-	if msg.Price < listing.Price {
-		return nil, types.ErrFailedToBuyNFT.Wrapf("Price is too low. Listing price was %d", listing.Price)
+	if listing.DutchAuction == nil && msg.Price.Amount.LT(listing.Price.Amount) {
+		return nil, types.ErrFailedToBuyNFT.Wrapf("Price is too low. Listing price was %s", listing.Price)
+	}
+
+	// dutch auction listings are charged exactly the declined price at block time
+	chargedPrice := msg.Price
+	if listing.DutchAuction != nil {
+		currentPrice := listing.CurrentPrice(ctx.BlockTime())
+		if msg.Price.Amount.LT(currentPrice.Amount) {
+			return nil, types.ErrFailedToBuyNFT.Wrapf("Price is too low. Current listing price is %s", currentPrice)
+		}
+		chargedPrice = currentPrice
 	}
 
 	// check user has enough balance
-	if k.bankKeeper.GetBalance(ctx, buyerAddress, k.GetParams(ctx).PriceDenom).Amount.Uint64() < msg.Price {
+	if k.bankKeeper.GetBalance(ctx, buyerAddress, chargedPrice.Denom).IsLT(chargedPrice) {
 		return nil, types.ErrFailedToBuyNFT.Wrapf("User does not have enough balance")
 	}
 
 	// transact
+	// all amounts are settled in the denom of the listing
+	denom := listing.Price.Denom
+	price := chargedPrice.Amount.Uint64()
 	// calculate royalty
 	royaltyConfig, found := k.GetRoyaltyConfig(ctx, msg.ClassId)
 	var royaltyAmount uint64
 	if found {
-		_royaltyAmount, allocations, err := k.ComputeRoyaltyAllocation(ctx, msg.Price, listing.FullPayToRoyalty, royaltyConfig)
+		_royaltyAmount, allocations, err := k.ComputeRoyaltyAllocation(ctx, price, listing.FullPayToRoyalty, royaltyConfig)
 		if err != nil {
 			return nil, err
 		}
 		royaltyAmount = _royaltyAmount
 		for _, allocation := range allocations {
-			coins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).PriceDenom, sdk.NewInt(int64(allocation.Amount))))
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(allocation.Amount)))
 			err = k.bankKeeper.SendCoins(ctx, buyerAddress, allocation.Account, coins)
 			if err != nil {
 				return nil, types.ErrFailedToBuyNFT.Wrapf(err.Error())
@@ -65,14 +83,14 @@ func (k msgServer) BuyNFT(goCtx context.Context, msg *types.MsgBuyNFT) (*types.M
 		}
 	}
 	// pay seller
-	netAmount := msg.Price - royaltyAmount
-	netAmountCoins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).PriceDenom, sdk.NewInt(int64(netAmount))))
+	netAmount := price - royaltyAmount
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(netAmount)))
 	err = k.bankKeeper.SendCoins(ctx, buyerAddress, sellerAddress, netAmountCoins)
 	if err != nil {
 		return nil, types.ErrFailedToBuyNFT.Wrapf(err.Error())
 	}
 	// sanity check
-	if royaltyAmount+netAmount != msg.Price {
+	if royaltyAmount+netAmount != price {
 		return nil, types.ErrFailedToBuyNFT.Wrapf("Price split calculation error")
 	}
 	// transfer nft to buyer
@@ -90,7 +108,7 @@ func (k msgServer) BuyNFT(goCtx context.Context, msg *types.MsgBuyNFT) (*types.M
 		NftId:   msg.NftId,
 		Buyer:   buyerAddress.String(),
 		Seller:  sellerAddress.String(),
-		Price:   msg.Price,
+		Price:   chargedPrice,
 	})
 
 	return &types.MsgBuyNFTResponse{}, nil
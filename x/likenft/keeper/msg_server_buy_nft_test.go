@@ -50,7 +50,7 @@ func TestBuyNFTNormalRoyalty(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -86,7 +86,7 @@ func TestBuyNFTNormalRoyalty(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBuyNFTResponse{}, res)
@@ -136,7 +136,7 @@ func TestBuyNFTNormalRoyaltyAndFullPay(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -172,7 +172,7 @@ func TestBuyNFTNormalRoyaltyAndFullPay(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBuyNFTResponse{}, res)
@@ -220,7 +220,7 @@ func TestBuyNFTNormalNoRoyalty(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -241,7 +241,7 @@ func TestBuyNFTNormalNoRoyalty(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBuyNFTResponse{}, res)
@@ -289,7 +289,7 @@ func TestBuyNFTNormalNoRoyaltyAndFullPay(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -310,7 +310,7 @@ func TestBuyNFTNormalNoRoyaltyAndFullPay(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.NoError(t, err)
 	require.Equal(t, &types.MsgBuyNFTResponse{}, res)
@@ -356,7 +356,7 @@ func TestBuyNFTListingNotFound(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.Error(t, err)
 	require.Nil(t, res)
@@ -401,7 +401,7 @@ func TestBuyNFTListingOwnerInvalid(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -415,7 +415,7 @@ func TestBuyNFTListingOwnerInvalid(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.Error(t, err)
 	require.Nil(t, res)
@@ -459,7 +459,7 @@ func TestBuyNFTListingExpired(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -473,7 +473,7 @@ func TestBuyNFTListingExpired(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.Error(t, err)
 	require.Nil(t, res)
@@ -517,7 +517,7 @@ func TestBuyNFTPriceTooLow(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -531,7 +531,7 @@ func TestBuyNFTPriceTooLow(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.Error(t, err)
 	require.Nil(t, res)
@@ -575,7 +575,7 @@ func TestBuyNFTNotEnoughBalance(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -590,7 +590,7 @@ func TestBuyNFTNotEnoughBalance(t *testing.T) {
 		ClassId: classId,
 		NftId:   nftId,
 		Seller:  sellerAddress,
-		Price:   finalPrice,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 	})
 	require.Error(t, err)
 	require.Nil(t, res)
@@ -598,3 +598,145 @@ func TestBuyNFTNotEnoughBalance(t *testing.T) {
 
 	ctrl.Finish()
 }
+
+// dutch auction charges the declined price
+func TestBuyNFTDutchAuction(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 6, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(1000000)
+	floorPrice := uint64(200000)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	// a quarter of the way from start to end
+	currentPrice := uint64(800000)
+	offeredPrice := uint64(1000000)
+
+	// Seed listing
+	k.SetListing(ctx, types.ListingStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Seller:     sellerAddressBytes,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		DutchAuction: &types.DutchAuctionConfig{
+			FloorPrice: sdk.NewCoin("nanolike", sdk.NewIntFromUint64(floorPrice)),
+			StartTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:    time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	})
+
+	// no royalty config
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes)
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), buyerAddressBytes, "nanolike").Return(sdk.NewCoin("nanolike", sdk.NewInt(1000000)))
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(currentPrice)))
+	bankKeeper.EXPECT().SendCoins(gomock.Any(), buyerAddressBytes, sellerAddressBytes, netAmountCoins).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, buyerAddressBytes).Return(nil)
+
+	// Run
+	res, err := msgServer.BuyNFT(goCtx, &types.MsgBuyNFT{
+		Creator: buyerAddress,
+		ClassId: classId,
+		NftId:   nftId,
+		Seller:  sellerAddress,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(offeredPrice)),
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgBuyNFTResponse{}, res)
+
+	// Check state
+	// Expect listing deleted
+	_, found := k.GetListing(ctx, classId, nftId, sellerAddressBytes)
+	require.False(t, found)
+
+	ctrl.Finish()
+}
+
+// price below the declined price of dutch auction
+func TestBuyNFTDutchAuctionPriceTooLow(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 6, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(1000000)
+	floorPrice := uint64(200000)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	// current price is 800000, floor price is not enough before the end time
+	offeredPrice := floorPrice
+
+	// Seed listing
+	k.SetListing(ctx, types.ListingStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Seller:     sellerAddressBytes,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+		DutchAuction: &types.DutchAuctionConfig{
+			FloorPrice: sdk.NewCoin("nanolike", sdk.NewIntFromUint64(floorPrice)),
+			StartTime:  time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+			EndTime:    time.Date(2022, 1, 2, 0, 0, 0, 0, time.UTC),
+		},
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes)
+
+	// Run
+	res, err := msgServer.BuyNFT(goCtx, &types.MsgBuyNFT{
+		Creator: buyerAddress,
+		ClassId: classId,
+		NftId:   nftId,
+		Seller:  sellerAddress,
+		Price:   sdk.NewCoin("nanolike", sdk.NewIntFromUint64(offeredPrice)),
+	})
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Contains(t, err.Error(), types.ErrFailedToBuyNFT.Error())
+
+	// Check state
+	// Expect listing kept
+	_, found := k.GetListing(ctx, classId, nftId, sellerAddressBytes)
+	require.True(t, found)
+
+	ctrl.Finish()
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func (k msgServer) CreateClassOffer(goCtx context.Context, msg *types.MsgCreateClassOffer) (*types.MsgCreateClassOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf(err.Error())
+	}
+
+	// Check if the value already exists
+	_, isFound := k.GetClassOffer(
+		ctx,
+		msg.ClassId,
+		userAddress,
+	)
+	if isFound {
+		return nil, types.ErrClassOfferAlreadyExists
+	}
+
+	// Check class exists
+	if _, isFound := k.nftKeeper.GetClass(ctx, msg.ClassId); !isFound {
+		return nil, types.ErrNftClassNotFound
+	}
+
+	// Check expiration range
+	if err := k.validateOfferExpiration(ctx, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	// Check price denom
+	if err := k.ValidatePriceDenom(ctx, msg.Price.Denom); err != nil {
+		return nil, err
+	}
+
+	classOffer := types.ClassOfferStoreRecord{
+		ClassId:    msg.ClassId,
+		Buyer:      userAddress,
+		Price:      msg.Price,
+		Quantity:   msg.Quantity,
+		Expiration: msg.Expiration,
+	}
+
+	// Take deposit for the whole quantity if needed
+	deposit := classOffer.Deposit()
+	if deposit.IsPositive() {
+		if k.bankKeeper.GetBalance(ctx, userAddress, deposit.Denom).IsLT(deposit) {
+			return nil, types.ErrInsufficientFunds
+		}
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+			return nil, types.ErrFailedToCreateClassOffer.Wrapf(err.Error())
+		}
+	}
+
+	k.SetClassOffer(
+		ctx,
+		classOffer,
+	)
+
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    classOffer.Expiration,
+		ClassOfferKey: types.ClassOfferKey(classOffer.ClassId, classOffer.Buyer),
+	})
+
+	pubClassOffer := classOffer.ToPublicRecord()
+
+	ctx.EventManager().EmitTypedEvent(&types.EventCreateClassOffer{
+		ClassId: pubClassOffer.ClassId,
+		Buyer:   pubClassOffer.Buyer,
+	})
+
+	return &types.MsgCreateClassOfferResponse{
+		ClassOffer: pubClassOffer,
+	}, nil
+}
+
+func (k msgServer) UpdateClassOffer(goCtx context.Context, msg *types.MsgUpdateClassOffer) (*types.MsgUpdateClassOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf(err.Error())
+	}
+
+	// Check if the value exists
+	oldClassOffer, isFound := k.GetClassOffer(
+		ctx,
+		msg.ClassId,
+		userAddress,
+	)
+	if !isFound {
+		return nil, types.ErrClassOfferNotFound
+	}
+
+	// Assume data in store is valid; i.e. class exists
+
+	// Check expiration range
+	if err := k.validateOfferExpiration(ctx, msg.Expiration); err != nil {
+		return nil, err
+	}
+
+	// Check price denom
+	if err := k.ValidatePriceDenom(ctx, msg.Price.Denom); err != nil {
+		return nil, err
+	}
+
+	newClassOffer := types.ClassOfferStoreRecord{
+		ClassId:    msg.ClassId,
+		Buyer:      userAddress,
+		Price:      msg.Price,
+		Quantity:   msg.Quantity,
+		Expiration: msg.Expiration,
+	}
+
+	// Update deposit if needed, the new price can be in a different denom
+	oldDeposit := oldClassOffer.Deposit()
+	newDeposit := newClassOffer.Deposit()
+	if oldDeposit.Denom != newDeposit.Denom || !oldDeposit.Amount.Equal(newDeposit.Amount) {
+		// Check user has enough fund to pay extra
+		required := newDeposit.Amount
+		if oldDeposit.Denom == newDeposit.Denom {
+			required = required.Sub(oldDeposit.Amount)
+		}
+		if required.IsPositive() && k.bankKeeper.GetBalance(ctx, userAddress, newDeposit.Denom).Amount.LT(required) {
+			return nil, types.ErrInsufficientFunds
+		}
+
+		// Refund old deposit
+		if oldDeposit.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddress, sdk.NewCoins(oldDeposit)); err != nil {
+				return nil, types.ErrFailedToUpdateClassOffer.Wrapf(err.Error())
+			}
+		}
+
+		// Take new deposit
+		if newDeposit.IsPositive() {
+			if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, sdk.NewCoins(newDeposit)); err != nil {
+				return nil, types.ErrFailedToUpdateClassOffer.Wrapf(err.Error())
+			}
+		}
+	}
+
+	k.SetClassOffer(ctx, newClassOffer)
+
+	k.UpdateClassOfferExpireQueueEntry(
+		ctx,
+		oldClassOffer.Expiration,
+		types.ClassOfferKey(oldClassOffer.ClassId, oldClassOffer.Buyer),
+		newClassOffer.Expiration,
+	)
+
+	pubClassOffer := newClassOffer.ToPublicRecord()
+
+	ctx.EventManager().EmitTypedEvent(&types.EventUpdateClassOffer{
+		ClassId: pubClassOffer.ClassId,
+		Buyer:   pubClassOffer.Buyer,
+	})
+
+	return &types.MsgUpdateClassOfferResponse{
+		ClassOffer: pubClassOffer,
+	}, nil
+}
+
+func (k msgServer) DeleteClassOffer(goCtx context.Context, msg *types.MsgDeleteClassOffer) (*types.MsgDeleteClassOfferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	userAddress, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf(err.Error())
+	}
+
+	// Check if the value exists
+	classOffer, isFound := k.GetClassOffer(
+		ctx,
+		msg.ClassId,
+		userAddress,
+	)
+	if !isFound {
+		return nil, types.ErrClassOfferNotFound
+	}
+
+	// Refund deposit of the unfilled quantity if needed
+	deposit := classOffer.Deposit()
+	if deposit.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddress, sdk.NewCoins(deposit)); err != nil {
+			return nil, types.ErrFailedToDeleteClassOffer.Wrapf(err.Error())
+		}
+	}
+
+	k.RemoveClassOffer(
+		ctx,
+		classOffer.ClassId,
+		classOffer.Buyer,
+	)
+
+	k.RemoveClassOfferExpireQueueEntry(
+		ctx,
+		classOffer.Expiration,
+		types.ClassOfferKey(classOffer.ClassId, classOffer.Buyer),
+	)
+
+	pubClassOffer := classOffer.ToPublicRecord()
+
+	ctx.EventManager().EmitTypedEvent(&types.EventDeleteClassOffer{
+		ClassId: pubClassOffer.ClassId,
+		Buyer:   pubClassOffer.Buyer,
+	})
+
+	return &types.MsgDeleteClassOfferResponse{}, nil
+}
@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+	"github.com/golang/mock/gomock"
+	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateClassOfferNormal(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	price := sdk.NewInt64Coin("nanolike", 123456)
+	quantity := uint64(3)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Mock
+	nftKeeper.EXPECT().GetClass(gomock.Any(), classId).Return(nft.Class{Id: classId}, true)
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), userAddressBytes, "nanolike").Return(sdk.NewInt64Coin("nanolike", 1000000))
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), userAddressBytes, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 370368))).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateClassOffer(goCtx, &types.MsgCreateClassOffer{
+		Creator:    userAddress,
+		ClassId:    classId,
+		Price:      price,
+		Quantity:   quantity,
+		Expiration: expiration,
+	})
+	require.NoError(t, err)
+	expectedClassOffer := types.ClassOffer{
+		ClassId:    classId,
+		Buyer:      userAddress,
+		Price:      price,
+		Quantity:   quantity,
+		Expiration: expiration,
+	}
+	require.Equal(t, &types.MsgCreateClassOfferResponse{
+		ClassOffer: expectedClassOffer,
+	}, res)
+
+	// Check state
+	// expect new class offer
+	classOffer, isFound := k.GetClassOffer(ctx, classId, userAddressBytes)
+	require.True(t, isFound)
+	require.Equal(t, expectedClassOffer.ToStoreRecord(), classOffer)
+	// expect enqueued class offer
+	_, isFound = k.GetClassOfferExpireQueueEntry(
+		ctx,
+		expiration,
+		types.ClassOfferKey(classId, userAddressBytes),
+	)
+	require.True(t, isFound)
+
+	ctrl.Finish()
+}
+
+func TestCreateClassOfferClassNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+
+	// Mock
+	nftKeeper.EXPECT().GetClass(gomock.Any(), classId).Return(nft.Class{}, false)
+
+	// Call
+	res, err := msgServer.CreateClassOffer(goCtx, &types.MsgCreateClassOffer{
+		Creator:    userAddress,
+		ClassId:    classId,
+		Price:      sdk.NewInt64Coin("nanolike", 123456),
+		Quantity:   3,
+		Expiration: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+	})
+	require.ErrorIs(t, err, types.ErrNftClassNotFound)
+	require.Nil(t, res)
+
+	// Check state
+	_, isFound := k.GetClassOffer(ctx, classId, userAddressBytes)
+	require.False(t, isFound)
+
+	ctrl.Finish()
+}
+
+func TestUpdateClassOfferRaiseQuantity(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	price := sdk.NewInt64Coin("nanolike", 100000)
+	oldExpiration := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+	newExpiration := time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed class offer
+	k.SetClassOffer(ctx, types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      userAddressBytes,
+		Price:      price,
+		Quantity:   2,
+		Expiration: oldExpiration,
+	})
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    oldExpiration,
+		ClassOfferKey: types.ClassOfferKey(classId, userAddressBytes),
+	})
+
+	// Mock
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), userAddressBytes, "nanolike").Return(sdk.NewInt64Coin("nanolike", 300000))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, userAddressBytes, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 200000))).Return(nil)
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), userAddressBytes, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 500000))).Return(nil)
+
+	// Call
+	res, err := msgServer.UpdateClassOffer(goCtx, &types.MsgUpdateClassOffer{
+		Creator:    userAddress,
+		ClassId:    classId,
+		Price:      price,
+		Quantity:   5,
+		Expiration: newExpiration,
+	})
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), res.ClassOffer.Quantity)
+
+	// Check state
+	classOffer, isFound := k.GetClassOffer(ctx, classId, userAddressBytes)
+	require.True(t, isFound)
+	require.Equal(t, uint64(5), classOffer.Quantity)
+	require.Equal(t, newExpiration, classOffer.Expiration)
+	// expect requeued class offer
+	_, isFound = k.GetClassOfferExpireQueueEntry(ctx, oldExpiration, types.ClassOfferKey(classId, userAddressBytes))
+	require.False(t, isFound)
+	_, isFound = k.GetClassOfferExpireQueueEntry(ctx, newExpiration, types.ClassOfferKey(classId, userAddressBytes))
+	require.True(t, isFound)
+
+	ctrl.Finish()
+}
+
+func TestDeleteClassOfferRefund(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	expiration := time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed class offer
+	k.SetClassOffer(ctx, types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      userAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 100000),
+		Quantity:   4,
+		Expiration: expiration,
+	})
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    expiration,
+		ClassOfferKey: types.ClassOfferKey(classId, userAddressBytes),
+	})
+
+	// Mock
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, userAddressBytes, sdk.NewCoins(sdk.NewInt64Coin("nanolike", 400000))).Return(nil)
+
+	// Call
+	res, err := msgServer.DeleteClassOffer(goCtx, &types.MsgDeleteClassOffer{
+		Creator: userAddress,
+		ClassId: classId,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgDeleteClassOfferResponse{}, res)
+
+	// Check state
+	_, isFound := k.GetClassOffer(ctx, classId, userAddressBytes)
+	require.False(t, isFound)
+	_, isFound = k.GetClassOfferExpireQueueEntry(ctx, expiration, types.ClassOfferKey(classId, userAddressBytes))
+	require.False(t, isFound)
+
+	ctrl.Finish()
+}
@@ -26,6 +26,11 @@ func (k msgServer) CreateListing(goCtx context.Context, msg *types.MsgCreateList
 		return nil, err
 	}
 
+	// Check price denom
+	if err := k.ValidatePriceDenom(ctx, msg.Price.Denom); err != nil {
+		return nil, err
+	}
+
 	// Check if the value already exists
 	_, isFound := k.GetListing(
 		ctx,
@@ -48,6 +53,41 @@ func (k msgServer) CreateListing(goCtx context.Context, msg *types.MsgCreateList
 		Price:            msg.Price,
 		Expiration:       msg.Expiration,
 		FullPayToRoyalty: msg.FullPayToRoyalty,
+		DutchAuction:     msg.DutchAuction,
+	}
+
+	// Settle with the best offer right away if requested
+	if msg.AutoMatch {
+		if offer, classOffer, isFound := k.FindBestOfferForListing(ctx, listing); isFound {
+			// trade at the price of the existing offer
+			_, err := k.SellNFT(goCtx, &types.MsgSellNFT{
+				Creator:          msg.Creator,
+				ClassId:          msg.ClassId,
+				NftId:            msg.NftId,
+				Buyer:            offer.Buyer.String(),
+				Price:            offer.Price,
+				FullPayToRoyalty: msg.FullPayToRoyalty,
+				ClassOffer:       classOffer,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			ctx.EventManager().EmitTypedEvent(&types.EventMatchOrder{
+				ClassId:    msg.ClassId,
+				NftId:      msg.NftId,
+				Seller:     msg.Creator,
+				Buyer:      offer.Buyer.String(),
+				Price:      offer.Price,
+				Taker:      msg.Creator,
+				ClassOffer: classOffer,
+			})
+
+			return &types.MsgCreateListingResponse{
+				Listing: listing.ToPublicRecord(),
+				Matched: true,
+			}, nil
+		}
 	}
 
 	k.SetListing(
@@ -97,6 +137,11 @@ func (k msgServer) UpdateListing(goCtx context.Context, msg *types.MsgUpdateList
 		return nil, err
 	}
 
+	// Check price denom
+	if err := k.ValidatePriceDenom(ctx, msg.Price.Denom); err != nil {
+		return nil, err
+	}
+
 	var newListing = types.ListingStoreRecord{
 		ClassId:          msg.ClassId,
 		NftId:            msg.NftId,
@@ -104,6 +149,7 @@ func (k msgServer) UpdateListing(goCtx context.Context, msg *types.MsgUpdateList
 		Price:            msg.Price,
 		Expiration:       msg.Expiration,
 		FullPayToRoyalty: msg.FullPayToRoyalty,
+		DutchAuction:     msg.DutchAuction,
 	}
 
 	k.SetListing(ctx, newListing)
@@ -45,7 +45,7 @@ func TestCreateListingNormal(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           notUserAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
@@ -63,7 +63,7 @@ func TestCreateListingNormal(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -72,7 +72,7 @@ func TestCreateListingNormal(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddress,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	}
@@ -133,7 +133,7 @@ func TestCreateListingUserNotOwner(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -178,7 +178,7 @@ func TestCreateListingExpirationInPast(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -223,7 +223,7 @@ func TestCreateListingExpirationTooFarInFuture(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -266,7 +266,7 @@ func TestCreateListingAlreadyExists(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -279,7 +279,7 @@ func TestCreateListingAlreadyExists(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -41,7 +41,7 @@ func TestDeleteListingNormalOwner(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
@@ -54,7 +54,7 @@ func TestDeleteListingNormalOwner(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           notUserAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
@@ -120,7 +120,7 @@ func TestDeleteListingNormalNotOwner(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
@@ -133,7 +133,7 @@ func TestDeleteListingNormalNotOwner(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           notUserAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
@@ -199,7 +199,7 @@ func TestDeleteListingNormalNotOwnerNotFound(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           notUserAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
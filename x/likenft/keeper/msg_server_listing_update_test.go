@@ -45,7 +45,7 @@ func TestUpdateListingNormal(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       prevExpiration,
 		FullPayToRoyalty: false,
 	})
@@ -59,7 +59,7 @@ func TestUpdateListingNormal(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -68,7 +68,7 @@ func TestUpdateListingNormal(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddress,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	}
@@ -122,7 +122,7 @@ func TestUpdateListingNotExist(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -165,7 +165,7 @@ func TestUpdateListingExpirationInPast(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           userAddressBytes,
-		Price:            uint64(987654),
+		Price:            sdk.NewInt64Coin("nanolike", 987654),
 		Expiration:       time.Date(2022, 3, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	}
@@ -180,7 +180,7 @@ func TestUpdateListingExpirationInPast(t *testing.T) {
 		Creator:          userAddress,
 		ClassId:          classId,
 		NftId:            nftId,
-		Price:            price,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
@@ -12,7 +12,6 @@ import (
 )
 
 func (k msgServer) mintBlindBoxNFT(ctx sdk.Context, classId string, classData *types.ClassData, ownerAddress sdk.AccAddress, userAddress sdk.AccAddress, totalSupply uint64, msg *types.MsgMintNFT) (*nft.NFT, error) {
-	params := k.GetParams(ctx)
 	tokenId := fmt.Sprintf("nft%d", totalSupply+1)
 
 	// Check if the class has already been revealed or not
@@ -51,13 +50,13 @@ func (k msgServer) mintBlindBoxNFT(ctx sdk.Context, classId string, classData *t
 	}
 
 	// Pay price to owner if mintPrice is not zero and the minter is not the owner
-	if !ownerAddress.Equals(userAddress) && mintPeriod.MintPrice > 0 {
-		spentableTokens := k.bankKeeper.GetBalance(ctx, userAddress, params.GetPriceDenom())
-		if spentableTokens.Amount.Uint64() < mintPeriod.MintPrice {
+	if !ownerAddress.Equals(userAddress) && mintPeriod.MintPrice.IsPositive() {
+		spentableTokens := k.bankKeeper.GetBalance(ctx, userAddress, mintPeriod.MintPrice.Denom)
+		if spentableTokens.IsLT(mintPeriod.MintPrice) {
 			return nil, types.ErrInsufficientFunds.Wrapf("insufficient funds to mint tokenId %s", tokenId)
 		}
 
-		err = k.bankKeeper.SendCoins(ctx, userAddress, ownerAddress, sdk.NewCoins(sdk.NewCoin(params.GetPriceDenom(), sdk.NewInt(int64(mintPeriod.MintPrice)))))
+		err = k.bankKeeper.SendCoins(ctx, userAddress, ownerAddress, sdk.NewCoins(mintPeriod.MintPrice))
 		if err != nil {
 			return nil, types.ErrFailedToMintNFT.Wrapf("%s", err.Error())
 		}
@@ -1074,7 +1074,7 @@ func TestMintBlindBoxNFTNormal(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        mintPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(mintPrice)),
 					},
 				},
 				RevealTime: revealTime,
@@ -1233,7 +1233,7 @@ func TestMintBlindBoxNFTOwnerNoPay(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        mintPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(mintPrice)),
 					},
 				},
 				RevealTime: revealTime,
@@ -1371,7 +1371,7 @@ func TestMintBlindBoxNFTOwnerIgnoreAllowList(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        mintPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(mintPrice)),
 					},
 				},
 				RevealTime: revealTime,
@@ -1515,17 +1515,17 @@ func TestMintBlindBoxNFTChangingMintPeriodPrice(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2048-04-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        thirdMintPeriodPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(thirdMintPeriodPrice)),
 					},
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        secondMintPeriodPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(secondMintPeriodPrice)),
 					},
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        firstMintPeriodPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(firstMintPeriodPrice)),
 					},
 				},
 				RevealTime: revealTime,
@@ -1685,7 +1685,7 @@ func TestMintBlindBoxNFTFree(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        mintPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(mintPrice)),
 					},
 				},
 				RevealTime: revealTime,
@@ -1824,7 +1824,7 @@ func TestMintBlindBoxNFTInsufficientFunds(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        mintPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(mintPrice)),
 					},
 				},
 				RevealTime: *revealTime,
@@ -2178,7 +2178,7 @@ func TestMintBlindBoxNFTAfterRevealTime(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        uint64(0),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 					},
 				},
 				RevealTime: revealTime,
@@ -2432,7 +2432,7 @@ func TestMintBlindBoxNFTNoContentSupply(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 						AllowedAddresses: []string{},
-						MintPrice:        mintPrice,
+						MintPrice:        sdk.NewCoin("nanolike", sdk.NewIntFromUint64(mintPrice)),
 					},
 				},
 				RevealTime: revealTime,
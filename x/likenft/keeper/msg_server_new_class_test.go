@@ -56,7 +56,7 @@ func TestNewClassISCNNormal(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -264,7 +264,7 @@ func TestNewClassInvalidIscn(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -344,7 +344,7 @@ func TestNewClassNonExistentIscn(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -429,7 +429,7 @@ func TestNewClassISCNInvalidUserAddress(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -580,7 +580,7 @@ func TestNewClassUserNotIscnOwner(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2020-01-01T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -672,17 +672,17 @@ func TestNewClassNormalMintPeriodConfig(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(20000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 20000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-20T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(30000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 30000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        uint64(90000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 90000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2022-04-28T00:00:00Z")
@@ -809,7 +809,7 @@ func TestNewClassInvalidMintPeriod(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2922-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -899,7 +899,7 @@ func TestNewClassMintPeriodInvalidAllowListAddress(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{"invalid address"},
-			MintPrice:        1000000000,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000000000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -37,6 +37,11 @@ func (k msgServer) CreateOffer(goCtx context.Context, msg *types.MsgCreateOffer)
 		return nil, err
 	}
 
+	// Check price denom
+	if err := k.ValidatePriceDenom(ctx, msg.Price.Denom); err != nil {
+		return nil, err
+	}
+
 	offer := types.OfferStoreRecord{
 		ClassId:    msg.ClassId,
 		NftId:      msg.NftId,
@@ -45,14 +50,44 @@ func (k msgServer) CreateOffer(goCtx context.Context, msg *types.MsgCreateOffer)
 		Expiration: msg.Expiration,
 	}
 
+	// Settle with the best listing right away if requested
+	if msg.AutoMatch {
+		if listing, isFound := k.FindBestListingForOffer(ctx, offer); isFound {
+			// trade at the current price of the existing listing
+			price := listing.CurrentPrice(ctx.BlockTime())
+			_, err := k.BuyNFT(goCtx, &types.MsgBuyNFT{
+				Creator: msg.Creator,
+				ClassId: msg.ClassId,
+				NftId:   msg.NftId,
+				Seller:  listing.Seller.String(),
+				Price:   price,
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			ctx.EventManager().EmitTypedEvent(&types.EventMatchOrder{
+				ClassId: msg.ClassId,
+				NftId:   msg.NftId,
+				Seller:  listing.Seller.String(),
+				Buyer:   msg.Creator,
+				Price:   price,
+				Taker:   msg.Creator,
+			})
+
+			return &types.MsgCreateOfferResponse{
+				Offer:   offer.ToPublicRecord(),
+				Matched: true,
+			}, nil
+		}
+	}
+
 	// Take deposit if needed
-	if offer.Price > 0 {
-		denom := k.PriceDenom(ctx)
-		coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(int64(offer.Price))))
-		if k.bankKeeper.GetBalance(ctx, userAddress, denom).Amount.Uint64() < offer.Price {
+	if offer.Price.IsPositive() {
+		if k.bankKeeper.GetBalance(ctx, userAddress, offer.Price.Denom).IsLT(offer.Price) {
 			return nil, types.ErrInsufficientFunds
 		}
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, coins); err != nil {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, sdk.NewCoins(offer.Price)); err != nil {
 			return nil, types.ErrFailedToCreateOffer.Wrapf(err.Error())
 		}
 	}
@@ -106,6 +141,11 @@ func (k msgServer) UpdateOffer(goCtx context.Context, msg *types.MsgUpdateOffer)
 		return nil, err
 	}
 
+	// Check price denom
+	if err := k.ValidatePriceDenom(ctx, msg.Price.Denom); err != nil {
+		return nil, err
+	}
+
 	newOffer := types.OfferStoreRecord{
 		ClassId:    msg.ClassId,
 		NftId:      msg.NftId,
@@ -114,24 +154,24 @@ func (k msgServer) UpdateOffer(goCtx context.Context, msg *types.MsgUpdateOffer)
 		Expiration: msg.Expiration,
 	}
 
-	// Update deposit if needed
-	if oldOffer.Price != newOffer.Price {
+	// Update deposit if needed, the new price can be in a different denom
+	if oldOffer.Price.Denom != newOffer.Price.Denom || !oldOffer.Price.Amount.Equal(newOffer.Price.Amount) {
 		// Check user has enough fund to pay extra
-		denom := k.PriceDenom(ctx)
-		priceDiff := int64(newOffer.Price) - int64(oldOffer.Price)
-		if priceDiff > 0 && k.bankKeeper.GetBalance(ctx, userAddress, denom).Amount.Int64() < priceDiff {
+		required := newOffer.Price.Amount
+		if oldOffer.Price.Denom == newOffer.Price.Denom {
+			required = required.Sub(oldOffer.Price.Amount)
+		}
+		if required.IsPositive() && k.bankKeeper.GetBalance(ctx, userAddress, newOffer.Price.Denom).Amount.LT(required) {
 			return nil, types.ErrInsufficientFunds
 		}
 
 		// Refund old deposit
-		oldCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(int64(oldOffer.Price))))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddress, oldCoins); err != nil {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddress, sdk.NewCoins(oldOffer.Price)); err != nil {
 			return nil, types.ErrFailedToUpdateOffer.Wrapf(err.Error())
 		}
 
 		// Take new deposit
-		newCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(int64(newOffer.Price))))
-		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, newCoins); err != nil {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, userAddress, types.ModuleName, sdk.NewCoins(newOffer.Price)); err != nil {
 			return nil, types.ErrFailedToUpdateOffer.Wrapf(err.Error())
 		}
 	}
@@ -178,10 +218,8 @@ func (k msgServer) DeleteOffer(goCtx context.Context, msg *types.MsgDeleteOffer)
 	}
 
 	// Refund deposit if needed
-	if offer.Price > 0 {
-		denom := k.PriceDenom(ctx)
-		coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(int64(offer.Price))))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddress, coins); err != nil {
+	if offer.Price.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, userAddress, sdk.NewCoins(offer.Price)); err != nil {
 			return nil, types.ErrFailedToDeleteOffer.Wrapf(err.Error())
 		}
 	}
@@ -50,7 +50,7 @@ func TestCreateOfferNormal(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.NoError(t, err)
@@ -58,7 +58,7 @@ func TestCreateOfferNormal(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddress,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	}
 	require.Equal(t, &types.MsgCreateOfferResponse{
@@ -117,7 +117,7 @@ func TestCreateOfferAlreadyExists(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -126,7 +126,7 @@ func TestCreateOfferAlreadyExists(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -170,7 +170,7 @@ func TestCreateOfferNFTNotExist(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -214,7 +214,7 @@ func TestCreateOfferExpirationInPast(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -257,7 +257,7 @@ func TestCreateOfferExpirationTooFarInFuture(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -302,7 +302,7 @@ func TestCreateOfferNotEnoughBalance(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -348,7 +348,7 @@ func TestCreateOfferPaymentError(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	require.Error(t, err)
@@ -357,3 +357,106 @@ func TestCreateOfferPaymentError(t *testing.T) {
 
 	ctrl.Finish()
 }
+
+// allowed price denom
+func TestCreateOfferAllowedPriceDenom(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	params := k.GetParams(ctx)
+	params.AllowedPriceDenoms = []string{ibcDenom}
+	k.SetParams(ctx, params)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := sdk.NewInt64Coin(ibcDenom, 123456)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Mock
+	nftKeeper.EXPECT().HasNFT(gomock.Any(), classId, nftId).Return(true)
+	bankKeeper.EXPECT().GetBalance(gomock.Any(), userAddressBytes, ibcDenom).Return(sdk.NewInt64Coin(ibcDenom, 1000000))
+	bankKeeper.EXPECT().SendCoinsFromAccountToModule(gomock.Any(), userAddressBytes, types.ModuleName, sdk.NewCoins(price)).Return(nil)
+
+	// Call
+	res, err := msgServer.CreateOffer(goCtx, &types.MsgCreateOffer{
+		Creator:    userAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      price,
+		Expiration: expiration,
+	})
+	require.NoError(t, err)
+	require.Equal(t, price, res.Offer.Price)
+
+	// Check state
+	offer, isFound := k.GetOffer(
+		ctx,
+		classId,
+		nftId,
+		userAddressBytes,
+	)
+	require.True(t, isFound)
+	require.Equal(t, price, offer.Price)
+
+	ctrl.Finish()
+}
+
+// price denom not allowed
+func TestCreateOfferPriceDenomNotAllowed(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, _ := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	userAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	userAddress, _ := sdk.Bech32ifyAddressBytes("like", userAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Mock
+	nftKeeper.EXPECT().HasNFT(gomock.Any(), classId, nftId).Return(true)
+
+	// Call
+	res, err := msgServer.CreateOffer(goCtx, &types.MsgCreateOffer{
+		Creator:    userAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Price:      sdk.NewInt64Coin("uatom", 123456),
+		Expiration: expiration,
+	})
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Contains(t, err.Error(), types.ErrInvalidPrice.Error())
+
+	ctrl.Finish()
+}
@@ -43,7 +43,7 @@ func TestDeleteOfferNormalRefund(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	k.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -105,7 +105,7 @@ func TestDeleteOfferNormalNoRefund(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	k.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -46,7 +46,7 @@ func TestUpdateOfferNormalRaisePrice(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	k.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -65,7 +65,7 @@ func TestUpdateOfferNormalRaisePrice(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	})
 	require.NoError(t, err)
@@ -73,7 +73,7 @@ func TestUpdateOfferNormalRaisePrice(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddress,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	}
 	require.Equal(t, &types.MsgUpdateOfferResponse{
@@ -127,7 +127,7 @@ func TestUpdateOfferNormalDropPrice(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	k.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -144,7 +144,7 @@ func TestUpdateOfferNormalDropPrice(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	})
 	require.NoError(t, err)
@@ -152,7 +152,7 @@ func TestUpdateOfferNormalDropPrice(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddress,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	}
 	require.Equal(t, &types.MsgUpdateOfferResponse{
@@ -206,7 +206,7 @@ func TestUpdateOfferNormalSamePrice(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 	k.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -219,7 +219,7 @@ func TestUpdateOfferNormalSamePrice(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	})
 	require.NoError(t, err)
@@ -227,7 +227,7 @@ func TestUpdateOfferNormalSamePrice(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddress,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	}
 	require.Equal(t, &types.MsgUpdateOfferResponse{
@@ -279,7 +279,7 @@ func TestUpdateOfferNotExists(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	})
 	require.Error(t, err)
@@ -322,7 +322,7 @@ func TestUpdateOfferExpirationInPast(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	}
 	k.SetOffer(ctx, offer)
@@ -336,7 +336,7 @@ func TestUpdateOfferExpirationInPast(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	})
 	require.Error(t, err)
@@ -387,7 +387,7 @@ func TestUpdateOfferExpirationTooFarInFuture(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      userAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	}
 	k.SetOffer(ctx, offer)
@@ -401,7 +401,7 @@ func TestUpdateOfferExpirationTooFarInFuture(t *testing.T) {
 		Creator:    userAddress,
 		ClassId:    classId,
 		NftId:      nftId,
-		Price:      newPrice,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(newPrice)),
 		Expiration: newExpiration,
 	})
 	require.Error(t, err)
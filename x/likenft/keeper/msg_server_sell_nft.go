@@ -25,9 +25,28 @@ func (k msgServer) SellNFT(goCtx context.Context, msg *types.MsgSellNFT) (*types
 	if err != nil {
 		return nil, types.ErrOfferNotFound
 	}
-	offer, isFound := k.GetOffer(ctx, msg.ClassId, msg.NftId, buyerAddress)
-	if !isFound {
-		return nil, types.ErrOfferNotFound
+	var offer types.OfferStoreRecord
+	var classOffer types.ClassOfferStoreRecord
+	if msg.ClassOffer {
+		var isFound bool
+		classOffer, isFound = k.GetClassOffer(ctx, msg.ClassId, buyerAddress)
+		if !isFound {
+			return nil, types.ErrClassOfferNotFound
+		}
+		// fill one of the quantity as an offer on the NFT at the price per NFT
+		offer = types.OfferStoreRecord{
+			ClassId:    classOffer.ClassId,
+			NftId:      msg.NftId,
+			Buyer:      classOffer.Buyer,
+			Price:      classOffer.Price,
+			Expiration: classOffer.Expiration,
+		}
+	} else {
+		var isFound bool
+		offer, isFound = k.GetOffer(ctx, msg.ClassId, msg.NftId, buyerAddress)
+		if !isFound {
+			return nil, types.ErrOfferNotFound
+		}
 	}
 
 	// check offer is not expired
@@ -35,23 +54,32 @@ func (k msgServer) SellNFT(goCtx context.Context, msg *types.MsgSellNFT) (*types
 		return nil, types.ErrOfferExpired
 	}
 
+	// check price denom matches offer
+	if msg.Price.Denom != offer.Price.Denom {
+		return nil, types.ErrFailedToSellNFT.Wrapf("Price denom %s does not match offered price denom %s", msg.Price.Denom, offer.Price.Denom)
+	}
+
 	// check price <= offer price
-	if msg.Price > offer.Price {
-		return nil, types.ErrFailedToSellNFT.Wrapf("Price is too high. Offered price was %d", offer.Price)
+	if msg.Price.Amount.GT(offer.Price.Amount) {
+		return nil, types.ErrFailedToSellNFT.Wrapf("Price is too high. Offered price was %s", offer.Price)
 	}
 
 	// transact
+	// all amounts are settled in the denom of the offer
+	denom := offer.Price.Denom
+	price := msg.Price.Amount.Uint64()
+	offerPrice := offer.Price.Amount.Uint64()
 	// calculate royalty
 	royaltyConfig, found := k.GetRoyaltyConfig(ctx, msg.ClassId)
 	var royaltyAmount uint64
 	if found {
-		_royaltyAmount, allocations, err := k.ComputeRoyaltyAllocation(ctx, msg.Price, msg.FullPayToRoyalty, royaltyConfig)
+		_royaltyAmount, allocations, err := k.ComputeRoyaltyAllocation(ctx, price, msg.FullPayToRoyalty, royaltyConfig)
 		if err != nil {
 			return nil, err
 		}
 		royaltyAmount = _royaltyAmount
 		for _, allocation := range allocations {
-			coins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).PriceDenom, sdk.NewInt(int64(allocation.Amount))))
+			coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(allocation.Amount)))
 			err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, allocation.Account, coins)
 			if err != nil {
 				return nil, types.ErrFailedToSellNFT.Wrapf(err.Error())
@@ -59,23 +87,23 @@ func (k msgServer) SellNFT(goCtx context.Context, msg *types.MsgSellNFT) (*types
 		}
 	}
 	// pay seller
-	netAmount := msg.Price - royaltyAmount
-	netAmountCoins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).PriceDenom, sdk.NewInt(int64(netAmount))))
+	netAmount := price - royaltyAmount
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(netAmount)))
 	err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sellerAddress, netAmountCoins)
 	if err != nil {
 		return nil, types.ErrFailedToSellNFT.Wrapf(err.Error())
 	}
 	// refund remainder to buyer
-	remainder := offer.Price - msg.Price
+	remainder := offerPrice - price
 	if remainder > 0 {
-		remainCoins := sdk.NewCoins(sdk.NewCoin(k.GetParams(ctx).PriceDenom, sdk.NewInt(int64(remainder))))
+		remainCoins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(remainder)))
 		err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, buyerAddress, remainCoins)
 		if err != nil {
 			return nil, types.ErrFailedToSellNFT.Wrapf(err.Error())
 		}
 	}
 	// sanity check
-	if royaltyAmount+netAmount+remainder != offer.Price {
+	if royaltyAmount+netAmount+remainder != offerPrice {
 		return nil, types.ErrFailedToSellNFT.Wrapf("Price split calculation error")
 	}
 	// transfer nft to buyer
@@ -83,18 +111,37 @@ func (k msgServer) SellNFT(goCtx context.Context, msg *types.MsgSellNFT) (*types
 	if err != nil {
 		return nil, types.ErrFailedToSellNFT.Wrapf(err.Error())
 	}
-	// remove offer
-	k.RemoveOffer(
-		ctx,
-		offer.ClassId,
-		offer.NftId,
-		offer.Buyer,
-	)
-	k.RemoveOfferExpireQueueEntry(
-		ctx,
-		offer.Expiration,
-		types.OfferKey(offer.ClassId, offer.NftId, offer.Buyer),
-	)
+	if msg.ClassOffer {
+		// reduce quantity, remove class offer when fully filled
+		classOffer.Quantity--
+		if classOffer.Quantity > 0 {
+			k.SetClassOffer(ctx, classOffer)
+		} else {
+			k.RemoveClassOffer(
+				ctx,
+				classOffer.ClassId,
+				classOffer.Buyer,
+			)
+			k.RemoveClassOfferExpireQueueEntry(
+				ctx,
+				classOffer.Expiration,
+				types.ClassOfferKey(classOffer.ClassId, classOffer.Buyer),
+			)
+		}
+	} else {
+		// remove offer
+		k.RemoveOffer(
+			ctx,
+			offer.ClassId,
+			offer.NftId,
+			offer.Buyer,
+		)
+		k.RemoveOfferExpireQueueEntry(
+			ctx,
+			offer.Expiration,
+			types.OfferKey(offer.ClassId, offer.NftId, offer.Buyer),
+		)
+	}
 
 	// owner changed, remove all listings
 	k.PruneAllListingsForNFT(ctx, msg.ClassId, msg.NftId)
@@ -107,6 +154,7 @@ func (k msgServer) SellNFT(goCtx context.Context, msg *types.MsgSellNFT) (*types
 		Buyer:            buyerAddress.String(),
 		Price:            msg.Price,
 		FullPayToRoyalty: msg.FullPayToRoyalty,
+		ClassOffer:       msg.ClassOffer,
 	})
 
 	return &types.MsgSellNFTResponse{}, nil
@@ -0,0 +1,213 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/golang/mock/gomock"
+	"github.com/likecoin/likecoin-chain/v4/testutil/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/testutil"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+	"github.com/stretchr/testify/require"
+)
+
+// fill one of the quantity and keep the rest
+func TestSellNFTClassOfferPartialFill(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(123456)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	finalPrice := uint64(100000)
+
+	// Seed class offer
+	k.SetClassOffer(ctx, types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Quantity:   3,
+		Expiration: expiration,
+	})
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    expiration,
+		ClassOfferKey: types.ClassOfferKey(classId, buyerAddressBytes),
+	})
+
+	// no royalty config
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes)
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sellerAddressBytes, netAmountCoins).Return(nil)
+	refundAmountCoins := sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price-finalPrice)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, buyerAddressBytes, refundAmountCoins).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, buyerAddressBytes).Return(nil)
+
+	// Call
+	res, err := msgServer.SellNFT(goCtx, &types.MsgSellNFT{
+		Creator:    sellerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddress,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
+		ClassOffer: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSellNFTResponse{}, res)
+
+	// Check state
+	// Expect quantity reduced
+	classOffer, found := k.GetClassOffer(ctx, classId, buyerAddressBytes)
+	require.True(t, found)
+	require.Equal(t, uint64(2), classOffer.Quantity)
+	_, found = k.GetClassOfferExpireQueueEntry(ctx, expiration, types.ClassOfferKey(classId, buyerAddressBytes))
+	require.True(t, found)
+
+	ctrl.Finish()
+}
+
+// fill the last of the quantity
+func TestSellNFTClassOfferLastFill(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(123456)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+
+	// Seed class offer
+	k.SetClassOffer(ctx, types.ClassOfferStoreRecord{
+		ClassId:    classId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Quantity:   1,
+		Expiration: expiration,
+	})
+	k.SetClassOfferExpireQueueEntry(ctx, types.ClassOfferExpireQueueEntry{
+		ExpireTime:    expiration,
+		ClassOfferKey: types.ClassOfferKey(classId, buyerAddressBytes),
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes)
+	netAmountCoins := sdk.NewCoins(sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)))
+	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, sellerAddressBytes, netAmountCoins).Return(nil)
+	nftKeeper.EXPECT().Transfer(gomock.Any(), classId, nftId, buyerAddressBytes).Return(nil)
+
+	// Call
+	res, err := msgServer.SellNFT(goCtx, &types.MsgSellNFT{
+		Creator:    sellerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddress,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		ClassOffer: true,
+	})
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgSellNFTResponse{}, res)
+
+	// Check state
+	// Expect class offer removed
+	_, found := k.GetClassOffer(ctx, classId, buyerAddressBytes)
+	require.False(t, found)
+	_, found = k.GetClassOfferExpireQueueEntry(ctx, expiration, types.ClassOfferKey(classId, buyerAddressBytes))
+	require.False(t, found)
+
+	ctrl.Finish()
+}
+
+func TestSellNFTClassOfferNotFound(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+
+	// Seed offer on the NFT, which should not be filled as class offer
+	k.SetOffer(ctx, types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewInt64Coin("nanolike", 123456),
+		Expiration: time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes)
+
+	// Call
+	res, err := msgServer.SellNFT(goCtx, &types.MsgSellNFT{
+		Creator:    sellerAddress,
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddress,
+		Price:      sdk.NewInt64Coin("nanolike", 123456),
+		ClassOffer: true,
+	})
+	require.ErrorIs(t, err, types.ErrClassOfferNotFound)
+	require.Nil(t, res)
+
+	// Check state
+	_, found := k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.True(t, found)
+
+	ctrl.Finish()
+}
@@ -51,7 +51,7 @@ func TestSellNFTNormalRoyalty(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -61,7 +61,7 @@ func TestSellNFTNormalRoyalty(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -98,7 +98,7 @@ func TestSellNFTNormalRoyalty(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.NoError(t, err)
@@ -152,7 +152,7 @@ func TestSellNFTNormalRoyaltyAndFullPay(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -162,7 +162,7 @@ func TestSellNFTNormalRoyaltyAndFullPay(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -199,7 +199,7 @@ func TestSellNFTNormalRoyaltyAndFullPay(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.NoError(t, err)
@@ -251,7 +251,7 @@ func TestSellNFTNormalNoRoyalty(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -261,7 +261,7 @@ func TestSellNFTNormalNoRoyalty(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -283,7 +283,7 @@ func TestSellNFTNormalNoRoyalty(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.NoError(t, err)
@@ -335,7 +335,7 @@ func TestSellNFTNormalNoRoyaltyAndFullPay(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -345,7 +345,7 @@ func TestSellNFTNormalNoRoyaltyAndFullPay(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -367,7 +367,7 @@ func TestSellNFTNormalNoRoyaltyAndFullPay(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.NoError(t, err)
@@ -421,7 +421,7 @@ func TestSellNFTNoRefund(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -431,7 +431,7 @@ func TestSellNFTNoRefund(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -465,7 +465,7 @@ func TestSellNFTNoRefund(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.NoError(t, err)
@@ -518,7 +518,7 @@ func TestSellNFTUserNotOwner(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -528,7 +528,7 @@ func TestSellNFTUserNotOwner(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -541,7 +541,7 @@ func TestSellNFTUserNotOwner(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.Error(t, err)
@@ -592,7 +592,7 @@ func TestSellNFTOfferNotFound(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -606,7 +606,7 @@ func TestSellNFTOfferNotFound(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.Error(t, err)
@@ -656,7 +656,7 @@ func TestSellNFTOfferExpired(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -666,7 +666,7 @@ func TestSellNFTOfferExpired(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -679,7 +679,7 @@ func TestSellNFTOfferExpired(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.Error(t, err)
@@ -732,7 +732,7 @@ func TestSellNFTPriceTooHigh(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Seller:           sellerAddressBytes,
-		Price:            uint64(999999),
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
 		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
 		FullPayToRoyalty: false,
 	})
@@ -742,7 +742,7 @@ func TestSellNFTPriceTooHigh(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyerAddressBytes,
-		Price:      price,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
 		Expiration: expiration,
 	})
 
@@ -755,7 +755,82 @@ func TestSellNFTPriceTooHigh(t *testing.T) {
 		ClassId:          classId,
 		NftId:            nftId,
 		Buyer:            buyerAddress,
-		Price:            finalPrice,
+		Price:            sdk.NewCoin("nanolike", sdk.NewIntFromUint64(finalPrice)),
+		FullPayToRoyalty: fullPayToRoyalty,
+	})
+	require.Error(t, err)
+	require.Nil(t, res)
+	require.Contains(t, err.Error(), types.ErrFailedToSellNFT.Error())
+
+	// Check state
+	// Expect offer not removed
+	_, found := k.GetOffer(ctx, classId, nftId, buyerAddressBytes)
+	require.True(t, found)
+	// Expect listing not removed
+	_, found = k.GetListing(ctx, classId, nftId, sellerAddressBytes)
+	require.True(t, found)
+
+	ctrl.Finish()
+}
+
+func TestSellNFTPriceDenomMismatch(t *testing.T) {
+	// Setup
+	ctrl := gomock.NewController(t)
+	accountKeeper := testutil.NewMockAccountKeeper(ctrl)
+	bankKeeper := testutil.NewMockBankKeeper(ctrl)
+	iscnKeeper := testutil.NewMockIscnKeeper(ctrl)
+	nftKeeper := testutil.NewMockNftKeeper(ctrl)
+	msgServer, goCtx, k := setupMsgServer(t, keeper.LikenftDependedKeepers{
+		AccountKeeper: accountKeeper,
+		BankKeeper:    bankKeeper,
+		IscnKeeper:    iscnKeeper,
+		NftKeeper:     nftKeeper,
+	})
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx = ctx.WithBlockTime(time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC))
+	goCtx = sdk.WrapSDKContext(ctx)
+
+	// Data)
+	sellerAddressBytes := []byte{0, 1, 0, 1, 0, 1, 0, 1}
+	sellerAddress, _ := sdk.Bech32ifyAddressBytes("like", sellerAddressBytes)
+	buyerAddressBytes := []byte{1, 0, 1, 0, 1, 0, 1, 0}
+	buyerAddress, _ := sdk.Bech32ifyAddressBytes("like", buyerAddressBytes)
+	classId := "likenft1abcdef"
+	nftId := "nft1"
+	price := uint64(123456)
+	expiration := time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC)
+	finalPrice := uint64(100000)
+	fullPayToRoyalty := false
+
+	// Seed listing to test deletion after txn
+	k.SetListing(ctx, types.ListingStoreRecord{
+		ClassId:          classId,
+		NftId:            nftId,
+		Seller:           sellerAddressBytes,
+		Price:            sdk.NewInt64Coin("nanolike", 999999),
+		Expiration:       time.Date(2022, 2, 1, 0, 0, 0, 0, time.UTC),
+		FullPayToRoyalty: false,
+	})
+
+	// Seed offer
+	k.SetOffer(ctx, types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      buyerAddressBytes,
+		Price:      sdk.NewCoin("nanolike", sdk.NewIntFromUint64(price)),
+		Expiration: expiration,
+	})
+
+	// Mock
+	nftKeeper.EXPECT().GetOwner(gomock.Any(), classId, nftId).Return(sellerAddressBytes)
+
+	// Call
+	res, err := msgServer.SellNFT(goCtx, &types.MsgSellNFT{
+		Creator:          sellerAddress,
+		ClassId:          classId,
+		NftId:            nftId,
+		Buyer:            buyerAddress,
+		Price:            sdk.NewCoin("uatom", sdk.NewIntFromUint64(finalPrice)),
 		FullPayToRoyalty: fullPayToRoyalty,
 	})
 	require.Error(t, err)
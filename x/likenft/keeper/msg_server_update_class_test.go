@@ -1247,17 +1247,17 @@ func TestUpdateClassEnableBlindBox(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(20000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 20000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-20T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(30000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 30000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        uint64(90000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 90000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2022-04-28T00:00:00Z")
@@ -1408,17 +1408,17 @@ func TestUpdateClassDisableBlindBox(t *testing.T) {
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 						AllowedAddresses: []string{ownerAddress},
-						MintPrice:        uint64(20000),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 20000),
 					},
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-20T00:00:00Z"),
 						AllowedAddresses: []string{ownerAddress},
-						MintPrice:        uint64(30000),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 30000),
 					},
 					{
 						StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 						AllowedAddresses: make([]string, 0),
-						MintPrice:        uint64(90000),
+						MintPrice:        sdk.NewInt64Coin("nanolike", 90000),
 					},
 				},
 				RevealTime: revealTime,
@@ -1560,7 +1560,7 @@ func TestUpdateClassUpdateMintPeriod(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(2048),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 2048),
 		},
 	}
 	oldRevealTime := *testutil.MustParseTime(time.RFC3339, "2022-05-01T00:00:00Z")
@@ -1603,17 +1603,17 @@ func TestUpdateClassUpdateMintPeriod(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(20000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 20000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-20T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(30000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 30000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        uint64(90000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 90000),
 		},
 	}
 	updatedRevealTime := *testutil.MustParseTime(time.RFC3339, "2022-04-28T00:00:00Z")
@@ -1748,7 +1748,7 @@ func TestUpdateClassInvalidMintPeriod(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        0,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -1792,7 +1792,7 @@ func TestUpdateClassInvalidMintPeriod(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2922-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        0,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		},
 	}
 
@@ -1870,7 +1870,7 @@ func TestUpdateClassMintPeriodInvalidAllowListAddress(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{"invalid address"},
-			MintPrice:        0,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -1980,7 +1980,7 @@ func TestUpdateClassNoMintPeriod(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        0,
+			MintPrice:        sdk.NewInt64Coin("nanolike", 0),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2322-04-20T00:00:00Z")
@@ -2124,17 +2124,17 @@ func TestUpdateClassMaxSupplyNotLessThanContentCount(t *testing.T) {
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-19T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(20000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 20000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-20T00:00:00Z"),
 			AllowedAddresses: []string{ownerAddress},
-			MintPrice:        uint64(30000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 30000),
 		},
 		{
 			StartTime:        *testutil.MustParseTime(time.RFC3339, "2022-04-21T00:00:00Z"),
 			AllowedAddresses: make([]string, 0),
-			MintPrice:        uint64(90000),
+			MintPrice:        sdk.NewInt64Coin("nanolike", 90000),
 		},
 	}
 	revealTime := *testutil.MustParseTime(time.RFC3339, "2022-04-28T00:00:00Z")
@@ -12,10 +12,8 @@ func (k Keeper) ExpireOffer(ctx sdk.Context, offer types.OfferStoreRecord) error
 	}
 
 	// Refund deposit if needed
-	if offer.Price > 0 {
-		denom := k.PriceDenom(ctx)
-		coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(int64(offer.Price))))
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, offer.Buyer, coins); err != nil {
+	if offer.Price.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, offer.Buyer, sdk.NewCoins(offer.Price)); err != nil {
 			return types.ErrFailedToExpireOffer.Wrapf(err.Error())
 		}
 	}
@@ -69,7 +69,7 @@ func TestExpireOfferFeature(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyer,
-		Price:      uint64(0), // refund to be covered by unit test
+		Price:      sdk.NewInt64Coin("nanolike", 0), // refund to be covered by unit test
 		Expiration: expireTime,
 	})
 	app.LikeNftKeeper.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -84,7 +84,7 @@ func TestExpireOfferFeature(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyer2,
-		Price:      uint64(0), // refund to be covered by unit test
+		Price:      sdk.NewInt64Coin("nanolike", 0), // refund to be covered by unit test
 		Expiration: expireTime2,
 	})
 	app.LikeNftKeeper.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -140,7 +140,7 @@ func TestExpireOfferFeatureErrorOccured(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyer,
-		Price:      uint64(123456), // will failed due to no balance in module acc
+		Price:      sdk.NewInt64Coin("nanolike", 123456), // will failed due to no balance in module acc
 		Expiration: expireTime,
 	})
 	app.LikeNftKeeper.SetOfferExpireQueueEntry(ctx, types.OfferExpireQueueEntry{
@@ -200,7 +200,7 @@ func TestExpireOfferNormalRefund(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyer,
-		Price:      uint64(123456),
+		Price:      sdk.NewInt64Coin("nanolike", 123456),
 		Expiration: expireTime,
 	}
 	keeper.SetOffer(ctx, offer)
@@ -210,8 +210,7 @@ func TestExpireOfferNormalRefund(t *testing.T) {
 	})
 
 	// Mock
-	denom := keeper.PriceDenom(ctx)
-	coins := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewInt(int64(offer.Price))))
+	coins := sdk.NewCoins(offer.Price)
 	bankKeeper.EXPECT().SendCoinsFromModuleToAccount(gomock.Any(), types.ModuleName, buyer, coins).Return(nil)
 
 	// Call
@@ -253,7 +252,7 @@ func TestExpireOfferNormalNoRefund(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyer,
-		Price:      uint64(0),
+		Price:      sdk.NewInt64Coin("nanolike", 0),
 		Expiration: expireTime,
 	}
 	keeper.SetOffer(ctx, offer)
@@ -301,7 +300,7 @@ func TestExpireOfferBadQueueEntry(t *testing.T) {
 		ClassId:    classId,
 		NftId:      nftId,
 		Buyer:      buyer,
-		Price:      uint64(0),
+		Price:      sdk.NewInt64Coin("nanolike", 0),
 		Expiration: expireTime,
 	}
 	keeper.SetOffer(ctx, offer)
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
 )
 
@@ -16,6 +17,8 @@ func (k Keeper) GetParams(ctx sdk.Context) types.Params {
 		MaxOfferDurationDays:   k.maxOfferDurationDays(ctx),
 		MaxListingDurationDays: k.maxListingDurationDays(ctx),
 		MaxRoyaltyBasisPoints:  k.MaxRoyaltyBasisPoints(ctx),
+		FeeRouting:             k.FeeRouting(ctx),
+		AllowedPriceDenoms:     k.AllowedPriceDenoms(ctx),
 	}
 }
 
@@ -69,6 +72,34 @@ func (k Keeper) MaxRoyaltyBasisPointsText(ctx sdk.Context) string {
 	return fmt.Sprintf("%d (%.2f%%)", points, float64(points)/100)
 }
 
+// FeeRouting returns the per-byte fee routing
+func (k Keeper) FeeRouting(ctx sdk.Context) (res likefeegranttypes.FeeRouting) {
+	k.paramstore.GetIfExists(ctx, types.ParamKeyFeeRouting, &res)
+	return res.OrDefault()
+}
+
+// AllowedPriceDenoms returns the denoms accepted for prices in addition to the price denom
+func (k Keeper) AllowedPriceDenoms(ctx sdk.Context) (res []string) {
+	k.paramstore.GetIfExists(ctx, types.ParamKeyAllowedPriceDenoms, &res)
+	if res == nil {
+		return []string{}
+	}
+	return res
+}
+
+// ValidatePriceDenom checks that the denom is the price denom or one of the allowed price denoms
+func (k Keeper) ValidatePriceDenom(ctx sdk.Context, denom string) error {
+	if denom == k.PriceDenom(ctx) {
+		return nil
+	}
+	for _, allowedDenom := range k.AllowedPriceDenoms(ctx) {
+		if denom == allowedDenom {
+			return nil
+		}
+	}
+	return types.ErrInvalidPrice.Wrapf("Price denom %s is not allowed", denom)
+}
+
 // SetParams set the params
 func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
 	k.paramstore.SetParamSet(ctx, &params)
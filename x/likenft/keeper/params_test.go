@@ -87,6 +87,21 @@ func TestParamsValidate(t *testing.T) {
 	err = params.Validate()
 	require.Error(t, err, "should not accept price denom with invalid characters")
 
+	params = types.DefaultParams()
+	params.AllowedPriceDenoms = []string{"ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"}
+	err = params.Validate()
+	require.NoError(t, err)
+
+	params = types.DefaultParams()
+	params.AllowedPriceDenoms = []string{"nanolike123!!!??"}
+	err = params.Validate()
+	require.Error(t, err, "should not accept allowed price denom with invalid characters")
+
+	params = types.DefaultParams()
+	params.AllowedPriceDenoms = []string{"uatom", "uatom"}
+	err = params.Validate()
+	require.Error(t, err, "should not accept repeated allowed price denoms")
+
 	params = types.Params{}
 	err = params.Validate()
 	require.Error(t, err, "should not accept empty params")
@@ -0,0 +1,130 @@
+package likenft_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/nft"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/keeper"
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+
+	testutil "github.com/likecoin/likecoin-chain/v4/testutil"
+)
+
+func appendLegacyVarint(bz []byte, num protowire.Number, v uint64) []byte {
+	bz = protowire.AppendTag(bz, num, protowire.VarintType)
+	return protowire.AppendVarint(bz, v)
+}
+
+func appendLegacyBytes(bz []byte, num protowire.Number, v []byte) []byte {
+	bz = protowire.AppendTag(bz, num, protowire.BytesType)
+	return protowire.AppendBytes(bz, v)
+}
+
+func TestMigrate1to2(t *testing.T) {
+	app := testutil.SetupTestAppWithDefaultState()
+	app.NextHeader(1234567890)
+	ctx := app.SetForQuery()
+	cdc := app.AppCodec()
+	store := ctx.KVStore(app.GetKey(types.StoreKey))
+
+	classId := "likenft1legacy"
+	nftId := "nft1"
+	expiration := time.Unix(1240000000, 0).UTC()
+	revealTime := time.Unix(1250000000, 0).UTC()
+
+	// Offer and listing with the bare prices in the removed field 4
+	offer := types.OfferStoreRecord{
+		ClassId:    classId,
+		NftId:      nftId,
+		Buyer:      addr1,
+		Expiration: expiration,
+	}
+	offerBz := appendLegacyVarint(cdc.MustMarshal(&offer), 4, 123456)
+	prefix.NewStore(store, types.KeyPrefix(types.OfferKeyPrefix)).Set(types.OfferKey(classId, nftId, addr1), offerBz)
+
+	listing := types.ListingStoreRecord{
+		ClassId:          classId,
+		NftId:            nftId,
+		Seller:           addr2,
+		Expiration:       expiration,
+		FullPayToRoyalty: true,
+	}
+	listingBz := appendLegacyVarint(cdc.MustMarshal(&listing), 4, 654321)
+	prefix.NewStore(store, types.KeyPrefix(types.ListingKeyPrefix)).Set(types.ListingKey(classId, nftId, addr2), listingBz)
+
+	// Class with the bare mint prices in the removed field 3 of the mint periods
+	blindBoxConfigBz, err := (&types.BlindBoxConfig{RevealTime: revealTime}).Marshal()
+	require.NoError(t, err)
+	for i, price := range []uint64{0, 1000} {
+		mintPeriod := types.MintPeriod{
+			StartTime:        time.Unix(int64(1230000000+i), 0).UTC(),
+			AllowedAddresses: []string{addr3.String()},
+		}
+		mintPeriodBz, err := mintPeriod.Marshal()
+		require.NoError(t, err)
+		blindBoxConfigBz = appendLegacyBytes(blindBoxConfigBz, 1, appendLegacyVarint(mintPeriodBz, 3, price))
+	}
+	classConfigBz, err := (&types.ClassConfig{Burnable: true, MaxSupply: 10}).Marshal()
+	require.NoError(t, err)
+	classConfigBz = appendLegacyBytes(classConfigBz, 3, blindBoxConfigBz)
+	classDataBz, err := (&types.ClassData{
+		Parent: types.ClassParent{
+			Type:    types.ClassParentType_ACCOUNT,
+			Account: addr1.String(),
+		},
+		BlindBoxState: types.BlindBoxState{ToBeRevealed: true},
+	}).Marshal()
+	require.NoError(t, err)
+	classDataBz = appendLegacyBytes(classDataBz, 3, classConfigBz)
+	err = app.NftKeeper.SaveClass(ctx, nft.Class{
+		Id:   classId,
+		Data: &cdctypes.Any{TypeUrl: "/likechain.likenft.v1.ClassData", Value: classDataBz},
+	})
+	require.NoError(t, err)
+	app.LikeNftKeeper.SetClassesByAccount(ctx, types.ClassesByAccount{
+		Account:  addr1.String(),
+		ClassIds: []string{classId},
+	})
+
+	err = keeper.NewMigrator(app.LikeNftKeeper).Migrate1to2(ctx)
+	require.NoError(t, err)
+
+	migratedOffer, found := app.LikeNftKeeper.GetOffer(ctx, classId, nftId, addr1)
+	require.True(t, found)
+	offer.Price = sdk.NewInt64Coin("nanolike", 123456)
+	require.Equal(t, offer, migratedOffer)
+
+	migratedListing, found := app.LikeNftKeeper.GetListing(ctx, classId, nftId, addr2)
+	require.True(t, found)
+	listing.Price = sdk.NewInt64Coin("nanolike", 654321)
+	require.Equal(t, listing, migratedListing)
+
+	_, classData, err := app.LikeNftKeeper.GetClass(ctx, classId)
+	require.NoError(t, err)
+	require.True(t, classData.Config.Burnable)
+	require.Equal(t, uint64(10), classData.Config.MaxSupply)
+	require.True(t, classData.BlindBoxState.ToBeRevealed)
+	blindBoxConfig := classData.Config.BlindBoxConfig
+	require.NotNil(t, blindBoxConfig)
+	require.Equal(t, revealTime, blindBoxConfig.RevealTime)
+	require.Equal(t, []types.MintPeriod{
+		{
+			StartTime:        time.Unix(1230000000, 0).UTC(),
+			AllowedAddresses: []string{addr3.String()},
+			MintPrice:        sdk.NewInt64Coin("nanolike", 0),
+		},
+		{
+			StartTime:        time.Unix(1230000001, 0).UTC(),
+			AllowedAddresses: []string{addr3.String()},
+			MintPrice:        sdk.NewInt64Coin("nanolike", 1000),
+		},
+	}, blindBoxConfig.MintPeriods)
+}
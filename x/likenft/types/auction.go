@@ -0,0 +1,76 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+func (a Auction) ToStoreRecord() AuctionStoreRecord {
+	seller, err := sdk.AccAddressFromBech32(a.Seller)
+	if err != nil {
+		panic(err)
+	}
+	var highestBidder sdk.AccAddress
+	if a.HighestBidder != "" {
+		highestBidder, err = sdk.AccAddressFromBech32(a.HighestBidder)
+		if err != nil {
+			panic(err)
+		}
+	}
+
+	return AuctionStoreRecord{
+		ClassId:          a.ClassId,
+		NftId:            a.NftId,
+		Seller:           seller,
+		ReservePrice:     a.ReservePrice,
+		MinIncrement:     a.MinIncrement,
+		EndTime:          a.EndTime,
+		FullPayToRoyalty: a.FullPayToRoyalty,
+		HighestBidder:    highestBidder,
+		HighestBid:       a.HighestBid,
+	}
+}
+
+func (r AuctionStoreRecord) ToPublicRecord() Auction {
+	var highestBidder string
+	if !r.HighestBidder.Empty() {
+		highestBidder = r.HighestBidder.String()
+	}
+
+	return Auction{
+		ClassId:          r.ClassId,
+		NftId:            r.NftId,
+		Seller:           r.Seller.String(),
+		ReservePrice:     r.ReservePrice,
+		MinIncrement:     r.MinIncrement,
+		EndTime:          r.EndTime,
+		FullPayToRoyalty: r.FullPayToRoyalty,
+		HighestBidder:    highestBidder,
+		HighestBid:       r.HighestBid,
+	}
+}
+
+// HasBid returns whether any bid has been placed on the auction
+func (r AuctionStoreRecord) HasBid() bool {
+	return !r.HighestBidder.Empty()
+}
+
+// MinNextBid returns the lowest amount the next bid has to offer, which is the reserve price for the first bid or
+// the highest bid plus the min increment afterwards
+func (r AuctionStoreRecord) MinNextBid() sdk.Coin {
+	if !r.HasBid() {
+		return r.ReservePrice
+	}
+	return r.HighestBid.Add(r.MinIncrement)
+}
+
+func MapAuctionsToStoreRecords(auctions []Auction) (records []AuctionStoreRecord) {
+	for _, auction := range auctions {
+		records = append(records, auction.ToStoreRecord())
+	}
+	return
+}
+
+func MapAuctionsToPublicRecords(records []AuctionStoreRecord) (auctions []Auction) {
+	for _, record := range records {
+		auctions = append(auctions, record.ToPublicRecord())
+	}
+	return
+}
@@ -0,0 +1,381 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: likechain/likenft/v1/auction_end_queue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type AuctionEndQueueEntry struct {
+	EndTime    time.Time `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	AuctionKey []byte    `protobuf:"bytes,2,opt,name=auction_key,json=auctionKey,proto3" json:"auction_key,omitempty"`
+}
+
+func (m *AuctionEndQueueEntry) Reset()         { *m = AuctionEndQueueEntry{} }
+func (m *AuctionEndQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*AuctionEndQueueEntry) ProtoMessage()    {}
+func (*AuctionEndQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0a384ea5c312d9e3, []int{0}
+}
+func (m *AuctionEndQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionEndQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionEndQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AuctionEndQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionEndQueueEntry.Merge(m, src)
+}
+func (m *AuctionEndQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionEndQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionEndQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuctionEndQueueEntry proto.InternalMessageInfo
+
+func (m *AuctionEndQueueEntry) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *AuctionEndQueueEntry) GetAuctionKey() []byte {
+	if m != nil {
+		return m.AuctionKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*AuctionEndQueueEntry)(nil), "likechain.likenft.v1.AuctionEndQueueEntry")
+}
+
+func init() {
+	proto.RegisterFile("likechain/likenft/v1/auction_end_queue.proto", fileDescriptor_0a384ea5c312d9e3)
+}
+
+var fileDescriptor_0a384ea5c312d9e3 = []byte{
+	// 265 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xc9, 0xc9, 0xcc, 0x4e,
+	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xf2, 0xd2, 0x4a, 0xf4, 0xcb, 0x0c, 0xf5, 0x13,
+	0x4b, 0x93, 0x4b, 0x32, 0xf3, 0xf3, 0xe2, 0x53, 0xf3, 0x52, 0xe2, 0x0b, 0x4b, 0x53, 0x4b, 0x53,
+	0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe0, 0xaa, 0xf5, 0xa0, 0xaa, 0xf5, 0xca, 0x0c,
+	0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a, 0xf4, 0x41, 0x2c, 0x88, 0x5a, 0x29, 0xf9, 0xf4,
+	0xfc, 0xfc, 0xf4, 0x9c, 0x54, 0x7d, 0x30, 0x2f, 0xa9, 0x34, 0x4d, 0xbf, 0x24, 0x33, 0x37, 0xb5,
+	0xb8, 0x24, 0x31, 0xb7, 0x00, 0xa2, 0x40, 0xa9, 0x82, 0x4b, 0xc4, 0x11, 0x62, 0x8f, 0x6b, 0x5e,
+	0x4a, 0x20, 0xc8, 0x16, 0xd7, 0xbc, 0x92, 0xa2, 0x4a, 0x21, 0x7b, 0x2e, 0x0e, 0x90, 0xbd, 0x20,
+	0xe5, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x52, 0x7a, 0x10, 0xb3, 0xf4, 0x60, 0x66, 0xe9,
+	0x85, 0xc0, 0xcc, 0x72, 0xe2, 0x38, 0x71, 0x4f, 0x9e, 0x61, 0xc2, 0x7d, 0x79, 0xc6, 0x20, 0xf6,
+	0xd4, 0xbc, 0x14, 0x90, 0xb8, 0x90, 0x3c, 0x17, 0x37, 0xcc, 0x03, 0xd9, 0xa9, 0x95, 0x12, 0x4c,
+	0x0a, 0x8c, 0x1a, 0x3c, 0x41, 0x5c, 0x50, 0x21, 0xef, 0xd4, 0x4a, 0x27, 0xff, 0x13, 0x8f, 0xe4,
+	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
+	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x32, 0x4d, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
+	0xce, 0xcf, 0x05, 0x87, 0x47, 0x72, 0x7e, 0x66, 0x1e, 0x9c, 0xa1, 0x0b, 0x09, 0xa7, 0x32, 0x13,
+	0xfd, 0x0a, 0x78, 0x60, 0x95, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0x1d, 0x66, 0x0c, 0x18,
+	0x00, 0x40, 0x60, 0x49, 0xb1, 0x4e, 0x01, 0x00, 0x00,
+}
+
+func (m *AuctionEndQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionEndQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionEndQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AuctionKey) > 0 {
+		i -= len(m.AuctionKey)
+		copy(dAtA[i:], m.AuctionKey)
+		i = encodeVarintAuctionEndQueue(dAtA, i, uint64(len(m.AuctionKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintAuctionEndQueue(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuctionEndQueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuctionEndQueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AuctionEndQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovAuctionEndQueue(uint64(l))
+	l = len(m.AuctionKey)
+	if l > 0 {
+		n += 1 + l + sovAuctionEndQueue(uint64(l))
+	}
+	return n
+}
+
+func sovAuctionEndQueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuctionEndQueue(x uint64) (n int) {
+	return sovAuctionEndQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AuctionEndQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuctionEndQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AuctionEndQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AuctionEndQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionEndQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuctionEndQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionEndQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuctionEndQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthAuctionEndQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuctionEndQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionKey = append(m.AuctionKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.AuctionKey == nil {
+				m.AuctionKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuctionEndQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuctionEndQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAuctionEndQueue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAuctionEndQueue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuctionEndQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAuctionEndQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAuctionEndQueue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAuctionEndQueue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAuctionEndQueue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAuctionEndQueue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAuctionEndQueue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAuctionEndQueue = fmt.Errorf("proto: unexpected end of group")
+)
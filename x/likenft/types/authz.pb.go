@@ -5,8 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	proto "github.com/gogo/protobuf/proto"
-	_ "github.com/regen-network/cosmos-proto"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -467,6 +467,294 @@ func (m *DeleteOfferAuthorization) GetNftId() string {
 	return ""
 }
 
+type CreateClassOfferAuthorization struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *CreateClassOfferAuthorization) Reset()         { *m = CreateClassOfferAuthorization{} }
+func (m *CreateClassOfferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreateClassOfferAuthorization) ProtoMessage()    {}
+func (*CreateClassOfferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86ee45dde1861b7d, []int{9}
+}
+func (m *CreateClassOfferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateClassOfferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateClassOfferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateClassOfferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateClassOfferAuthorization.Merge(m, src)
+}
+func (m *CreateClassOfferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateClassOfferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateClassOfferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateClassOfferAuthorization proto.InternalMessageInfo
+
+func (m *CreateClassOfferAuthorization) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type UpdateClassOfferAuthorization struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *UpdateClassOfferAuthorization) Reset()         { *m = UpdateClassOfferAuthorization{} }
+func (m *UpdateClassOfferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*UpdateClassOfferAuthorization) ProtoMessage()    {}
+func (*UpdateClassOfferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86ee45dde1861b7d, []int{10}
+}
+func (m *UpdateClassOfferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateClassOfferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateClassOfferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateClassOfferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateClassOfferAuthorization.Merge(m, src)
+}
+func (m *UpdateClassOfferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateClassOfferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateClassOfferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateClassOfferAuthorization proto.InternalMessageInfo
+
+func (m *UpdateClassOfferAuthorization) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type DeleteClassOfferAuthorization struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+}
+
+func (m *DeleteClassOfferAuthorization) Reset()         { *m = DeleteClassOfferAuthorization{} }
+func (m *DeleteClassOfferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*DeleteClassOfferAuthorization) ProtoMessage()    {}
+func (*DeleteClassOfferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86ee45dde1861b7d, []int{11}
+}
+func (m *DeleteClassOfferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DeleteClassOfferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DeleteClassOfferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DeleteClassOfferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteClassOfferAuthorization.Merge(m, src)
+}
+func (m *DeleteClassOfferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *DeleteClassOfferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteClassOfferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteClassOfferAuthorization proto.InternalMessageInfo
+
+func (m *DeleteClassOfferAuthorization) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+type CreateAuctionAuthorization struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *CreateAuctionAuthorization) Reset()         { *m = CreateAuctionAuthorization{} }
+func (m *CreateAuctionAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CreateAuctionAuthorization) ProtoMessage()    {}
+func (*CreateAuctionAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86ee45dde1861b7d, []int{12}
+}
+func (m *CreateAuctionAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CreateAuctionAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CreateAuctionAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CreateAuctionAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateAuctionAuthorization.Merge(m, src)
+}
+func (m *CreateAuctionAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CreateAuctionAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateAuctionAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateAuctionAuthorization proto.InternalMessageInfo
+
+func (m *CreateAuctionAuthorization) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *CreateAuctionAuthorization) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+type CancelAuctionAuthorization struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *CancelAuctionAuthorization) Reset()         { *m = CancelAuctionAuthorization{} }
+func (m *CancelAuctionAuthorization) String() string { return proto.CompactTextString(m) }
+func (*CancelAuctionAuthorization) ProtoMessage()    {}
+func (*CancelAuctionAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86ee45dde1861b7d, []int{13}
+}
+func (m *CancelAuctionAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CancelAuctionAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CancelAuctionAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CancelAuctionAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelAuctionAuthorization.Merge(m, src)
+}
+func (m *CancelAuctionAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *CancelAuctionAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelAuctionAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelAuctionAuthorization proto.InternalMessageInfo
+
+func (m *CancelAuctionAuthorization) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *CancelAuctionAuthorization) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
+type PlaceBidAuthorization struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+}
+
+func (m *PlaceBidAuthorization) Reset()         { *m = PlaceBidAuthorization{} }
+func (m *PlaceBidAuthorization) String() string { return proto.CompactTextString(m) }
+func (*PlaceBidAuthorization) ProtoMessage()    {}
+func (*PlaceBidAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_86ee45dde1861b7d, []int{14}
+}
+func (m *PlaceBidAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PlaceBidAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PlaceBidAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PlaceBidAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceBidAuthorization.Merge(m, src)
+}
+func (m *PlaceBidAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *PlaceBidAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceBidAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PlaceBidAuthorization proto.InternalMessageInfo
+
+func (m *PlaceBidAuthorization) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *PlaceBidAuthorization) GetNftId() string {
+	if m != nil {
+		return m.NftId
+	}
+	return ""
+}
+
 type NewClassAuthorization struct {
 	IscnIdPrefix string `protobuf:"bytes,1,opt,name=iscn_id_prefix,json=iscnIdPrefix,proto3" json:"iscn_id_prefix,omitempty"`
 }
@@ -475,7 +763,7 @@ func (m *NewClassAuthorization) Reset()         { *m = NewClassAuthorization{} }
 func (m *NewClassAuthorization) String() string { return proto.CompactTextString(m) }
 func (*NewClassAuthorization) ProtoMessage()    {}
 func (*NewClassAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86ee45dde1861b7d, []int{9}
+	return fileDescriptor_86ee45dde1861b7d, []int{15}
 }
 func (m *NewClassAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +807,7 @@ func (m *UpdateClassAuthorization) Reset()         { *m = UpdateClassAuthorizati
 func (m *UpdateClassAuthorization) String() string { return proto.CompactTextString(m) }
 func (*UpdateClassAuthorization) ProtoMessage()    {}
 func (*UpdateClassAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86ee45dde1861b7d, []int{10}
+	return fileDescriptor_86ee45dde1861b7d, []int{16}
 }
 func (m *UpdateClassAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -563,7 +851,7 @@ func (m *MintNFTAuthorization) Reset()         { *m = MintNFTAuthorization{} }
 func (m *MintNFTAuthorization) String() string { return proto.CompactTextString(m) }
 func (*MintNFTAuthorization) ProtoMessage()    {}
 func (*MintNFTAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86ee45dde1861b7d, []int{11}
+	return fileDescriptor_86ee45dde1861b7d, []int{17}
 }
 func (m *MintNFTAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -608,7 +896,7 @@ func (m *SendNFTAuthorization) Reset()         { *m = SendNFTAuthorization{} }
 func (m *SendNFTAuthorization) String() string { return proto.CompactTextString(m) }
 func (*SendNFTAuthorization) ProtoMessage()    {}
 func (*SendNFTAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_86ee45dde1861b7d, []int{12}
+	return fileDescriptor_86ee45dde1861b7d, []int{18}
 }
 func (m *SendNFTAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -661,6 +949,12 @@ func init() {
 	proto.RegisterType((*CreateOfferAuthorization)(nil), "likechain.likenft.v1.CreateOfferAuthorization")
 	proto.RegisterType((*UpdateOfferAuthorization)(nil), "likechain.likenft.v1.UpdateOfferAuthorization")
 	proto.RegisterType((*DeleteOfferAuthorization)(nil), "likechain.likenft.v1.DeleteOfferAuthorization")
+	proto.RegisterType((*CreateClassOfferAuthorization)(nil), "likechain.likenft.v1.CreateClassOfferAuthorization")
+	proto.RegisterType((*UpdateClassOfferAuthorization)(nil), "likechain.likenft.v1.UpdateClassOfferAuthorization")
+	proto.RegisterType((*DeleteClassOfferAuthorization)(nil), "likechain.likenft.v1.DeleteClassOfferAuthorization")
+	proto.RegisterType((*CreateAuctionAuthorization)(nil), "likechain.likenft.v1.CreateAuctionAuthorization")
+	proto.RegisterType((*CancelAuctionAuthorization)(nil), "likechain.likenft.v1.CancelAuctionAuthorization")
+	proto.RegisterType((*PlaceBidAuthorization)(nil), "likechain.likenft.v1.PlaceBidAuthorization")
 	proto.RegisterType((*NewClassAuthorization)(nil), "likechain.likenft.v1.NewClassAuthorization")
 	proto.RegisterType((*UpdateClassAuthorization)(nil), "likechain.likenft.v1.UpdateClassAuthorization")
 	proto.RegisterType((*MintNFTAuthorization)(nil), "likechain.likenft.v1.MintNFTAuthorization")
@@ -670,31 +964,34 @@ func init() {
 func init() { proto.RegisterFile("likechain/likenft/v1/authz.proto", fileDescriptor_86ee45dde1861b7d) }
 
 var fileDescriptor_86ee45dde1861b7d = []byte{
-	// 380 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc8, 0xc9, 0xcc, 0x4e,
-	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xf2, 0xd2, 0x4a, 0xf4, 0xcb, 0x0c, 0xf5, 0x13,
-	0x4b, 0x4b, 0x32, 0xaa, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe0, 0x2a, 0xf4, 0xa0,
-	0x2a, 0xf4, 0xca, 0x0c, 0xa5, 0x24, 0x93, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xe3, 0xc1, 0x6a, 0xf4,
-	0x21, 0x1c, 0x88, 0x06, 0xa5, 0x00, 0x2e, 0x05, 0xe7, 0xa2, 0xd4, 0xc4, 0x92, 0xd4, 0xa0, 0xfc,
-	0xca, 0xc4, 0x9c, 0x92, 0x4a, 0xe7, 0xfc, 0xbc, 0xb4, 0xcc, 0x74, 0xc7, 0xd2, 0x92, 0x8c, 0xfc,
-	0xa2, 0xcc, 0xaa, 0xc4, 0x92, 0xcc, 0xfc, 0x3c, 0x21, 0x49, 0x2e, 0x8e, 0xe4, 0x9c, 0xc4, 0xe2,
-	0xe2, 0xf8, 0xcc, 0x14, 0x09, 0x46, 0x05, 0x46, 0x0d, 0xce, 0x20, 0x76, 0x30, 0xdf, 0x33, 0xc5,
-	0x4a, 0xf0, 0xd2, 0x16, 0x5d, 0x5e, 0x14, 0xd5, 0x20, 0x13, 0x43, 0x0b, 0x52, 0xa8, 0x6c, 0xa2,
-	0x4b, 0x6a, 0x4e, 0x2a, 0x15, 0x4d, 0x4c, 0xe4, 0x92, 0x82, 0xf8, 0xda, 0x27, 0xb3, 0xb8, 0x24,
-	0x33, 0x8f, 0x68, 0xb3, 0x84, 0x44, 0xb9, 0xd8, 0xf2, 0xd2, 0x4a, 0x40, 0x12, 0x4c, 0x60, 0x09,
-	0xd6, 0xbc, 0xb4, 0x12, 0x9c, 0x56, 0x40, 0x82, 0x81, 0xa6, 0x56, 0x40, 0xc2, 0x85, 0x76, 0x56,
-	0xc4, 0x73, 0x49, 0x40, 0x02, 0xca, 0x3f, 0x2d, 0x2d, 0xb5, 0x88, 0x36, 0x16, 0x40, 0x82, 0x89,
-	0x86, 0x16, 0x40, 0x02, 0x89, 0x56, 0x16, 0x04, 0x70, 0x89, 0xfa, 0xa5, 0x96, 0x3b, 0x83, 0xf4,
-	0xa1, 0x9a, 0xae, 0xc2, 0xc5, 0x97, 0x59, 0x9c, 0x9c, 0x17, 0x9f, 0x99, 0x12, 0x5f, 0x50, 0x94,
-	0x9a, 0x96, 0x59, 0x01, 0xb5, 0x83, 0x07, 0x24, 0xea, 0x99, 0x12, 0x00, 0x16, 0xc3, 0x66, 0xa2,
-	0x07, 0x2c, 0x4c, 0xb0, 0x18, 0x4a, 0x5a, 0x3a, 0x77, 0xe1, 0x12, 0xf1, 0xcd, 0xcc, 0x2b, 0xf1,
-	0x73, 0x0b, 0xa1, 0xc4, 0x94, 0x10, 0x2e, 0x91, 0xe0, 0xd4, 0xbc, 0x14, 0x12, 0x4c, 0x11, 0xe2,
-	0xe3, 0x62, 0x82, 0x07, 0x1d, 0x53, 0x26, 0x36, 0x53, 0x9d, 0xfc, 0x4f, 0x3c, 0x92, 0x63, 0xbc,
-	0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63,
-	0xb8, 0xf1, 0x58, 0x8e, 0x21, 0xca, 0x34, 0x3d, 0xb3, 0x24, 0xa3, 0x34, 0x49, 0x2f, 0x39, 0x3f,
-	0x17, 0x5c, 0xce, 0x25, 0xe7, 0x43, 0x0b, 0x3c, 0x10, 0x43, 0x17, 0x52, 0xfe, 0x95, 0x99, 0xe8,
-	0x57, 0xc0, 0x0b, 0xc1, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0x89, 0x66, 0x0c, 0x08,
-	0x00, 0x00, 0xff, 0xff, 0x9d, 0xa1, 0x70, 0x9a, 0x26, 0x05, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x95, 0x4f, 0xeb, 0xda, 0x30,
+	0x18, 0xc7, 0xad, 0xb0, 0xdf, 0xb6, 0xb0, 0x09, 0x2b, 0x0a, 0x2a, 0xac, 0xc8, 0xd8, 0x61, 0x17,
+	0x5b, 0x64, 0xdb, 0x65, 0x37, 0xad, 0x8c, 0x09, 0x53, 0x8b, 0x73, 0x97, 0x31, 0x28, 0x31, 0x4d,
+	0xed, 0xc3, 0x6a, 0x52, 0xda, 0xd4, 0xa9, 0xaf, 0x62, 0x2f, 0x66, 0x2f, 0x62, 0xec, 0xe4, 0x71,
+	0xc7, 0xa1, 0x6f, 0x64, 0xb4, 0x71, 0x05, 0x41, 0x61, 0xb2, 0xe6, 0x96, 0x3f, 0x4f, 0x3e, 0x5f,
+	0xf2, 0x69, 0xc3, 0x83, 0x3a, 0x21, 0x7c, 0xa1, 0x24, 0xc0, 0xc0, 0xac, 0x6c, 0xc4, 0x7c, 0x61,
+	0xad, 0x7b, 0x16, 0x4e, 0x45, 0xb0, 0x33, 0xa3, 0x98, 0x0b, 0xae, 0xd7, 0x8b, 0x0a, 0xf3, 0x54,
+	0x61, 0xae, 0x7b, 0xed, 0x16, 0xe1, 0xc9, 0x8a, 0x27, 0x6e, 0x5e, 0x63, 0xc9, 0x89, 0x3c, 0xf0,
+	0xcc, 0x41, 0x1d, 0x3b, 0xa6, 0x58, 0xd0, 0x19, 0xdf, 0xe2, 0x50, 0x6c, 0x6d, 0xce, 0x7c, 0x58,
+	0xf6, 0x53, 0x11, 0xf0, 0x18, 0x76, 0x58, 0x00, 0x67, 0x7a, 0x0b, 0x3d, 0x20, 0x21, 0x4e, 0x12,
+	0x17, 0xbc, 0xa6, 0xd6, 0xd1, 0x5e, 0x3c, 0x9c, 0xdd, 0xcf, 0xe7, 0x23, 0xef, 0xcd, 0x93, 0x9f,
+	0xdf, 0xbb, 0x8f, 0xcf, 0xaa, 0x33, 0xe2, 0xc7, 0xc8, 0x2b, 0x99, 0x38, 0xa4, 0x21, 0x2d, 0x91,
+	0x88, 0x51, 0x5b, 0xde, 0xfa, 0x3d, 0x24, 0x02, 0xd8, 0x3f, 0xb3, 0xf4, 0x06, 0xba, 0x63, 0xbe,
+	0xc8, 0x36, 0xaa, 0xf9, 0xc6, 0x3d, 0xe6, 0x8b, 0xab, 0x11, 0x52, 0x83, 0xd2, 0x08, 0xe9, 0x45,
+	0x5d, 0x84, 0x8b, 0x9a, 0x52, 0xd4, 0xd4, 0xf7, 0x69, 0xac, 0x26, 0x40, 0x6a, 0x52, 0x18, 0x20,
+	0x25, 0xa9, 0x0a, 0x18, 0xa3, 0xa7, 0x52, 0x91, 0x9d, 0x1d, 0xbd, 0x29, 0xe5, 0x0a, 0x4e, 0x0a,
+	0x29, 0x0d, 0x27, 0xaf, 0x5f, 0x0e, 0xae, 0x78, 0x38, 0xfd, 0x94, 0x64, 0x0b, 0x6a, 0xfe, 0x6a,
+	0x1b, 0x33, 0x42, 0x43, 0x75, 0x11, 0x9f, 0x51, 0xc3, 0x09, 0x31, 0xa1, 0x03, 0xf0, 0x14, 0xd0,
+	0x1d, 0xd4, 0x98, 0xd0, 0xaf, 0xb9, 0xef, 0x73, 0xfa, 0x73, 0x54, 0x83, 0x84, 0x30, 0x17, 0x3c,
+	0x37, 0x8a, 0xa9, 0x0f, 0x9b, 0x53, 0xc6, 0xa3, 0x6c, 0x75, 0xe4, 0x39, 0xf9, 0xda, 0x25, 0xe2,
+	0xbb, 0xbf, 0x8f, 0xe4, 0x02, 0xf4, 0xb6, 0xef, 0x37, 0x44, 0xf5, 0x31, 0x30, 0x31, 0x79, 0x3b,
+	0xff, 0x1f, 0xca, 0x1c, 0xd5, 0x3f, 0x50, 0xe6, 0xdd, 0x40, 0xd1, 0x6b, 0xa8, 0x5a, 0xa8, 0xab,
+	0xc2, 0x25, 0xea, 0x60, 0xfa, 0xe3, 0x60, 0x68, 0xfb, 0x83, 0xa1, 0xfd, 0x3e, 0x18, 0xda, 0xb7,
+	0xa3, 0x51, 0xd9, 0x1f, 0x8d, 0xca, 0xaf, 0xa3, 0x51, 0xf9, 0xf4, 0x7a, 0x09, 0x22, 0x48, 0x17,
+	0x26, 0xe1, 0xab, 0xbc, 0xf1, 0x11, 0x0e, 0xac, 0x18, 0x74, 0x65, 0x43, 0x5c, 0xbf, 0xb2, 0x36,
+	0x45, 0x57, 0x14, 0xdb, 0x88, 0x26, 0x8b, 0xbb, 0xbc, 0xc5, 0xbd, 0xfc, 0x33, 0x00, 0x4f, 0xa2,
+	0xdf, 0xd6, 0x37, 0x07, 0x00, 0x00,
 }
 
 func (m *CreateRoyaltyConfigAuthorization) Marshal() (dAtA []byte, err error) {
@@ -1009,7 +1306,7 @@ func (m *DeleteOfferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *NewClassAuthorization) Marshal() (dAtA []byte, err error) {
+func (m *CreateClassOfferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1019,27 +1316,27 @@ func (m *NewClassAuthorization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *NewClassAuthorization) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateClassOfferAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *NewClassAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateClassOfferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.IscnIdPrefix) > 0 {
-		i -= len(m.IscnIdPrefix)
-		copy(dAtA[i:], m.IscnIdPrefix)
-		i = encodeVarintAuthz(dAtA, i, uint64(len(m.IscnIdPrefix)))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *UpdateClassAuthorization) Marshal() (dAtA []byte, err error) {
+func (m *UpdateClassOfferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1049,12 +1346,12 @@ func (m *UpdateClassAuthorization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *UpdateClassAuthorization) MarshalTo(dAtA []byte) (int, error) {
+func (m *UpdateClassOfferAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateClassAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *UpdateClassOfferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1069,7 +1366,7 @@ func (m *UpdateClassAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MintNFTAuthorization) Marshal() (dAtA []byte, err error) {
+func (m *DeleteClassOfferAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1079,12 +1376,12 @@ func (m *MintNFTAuthorization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MintNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+func (m *DeleteClassOfferAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MintNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DeleteClassOfferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1099,7 +1396,7 @@ func (m *MintNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *SendNFTAuthorization) Marshal() (dAtA []byte, err error) {
+func (m *CreateAuctionAuthorization) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1109,20 +1406,20 @@ func (m *SendNFTAuthorization) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SendNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+func (m *CreateAuctionAuthorization) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *SendNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *CreateAuctionAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Id)))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.NftId)))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -1136,32 +1433,233 @@ func (m *SendNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuthz(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *CancelAuctionAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *CreateRoyaltyConfigAuthorization) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovAuthz(uint64(l))
-	}
-	return n
+
+func (m *CancelAuctionAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *UpdateRoyaltyConfigAuthorization) Size() (n int) {
-	if m == nil {
+func (m *CancelAuctionAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PlaceBidAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PlaceBidAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PlaceBidAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NewClassAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NewClassAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NewClassAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateClassAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateClassAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateClassAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintNFTAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SendNFTAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SendNFTAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SendNFTAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintAuthz(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuthz(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CreateRoyaltyConfigAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateRoyaltyConfigAuthorization) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1288,20 +1786,20 @@ func (m *DeleteOfferAuthorization) Size() (n int) {
 	return n
 }
 
-func (m *NewClassAuthorization) Size() (n int) {
+func (m *CreateClassOfferAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.IscnIdPrefix)
+	l = len(m.ClassId)
 	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
 	return n
 }
 
-func (m *UpdateClassAuthorization) Size() (n int) {
+func (m *UpdateClassOfferAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1314,7 +1812,7 @@ func (m *UpdateClassAuthorization) Size() (n int) {
 	return n
 }
 
-func (m *MintNFTAuthorization) Size() (n int) {
+func (m *DeleteClassOfferAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1327,7 +1825,7 @@ func (m *MintNFTAuthorization) Size() (n int) {
 	return n
 }
 
-func (m *SendNFTAuthorization) Size() (n int) {
+func (m *CreateAuctionAuthorization) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -1337,51 +1835,533 @@ func (m *SendNFTAuthorization) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
-	l = len(m.Id)
+	l = len(m.NftId)
 	if l > 0 {
 		n += 1 + l + sovAuthz(uint64(l))
 	}
 	return n
 }
 
-func sovAuthz(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *CancelAuctionAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
 }
-func sozAuthz(x uint64) (n int) {
-	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *PlaceBidAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
 }
-func (m *CreateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
+
+func (m *NewClassAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateClassAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *MintNFTAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *SendNFTAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func sovAuthz(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuthz(x uint64) (n int) {
+	return sovAuthz(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CreateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateRoyaltyConfigAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateRoyaltyConfigAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateRoyaltyConfigAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateRoyaltyConfigAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DeleteRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DeleteRoyaltyConfigAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DeleteRoyaltyConfigAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateListingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateListingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateListingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateListingAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
 				return ErrIntOverflowAuthz
 			}
 			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CreateRoyaltyConfigAuthorization: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateRoyaltyConfigAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateListingAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateListingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1409,7 +2389,7 @@ func (m *CreateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1432,7 +2412,7 @@ func (m *CreateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
+func (m *DeleteListingAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1455,10 +2435,10 @@ func (m *UpdateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateRoyaltyConfigAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteListingAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateRoyaltyConfigAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteListingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1493,6 +2473,38 @@ func (m *UpdateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -1514,7 +2526,7 @@ func (m *UpdateRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
+func (m *CreateOfferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1537,10 +2549,10 @@ func (m *DeleteRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteRoyaltyConfigAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateOfferAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteRoyaltyConfigAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1575,6 +2587,38 @@ func (m *DeleteRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -1596,7 +2640,7 @@ func (m *DeleteRoyaltyConfigAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateListingAuthorization) Unmarshal(dAtA []byte) error {
+func (m *UpdateOfferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1619,10 +2663,10 @@ func (m *CreateListingAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateListingAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: UpdateOfferAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateListingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UpdateOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1710,7 +2754,7 @@ func (m *CreateListingAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateListingAuthorization) Unmarshal(dAtA []byte) error {
+func (m *DeleteOfferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1733,10 +2777,10 @@ func (m *UpdateListingAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateListingAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteOfferAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateListingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1824,7 +2868,7 @@ func (m *UpdateListingAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteListingAuthorization) Unmarshal(dAtA []byte) error {
+func (m *CreateClassOfferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1847,10 +2891,10 @@ func (m *DeleteListingAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteListingAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: CreateClassOfferAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteListingAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CreateClassOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1885,9 +2929,59 @@ func (m *DeleteListingAuthorization) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateClassOfferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateClassOfferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateClassOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1915,7 +3009,7 @@ func (m *DeleteListingAuthorization) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftId = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1938,7 +3032,7 @@ func (m *DeleteListingAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *CreateOfferAuthorization) Unmarshal(dAtA []byte) error {
+func (m *DeleteClassOfferAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1961,10 +3055,92 @@ func (m *CreateOfferAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: CreateOfferAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: DeleteClassOfferAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CreateOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DeleteClassOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CreateAuctionAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CreateAuctionAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CreateAuctionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2052,7 +3228,7 @@ func (m *CreateOfferAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpdateOfferAuthorization) Unmarshal(dAtA []byte) error {
+func (m *CancelAuctionAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2075,10 +3251,10 @@ func (m *UpdateOfferAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpdateOfferAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: CancelAuctionAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpdateOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CancelAuctionAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2166,7 +3342,7 @@ func (m *UpdateOfferAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DeleteOfferAuthorization) Unmarshal(dAtA []byte) error {
+func (m *PlaceBidAuthorization) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2189,10 +3365,10 @@ func (m *DeleteOfferAuthorization) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DeleteOfferAuthorization: wiretype end group for non-group")
+			return fmt.Errorf("proto: PlaceBidAuthorization: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DeleteOfferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PlaceBidAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -0,0 +1,98 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &CreateAuctionAuthorization{}
+var _ authz.Authorization = &CancelAuctionAuthorization{}
+var _ authz.Authorization = &PlaceBidAuthorization{}
+
+func NewCreateAuctionAuthorization(classId string, nftId string) *CreateAuctionAuthorization {
+	return &CreateAuctionAuthorization{
+		ClassId: classId,
+		NftId:   nftId,
+	}
+}
+
+func (a CreateAuctionAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCreateAuction{})
+}
+
+func (a CreateAuctionAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgCreate, ok := msg.(*MsgCreateAuction)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if msgCreate.ClassId != a.ClassId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("class ID mismatch")
+	}
+	if a.NftId != "" && msgCreate.NftId != a.NftId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("NFT ID mismatch")
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a CreateAuctionAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func NewCancelAuctionAuthorization(classId string, nftId string) *CancelAuctionAuthorization {
+	return &CancelAuctionAuthorization{
+		ClassId: classId,
+		NftId:   nftId,
+	}
+}
+
+func (a CancelAuctionAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCancelAuction{})
+}
+
+func (a CancelAuctionAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgCancel, ok := msg.(*MsgCancelAuction)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if msgCancel.ClassId != a.ClassId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("class ID mismatch")
+	}
+	if a.NftId != "" && msgCancel.NftId != a.NftId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("NFT ID mismatch")
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a CancelAuctionAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func NewPlaceBidAuthorization(classId string, nftId string) *PlaceBidAuthorization {
+	return &PlaceBidAuthorization{
+		ClassId: classId,
+		NftId:   nftId,
+	}
+}
+
+func (a PlaceBidAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgPlaceBid{})
+}
+
+func (a PlaceBidAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgPlaceBid, ok := msg.(*MsgPlaceBid)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if msgPlaceBid.ClassId != a.ClassId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("class ID mismatch")
+	}
+	if a.NftId != "" && msgPlaceBid.NftId != a.NftId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("NFT ID mismatch")
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a PlaceBidAuthorization) ValidateBasic() error {
+	return nil
+}
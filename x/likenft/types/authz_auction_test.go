@@ -0,0 +1,254 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestCreateAuctionAuthorization(t *testing.T) {
+	var auth *types.CreateAuctionAuthorization
+	var msg sdk.Msg
+	var res authz.AcceptResponse
+	var err error
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	classId1, err := types.NewClassIdForAccount(sdk.AccAddress{1}, 1)
+	require.NoError(t, err)
+	classId2, err := types.NewClassIdForAccount(sdk.AccAddress{2}, 1)
+	require.NoError(t, err)
+	nftId1 := "nft-id-1"
+	nftId2 := "nft-id-2"
+
+	auth = types.NewCreateAuctionAuthorization(classId1, nftId1)
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgCreateAuction{
+		ClassId: classId1,
+		NftId:   nftId1,
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCreateAuction{
+		ClassId: classId1,
+		NftId:   nftId2,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.Error(t, err)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgCreateAuction{
+		ClassId: classId2,
+		NftId:   nftId1,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgMintNFT{}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	auth = types.NewCreateAuctionAuthorization(classId1, "")
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgCreateAuction{
+		ClassId: classId1,
+		NftId:   nftId1,
+	}
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCreateAuction{
+		ClassId: classId1,
+		NftId:   nftId2,
+	}
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCreateAuction{
+		ClassId: classId2,
+		NftId:   nftId1,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestCancelAuctionAuthorization(t *testing.T) {
+	var auth *types.CancelAuctionAuthorization
+	var msg sdk.Msg
+	var res authz.AcceptResponse
+	var err error
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	classId1, err := types.NewClassIdForAccount(sdk.AccAddress{1}, 1)
+	require.NoError(t, err)
+	classId2, err := types.NewClassIdForAccount(sdk.AccAddress{2}, 1)
+	require.NoError(t, err)
+	nftId1 := "nft-id-1"
+	nftId2 := "nft-id-2"
+
+	auth = types.NewCancelAuctionAuthorization(classId1, nftId1)
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgCancelAuction{
+		ClassId: classId1,
+		NftId:   nftId1,
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCancelAuction{
+		ClassId: classId1,
+		NftId:   nftId2,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgCancelAuction{
+		ClassId: classId2,
+		NftId:   nftId1,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgMintNFT{}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	auth = types.NewCancelAuctionAuthorization(classId1, "")
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgCancelAuction{
+		ClassId: classId1,
+		NftId:   nftId1,
+	}
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCancelAuction{
+		ClassId: classId1,
+		NftId:   nftId2,
+	}
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCancelAuction{
+		ClassId: classId2,
+		NftId:   nftId1,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestPlaceBidAuthorization(t *testing.T) {
+	var auth *types.PlaceBidAuthorization
+	var msg sdk.Msg
+	var res authz.AcceptResponse
+	var err error
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	classId1, err := types.NewClassIdForAccount(sdk.AccAddress{1}, 1)
+	require.NoError(t, err)
+	classId2, err := types.NewClassIdForAccount(sdk.AccAddress{2}, 1)
+	require.NoError(t, err)
+	nftId1 := "nft-id-1"
+	nftId2 := "nft-id-2"
+
+	auth = types.NewPlaceBidAuthorization(classId1, nftId1)
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgPlaceBid{
+		ClassId: classId1,
+		NftId:   nftId1,
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgPlaceBid{
+		ClassId: classId1,
+		NftId:   nftId2,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.Error(t, err)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgPlaceBid{
+		ClassId: classId2,
+		NftId:   nftId1,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgMintNFT{}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	auth = types.NewPlaceBidAuthorization(classId1, "")
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgPlaceBid{
+		ClassId: classId1,
+		NftId:   nftId1,
+	}
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgPlaceBid{
+		ClassId: classId1,
+		NftId:   nftId2,
+	}
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgPlaceBid{
+		ClassId: classId2,
+		NftId:   nftId1,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
@@ -0,0 +1,86 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+var _ authz.Authorization = &CreateClassOfferAuthorization{}
+var _ authz.Authorization = &UpdateClassOfferAuthorization{}
+var _ authz.Authorization = &DeleteClassOfferAuthorization{}
+
+func NewCreateClassOfferAuthorization(classId string) *CreateClassOfferAuthorization {
+	return &CreateClassOfferAuthorization{
+		ClassId: classId,
+	}
+}
+
+func (a CreateClassOfferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCreateClassOffer{})
+}
+
+func (a CreateClassOfferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgCreate, ok := msg.(*MsgCreateClassOffer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if msgCreate.ClassId != a.ClassId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("class ID mismatch")
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a CreateClassOfferAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func NewUpdateClassOfferAuthorization(classId string) *UpdateClassOfferAuthorization {
+	return &UpdateClassOfferAuthorization{
+		ClassId: classId,
+	}
+}
+
+func (a UpdateClassOfferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgUpdateClassOffer{})
+}
+
+func (a UpdateClassOfferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgUpdate, ok := msg.(*MsgUpdateClassOffer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if msgUpdate.ClassId != a.ClassId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("class ID mismatch")
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a UpdateClassOfferAuthorization) ValidateBasic() error {
+	return nil
+}
+
+func NewDeleteClassOfferAuthorization(classId string) *DeleteClassOfferAuthorization {
+	return &DeleteClassOfferAuthorization{
+		ClassId: classId,
+	}
+}
+
+func (a DeleteClassOfferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgDeleteClassOffer{})
+}
+
+func (a DeleteClassOfferAuthorization) Accept(ctx sdk.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgDelete, ok := msg.(*MsgDeleteClassOffer)
+	if !ok {
+		return authz.AcceptResponse{}, sdkerrors.ErrInvalidType.Wrap("type mismatch")
+	}
+	if msgDelete.ClassId != a.ClassId {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("class ID mismatch")
+	}
+	return authz.AcceptResponse{Accept: true}, nil
+}
+
+func (a DeleteClassOfferAuthorization) ValidateBasic() error {
+	return nil
+}
@@ -0,0 +1,126 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/likecoin/likecoin-chain/v4/x/likenft/types"
+)
+
+func TestCreateClassOfferAuthorization(t *testing.T) {
+	var auth *types.CreateClassOfferAuthorization
+	var msg sdk.Msg
+	var res authz.AcceptResponse
+	var err error
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	classId1, err := types.NewClassIdForAccount(sdk.AccAddress{1}, 1)
+	require.NoError(t, err)
+	classId2, err := types.NewClassIdForAccount(sdk.AccAddress{2}, 1)
+	require.NoError(t, err)
+
+	auth = types.NewCreateClassOfferAuthorization(classId1)
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgCreateClassOffer{
+		ClassId: classId1,
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgCreateClassOffer{
+		ClassId: classId2,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgMintNFT{}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestUpdateClassOfferAuthorization(t *testing.T) {
+	var auth *types.UpdateClassOfferAuthorization
+	var msg sdk.Msg
+	var res authz.AcceptResponse
+	var err error
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	classId1, err := types.NewClassIdForAccount(sdk.AccAddress{1}, 1)
+	require.NoError(t, err)
+	classId2, err := types.NewClassIdForAccount(sdk.AccAddress{2}, 1)
+	require.NoError(t, err)
+
+	auth = types.NewUpdateClassOfferAuthorization(classId1)
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgUpdateClassOffer{
+		ClassId: classId1,
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgUpdateClassOffer{
+		ClassId: classId2,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgMintNFT{}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
+
+func TestDeleteClassOfferAuthorization(t *testing.T) {
+	var auth *types.DeleteClassOfferAuthorization
+	var msg sdk.Msg
+	var res authz.AcceptResponse
+	var err error
+
+	ctx := sdk.NewContext(nil, tmproto.Header{}, false, nil)
+	classId1, err := types.NewClassIdForAccount(sdk.AccAddress{1}, 1)
+	require.NoError(t, err)
+	classId2, err := types.NewClassIdForAccount(sdk.AccAddress{2}, 1)
+	require.NoError(t, err)
+
+	auth = types.NewDeleteClassOfferAuthorization(classId1)
+	err = auth.ValidateBasic()
+	require.NoError(t, err)
+
+	msg = &types.MsgDeleteClassOffer{
+		ClassId: classId1,
+	}
+	require.Equal(t, sdk.MsgTypeURL(msg), auth.MsgTypeURL())
+	res, err = auth.Accept(ctx, msg)
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	require.Nil(t, res.Updated)
+
+	msg = &types.MsgDeleteClassOffer{
+		ClassId: classId2,
+	}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg = &types.MsgMintNFT{}
+	_, err = auth.Accept(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+}
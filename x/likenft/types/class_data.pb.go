@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -185,9 +186,9 @@ func (m *ClassParent) GetAccount() string {
 }
 
 type MintPeriod struct {
-	StartTime        time.Time `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
-	AllowedAddresses []string  `protobuf:"bytes,2,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
-	MintPrice        uint64    `protobuf:"varint,3,opt,name=mint_price,json=mintPrice,proto3" json:"mint_price,omitempty"`
+	StartTime        time.Time  `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	AllowedAddresses []string   `protobuf:"bytes,2,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
+	MintPrice        types.Coin `protobuf:"bytes,4,opt,name=mint_price,json=mintPrice,proto3" json:"mint_price"`
 }
 
 func (m *MintPeriod) Reset()         { *m = MintPeriod{} }
@@ -237,11 +238,11 @@ func (m *MintPeriod) GetAllowedAddresses() []string {
 	return nil
 }
 
-func (m *MintPeriod) GetMintPrice() uint64 {
+func (m *MintPeriod) GetMintPrice() types.Coin {
 	if m != nil {
 		return m.MintPrice
 	}
-	return 0
+	return types.Coin{}
 }
 
 type ClassConfig struct {
@@ -423,52 +424,55 @@ func init() {
 }
 
 var fileDescriptor_8851f84d0ef535e5 = []byte{
-	// 710 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x41, 0x4f, 0x1a, 0x41,
-	0x18, 0x65, 0x90, 0x28, 0x7c, 0x20, 0xe2, 0xc4, 0x03, 0x21, 0x29, 0x50, 0xd4, 0x84, 0xd8, 0xb8,
-	0x44, 0x5a, 0x0f, 0x3d, 0x35, 0x42, 0x7b, 0xa0, 0x4d, 0x91, 0xae, 0xd8, 0x26, 0x5e, 0x36, 0xb3,
-	0xbb, 0x03, 0x6e, 0xba, 0xcc, 0x6c, 0x76, 0x06, 0x8a, 0xbf, 0xa2, 0xfe, 0x81, 0xf6, 0xd0, 0x5f,
-	0x63, 0xd2, 0x8b, 0xc7, 0xa6, 0x07, 0xdb, 0xe8, 0x1f, 0x69, 0x66, 0x76, 0x41, 0x69, 0x4c, 0x4d,
-	0x6f, 0xb3, 0x6f, 0xde, 0xf7, 0xbe, 0xf9, 0xde, 0xbc, 0x1d, 0xd8, 0xf6, 0xbd, 0x8f, 0xd4, 0x39,
-	0x25, 0x1e, 0x6b, 0xa8, 0x15, 0x1b, 0xc8, 0xc6, 0x64, 0xaf, 0xe1, 0xf8, 0x44, 0x08, 0xcb, 0x25,
-	0x92, 0x18, 0x41, 0xc8, 0x25, 0xc7, 0x1b, 0x73, 0x9a, 0x11, 0xd3, 0x8c, 0xc9, 0x5e, 0x69, 0x63,
-	0xc8, 0x87, 0x5c, 0x13, 0x1a, 0x6a, 0x15, 0x71, 0x4b, 0x95, 0x21, 0xe7, 0x43, 0x9f, 0x36, 0xf4,
-	0x97, 0x3d, 0x1e, 0x34, 0xa4, 0x37, 0xa2, 0x42, 0x92, 0x51, 0x10, 0x11, 0x6a, 0x9f, 0x93, 0x90,
-	0x69, 0xab, 0x0e, 0x2f, 0x89, 0x24, 0x78, 0x17, 0xd2, 0x23, 0x2a, 0x89, 0x6a, 0x56, 0x44, 0x55,
-	0x54, 0xcf, 0xb5, 0xd6, 0x2f, 0xae, 0x2a, 0x89, 0x9f, 0x57, 0x95, 0xcc, 0x6b, 0xc1, 0x59, 0x87,
-	0x05, 0x63, 0x69, 0xce, 0x29, 0xf8, 0x05, 0x2c, 0x07, 0x24, 0xa4, 0x4c, 0x16, 0x93, 0x55, 0x54,
-	0xcf, 0x36, 0x1f, 0x1b, 0xf7, 0x1d, 0xcd, 0xd0, 0xfa, 0x3d, 0x4d, 0x6c, 0xa5, 0x94, 0x9e, 0x19,
-	0x97, 0x29, 0x01, 0x87, 0xb3, 0x81, 0x37, 0x2c, 0x2e, 0x3d, 0x28, 0xd0, 0xd6, 0xc4, 0x99, 0x40,
-	0x54, 0x86, 0xdf, 0xc1, 0x9a, 0xed, 0x7b, 0xcc, 0xb5, 0x6c, 0x3e, 0xb5, 0x84, 0x24, 0x92, 0x16,
-	0x53, 0x5a, 0x69, 0xf3, 0x7e, 0xa5, 0x96, 0x22, 0xb7, 0xf8, 0xf4, 0x48, 0x51, 0x63, 0xad, 0x55,
-	0xfb, 0x2e, 0x58, 0xfb, 0x8e, 0x20, 0x7b, 0xe7, 0xc4, 0xf8, 0x39, 0xa4, 0xe4, 0x59, 0x40, 0xb5,
-	0x1f, 0xf9, 0xe6, 0xf6, 0x83, 0x23, 0xf6, 0xcf, 0x02, 0x6a, 0xea, 0x12, 0xbc, 0x03, 0x79, 0x4f,
-	0x38, 0xcc, 0xf2, 0x5c, 0x2b, 0x08, 0xe9, 0xc0, 0x9b, 0x6a, 0x9f, 0x32, 0xba, 0x2f, 0x32, 0x73,
-	0x6a, 0xaf, 0xe3, 0xf6, 0xf4, 0x0e, 0xde, 0x87, 0x0d, 0xcd, 0x9d, 0xd0, 0x50, 0x78, 0x9c, 0x59,
-	0x44, 0x5a, 0x23, 0x8f, 0x49, 0x6d, 0x4c, 0x2a, 0xae, 0x58, 0x57, 0x8c, 0xf7, 0x11, 0xe1, 0x40,
-	0xbe, 0xf5, 0x98, 0xc4, 0x65, 0x58, 0x21, 0x8e, 0xc3, 0xc7, 0x4c, 0xea, 0xc1, 0x67, 0xda, 0x33,
-	0xb0, 0xf6, 0x05, 0x01, 0x28, 0x62, 0x8f, 0x86, 0x1e, 0x77, 0x71, 0x1b, 0x40, 0x48, 0x12, 0x4a,
-	0x4b, 0xe5, 0x40, 0x8f, 0x94, 0x6d, 0x96, 0x8c, 0x28, 0x24, 0xc6, 0x2c, 0x24, 0x46, 0x7f, 0x16,
-	0x92, 0x56, 0x5a, 0x39, 0x74, 0xfe, 0xab, 0x82, 0xcc, 0x8c, 0xae, 0x53, 0x3b, 0xf8, 0x09, 0xac,
-	0x13, 0xdf, 0xe7, 0x9f, 0xa8, 0x6b, 0x11, 0xd7, 0x0d, 0xa9, 0x10, 0x54, 0x14, 0x93, 0xd5, 0xa5,
-	0x7a, 0xc6, 0x2c, 0xc4, 0x1b, 0x07, 0x33, 0x1c, 0x3f, 0x02, 0x50, 0x73, 0x58, 0x41, 0xe8, 0x39,
-	0x34, 0x9a, 0xc6, 0xcc, 0x28, 0xa4, 0xa7, 0x80, 0xda, 0xd7, 0x99, 0xdb, 0xd1, 0xf5, 0xe2, 0x12,
-	0xa4, 0xed, 0x71, 0xc8, 0x88, 0xed, 0x47, 0xc7, 0x4b, 0x9b, 0xf3, 0x6f, 0x2d, 0x45, 0xa6, 0x96,
-	0x18, 0x07, 0x81, 0x7f, 0xa6, 0xad, 0x54, 0x52, 0x64, 0x7a, 0xa4, 0x01, 0xdc, 0x87, 0xc2, 0x6d,
-	0x16, 0x16, 0x62, 0xb5, 0xf5, 0xef, 0x30, 0xdc, 0x49, 0x16, 0x32, 0xf3, 0xf6, 0x02, 0x5a, 0xfb,
-	0x86, 0x20, 0xbf, 0x48, 0xc4, 0x1d, 0xc8, 0x45, 0x23, 0x69, 0x4f, 0x45, 0x11, 0x55, 0x97, 0xea,
-	0xd9, 0x66, 0xf5, 0xfe, 0x26, 0xb7, 0xe6, 0xc7, 0x71, 0xcb, 0x8e, 0xe6, 0x88, 0xc0, 0xaf, 0x20,
-	0x1b, 0xd2, 0x09, 0x25, 0x7e, 0x74, 0x21, 0xc9, 0xff, 0xb8, 0x10, 0x88, 0x0a, 0xd5, 0x56, 0xed,
-	0x04, 0x56, 0x17, 0x92, 0x8d, 0x37, 0x61, 0xd5, 0xe1, 0x4c, 0x52, 0x26, 0xad, 0x28, 0x1c, 0x48,
-	0xbb, 0x95, 0x8b, 0xc1, 0xb6, 0xc2, 0xf0, 0x16, 0xe4, 0x25, 0xb7, 0x6c, 0x6a, 0x45, 0x4a, 0xd4,
-	0xd5, 0xfd, 0xd3, 0x66, 0x4e, 0xf2, 0x16, 0x35, 0x63, 0x6c, 0x67, 0x1f, 0xd6, 0xfe, 0x4a, 0x37,
-	0xce, 0xc2, 0xca, 0x71, 0xf7, 0x4d, 0xf7, 0xf0, 0x43, 0xb7, 0x90, 0xc0, 0x69, 0x48, 0x75, 0x8e,
-	0xda, 0xdd, 0x02, 0x52, 0xf0, 0x41, 0xbb, 0x7d, 0x78, 0xdc, 0xed, 0x17, 0x92, 0xad, 0xc3, 0x8b,
-	0xeb, 0x32, 0xba, 0xbc, 0x2e, 0xa3, 0xdf, 0xd7, 0x65, 0x74, 0x7e, 0x53, 0x4e, 0x5c, 0xde, 0x94,
-	0x13, 0x3f, 0x6e, 0xca, 0x89, 0x93, 0xfd, 0xa1, 0x27, 0x4f, 0xc7, 0xb6, 0xe1, 0xf0, 0x91, 0x7e,
-	0xe7, 0x1c, 0x1e, 0x3f, 0x78, 0x6a, 0xb1, 0x1b, 0xbd, 0x7f, 0x93, 0x67, 0x8d, 0xe9, 0xfc, 0x11,
-	0x54, 0xff, 0x92, 0xb0, 0x97, 0xb5, 0x1b, 0x4f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0x35, 0xe0,
-	0x51, 0xe9, 0x26, 0x05, 0x00, 0x00,
+	// 755 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xc1, 0x6e, 0xea, 0x46,
+	0x14, 0xc5, 0x60, 0x25, 0x30, 0x10, 0x42, 0x46, 0x59, 0x50, 0xa4, 0x1a, 0x4a, 0x12, 0x09, 0xa5,
+	0x8a, 0x2d, 0x68, 0xb3, 0xe8, 0xa6, 0x55, 0x70, 0xbb, 0x20, 0x55, 0x09, 0x75, 0x48, 0x2b, 0x65,
+	0x63, 0x8d, 0xed, 0x81, 0x8c, 0x6a, 0xcf, 0x58, 0x9e, 0x81, 0x92, 0xaf, 0x68, 0xbe, 0xa0, 0x8b,
+	0x7e, 0x4b, 0x17, 0x91, 0xba, 0xc9, 0xb2, 0xea, 0x22, 0x7d, 0x4a, 0x7e, 0xe4, 0x69, 0xc6, 0x86,
+	0xc0, 0x53, 0xf4, 0xa2, 0xb7, 0x9b, 0x39, 0xf7, 0xdc, 0x33, 0x73, 0xef, 0x3d, 0x33, 0xe0, 0x28,
+	0x24, 0xbf, 0x61, 0xff, 0x06, 0x11, 0x6a, 0xc9, 0x15, 0x9d, 0x08, 0x6b, 0xde, 0xb5, 0xfc, 0x10,
+	0x71, 0xee, 0x06, 0x48, 0x20, 0x33, 0x4e, 0x98, 0x60, 0x70, 0x7f, 0x45, 0x33, 0x33, 0x9a, 0x39,
+	0xef, 0x36, 0x0c, 0x9f, 0xf1, 0x88, 0x71, 0xcb, 0x43, 0x1c, 0x5b, 0xf3, 0xae, 0x87, 0x05, 0xea,
+	0x5a, 0x3e, 0x23, 0x34, 0xcd, 0x6a, 0xec, 0x4f, 0xd9, 0x94, 0xa9, 0xa5, 0x25, 0x57, 0x19, 0xda,
+	0x9c, 0x32, 0x36, 0x0d, 0xb1, 0xa5, 0x76, 0xde, 0x6c, 0x62, 0x09, 0x12, 0x61, 0x2e, 0x50, 0x14,
+	0xa7, 0x84, 0xf6, 0x1f, 0x79, 0x50, 0xb2, 0xe5, 0x0d, 0xbe, 0x47, 0x02, 0xc1, 0x13, 0x50, 0x8c,
+	0xb0, 0x40, 0xf2, 0x32, 0x75, 0xad, 0xa5, 0x75, 0x2a, 0xfd, 0xbd, 0xfb, 0xc7, 0x66, 0xee, 0xbf,
+	0xc7, 0x66, 0xe9, 0x9c, 0x33, 0x3a, 0xa0, 0xf1, 0x4c, 0x38, 0x2b, 0x0a, 0xfc, 0x0e, 0x6c, 0xc5,
+	0x28, 0xc1, 0x54, 0xd4, 0xf3, 0x2d, 0xad, 0x53, 0xee, 0x7d, 0x61, 0xbe, 0x76, 0x75, 0x53, 0xe9,
+	0x8f, 0x14, 0xb1, 0xaf, 0x4b, 0x3d, 0x27, 0x4b, 0x93, 0x02, 0x3e, 0xa3, 0x13, 0x32, 0xad, 0x17,
+	0xde, 0x14, 0xb0, 0x15, 0x71, 0x29, 0x90, 0xa6, 0xc1, 0x9f, 0xc1, 0xae, 0x17, 0x12, 0x1a, 0xb8,
+	0x1e, 0x5b, 0xb8, 0x5c, 0x20, 0x81, 0xeb, 0xba, 0x52, 0x3a, 0x78, 0x5d, 0xa9, 0x2f, 0xc9, 0x7d,
+	0xb6, 0xb8, 0x94, 0xd4, 0x4c, 0x6b, 0xc7, 0x5b, 0x07, 0xdb, 0xff, 0x68, 0xa0, 0xbc, 0x76, 0x63,
+	0xf8, 0x0d, 0xd0, 0xc5, 0x6d, 0x8c, 0x55, 0x3f, 0xaa, 0xbd, 0xa3, 0x37, 0x4b, 0x1c, 0xdf, 0xc6,
+	0xd8, 0x51, 0x29, 0xf0, 0x18, 0x54, 0x09, 0xf7, 0xa9, 0x4b, 0x02, 0x37, 0x4e, 0xf0, 0x84, 0x2c,
+	0x54, 0x9f, 0x4a, 0xea, 0x5c, 0xcd, 0xa9, 0xc8, 0xd8, 0x20, 0x18, 0xa9, 0x08, 0x3c, 0x05, 0xfb,
+	0x8a, 0x3b, 0xc7, 0x09, 0x27, 0x8c, 0xba, 0x48, 0xb8, 0x11, 0xa1, 0x42, 0x35, 0x46, 0xcf, 0x32,
+	0xf6, 0x24, 0xe3, 0x97, 0x94, 0x70, 0x26, 0x7e, 0x22, 0x54, 0x40, 0x03, 0x6c, 0x23, 0xdf, 0x67,
+	0x33, 0x2a, 0xea, 0xfa, 0x9a, 0xf6, 0x12, 0x6c, 0xff, 0xad, 0x01, 0x20, 0x89, 0x23, 0x9c, 0x10,
+	0x16, 0x40, 0x1b, 0x00, 0x2e, 0x50, 0x22, 0x5c, 0xe9, 0x03, 0x55, 0x52, 0xb9, 0xd7, 0x30, 0x53,
+	0x93, 0x98, 0x4b, 0x93, 0x98, 0xe3, 0xa5, 0x49, 0xfa, 0x45, 0xd9, 0xa1, 0xbb, 0xff, 0x9b, 0x9a,
+	0x53, 0x52, 0x79, 0x32, 0x02, 0xbf, 0x04, 0x7b, 0x28, 0x0c, 0xd9, 0xef, 0x38, 0x70, 0x51, 0x10,
+	0x24, 0x98, 0x73, 0xcc, 0xeb, 0xf9, 0x56, 0xa1, 0x53, 0x72, 0x6a, 0x59, 0xe0, 0x6c, 0x89, 0xc3,
+	0x6f, 0x01, 0x90, 0x75, 0xb8, 0x71, 0x42, 0xfc, 0xe5, 0x70, 0x3e, 0x33, 0x53, 0x33, 0x9b, 0xd2,
+	0xcc, 0x66, 0x66, 0x66, 0xd3, 0x66, 0x84, 0x66, 0x23, 0x29, 0xc9, 0x94, 0x91, 0xcc, 0x38, 0xd7,
+	0x8b, 0x85, 0x9a, 0xde, 0xfe, 0x73, 0x39, 0x94, 0xd4, 0x05, 0xb0, 0x01, 0x8a, 0xde, 0x2c, 0xa1,
+	0xc8, 0x0b, 0xd3, 0x2a, 0x8a, 0xce, 0x6a, 0x0f, 0x3f, 0x07, 0x20, 0x42, 0x0b, 0x97, 0xcf, 0xe2,
+	0x38, 0xbc, 0x55, 0x1d, 0xd7, 0x9d, 0x52, 0x84, 0x16, 0x97, 0x0a, 0x80, 0x63, 0x50, 0x7b, 0xb1,
+	0xcc, 0x86, 0xfb, 0x0e, 0x3f, 0xee, 0x99, 0x35, 0x03, 0x6a, 0x4e, 0xd5, 0xdb, 0x40, 0xdb, 0x7f,
+	0x69, 0xa0, 0xba, 0x49, 0x84, 0x03, 0x50, 0x49, 0x2b, 0x57, 0xad, 0xe7, 0x75, 0xad, 0x55, 0xe8,
+	0x94, 0x7b, 0xad, 0xd7, 0x0f, 0x79, 0x99, 0x51, 0xd6, 0x82, 0x72, 0xb4, 0x42, 0x38, 0xfc, 0x01,
+	0x94, 0x13, 0x3c, 0xc7, 0x28, 0x4c, 0xe7, 0x96, 0xff, 0x84, 0xb9, 0x81, 0x34, 0x51, 0x86, 0xda,
+	0xd7, 0x60, 0x67, 0xe3, 0x01, 0xc0, 0x03, 0xb0, 0xe3, 0x33, 0x2a, 0x30, 0x15, 0x6e, 0xea, 0x21,
+	0x4d, 0x75, 0xab, 0x92, 0x81, 0xb6, 0xc4, 0xe0, 0x21, 0xa8, 0x0a, 0xe6, 0x7a, 0xd8, 0x4d, 0x95,
+	0x70, 0xa0, 0xce, 0x2f, 0x3a, 0x15, 0xc1, 0xfa, 0xd8, 0xc9, 0xb0, 0xe3, 0x53, 0xb0, 0xfb, 0xc1,
+	0x23, 0x80, 0x65, 0xb0, 0x7d, 0x35, 0xfc, 0x71, 0x78, 0xf1, 0xeb, 0xb0, 0x96, 0x83, 0x45, 0xa0,
+	0x0f, 0x2e, 0xed, 0x61, 0x4d, 0x93, 0xf0, 0x99, 0x6d, 0x5f, 0x5c, 0x0d, 0xc7, 0xb5, 0x7c, 0xff,
+	0xe2, 0xfe, 0xc9, 0xd0, 0x1e, 0x9e, 0x0c, 0xed, 0xdd, 0x93, 0xa1, 0xdd, 0x3d, 0x1b, 0xb9, 0x87,
+	0x67, 0x23, 0xf7, 0xef, 0xb3, 0x91, 0xbb, 0x3e, 0x9d, 0x12, 0x71, 0x33, 0xf3, 0x4c, 0x9f, 0x45,
+	0xea, 0xbb, 0x94, 0x7f, 0xdd, 0x6a, 0x71, 0x92, 0x7e, 0xa3, 0xf3, 0xaf, 0xad, 0xc5, 0xea, 0x2f,
+	0x95, 0x4f, 0x8e, 0x7b, 0x5b, 0xaa, 0x1b, 0x5f, 0xbd, 0x1f, 0x00, 0x55, 0x45, 0x26, 0x95, 0x6d,
+	0x05, 0x00, 0x00,
 }
 
 func (m *ClassData) Marshal() (dAtA []byte, err error) {
@@ -601,11 +605,16 @@ func (m *MintPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if m.MintPrice != 0 {
-		i = encodeVarintClassData(dAtA, i, uint64(m.MintPrice))
-		i--
-		dAtA[i] = 0x18
+	{
+		size, err := m.MintPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClassData(dAtA, i, uint64(size))
 	}
+	i--
+	dAtA[i] = 0x22
 	if len(m.AllowedAddresses) > 0 {
 		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowedAddresses[iNdEx])
@@ -615,12 +624,12 @@ func (m *MintPeriod) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x12
 		}
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintClassData(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintClassData(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
@@ -696,12 +705,12 @@ func (m *BlindBoxConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealTime):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.RevealTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.RevealTime):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintClassData(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintClassData(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x12
 	if len(m.MintPeriods) > 0 {
@@ -824,9 +833,8 @@ func (m *MintPeriod) Size() (n int) {
 			n += 1 + l + sovClassData(uint64(l))
 		}
 	}
-	if m.MintPrice != 0 {
-		n += 1 + sovClassData(uint64(m.MintPrice))
-	}
+	l = m.MintPrice.Size()
+	n += 1 + l + sovClassData(uint64(l))
 	return n
 }
 
@@ -1315,11 +1323,11 @@ func (m *MintPeriod) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
+		case 4:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field MintPrice", wireType)
 			}
-			m.MintPrice = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowClassData
@@ -1329,11 +1337,25 @@ func (m *MintPeriod) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MintPrice |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthClassData
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassData
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClassData(dAtA[iNdEx:])
@@ -0,0 +1,52 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+func (o ClassOffer) ToStoreRecord() ClassOfferStoreRecord {
+	buyer, err := sdk.AccAddressFromBech32(o.Buyer)
+	if err != nil {
+		panic(err)
+	}
+
+	return ClassOfferStoreRecord{
+		ClassId:    o.ClassId,
+		Buyer:      buyer,
+		Price:      o.Price,
+		Quantity:   o.Quantity,
+		Expiration: o.Expiration,
+	}
+}
+
+func (r ClassOfferStoreRecord) ToPublicRecord() ClassOffer {
+	return ClassOffer{
+		ClassId:    r.ClassId,
+		Buyer:      r.Buyer.String(),
+		Price:      r.Price,
+		Quantity:   r.Quantity,
+		Expiration: r.Expiration,
+	}
+}
+
+// Deposit returns the amount escrowed for the remaining quantity of the class offer
+func (r ClassOfferStoreRecord) Deposit() sdk.Coin {
+	return ClassOfferDeposit(r.Price, r.Quantity)
+}
+
+// ClassOfferDeposit returns the amount to escrow for buying the quantity of NFTs at the price
+func ClassOfferDeposit(price sdk.Coin, quantity uint64) sdk.Coin {
+	return sdk.NewCoin(price.Denom, price.Amount.Mul(sdk.NewIntFromUint64(quantity)))
+}
+
+func MapClassOffersToStoreRecords(classOffers []ClassOffer) (records []ClassOfferStoreRecord) {
+	for _, classOffer := range classOffers {
+		records = append(records, classOffer.ToStoreRecord())
+	}
+	return
+}
+
+func MapClassOffersToPublicRecords(records []ClassOfferStoreRecord) (classOffers []ClassOffer) {
+	for _, record := range records {
+		classOffers = append(classOffers, record.ToPublicRecord())
+	}
+	return
+}
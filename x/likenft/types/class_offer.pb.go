@@ -0,0 +1,891 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: likechain/likenft/v1/class_offer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ClassOffer is an offer to buy any NFT in a class, up to `quantity` NFTs at `price` each
+type ClassOffer struct {
+	ClassId    string     `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Buyer      string     `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Price      types.Coin `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	Quantity   uint64     `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Expiration time.Time  `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ClassOffer) Reset()         { *m = ClassOffer{} }
+func (m *ClassOffer) String() string { return proto.CompactTextString(m) }
+func (*ClassOffer) ProtoMessage()    {}
+func (*ClassOffer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b682efa3ca386c86, []int{0}
+}
+func (m *ClassOffer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassOffer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassOffer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassOffer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassOffer.Merge(m, src)
+}
+func (m *ClassOffer) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassOffer) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassOffer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassOffer proto.InternalMessageInfo
+
+func (m *ClassOffer) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassOffer) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
+}
+
+func (m *ClassOffer) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *ClassOffer) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *ClassOffer) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+type ClassOfferStoreRecord struct {
+	ClassId    string                                        `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Buyer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,2,opt,name=buyer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"buyer,omitempty"`
+	Price      types.Coin                                    `protobuf:"bytes,3,opt,name=price,proto3" json:"price"`
+	Quantity   uint64                                        `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Expiration time.Time                                     `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+}
+
+func (m *ClassOfferStoreRecord) Reset()         { *m = ClassOfferStoreRecord{} }
+func (m *ClassOfferStoreRecord) String() string { return proto.CompactTextString(m) }
+func (*ClassOfferStoreRecord) ProtoMessage()    {}
+func (*ClassOfferStoreRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b682efa3ca386c86, []int{1}
+}
+func (m *ClassOfferStoreRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassOfferStoreRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassOfferStoreRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassOfferStoreRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassOfferStoreRecord.Merge(m, src)
+}
+func (m *ClassOfferStoreRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassOfferStoreRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassOfferStoreRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassOfferStoreRecord proto.InternalMessageInfo
+
+func (m *ClassOfferStoreRecord) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
+}
+
+func (m *ClassOfferStoreRecord) GetBuyer() github_com_cosmos_cosmos_sdk_types.AccAddress {
+	if m != nil {
+		return m.Buyer
+	}
+	return nil
+}
+
+func (m *ClassOfferStoreRecord) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *ClassOfferStoreRecord) GetQuantity() uint64 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *ClassOfferStoreRecord) GetExpiration() time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*ClassOffer)(nil), "likechain.likenft.v1.ClassOffer")
+	proto.RegisterType((*ClassOfferStoreRecord)(nil), "likechain.likenft.v1.ClassOfferStoreRecord")
+}
+
+func init() {
+	proto.RegisterFile("likechain/likenft/v1/class_offer.proto", fileDescriptor_b682efa3ca386c86)
+}
+
+var fileDescriptor_b682efa3ca386c86 = []byte{
+	// 394 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xb1, 0x6e, 0xd3, 0x40,
+	0x1c, 0xc6, 0x7d, 0x25, 0x81, 0x70, 0x30, 0x59, 0x41, 0x72, 0x3d, 0xd8, 0x51, 0x07, 0x94, 0x25,
+	0x77, 0x32, 0xd0, 0x07, 0xa8, 0x8b, 0x84, 0x98, 0x2a, 0x19, 0x26, 0x16, 0x64, 0x9f, 0xcf, 0xee,
+	0xa9, 0xb1, 0xff, 0xe6, 0xee, 0x6c, 0x35, 0x6f, 0xd1, 0x81, 0x87, 0xea, 0xd8, 0x09, 0x31, 0x05,
+	0x94, 0xbc, 0x05, 0x13, 0xf2, 0x9d, 0x63, 0x32, 0x31, 0x77, 0xf2, 0xf7, 0x9d, 0xbe, 0xfb, 0xfb,
+	0xfb, 0xd9, 0x7f, 0xfc, 0x7a, 0x2d, 0x6e, 0x38, 0xbb, 0x4e, 0x45, 0x4d, 0x7b, 0x55, 0x17, 0x9a,
+	0x76, 0x11, 0x65, 0xeb, 0x54, 0xa9, 0xaf, 0x50, 0x14, 0x5c, 0x92, 0x46, 0x82, 0x06, 0x77, 0x3e,
+	0xe6, 0xc8, 0x90, 0x23, 0x5d, 0xe4, 0x07, 0x0c, 0x54, 0x05, 0x8a, 0x66, 0xa9, 0xe2, 0xb4, 0x8b,
+	0x32, 0xae, 0xd3, 0x88, 0x32, 0x10, 0xb5, 0xbd, 0xe5, 0xcf, 0x4b, 0x28, 0xc1, 0x48, 0xda, 0xab,
+	0xe1, 0x34, 0x2c, 0x01, 0xca, 0x35, 0xa7, 0xc6, 0x65, 0x6d, 0x41, 0xb5, 0xa8, 0xb8, 0xd2, 0x69,
+	0xd5, 0xd8, 0xc0, 0xd9, 0x0f, 0x84, 0xf1, 0x65, 0x5f, 0xe1, 0xaa, 0x6f, 0xe0, 0x9e, 0xe2, 0x99,
+	0x2d, 0x24, 0x72, 0x0f, 0x2d, 0xd0, 0xf2, 0x79, 0xf2, 0xcc, 0xf8, 0x8f, 0xb9, 0x3b, 0xc7, 0xd3,
+	0xac, 0xdd, 0x70, 0xe9, 0x9d, 0x98, 0x73, 0x6b, 0xdc, 0x73, 0x3c, 0x6d, 0xa4, 0x60, 0xdc, 0x7b,
+	0xb2, 0x40, 0xcb, 0x17, 0x6f, 0x4e, 0x89, 0xad, 0x49, 0xfa, 0x9a, 0x64, 0xa8, 0x49, 0x2e, 0x41,
+	0xd4, 0xf1, 0xe4, 0x7e, 0x1b, 0x3a, 0x89, 0x4d, 0xbb, 0x3e, 0x9e, 0x7d, 0x6b, 0xd3, 0x5a, 0x0b,
+	0xbd, 0xf1, 0x26, 0x0b, 0xb4, 0x9c, 0x24, 0xa3, 0x77, 0xdf, 0x63, 0xcc, 0x6f, 0x1b, 0x21, 0x53,
+	0x2d, 0xa0, 0xf6, 0xa6, 0x66, 0xae, 0x4f, 0x2c, 0x08, 0x39, 0x80, 0x90, 0xcf, 0x07, 0x90, 0x78,
+	0xd6, 0x0f, 0xbe, 0xfb, 0x15, 0xa2, 0xe4, 0xe8, 0xde, 0xd9, 0xf7, 0x13, 0xfc, 0xea, 0x1f, 0xd8,
+	0x27, 0x0d, 0x92, 0x27, 0x9c, 0x81, 0xcc, 0xff, 0xc7, 0xf8, 0xe1, 0x98, 0xf1, 0x65, 0x1c, 0xfd,
+	0xd9, 0x86, 0xab, 0x52, 0xe8, 0xeb, 0x36, 0x23, 0x0c, 0x2a, 0x3a, 0xfc, 0x02, 0xfb, 0x58, 0xa9,
+	0xfc, 0x86, 0xea, 0x4d, 0xc3, 0x15, 0xb9, 0x60, 0xec, 0x22, 0xcf, 0x25, 0x57, 0xea, 0xb1, 0x7f,
+	0x96, 0xf8, 0xea, 0x7e, 0x17, 0xa0, 0x87, 0x5d, 0x80, 0x7e, 0xef, 0x02, 0x74, 0xb7, 0x0f, 0x9c,
+	0x87, 0x7d, 0xe0, 0xfc, 0xdc, 0x07, 0xce, 0x97, 0xf3, 0x23, 0x50, 0xb3, 0x81, 0x20, 0xea, 0x51,
+	0xac, 0xec, 0xde, 0x76, 0xef, 0xe8, 0xed, 0xb8, 0xbc, 0x86, 0x3d, 0x7b, 0x6a, 0x5e, 0xfd, 0xf6,
+	0xef, 0x00, 0x84, 0x20, 0xac, 0x1f, 0xde, 0x02, 0x00, 0x00,
+}
+
+func (m *ClassOffer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassOffer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassOffer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintClassOffer(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.Quantity != 0 {
+		i = encodeVarintClassOffer(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClassOffer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintClassOffer(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintClassOffer(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClassOfferStoreRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassOfferStoreRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassOfferStoreRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintClassOffer(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x2a
+	if m.Quantity != 0 {
+		i = encodeVarintClassOffer(dAtA, i, uint64(m.Quantity))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClassOffer(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintClassOffer(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintClassOffer(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClassOffer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClassOffer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClassOffer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovClassOffer(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovClassOffer(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovClassOffer(uint64(l))
+	if m.Quantity != 0 {
+		n += 1 + sovClassOffer(uint64(m.Quantity))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovClassOffer(uint64(l))
+	return n
+}
+
+func (m *ClassOfferStoreRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovClassOffer(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovClassOffer(uint64(l))
+	}
+	l = m.Price.Size()
+	n += 1 + l + sovClassOffer(uint64(l))
+	if m.Quantity != 0 {
+		n += 1 + sovClassOffer(uint64(m.Quantity))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
+	n += 1 + l + sovClassOffer(uint64(l))
+	return n
+}
+
+func sovClassOffer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClassOffer(x uint64) (n int) {
+	return sovClassOffer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClassOffer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClassOffer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassOffer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassOffer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClassOffer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClassOfferStoreRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClassOffer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassOfferStoreRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassOfferStoreRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buyer = append(m.Buyer[:0], dAtA[iNdEx:postIndex]...)
+			if m.Buyer == nil {
+				m.Buyer = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quantity", wireType)
+			}
+			m.Quantity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Quantity |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClassOffer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClassOffer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClassOffer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClassOffer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClassOffer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClassOffer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClassOffer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClassOffer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClassOffer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClassOffer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClassOffer = fmt.Errorf("proto: unexpected end of group")
+)
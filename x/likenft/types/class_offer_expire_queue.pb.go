@@ -0,0 +1,382 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: likechain/likenft/v1/class_offer_expire_queue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+type ClassOfferExpireQueueEntry struct {
+	ExpireTime    time.Time `protobuf:"bytes,1,opt,name=expire_time,json=expireTime,proto3,stdtime" json:"expire_time"`
+	ClassOfferKey []byte    `protobuf:"bytes,2,opt,name=class_offer_key,json=classOfferKey,proto3" json:"class_offer_key,omitempty"`
+}
+
+func (m *ClassOfferExpireQueueEntry) Reset()         { *m = ClassOfferExpireQueueEntry{} }
+func (m *ClassOfferExpireQueueEntry) String() string { return proto.CompactTextString(m) }
+func (*ClassOfferExpireQueueEntry) ProtoMessage()    {}
+func (*ClassOfferExpireQueueEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f6e75be52862cc42, []int{0}
+}
+func (m *ClassOfferExpireQueueEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClassOfferExpireQueueEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClassOfferExpireQueueEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClassOfferExpireQueueEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClassOfferExpireQueueEntry.Merge(m, src)
+}
+func (m *ClassOfferExpireQueueEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClassOfferExpireQueueEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClassOfferExpireQueueEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClassOfferExpireQueueEntry proto.InternalMessageInfo
+
+func (m *ClassOfferExpireQueueEntry) GetExpireTime() time.Time {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return time.Time{}
+}
+
+func (m *ClassOfferExpireQueueEntry) GetClassOfferKey() []byte {
+	if m != nil {
+		return m.ClassOfferKey
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*ClassOfferExpireQueueEntry)(nil), "likechain.likenft.v1.ClassOfferExpireQueueEntry")
+}
+
+func init() {
+	proto.RegisterFile("likechain/likenft/v1/class_offer_expire_queue.proto", fileDescriptor_f6e75be52862cc42)
+}
+
+var fileDescriptor_f6e75be52862cc42 = []byte{
+	// 277 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xce, 0xc9, 0xcc, 0x4e,
+	0x4d, 0xce, 0x48, 0xcc, 0xcc, 0xd3, 0x07, 0xb1, 0xf2, 0xd2, 0x4a, 0xf4, 0xcb, 0x0c, 0xf5, 0x93,
+	0x73, 0x12, 0x8b, 0x8b, 0xe3, 0xf3, 0xd3, 0xd2, 0x52, 0x8b, 0xe2, 0x53, 0x2b, 0x0a, 0x32, 0x8b,
+	0x52, 0xe3, 0x0b, 0x4b, 0x53, 0x4b, 0x53, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0x44, 0xe0,
+	0x9a, 0xf4, 0xa0, 0x9a, 0xf4, 0xca, 0x0c, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3, 0xc1, 0x0a, 0xf4,
+	0x41, 0x2c, 0x88, 0x5a, 0x29, 0xf9, 0xf4, 0xfc, 0xfc, 0xf4, 0x9c, 0x54, 0x7d, 0x30, 0x2f, 0xa9,
+	0x34, 0x4d, 0xbf, 0x24, 0x33, 0x37, 0xb5, 0xb8, 0x24, 0x31, 0xb7, 0x00, 0xa2, 0x40, 0xa9, 0x9b,
+	0x91, 0x4b, 0xca, 0x19, 0x64, 0x9f, 0x3f, 0xc8, 0x3a, 0x57, 0xb0, 0x6d, 0x81, 0x20, 0xcb, 0x5c,
+	0xf3, 0x4a, 0x8a, 0x2a, 0x85, 0x5c, 0xb9, 0xb8, 0xa1, 0x2e, 0x00, 0x69, 0x94, 0x60, 0x54, 0x60,
+	0xd4, 0xe0, 0x36, 0x92, 0xd2, 0x83, 0x98, 0xaa, 0x07, 0x33, 0x55, 0x2f, 0x04, 0x66, 0xaa, 0x13,
+	0xc7, 0x89, 0x7b, 0xf2, 0x0c, 0x13, 0xee, 0xcb, 0x33, 0x06, 0x71, 0x41, 0x34, 0x82, 0xa4, 0x84,
+	0xd4, 0xb8, 0xf8, 0x91, 0x3d, 0x95, 0x9d, 0x5a, 0x29, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x13, 0xc4,
+	0x9b, 0x0c, 0xb7, 0xdb, 0x3b, 0xb5, 0xd2, 0xc9, 0xff, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4,
+	0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f,
+	0xe5, 0x18, 0xa2, 0x4c, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xc1, 0x41,
+	0x95, 0x9c, 0x9f, 0x99, 0x07, 0x67, 0xe8, 0x42, 0x82, 0xb0, 0xcc, 0x44, 0xbf, 0x02, 0x1e, 0x8e,
+	0x25, 0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0x27, 0x1a, 0x03, 0x06, 0x00, 0xd0, 0x99, 0x69,
+	0xe8, 0x69, 0x01, 0x00, 0x00,
+}
+
+func (m *ClassOfferExpireQueueEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClassOfferExpireQueueEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClassOfferExpireQueueEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassOfferKey) > 0 {
+		i -= len(m.ClassOfferKey)
+		copy(dAtA[i:], m.ClassOfferKey)
+		i = encodeVarintClassOfferExpireQueue(dAtA, i, uint64(len(m.ClassOfferKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.ExpireTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintClassOfferExpireQueue(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintClassOfferExpireQueue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovClassOfferExpireQueue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ClassOfferExpireQueueEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.ExpireTime)
+	n += 1 + l + sovClassOfferExpireQueue(uint64(l))
+	l = len(m.ClassOfferKey)
+	if l > 0 {
+		n += 1 + l + sovClassOfferExpireQueue(uint64(l))
+	}
+	return n
+}
+
+func sovClassOfferExpireQueue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozClassOfferExpireQueue(x uint64) (n int) {
+	return sovClassOfferExpireQueue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ClassOfferExpireQueueEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowClassOfferExpireQueue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClassOfferExpireQueueEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClassOfferExpireQueueEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOfferExpireQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClassOfferExpireQueue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOfferExpireQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.ExpireTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassOfferKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClassOfferExpireQueue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthClassOfferExpireQueue
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClassOfferExpireQueue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassOfferKey = append(m.ClassOfferKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClassOfferKey == nil {
+				m.ClassOfferKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipClassOfferExpireQueue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthClassOfferExpireQueue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipClassOfferExpireQueue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowClassOfferExpireQueue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClassOfferExpireQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowClassOfferExpireQueue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthClassOfferExpireQueue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupClassOfferExpireQueue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthClassOfferExpireQueue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthClassOfferExpireQueue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowClassOfferExpireQueue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupClassOfferExpireQueue = fmt.Errorf("proto: unexpected end of group")
+)
@@ -29,6 +29,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRoyaltyConfig{}, "likenft/CreateRoyaltyConfig", nil)
 	cdc.RegisterConcrete(&MsgUpdateRoyaltyConfig{}, "likenft/UpdateRoyaltyConfig", nil)
 	cdc.RegisterConcrete(&MsgDeleteRoyaltyConfig{}, "likenft/DeleteRoyaltyConfig", nil)
+	cdc.RegisterConcrete(&MsgCreateAuction{}, "likenft/CreateAuction", nil)
+	cdc.RegisterConcrete(&MsgCancelAuction{}, "likenft/CancelAuction", nil)
+	cdc.RegisterConcrete(&MsgPlaceBid{}, "likenft/PlaceBid", nil)
+	cdc.RegisterConcrete(&MsgCreateClassOffer{}, "likenft/CreateClassOffer", nil)
+	cdc.RegisterConcrete(&MsgUpdateClassOffer{}, "likenft/UpdateClassOffer", nil)
+	cdc.RegisterConcrete(&MsgDeleteClassOffer{}, "likenft/DeleteClassOffer", nil)
 	// this line is used by starport scaffolding # 2
 	cdc.RegisterConcrete(&ClassData{}, "likenft/ClassData", nil)
 	cdc.RegisterConcrete(&ClassParent{}, "likenft/ClassParent", nil)
@@ -44,6 +50,12 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&CreateOfferAuthorization{}, "likenft/CreateOfferAuthorization", nil)
 	cdc.RegisterConcrete(&UpdateOfferAuthorization{}, "likenft/UpdateOfferAuthorization", nil)
 	cdc.RegisterConcrete(&DeleteOfferAuthorization{}, "likenft/DeleteOfferAuthorization", nil)
+	cdc.RegisterConcrete(&CreateClassOfferAuthorization{}, "likenft/CreateClassOfferAuthorization", nil)
+	cdc.RegisterConcrete(&UpdateClassOfferAuthorization{}, "likenft/UpdateClassOfferAuthorization", nil)
+	cdc.RegisterConcrete(&DeleteClassOfferAuthorization{}, "likenft/DeleteClassOfferAuthorization", nil)
+	cdc.RegisterConcrete(&CreateAuctionAuthorization{}, "likenft/CreateAuctionAuthorization", nil)
+	cdc.RegisterConcrete(&CancelAuctionAuthorization{}, "likenft/CancelAuctionAuthorization", nil)
+	cdc.RegisterConcrete(&PlaceBidAuthorization{}, "likenft/PlaceBidAuthorization", nil)
 	cdc.RegisterConcrete(&NewClassAuthorization{}, "likenft/NewClassAuthorization", nil)
 	cdc.RegisterConcrete(&UpdateClassAuthorization{}, "likenft/UpdateClassAuthorization", nil)
 	cdc.RegisterConcrete(&SendNFTAuthorization{}, "likenft/SendNFTAuthorization", nil)
@@ -93,6 +105,16 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgUpdateRoyaltyConfig{},
 		&MsgDeleteRoyaltyConfig{},
 	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateAuction{},
+		&MsgCancelAuction{},
+		&MsgPlaceBid{},
+	)
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateClassOffer{},
+		&MsgUpdateClassOffer{},
+		&MsgDeleteClassOffer{},
+	)
 	// this line is used by starport scaffolding # 3
 	registry.RegisterImplementations((*proto.Message)(nil), &ClassData{})
 	registry.RegisterImplementations((*proto.Message)(nil), &ClassParent{})
@@ -110,6 +132,12 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&CreateOfferAuthorization{},
 		&UpdateOfferAuthorization{},
 		&DeleteOfferAuthorization{},
+		&CreateClassOfferAuthorization{},
+		&UpdateClassOfferAuthorization{},
+		&DeleteClassOfferAuthorization{},
+		&CreateAuctionAuthorization{},
+		&CancelAuctionAuthorization{},
+		&PlaceBidAuthorization{},
 		&NewClassAuthorization{},
 		&UpdateClassAuthorization{},
 		&SendNFTAuthorization{},
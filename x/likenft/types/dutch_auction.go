@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Validate checks the dutch auction against the start price of the listing
+func (c DutchAuctionConfig) Validate(startPrice sdk.Coin) error {
+	if err := ValidatePrice(c.FloorPrice); err != nil {
+		return err
+	}
+	if c.FloorPrice.Denom != startPrice.Denom {
+		return fmt.Errorf("floor price denom %s does not match price denom %s", c.FloorPrice.Denom, startPrice.Denom)
+	}
+	if c.FloorPrice.Amount.GT(startPrice.Amount) {
+		return fmt.Errorf("floor price %s is higher than price %s", c.FloorPrice, startPrice)
+	}
+	if !c.StartTime.Before(c.EndTime) {
+		return fmt.Errorf("start time must be before end time")
+	}
+	return nil
+}
+
+// PriceAt returns the price declined from the start price at the given time. The price stays at the start price
+// before the start time and at the floor price after the end time, and declines linearly in between, in steps of
+// `StepSeconds` if set. Partial amounts are rounded up in favor of the seller.
+func (c DutchAuctionConfig) PriceAt(startPrice sdk.Coin, t time.Time) sdk.Coin {
+	if !t.After(c.StartTime) {
+		return startPrice
+	}
+	if !t.Before(c.EndTime) {
+		return c.FloorPrice
+	}
+	elapsed := t.Sub(c.StartTime)
+	if c.StepSeconds > 0 {
+		step := time.Duration(c.StepSeconds) * time.Second
+		elapsed -= elapsed % step
+	}
+	duration := c.EndTime.Sub(c.StartTime)
+	drop := startPrice.Amount.Sub(c.FloorPrice.Amount).
+		Mul(sdk.NewInt(int64(elapsed))).
+		Quo(sdk.NewInt(int64(duration)))
+	return sdk.NewCoin(startPrice.Denom, startPrice.Amount.Sub(drop))
+}
+
+// CurrentPrice returns the price to buy the listing at the given time
+func (r ListingStoreRecord) CurrentPrice(t time.Time) sdk.Coin {
+	if r.DutchAuction == nil {
+		return r.Price
+	}
+	return r.DutchAuction.PriceAt(r.Price, t)
+}
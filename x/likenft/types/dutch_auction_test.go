@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDutchAuctionConfig_Validate(t *testing.T) {
+	startPrice := sdk.NewInt64Coin("nanolike", 1000)
+	startTime := time.Unix(1600000000, 0)
+	endTime := time.Unix(1600001000, 0)
+	tests := []struct {
+		name   string
+		config DutchAuctionConfig
+		valid  bool
+	}{
+		{
+			name: "valid",
+			config: DutchAuctionConfig{
+				FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+				StartTime:  startTime,
+				EndTime:    endTime,
+			},
+			valid: true,
+		}, {
+			name: "floor price equals start price",
+			config: DutchAuctionConfig{
+				FloorPrice: startPrice,
+				StartTime:  startTime,
+				EndTime:    endTime,
+			},
+			valid: true,
+		}, {
+			name: "floor price above start price",
+			config: DutchAuctionConfig{
+				FloorPrice: sdk.NewInt64Coin("nanolike", 1001),
+				StartTime:  startTime,
+				EndTime:    endTime,
+			},
+		}, {
+			name: "floor price in other denom",
+			config: DutchAuctionConfig{
+				FloorPrice: sdk.NewInt64Coin("uusdc", 100),
+				StartTime:  startTime,
+				EndTime:    endTime,
+			},
+		}, {
+			name: "invalid floor price",
+			config: DutchAuctionConfig{
+				FloorPrice: sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+				StartTime:  startTime,
+				EndTime:    endTime,
+			},
+		}, {
+			name: "end time equals start time",
+			config: DutchAuctionConfig{
+				FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+				StartTime:  startTime,
+				EndTime:    startTime,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate(startPrice)
+			if tt.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestDutchAuctionConfig_PriceAt(t *testing.T) {
+	startPrice := sdk.NewInt64Coin("nanolike", 1000)
+	startTime := time.Unix(1600000000, 0)
+	continuous := DutchAuctionConfig{
+		FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+		StartTime:  startTime,
+		EndTime:    startTime.Add(1000 * time.Second),
+	}
+	stepwise := continuous
+	stepwise.StepSeconds = 300
+	tests := []struct {
+		name     string
+		config   DutchAuctionConfig
+		offset   time.Duration
+		expected int64
+	}{
+		{"before start", continuous, -time.Second, 1000},
+		{"at start", continuous, 0, 1000},
+		{"continuous quarter", continuous, 250 * time.Second, 775},
+		{"continuous partial second", continuous, 1500 * time.Millisecond, 999},
+		{"at end", continuous, 1000 * time.Second, 100},
+		{"after end", continuous, 2000 * time.Second, 100},
+		{"stepwise before first step", stepwise, 299 * time.Second, 1000},
+		{"stepwise first step", stepwise, 300 * time.Second, 730},
+		{"stepwise last step", stepwise, 999 * time.Second, 190},
+		{"stepwise at end", stepwise, 1000 * time.Second, 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			price := tt.config.PriceAt(startPrice, startTime.Add(tt.offset))
+			require.Equal(t, sdk.NewInt64Coin("nanolike", tt.expected), price)
+		})
+	}
+}
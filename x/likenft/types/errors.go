@@ -50,4 +50,18 @@ var (
 	ErrRoyaltyConfigAlreadyExists        = sdkerrors.Register(ModuleName, 42, "Royalty config already exists")
 	ErrRoyaltyConfigNotFound             = sdkerrors.Register(ModuleName, 43, "Royalty config not found")
 	ErrInvalidRoyaltyConfig              = sdkerrors.Register(ModuleName, 44, "Royalty config invalid")
+	ErrInvalidPrice                      = sdkerrors.Register(ModuleName, 45, "Invalid price")
+	ErrAuctionNotFound                   = sdkerrors.Register(ModuleName, 46, "Existing auction not found")
+	ErrAuctionAlreadyExists              = sdkerrors.Register(ModuleName, 47, "Auction already exists")
+	ErrFailedToCreateAuction             = sdkerrors.Register(ModuleName, 48, "Failed to create auction")
+	ErrFailedToCancelAuction             = sdkerrors.Register(ModuleName, 49, "Failed to cancel auction")
+	ErrFailedToPlaceBid                  = sdkerrors.Register(ModuleName, 50, "Failed to place bid")
+	ErrAuctionEnded                      = sdkerrors.Register(ModuleName, 51, "Auction ended")
+	ErrFailedToSettleAuction             = sdkerrors.Register(ModuleName, 52, "Failed to settle auction")
+	ErrClassOfferNotFound                = sdkerrors.Register(ModuleName, 53, "Existing class offer not found")
+	ErrClassOfferAlreadyExists           = sdkerrors.Register(ModuleName, 54, "Class offer already exists")
+	ErrFailedToCreateClassOffer          = sdkerrors.Register(ModuleName, 55, "Failed to create class offer")
+	ErrFailedToUpdateClassOffer          = sdkerrors.Register(ModuleName, 56, "Failed to update class offer")
+	ErrFailedToDeleteClassOffer          = sdkerrors.Register(ModuleName, 57, "Failed to delete class offer")
+	ErrFailedToExpireClassOffer          = sdkerrors.Register(ModuleName, 58, "Failed to expire class offer")
 )
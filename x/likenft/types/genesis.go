@@ -134,6 +134,62 @@ func (gs GenesisState) Validate() error {
 		}
 		royaltyConfigByClassIndexMap[index] = struct{}{}
 	}
+	// Check for duplicated index in auction
+	auctionIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AuctionList {
+		if _, err := sdk.AccAddressFromBech32(elem.Seller); err != nil {
+			return fmt.Errorf("Invalid account address: %s", err.Error())
+		}
+		if elem.HighestBidder != "" {
+			if _, err := sdk.AccAddressFromBech32(elem.HighestBidder); err != nil {
+				return fmt.Errorf("Invalid account address: %s", err.Error())
+			}
+		}
+		index := string(AuctionKey(elem.ClassId, elem.NftId))
+		if _, ok := auctionIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for auction")
+		}
+		auctionIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in auctionEndQueueEntry
+	auctionEndQueueEntryIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.AuctionEndQueue {
+		index := string(AuctionEndQueueKey(elem.EndTime, elem.AuctionKey))
+		if _, ok := auctionEndQueueEntryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for auctionEndQueueEntry")
+		}
+		auctionEndQueueEntryIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in classOffer
+	classOfferIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ClassOfferList {
+		acc, err := sdk.AccAddressFromBech32(elem.Buyer)
+		if err != nil {
+			return fmt.Errorf("Invalid account address: %s", err.Error())
+		}
+		index := string(ClassOfferKey(elem.ClassId, acc))
+		if _, ok := classOfferIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for classOffer")
+		}
+		classOfferIndexMap[index] = struct{}{}
+	}
+	// Check for duplicated index in classOfferExpireQueueEntry
+	classOfferExpireQueueEntryIndexMap := make(map[string]struct{})
+
+	for _, elem := range gs.ClassOfferExpireQueue {
+		index := string(ClassOfferExpireQueueKey(elem.ExpireTime, elem.ClassOfferKey))
+		if _, ok := classOfferExpireQueueEntryIndexMap[index]; ok {
+			return fmt.Errorf("duplicated index for classOfferExpireQueueEntry")
+		}
+		classOfferExpireQueueEntryIndexMap[index] = struct{}{}
+	}
+	// Check collected fees
+	if err := gs.CollectedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid collected fees: %w", err)
+	}
 	// this line is used by starport scaffolding # genesis/types/validate
 
 	return gs.Params.Validate()
@@ -7,6 +7,7 @@ import (
 	fmt "fmt"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -25,16 +26,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState defines the likenft module's genesis state.
 type GenesisState struct {
-	Params                   Params                    `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ClassesByIscnList        []ClassesByISCN           `protobuf:"bytes,2,rep,name=classes_by_iscn_list,json=classesByIscnList,proto3" json:"classes_by_iscn_list"`
-	ClassesByAccountList     []ClassesByAccount        `protobuf:"bytes,3,rep,name=classes_by_account_list,json=classesByAccountList,proto3" json:"classes_by_account_list"`
-	BlindBoxContentList      []BlindBoxContent         `protobuf:"bytes,4,rep,name=blind_box_content_list,json=blindBoxContentList,proto3" json:"blind_box_content_list"`
-	ClassRevealQueue         []ClassRevealQueueEntry   `protobuf:"bytes,5,rep,name=class_reveal_queue,json=classRevealQueue,proto3" json:"class_reveal_queue"`
-	OfferList                []Offer                   `protobuf:"bytes,6,rep,name=offer_list,json=offerList,proto3" json:"offer_list"`
-	ListingList              []Listing                 `protobuf:"bytes,7,rep,name=listing_list,json=listingList,proto3" json:"listing_list"`
-	OfferExpireQueue         []OfferExpireQueueEntry   `protobuf:"bytes,8,rep,name=offer_expire_queue,json=offerExpireQueue,proto3" json:"offer_expire_queue"`
-	ListingExpireQueue       []ListingExpireQueueEntry `protobuf:"bytes,9,rep,name=listing_expire_queue,json=listingExpireQueue,proto3" json:"listing_expire_queue"`
-	RoyaltyConfigByClassList []RoyaltyConfigByClass    `protobuf:"bytes,10,rep,name=royalty_config_by_class_list,json=royaltyConfigByClassList,proto3" json:"royalty_config_by_class_list"`
+	Params                   Params                       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ClassesByIscnList        []ClassesByISCN              `protobuf:"bytes,2,rep,name=classes_by_iscn_list,json=classesByIscnList,proto3" json:"classes_by_iscn_list"`
+	ClassesByAccountList     []ClassesByAccount           `protobuf:"bytes,3,rep,name=classes_by_account_list,json=classesByAccountList,proto3" json:"classes_by_account_list"`
+	BlindBoxContentList      []BlindBoxContent            `protobuf:"bytes,4,rep,name=blind_box_content_list,json=blindBoxContentList,proto3" json:"blind_box_content_list"`
+	ClassRevealQueue         []ClassRevealQueueEntry      `protobuf:"bytes,5,rep,name=class_reveal_queue,json=classRevealQueue,proto3" json:"class_reveal_queue"`
+	OfferList                []Offer                      `protobuf:"bytes,6,rep,name=offer_list,json=offerList,proto3" json:"offer_list"`
+	ListingList              []Listing                    `protobuf:"bytes,7,rep,name=listing_list,json=listingList,proto3" json:"listing_list"`
+	OfferExpireQueue         []OfferExpireQueueEntry      `protobuf:"bytes,8,rep,name=offer_expire_queue,json=offerExpireQueue,proto3" json:"offer_expire_queue"`
+	ListingExpireQueue       []ListingExpireQueueEntry    `protobuf:"bytes,9,rep,name=listing_expire_queue,json=listingExpireQueue,proto3" json:"listing_expire_queue"`
+	RoyaltyConfigByClassList []RoyaltyConfigByClass       `protobuf:"bytes,10,rep,name=royalty_config_by_class_list,json=royaltyConfigByClassList,proto3" json:"royalty_config_by_class_list"`
+	CollectedFees            types.CollectedFees          `protobuf:"bytes,11,opt,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
+	AuctionList              []Auction                    `protobuf:"bytes,12,rep,name=auction_list,json=auctionList,proto3" json:"auction_list"`
+	AuctionEndQueue          []AuctionEndQueueEntry       `protobuf:"bytes,13,rep,name=auction_end_queue,json=auctionEndQueue,proto3" json:"auction_end_queue"`
+	ClassOfferList           []ClassOffer                 `protobuf:"bytes,14,rep,name=class_offer_list,json=classOfferList,proto3" json:"class_offer_list"`
+	ClassOfferExpireQueue    []ClassOfferExpireQueueEntry `protobuf:"bytes,15,rep,name=class_offer_expire_queue,json=classOfferExpireQueue,proto3" json:"class_offer_expire_queue"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -140,6 +146,41 @@ func (m *GenesisState) GetRoyaltyConfigByClassList() []RoyaltyConfigByClass {
 	return nil
 }
 
+func (m *GenesisState) GetCollectedFees() types.CollectedFees {
+	if m != nil {
+		return m.CollectedFees
+	}
+	return types.CollectedFees{}
+}
+
+func (m *GenesisState) GetAuctionList() []Auction {
+	if m != nil {
+		return m.AuctionList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuctionEndQueue() []AuctionEndQueueEntry {
+	if m != nil {
+		return m.AuctionEndQueue
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassOfferList() []ClassOffer {
+	if m != nil {
+		return m.ClassOfferList
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClassOfferExpireQueue() []ClassOfferExpireQueueEntry {
+	if m != nil {
+		return m.ClassOfferExpireQueue
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "likechain.likenft.v1.GenesisState")
 }
@@ -149,42 +190,52 @@ func init() {
 }
 
 var fileDescriptor_e01c79fdac411e6f = []byte{
-	// 555 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x5b, 0x36, 0x3a, 0xe6, 0xee, 0x00, 0xa1, 0x82, 0xa8, 0x8c, 0x50, 0x86, 0x40, 0x63,
-	0xd0, 0x44, 0x1b, 0x70, 0xe1, 0x04, 0xa9, 0x06, 0x42, 0x9a, 0x18, 0x74, 0xb7, 0x5d, 0x42, 0x62,
-	0xdc, 0xce, 0x22, 0xb3, 0x43, 0xec, 0x56, 0xcd, 0xb7, 0x40, 0x7c, 0xaa, 0x1d, 0x77, 0xe4, 0x84,
-	0x50, 0xfb, 0x45, 0x90, 0x9f, 0xbd, 0x4a, 0x6d, 0xbc, 0xf4, 0x16, 0xd9, 0xbf, 0xf7, 0xff, 0xbf,
-	0xe7, 0xf7, 0x5e, 0xd0, 0x4e, 0x4a, 0x7f, 0x10, 0x7c, 0x16, 0x53, 0x16, 0xa8, 0x2f, 0x36, 0x90,
-	0xc1, 0x78, 0x3f, 0x18, 0x12, 0x46, 0x04, 0x15, 0x7e, 0x96, 0x73, 0xc9, 0x9d, 0xd6, 0x9c, 0xf1,
-	0x0d, 0xe3, 0x8f, 0xf7, 0xdb, 0xad, 0x21, 0x1f, 0x72, 0x00, 0x02, 0xf5, 0xa5, 0xd9, 0xf6, 0x4b,
-	0xab, 0x5e, 0x92, 0x52, 0xf6, 0x3d, 0x4a, 0xf8, 0x24, 0xc2, 0x9c, 0x49, 0xc2, 0xa4, 0xa1, 0xbb,
-	0x56, 0x1a, 0xa7, 0xb1, 0x10, 0x51, 0x4e, 0xc6, 0x24, 0x4e, 0xa3, 0x9f, 0x23, 0x32, 0x22, 0xab,
-	0x71, 0x22, 0xa2, 0xa4, 0x88, 0x62, 0x8c, 0xf9, 0x68, 0xae, 0xbe, 0xb7, 0x0a, 0xa7, 0x02, 0x33,
-	0xc3, 0xda, 0xdf, 0x21, 0xa5, 0x42, 0x52, 0x36, 0x34, 0x4c, 0x50, 0xc5, 0x44, 0x64, 0x92, 0xd1,
-	0x9c, 0x2c, 0xe4, 0xdb, 0xb1, 0x06, 0xf0, 0xc1, 0x80, 0xe4, 0x95, 0x15, 0x01, 0x61, 0x13, 0x7c,
-	0x6c, 0xc5, 0xb3, 0x38, 0x8f, 0xcf, 0x4d, 0xb3, 0xda, 0xcf, 0xad, 0x48, 0xce, 0x8b, 0x38, 0x95,
-	0x85, 0x7a, 0xfe, 0x01, 0x35, 0xf5, 0xec, 0xfc, 0xde, 0x40, 0x5b, 0x1f, 0x75, 0xa7, 0x4f, 0x64,
-	0x2c, 0x89, 0xf3, 0x16, 0x35, 0xb4, 0x96, 0x5b, 0xef, 0xd4, 0x77, 0x9b, 0x07, 0xdb, 0xbe, 0xad,
-	0xf3, 0xfe, 0x17, 0x60, 0xc2, 0xf5, 0x8b, 0xbf, 0x8f, 0x6a, 0x7d, 0x13, 0xe1, 0x9c, 0xa2, 0xd6,
-	0xd2, 0xcb, 0x46, 0xea, 0x65, 0xdc, 0x1b, 0x9d, 0xb5, 0xdd, 0xe6, 0xc1, 0x13, 0xbb, 0x52, 0x4f,
-	0x47, 0x84, 0xc5, 0xa7, 0x93, 0xde, 0x67, 0x23, 0x78, 0x07, 0xcf, 0x0f, 0x05, 0x66, 0x47, 0x54,
-	0x48, 0x07, 0xa3, 0xfb, 0xe5, 0x26, 0x6b, 0xf9, 0x35, 0x90, 0x7f, 0xb6, 0x42, 0xfe, 0xbd, 0x0e,
-	0x31, 0x0e, 0x2d, 0xbc, 0x74, 0x0e, 0x26, 0xdf, 0xd0, 0xbd, 0xd2, 0x98, 0x6a, 0x8f, 0x75, 0xf0,
-	0x78, 0x6a, 0xf7, 0x08, 0x55, 0x4c, 0xc8, 0x27, 0x3d, 0x1d, 0x61, 0x2c, 0xee, 0x26, 0x8b, 0xc7,
-	0xe0, 0x10, 0x21, 0xa7, 0x3c, 0xda, 0xee, 0x4d, 0x50, 0x7f, 0x51, 0x51, 0x41, 0x1f, 0xf0, 0xaf,
-	0x8a, 0x3e, 0x64, 0x32, 0x2f, 0x8c, 0xc7, 0x6d, 0xbc, 0x74, 0xe9, 0xbc, 0x43, 0x48, 0x8f, 0x0e,
-	0xa4, 0xdd, 0x00, 0xe1, 0x07, 0x76, 0xe1, 0x63, 0xc5, 0x19, 0xa1, 0x4d, 0x08, 0x82, 0x14, 0x3f,
-	0xa0, 0xad, 0xab, 0x79, 0x06, 0x8d, 0x0d, 0xd0, 0x78, 0x68, 0xd7, 0x38, 0xd2, 0xa4, 0x51, 0x69,
-	0x9a, 0xc0, 0xab, 0x52, 0xcb, 0x43, 0xec, 0xde, 0xaa, 0x2a, 0x15, 0x32, 0x3a, 0x04, 0xbc, 0x5c,
-	0x2a, 0x5f, 0xba, 0x74, 0x08, 0x6a, 0xd9, 0x16, 0xcf, 0xdd, 0x04, 0x8b, 0x6e, 0x65, 0xc2, 0xd7,
-	0x98, 0x38, 0x69, 0xe9, 0xda, 0xc9, 0xd0, 0xf6, 0xe2, 0xea, 0xa8, 0x01, 0xd4, 0x4d, 0x84, 0xf7,
-	0x41, 0x60, 0xb7, 0x67, 0xb7, 0xeb, 0xeb, 0xc8, 0x1e, 0x04, 0x86, 0x05, 0xf4, 0xd2, 0x78, 0xb9,
-	0xb9, 0xe5, 0x4e, 0xa5, 0x17, 0x1e, 0x5f, 0x4c, 0xbd, 0xfa, 0xe5, 0xd4, 0xab, 0xff, 0x9b, 0x7a,
-	0xf5, 0x5f, 0x33, 0xaf, 0x76, 0x39, 0xf3, 0x6a, 0x7f, 0x66, 0x5e, 0xed, 0xf4, 0xcd, 0x90, 0xca,
-	0xb3, 0x51, 0xe2, 0x63, 0x7e, 0xae, 0xff, 0x44, 0xdc, 0xec, 0xb8, 0xfa, 0xe8, 0xea, 0x95, 0x1f,
-	0xbf, 0x0e, 0x26, 0xf3, 0xbd, 0x97, 0x45, 0x46, 0x44, 0xd2, 0x80, 0x65, 0x7f, 0xf5, 0x3f, 0x00,
-	0x00, 0xff, 0xff, 0xe5, 0x05, 0x39, 0xcc, 0xea, 0x05, 0x00, 0x00,
+	// 708 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6e, 0xd3, 0x4a,
+	0x14, 0xc6, 0x93, 0xdb, 0xde, 0xde, 0xdb, 0x49, 0xff, 0xfa, 0xe6, 0x82, 0x55, 0x4a, 0x08, 0x45,
+	0x54, 0xa5, 0x10, 0x9b, 0xb6, 0xb0, 0x61, 0x45, 0x13, 0xb5, 0x08, 0xa9, 0xa2, 0x25, 0xdd, 0x55,
+	0x48, 0xc6, 0x99, 0x1c, 0xa7, 0x16, 0xee, 0x4c, 0xb0, 0x27, 0x51, 0xfc, 0x16, 0x3c, 0x56, 0x77,
+	0x74, 0xc9, 0x0a, 0xa1, 0xf6, 0x45, 0x90, 0xcf, 0x8c, 0xa3, 0x24, 0x9e, 0x38, 0xec, 0xac, 0x99,
+	0xdf, 0xf9, 0xbe, 0x33, 0xe7, 0x9c, 0xf1, 0x90, 0xad, 0xc0, 0xff, 0x02, 0xf4, 0xd2, 0xf5, 0x99,
+	0x9d, 0x7c, 0x31, 0x4f, 0xd8, 0xfd, 0x3d, 0xbb, 0x03, 0x0c, 0x22, 0x3f, 0xb2, 0xba, 0x21, 0x17,
+	0xdc, 0x28, 0x0f, 0x19, 0x4b, 0x31, 0x56, 0x7f, 0x6f, 0xa3, 0xdc, 0xe1, 0x1d, 0x8e, 0x80, 0x9d,
+	0x7c, 0x49, 0x76, 0x63, 0x67, 0x5c, 0xcf, 0x03, 0xe8, 0x84, 0x2e, 0x13, 0xb6, 0x07, 0xe0, 0x84,
+	0xbc, 0x27, 0x7c, 0xd6, 0x51, 0xa4, 0xde, 0xd9, 0xed, 0x51, 0xe1, 0x73, 0xa6, 0x98, 0x17, 0x79,
+	0x8c, 0x03, 0xac, 0xed, 0x7c, 0xed, 0x41, 0x0f, 0x72, 0xe9, 0x56, 0xe0, 0xb3, 0xb6, 0xd3, 0xe2,
+	0x03, 0x87, 0x72, 0x26, 0x80, 0x09, 0x45, 0x6f, 0x6b, 0x69, 0x1a, 0xb8, 0x51, 0xe4, 0x70, 0xcf,
+	0x83, 0x50, 0x71, 0x07, 0xb3, 0x38, 0x07, 0x06, 0x5d, 0x3f, 0x84, 0xb1, 0x54, 0x6a, 0x39, 0x41,
+	0x21, 0xf4, 0xc1, 0x0d, 0xfe, 0x14, 0x87, 0xc8, 0x69, 0xc5, 0x8e, 0x4b, 0x29, 0xef, 0x0d, 0x53,
+	0xdf, 0x9d, 0x85, 0xfb, 0x11, 0x65, 0xb9, 0x65, 0x0e, 0xfc, 0x68, 0xa4, 0x15, 0x76, 0x1e, 0xa3,
+	0x3b, 0x5e, 0x55, 0x1b, 0x30, 0x5a, 0xb5, 0xda, 0x74, 0x42, 0x27, 0xf8, 0x58, 0x8b, 0x77, 0xdd,
+	0xd0, 0xbd, 0x52, 0x53, 0xb8, 0xf1, 0x4c, 0x8b, 0x84, 0x3c, 0x76, 0x03, 0x11, 0x27, 0xbd, 0xf5,
+	0x7c, 0x75, 0x9e, 0xad, 0xef, 0x84, 0x2c, 0xbd, 0x93, 0x23, 0x7c, 0x2e, 0x5c, 0x01, 0xc6, 0x1b,
+	0xb2, 0x20, 0xb5, 0xcc, 0x62, 0xb5, 0xb8, 0x53, 0xda, 0xdf, 0xb4, 0x74, 0x23, 0x6d, 0x9d, 0x21,
+	0x53, 0x9f, 0xbf, 0xfe, 0xf9, 0xa8, 0xd0, 0x54, 0x11, 0xc6, 0x05, 0x29, 0x4f, 0x54, 0xd6, 0x49,
+	0x2a, 0x63, 0xfe, 0x55, 0x9d, 0xdb, 0x29, 0xed, 0x3f, 0xd1, 0x2b, 0x35, 0x64, 0x44, 0x3d, 0x7e,
+	0x7f, 0xde, 0xf8, 0xa0, 0x04, 0xd7, 0xe9, 0x70, 0x31, 0xa2, 0xec, 0xc4, 0x8f, 0x84, 0x41, 0xc9,
+	0xfd, 0x6c, 0x93, 0xa5, 0xfc, 0x1c, 0xca, 0x6f, 0xcf, 0x90, 0x3f, 0x94, 0x21, 0xca, 0xa1, 0x4c,
+	0x27, 0xd6, 0xd1, 0xe4, 0x33, 0xb9, 0x97, 0xb9, 0x03, 0xd2, 0x63, 0x1e, 0x3d, 0x9e, 0xea, 0x3d,
+	0xea, 0x49, 0x4c, 0x9d, 0x0f, 0x1a, 0x32, 0x42, 0x59, 0xfc, 0xd7, 0x1a, 0x5f, 0x46, 0x07, 0x87,
+	0x18, 0xd9, 0xd1, 0x36, 0xff, 0x46, 0xf5, 0xe7, 0x39, 0x27, 0x68, 0x22, 0xfe, 0x31, 0xa1, 0x8f,
+	0x98, 0x08, 0x63, 0xe5, 0xb1, 0x46, 0x27, 0x36, 0x8d, 0xb7, 0x84, 0xc8, 0xd1, 0xc1, 0xb4, 0x17,
+	0x50, 0xf8, 0x81, 0x5e, 0xf8, 0x34, 0xe1, 0x94, 0xd0, 0x22, 0x06, 0x61, 0x8a, 0xc7, 0x64, 0x29,
+	0x9d, 0x67, 0xd4, 0xf8, 0x07, 0x35, 0x1e, 0xea, 0x35, 0x4e, 0x24, 0xa9, 0x54, 0x4a, 0x2a, 0x30,
+	0x3d, 0x6a, 0x76, 0x88, 0xcd, 0x7f, 0xf3, 0x8e, 0x8a, 0x19, 0x1d, 0x21, 0x9e, 0x3d, 0x2a, 0x9f,
+	0xd8, 0x34, 0x80, 0x94, 0x75, 0x17, 0xcf, 0x5c, 0x44, 0x8b, 0x5a, 0x6e, 0xc2, 0x53, 0x4c, 0x8c,
+	0x20, 0xb3, 0x6d, 0x74, 0xc9, 0xe6, 0xf8, 0xd5, 0x49, 0x06, 0x50, 0x36, 0x11, 0xeb, 0x43, 0xd0,
+	0x6e, 0x57, 0x6f, 0xd7, 0x94, 0x91, 0x0d, 0x0c, 0xac, 0xc7, 0xd8, 0x4b, 0xe5, 0x65, 0x86, 0x9a,
+	0x3d, 0xac, 0x5c, 0x93, 0xac, 0x50, 0x1e, 0x04, 0x40, 0x05, 0xb4, 0x1d, 0x0f, 0x20, 0x32, 0x4b,
+	0xd5, 0xa2, 0x66, 0xfc, 0xd2, 0x27, 0xc3, 0x6a, 0xa4, 0xf4, 0x31, 0x40, 0x2a, 0xbf, 0x4c, 0x47,
+	0x17, 0x93, 0xae, 0xa6, 0x8f, 0x01, 0x66, 0xbd, 0x94, 0xd7, 0xd5, 0x43, 0x49, 0xa6, 0x5d, 0x55,
+	0x81, 0x98, 0xdb, 0x27, 0xb2, 0x9e, 0x79, 0x54, 0xcc, 0xe5, 0xbc, 0x12, 0x28, 0xb1, 0x23, 0xd6,
+	0xce, 0x94, 0x7b, 0xd5, 0x1d, 0xdf, 0x33, 0xce, 0xc8, 0xda, 0xe8, 0x73, 0x81, 0x99, 0xae, 0xa0,
+	0x78, 0x35, 0xe7, 0x72, 0x8c, 0x0e, 0xf2, 0x0a, 0x1d, 0xae, 0x60, 0xbe, 0x9c, 0x98, 0xd3, 0x1e,
+	0x20, 0x73, 0x15, 0x95, 0x5f, 0xce, 0x52, 0x9e, 0x32, 0x2b, 0xff, 0x53, 0x1d, 0x51, 0x3f, 0xbd,
+	0xbe, 0xad, 0x14, 0x6f, 0x6e, 0x2b, 0xc5, 0x5f, 0xb7, 0x95, 0xe2, 0xb7, 0xbb, 0x4a, 0xe1, 0xe6,
+	0xae, 0x52, 0xf8, 0x71, 0x57, 0x29, 0x5c, 0xbc, 0xee, 0xf8, 0xe2, 0xb2, 0xd7, 0xb2, 0x28, 0xbf,
+	0x92, 0xcf, 0x08, 0xf7, 0xd9, 0xf0, 0xa3, 0x26, 0xff, 0xd7, 0xfd, 0x57, 0xf6, 0x60, 0xf8, 0xd3,
+	0x16, 0x71, 0x17, 0xa2, 0xd6, 0x02, 0xfe, 0xa9, 0x0f, 0x7e, 0x0f, 0x00, 0xa0, 0x4a, 0x23, 0x77,
+	0x80, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -207,6 +258,72 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ClassOfferExpireQueue) > 0 {
+		for iNdEx := len(m.ClassOfferExpireQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassOfferExpireQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.ClassOfferList) > 0 {
+		for iNdEx := len(m.ClassOfferList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassOfferList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.AuctionEndQueue) > 0 {
+		for iNdEx := len(m.AuctionEndQueue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionEndQueue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.AuctionList) > 0 {
+		for iNdEx := len(m.AuctionList) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AuctionList[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	{
+		size, err := m.CollectedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
 	if len(m.RoyaltyConfigByClassList) > 0 {
 		for iNdEx := len(m.RoyaltyConfigByClassList) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -419,6 +536,32 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.CollectedFees.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AuctionList) > 0 {
+		for _, e := range m.AuctionList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AuctionEndQueue) > 0 {
+		for _, e := range m.AuctionEndQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassOfferList) > 0 {
+		for _, e := range m.ClassOfferList {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClassOfferExpireQueue) > 0 {
+		for _, e := range m.ClassOfferExpireQueue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -796,6 +939,175 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CollectedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionList = append(m.AuctionList, Auction{})
+			if err := m.AuctionList[len(m.AuctionList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionEndQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AuctionEndQueue = append(m.AuctionEndQueue, AuctionEndQueueEntry{})
+			if err := m.AuctionEndQueue[len(m.AuctionEndQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassOfferList", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassOfferList = append(m.ClassOfferList, ClassOffer{})
+			if err := m.ClassOfferList[len(m.ClassOfferList)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassOfferExpireQueue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassOfferExpireQueue = append(m.ClassOfferExpireQueue, ClassOfferExpireQueueEntry{})
+			if err := m.ClassOfferExpireQueue[len(m.ClassOfferExpireQueue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -116,6 +116,49 @@ func TestGenesisState_Validate(t *testing.T) {
 						ClassId: "1",
 					},
 				},
+				AuctionList: []types.Auction{
+					{
+						ClassId: "0",
+						NftId:   "0",
+						Seller:  accounts[0].String(),
+					},
+					{
+						ClassId:       "1",
+						NftId:         "1",
+						Seller:        accounts[1].String(),
+						HighestBidder: accounts[0].String(),
+					},
+				},
+				AuctionEndQueue: []types.AuctionEndQueueEntry{
+					{
+						EndTime:    nowTime,
+						AuctionKey: []byte("0"),
+					},
+					{
+						EndTime:    nowTime,
+						AuctionKey: []byte("1"),
+					},
+				},
+				ClassOfferList: []types.ClassOffer{
+					{
+						ClassId: "0",
+						Buyer:   accounts[0].String(),
+					},
+					{
+						ClassId: "0",
+						Buyer:   accounts[1].String(),
+					},
+				},
+				ClassOfferExpireQueue: []types.ClassOfferExpireQueueEntry{
+					{
+						ExpireTime:    nowTime,
+						ClassOfferKey: []byte("0"),
+					},
+					{
+						ExpireTime:    nowTime,
+						ClassOfferKey: []byte("1"),
+					},
+				},
 				// this line is used by starport scaffolding # types/genesis/validField
 			},
 			valid: true,
@@ -355,6 +398,98 @@ func TestGenesisState_Validate(t *testing.T) {
 			},
 			valid: false,
 		},
+		{
+			desc: "duplicated auction",
+			genState: &types.GenesisState{
+				AuctionList: []types.Auction{
+					{
+						ClassId: "0",
+						NftId:   "0",
+						Seller:  accounts[0].String(),
+					},
+					{
+						ClassId: "0",
+						NftId:   "0",
+						Seller:  accounts[1].String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid auction bidder",
+			genState: &types.GenesisState{
+				AuctionList: []types.Auction{
+					{
+						ClassId:       "0",
+						NftId:         "0",
+						Seller:        accounts[0].String(),
+						HighestBidder: "invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated auctionEndQueueEntry",
+			genState: &types.GenesisState{
+				AuctionEndQueue: []types.AuctionEndQueueEntry{
+					{
+						EndTime:    nowTime,
+						AuctionKey: []byte("0"),
+					},
+					{
+						EndTime:    nowTime,
+						AuctionKey: []byte("0"),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated classOffer",
+			genState: &types.GenesisState{
+				ClassOfferList: []types.ClassOffer{
+					{
+						ClassId: "0",
+						Buyer:   accounts[0].String(),
+					},
+					{
+						ClassId: "0",
+						Buyer:   accounts[0].String(),
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid classOffer buyer",
+			genState: &types.GenesisState{
+				ClassOfferList: []types.ClassOffer{
+					{
+						ClassId: "0",
+						Buyer:   "invalid",
+					},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated classOfferExpireQueueEntry",
+			genState: &types.GenesisState{
+				ClassOfferExpireQueue: []types.ClassOfferExpireQueueEntry{
+					{
+						ExpireTime:    nowTime,
+						ClassOfferKey: []byte("0"),
+					},
+					{
+						ExpireTime:    nowTime,
+						ClassOfferKey: []byte("0"),
+					},
+				},
+			},
+			valid: false,
+		},
 		// this line is used by starport scaffolding # types/genesis/testcase
 	} {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"encoding/binary"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// AuctionKeyPrefix is the prefix to retrieve all Auction
+	AuctionKeyPrefix = "Auction/value/"
+)
+
+func AuctionsByClassKey(
+	classId string,
+) []byte {
+	var key []byte
+
+	classIdBytes := []byte(classId)
+	key = append(key, classIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuctionKey returns the store key to retrieve an Auction from the index fields
+func AuctionKey(
+	classId string,
+	nftId string,
+) []byte {
+	key := AuctionsByClassKey(classId)
+
+	nftIdBytes := []byte(nftId)
+	key = append(key, nftIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/binary"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// AuctionEndQueueKeyPrefix is the prefix to retrieve all AuctionEndQueueEntry
+	AuctionEndQueueKeyPrefix = "AuctionEndQueueEntry/value/"
+)
+
+func AuctionEndByTimeKey(
+	endTime time.Time,
+) []byte {
+	var key []byte
+	endTimeBytes := sdk.FormatTimeBytes(endTime)
+	key = append(key, endTimeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// AuctionEndQueueKey returns the store key to retrieve an AuctionEndQueueEntry from the index fields
+func AuctionEndQueueKey(
+	endTime time.Time,
+	auctionKey []byte,
+) []byte {
+	key := AuctionEndByTimeKey(endTime)
+
+	auctionKeyBytes := auctionKey
+	key = append(key, auctionKeyBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// ClassOfferKeyPrefix is the prefix to retrieve all ClassOffer
+	ClassOfferKeyPrefix = "ClassOffer/value/"
+)
+
+func ClassOffersByClassKey(
+	classId string,
+) []byte {
+	var key []byte
+
+	classIdBytes := []byte(classId)
+	key = append(key, classIdBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ClassOfferKey returns the store key to retrieve a ClassOffer from the index fields
+func ClassOfferKey(
+	classId string,
+	buyer sdk.AccAddress,
+) []byte {
+	key := ClassOffersByClassKey(classId)
+
+	buyerBytes := buyer
+	key = append(key, buyerBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
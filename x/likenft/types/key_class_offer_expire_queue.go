@@ -0,0 +1,40 @@
+package types
+
+import (
+	"encoding/binary"
+	time "time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ binary.ByteOrder
+
+const (
+	// ClassOfferExpireQueueKeyPrefix is the prefix to retrieve all ClassOfferExpireQueueEntry
+	ClassOfferExpireQueueKeyPrefix = "ClassOfferExpireQueueEntry/value/"
+)
+
+func ClassOfferExpireByTimeKey(
+	expireTime time.Time,
+) []byte {
+	var key []byte
+	expireTimeBytes := sdk.FormatTimeBytes(expireTime)
+	key = append(key, expireTimeBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
+
+// ClassOfferExpireQueueKey returns the store key to retrieve a ClassOfferExpireQueueEntry from the index fields
+func ClassOfferExpireQueueKey(
+	expireTime time.Time,
+	classOfferKey []byte,
+) []byte {
+	key := ClassOfferExpireByTimeKey(expireTime)
+
+	classOfferKeyBytes := classOfferKey
+	key = append(key, classOfferKeyBytes...)
+	key = append(key, []byte("/")...)
+
+	return key
+}
@@ -0,0 +1,6 @@
+package types
+
+const (
+	// CollectedFeesKey is the key to retrieve the total per-byte fees routed to each destination
+	CollectedFeesKey = "CollectedFees/value/"
+)
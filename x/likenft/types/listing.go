@@ -15,6 +15,7 @@ func (l Listing) ToStoreRecord() ListingStoreRecord {
 		Price:            l.Price,
 		Expiration:       l.Expiration,
 		FullPayToRoyalty: l.FullPayToRoyalty,
+		DutchAuction:     l.DutchAuction,
 	}
 }
 
@@ -26,6 +27,7 @@ func (r ListingStoreRecord) ToPublicRecord() Listing {
 		Price:            r.Price,
 		Expiration:       r.Expiration,
 		FullPayToRoyalty: r.FullPayToRoyalty,
+		DutchAuction:     r.DutchAuction,
 	}
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -29,12 +30,14 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Listing struct {
-	ClassId          string    `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	NftId            string    `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
-	Seller           string    `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
-	Price            uint64    `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Expiration       time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
-	FullPayToRoyalty bool      `protobuf:"varint,6,opt,name=full_pay_to_royalty,json=fullPayToRoyalty,proto3" json:"full_pay_to_royalty,omitempty"`
+	ClassId          string     `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId            string     `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	Seller           string     `protobuf:"bytes,3,opt,name=seller,proto3" json:"seller,omitempty"`
+	Expiration       time.Time  `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	FullPayToRoyalty bool       `protobuf:"varint,6,opt,name=full_pay_to_royalty,json=fullPayToRoyalty,proto3" json:"full_pay_to_royalty,omitempty"`
+	Price            types.Coin `protobuf:"bytes,7,opt,name=price,proto3" json:"price"`
+	// If set, the price declines from `price` to the floor price of the dutch auction
+	DutchAuction *DutchAuctionConfig `protobuf:"bytes,8,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty"`
 }
 
 func (m *Listing) Reset()         { *m = Listing{} }
@@ -91,13 +94,6 @@ func (m *Listing) GetSeller() string {
 	return ""
 }
 
-func (m *Listing) GetPrice() uint64 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
-}
-
 func (m *Listing) GetExpiration() time.Time {
 	if m != nil {
 		return m.Expiration
@@ -112,13 +108,29 @@ func (m *Listing) GetFullPayToRoyalty() bool {
 	return false
 }
 
+func (m *Listing) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *Listing) GetDutchAuction() *DutchAuctionConfig {
+	if m != nil {
+		return m.DutchAuction
+	}
+	return nil
+}
+
 type ListingStoreRecord struct {
 	ClassId          string                                        `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 	NftId            string                                        `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 	Seller           github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=seller,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"seller,omitempty"`
-	Price            uint64                                        `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
 	Expiration       time.Time                                     `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
 	FullPayToRoyalty bool                                          `protobuf:"varint,6,opt,name=full_pay_to_royalty,json=fullPayToRoyalty,proto3" json:"full_pay_to_royalty,omitempty"`
+	Price            types.Coin                                    `protobuf:"bytes,7,opt,name=price,proto3" json:"price"`
+	// If set, the price declines from `price` to the floor price of the dutch auction
+	DutchAuction *DutchAuctionConfig `protobuf:"bytes,8,opt,name=dutch_auction,json=dutchAuction,proto3" json:"dutch_auction,omitempty"`
 }
 
 func (m *ListingStoreRecord) Reset()         { *m = ListingStoreRecord{} }
@@ -175,13 +187,6 @@ func (m *ListingStoreRecord) GetSeller() github_com_cosmos_cosmos_sdk_types.AccA
 	return nil
 }
 
-func (m *ListingStoreRecord) GetPrice() uint64 {
-	if m != nil {
-		return m.Price
-	}
-	return 0
-}
-
 func (m *ListingStoreRecord) GetExpiration() time.Time {
 	if m != nil {
 		return m.Expiration
@@ -196,9 +201,95 @@ func (m *ListingStoreRecord) GetFullPayToRoyalty() bool {
 	return false
 }
 
+func (m *ListingStoreRecord) GetPrice() types.Coin {
+	if m != nil {
+		return m.Price
+	}
+	return types.Coin{}
+}
+
+func (m *ListingStoreRecord) GetDutchAuction() *DutchAuctionConfig {
+	if m != nil {
+		return m.DutchAuction
+	}
+	return nil
+}
+
+// DutchAuctionConfig makes the price of a listing decline from the listing price down to the floor price between the
+// start time and the end time
+type DutchAuctionConfig struct {
+	FloorPrice types.Coin `protobuf:"bytes,1,opt,name=floor_price,json=floorPrice,proto3" json:"floor_price"`
+	StartTime  time.Time  `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime    time.Time  `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+	// The price drops once every `step_seconds`, or continuously if 0
+	StepSeconds uint64 `protobuf:"varint,4,opt,name=step_seconds,json=stepSeconds,proto3" json:"step_seconds,omitempty"`
+}
+
+func (m *DutchAuctionConfig) Reset()         { *m = DutchAuctionConfig{} }
+func (m *DutchAuctionConfig) String() string { return proto.CompactTextString(m) }
+func (*DutchAuctionConfig) ProtoMessage()    {}
+func (*DutchAuctionConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_592867f987c9f178, []int{2}
+}
+func (m *DutchAuctionConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DutchAuctionConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DutchAuctionConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DutchAuctionConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DutchAuctionConfig.Merge(m, src)
+}
+func (m *DutchAuctionConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *DutchAuctionConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_DutchAuctionConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DutchAuctionConfig proto.InternalMessageInfo
+
+func (m *DutchAuctionConfig) GetFloorPrice() types.Coin {
+	if m != nil {
+		return m.FloorPrice
+	}
+	return types.Coin{}
+}
+
+func (m *DutchAuctionConfig) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *DutchAuctionConfig) GetEndTime() time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return time.Time{}
+}
+
+func (m *DutchAuctionConfig) GetStepSeconds() uint64 {
+	if m != nil {
+		return m.StepSeconds
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Listing)(nil), "likechain.likenft.v1.Listing")
 	proto.RegisterType((*ListingStoreRecord)(nil), "likechain.likenft.v1.ListingStoreRecord")
+	proto.RegisterType((*DutchAuctionConfig)(nil), "likechain.likenft.v1.DutchAuctionConfig")
 }
 
 func init() {
@@ -206,32 +297,42 @@ func init() {
 }
 
 var fileDescriptor_592867f987c9f178 = []byte{
-	// 394 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x52, 0xbd, 0x6e, 0xdb, 0x30,
-	0x18, 0x34, 0xd3, 0xd8, 0x71, 0xd9, 0x0e, 0x05, 0xeb, 0x16, 0xaa, 0x07, 0x49, 0xf0, 0xa4, 0x45,
-	0x24, 0xdc, 0x9f, 0x07, 0x88, 0xd1, 0xc5, 0x40, 0x81, 0x16, 0x6a, 0xa6, 0x2e, 0x82, 0x4c, 0x51,
-	0x0a, 0x11, 0x4a, 0x9f, 0x40, 0xd2, 0x46, 0xf4, 0x16, 0x79, 0x80, 0x3e, 0x50, 0xc6, 0x8c, 0x9d,
-	0xdc, 0xc2, 0x7e, 0x8b, 0x4e, 0x85, 0x28, 0x25, 0x48, 0xd7, 0x4e, 0x9d, 0xf8, 0xdd, 0x77, 0x47,
-	0xe2, 0x8e, 0x38, 0xbc, 0x50, 0xf2, 0x4a, 0xf0, 0xcb, 0x4c, 0xd6, 0xac, 0x9b, 0xea, 0xc2, 0xb2,
-	0xdd, 0x92, 0x29, 0x69, 0xac, 0xac, 0x4b, 0xda, 0x68, 0xb0, 0x40, 0x66, 0x0f, 0x1a, 0x3a, 0x68,
-	0xe8, 0x6e, 0x39, 0x9f, 0x95, 0x50, 0x82, 0x13, 0xb0, 0x6e, 0xea, 0xb5, 0xf3, 0xa0, 0x04, 0x28,
-	0x95, 0x60, 0x0e, 0x6d, 0xb6, 0x05, 0xb3, 0xb2, 0x12, 0xc6, 0x66, 0x55, 0xd3, 0x0b, 0x16, 0x7b,
-	0x84, 0xcf, 0x3e, 0xf5, 0xcf, 0x93, 0x37, 0x78, 0xca, 0x55, 0x66, 0x4c, 0x2a, 0x73, 0x0f, 0x85,
-	0x28, 0x7a, 0x9a, 0x9c, 0x39, 0xbc, 0xce, 0xc9, 0x2b, 0x3c, 0xa9, 0x0b, 0xdb, 0x11, 0x27, 0x8e,
-	0x18, 0xd7, 0x85, 0x5d, 0xe7, 0xe4, 0x35, 0x9e, 0x18, 0xa1, 0x94, 0xd0, 0xde, 0x13, 0xb7, 0x1e,
-	0x10, 0x99, 0xe1, 0x71, 0xa3, 0x25, 0x17, 0xde, 0x69, 0x88, 0xa2, 0xd3, 0xa4, 0x07, 0xe4, 0x23,
-	0xc6, 0xe2, 0xba, 0x91, 0x3a, 0xb3, 0x12, 0x6a, 0x6f, 0x1c, 0xa2, 0xe8, 0xd9, 0xdb, 0x39, 0xed,
-	0x1d, 0xd2, 0x7b, 0x87, 0xf4, 0xe2, 0xde, 0xe1, 0x6a, 0x7a, 0xbb, 0x0f, 0x46, 0x37, 0x3f, 0x03,
-	0x94, 0x3c, 0xba, 0x47, 0x62, 0xfc, 0xb2, 0xd8, 0x2a, 0x95, 0x36, 0x59, 0x9b, 0x5a, 0x48, 0x35,
-	0xb4, 0x99, 0xb2, 0xad, 0x37, 0x09, 0x51, 0x34, 0x4d, 0x5e, 0x74, 0xd4, 0x97, 0xac, 0xbd, 0x80,
-	0xa4, 0xdf, 0x2f, 0xbe, 0x9f, 0x60, 0x32, 0x04, 0xfc, 0x6a, 0x41, 0x8b, 0x44, 0x70, 0xd0, 0xf9,
-	0x3f, 0x64, 0x5d, 0xff, 0x95, 0xf5, 0xf9, 0x6a, 0xf9, 0x7b, 0x1f, 0xc4, 0xa5, 0xb4, 0x97, 0xdb,
-	0x0d, 0xe5, 0x50, 0x31, 0x0e, 0xa6, 0x02, 0x33, 0x1c, 0xb1, 0xc9, 0xaf, 0x98, 0x6d, 0x1b, 0x61,
-	0xe8, 0x39, 0xe7, 0xe7, 0x79, 0xae, 0x85, 0x31, 0xff, 0xe1, 0xf7, 0xac, 0x3e, 0xdf, 0x1e, 0x7c,
-	0x74, 0x77, 0xf0, 0xd1, 0xaf, 0x83, 0x8f, 0x6e, 0x8e, 0xfe, 0xe8, 0xee, 0xe8, 0x8f, 0x7e, 0x1c,
-	0xfd, 0xd1, 0xb7, 0x0f, 0x8f, 0xb2, 0xb9, 0xc6, 0xc1, 0x50, 0xca, 0x6e, 0x88, 0xfb, 0x8e, 0xee,
-	0xde, 0xb3, 0xeb, 0x87, 0xa2, 0xba, 0xb8, 0x9b, 0x89, 0x73, 0xfa, 0xee, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x68, 0x95, 0xfd, 0x67, 0xca, 0x02, 0x00, 0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x54, 0x3f, 0x6f, 0xdb, 0x3e,
+	0x10, 0xb5, 0x12, 0xff, 0x51, 0x98, 0xfc, 0x80, 0x80, 0xbf, 0xb4, 0x50, 0x3c, 0xc8, 0xae, 0x27,
+	0x2f, 0x26, 0xe1, 0xb6, 0x99, 0x5b, 0xdb, 0x59, 0x5c, 0xb4, 0x68, 0xa0, 0x64, 0xea, 0x22, 0xc8,
+	0x24, 0x25, 0x13, 0x91, 0x49, 0x41, 0xa4, 0x8d, 0xf8, 0x5b, 0x04, 0xfd, 0x54, 0x19, 0x33, 0x74,
+	0xe8, 0x94, 0x16, 0xf6, 0xb7, 0xe8, 0xd2, 0x42, 0xa4, 0x6c, 0xb8, 0x68, 0x87, 0xa6, 0x73, 0x27,
+	0x1d, 0xdf, 0xbd, 0xe3, 0x3d, 0xbc, 0x3b, 0x11, 0x74, 0x52, 0x7e, 0xcd, 0xc8, 0x34, 0xe2, 0x02,
+	0x17, 0x91, 0x88, 0x35, 0x5e, 0xf4, 0x71, 0xca, 0x95, 0xe6, 0x22, 0x41, 0x59, 0x2e, 0xb5, 0x84,
+	0x27, 0x5b, 0x0e, 0x2a, 0x39, 0x68, 0xd1, 0x6f, 0xfa, 0x44, 0xaa, 0x99, 0x54, 0x78, 0x12, 0x29,
+	0x86, 0x17, 0xfd, 0x09, 0xd3, 0x51, 0x1f, 0x13, 0xc9, 0x85, 0xad, 0x6a, 0x9e, 0x24, 0x32, 0x91,
+	0x26, 0xc4, 0x45, 0x54, 0xa2, 0xad, 0x44, 0xca, 0x24, 0x65, 0xd8, 0x9c, 0x26, 0xf3, 0x18, 0x6b,
+	0x3e, 0x63, 0x4a, 0x47, 0xb3, 0xcc, 0x12, 0x3a, 0x9f, 0xf6, 0x40, 0xe3, 0xad, 0x6d, 0x0f, 0x4f,
+	0x81, 0x4b, 0xd2, 0x48, 0xa9, 0x90, 0x53, 0xcf, 0x69, 0x3b, 0xdd, 0x83, 0xa0, 0x61, 0xce, 0x63,
+	0x0a, 0x9f, 0x80, 0xba, 0x88, 0x75, 0x91, 0xd8, 0x33, 0x89, 0x9a, 0x88, 0xf5, 0x98, 0xc2, 0xa7,
+	0xa0, 0xae, 0x58, 0x9a, 0xb2, 0xdc, 0xdb, 0x37, 0x70, 0x79, 0x82, 0xe7, 0x00, 0xb0, 0x9b, 0x8c,
+	0xe7, 0x91, 0xe6, 0x52, 0x78, 0xb5, 0xb6, 0xd3, 0x3d, 0x7c, 0xde, 0x44, 0x56, 0x0b, 0xda, 0x68,
+	0x41, 0x57, 0x1b, 0x2d, 0x43, 0xf7, 0xee, 0xa1, 0x55, 0xb9, 0xfd, 0xd2, 0x72, 0x82, 0x9d, 0x3a,
+	0xd8, 0x03, 0xff, 0xc7, 0xf3, 0x34, 0x0d, 0xb3, 0x68, 0x19, 0x6a, 0x19, 0xe6, 0x72, 0x19, 0xa5,
+	0x7a, 0xe9, 0xd5, 0xdb, 0x4e, 0xd7, 0x0d, 0x8e, 0x8b, 0xd4, 0x45, 0xb4, 0xbc, 0x92, 0x81, 0xc5,
+	0xe1, 0x19, 0xa8, 0x65, 0x39, 0x27, 0xcc, 0x6b, 0x98, 0x7e, 0xa7, 0xc8, 0x3a, 0x86, 0x0a, 0xc7,
+	0x50, 0xe9, 0x18, 0x1a, 0x49, 0x2e, 0x86, 0xd5, 0xa2, 0x5d, 0x60, 0xd9, 0xf0, 0x1d, 0xf8, 0x8f,
+	0xce, 0x35, 0x99, 0x86, 0xd1, 0x9c, 0x18, 0xb9, 0xae, 0x29, 0xef, 0xa2, 0xdf, 0x8d, 0x01, 0x9d,
+	0x17, 0xd4, 0x81, 0x65, 0x8e, 0xa4, 0x88, 0x79, 0x12, 0x1c, 0xd1, 0x1d, 0xec, 0x4d, 0xd5, 0xad,
+	0x1e, 0xd7, 0x3a, 0x1f, 0xf7, 0x01, 0x2c, 0x6d, 0xbd, 0xd4, 0x32, 0x67, 0x01, 0x23, 0x32, 0xa7,
+	0x7f, 0xe1, 0xf0, 0xf8, 0x27, 0x87, 0x8f, 0x86, 0xfd, 0x6f, 0x0f, 0xad, 0x5e, 0xc2, 0xf5, 0x74,
+	0x3e, 0x41, 0x44, 0xce, 0x70, 0xb9, 0x15, 0xf6, 0xd3, 0x53, 0xf4, 0x1a, 0xeb, 0x65, 0xc6, 0x14,
+	0x1a, 0x10, 0x32, 0xa0, 0x34, 0x67, 0x4a, 0xfd, 0x1b, 0xca, 0x66, 0x28, 0xdf, 0x1d, 0x00, 0x7f,
+	0xa5, 0xc2, 0xd7, 0xe0, 0x30, 0x4e, 0xa5, 0xcc, 0x43, 0x2b, 0xd4, 0xf9, 0x33, 0xa1, 0xc0, 0xd4,
+	0x5c, 0x18, 0xb5, 0x23, 0x00, 0x94, 0x8e, 0x72, 0x1d, 0x16, 0x7f, 0x97, 0xb7, 0xf7, 0x08, 0x67,
+	0x0f, 0x4c, 0x5d, 0x91, 0x81, 0xaf, 0x80, 0xcb, 0x04, 0xb5, 0x57, 0xec, 0x3f, 0xe2, 0x8a, 0x06,
+	0x13, 0xd4, 0x5c, 0xf0, 0x0c, 0x1c, 0x29, 0xcd, 0xb2, 0x50, 0x31, 0x22, 0x05, 0x55, 0x5e, 0xb5,
+	0xed, 0x74, 0xab, 0xc1, 0x61, 0x81, 0x5d, 0x5a, 0x68, 0xf8, 0xfe, 0x6e, 0xe5, 0x3b, 0xf7, 0x2b,
+	0xdf, 0xf9, 0xba, 0xf2, 0x9d, 0xdb, 0xb5, 0x5f, 0xb9, 0x5f, 0xfb, 0x95, 0xcf, 0x6b, 0xbf, 0xf2,
+	0xe1, 0x6c, 0x67, 0xa7, 0x8c, 0xc7, 0x92, 0x8b, 0x6d, 0xd0, 0xb3, 0x2f, 0xd6, 0xe2, 0x25, 0xbe,
+	0xd9, 0x3e, 0x5b, 0x66, 0xcd, 0x26, 0x75, 0x23, 0xed, 0xc5, 0x8f, 0x01, 0x00, 0x07, 0x58, 0x91,
+	0xf9, 0xd8, 0x04, 0x00, 0x00,
 }
 
 func (m *Listing) Marshal() (dAtA []byte, err error) {
@@ -254,6 +355,28 @@ func (m *Listing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DutchAuction != nil {
+		{
+			size, err := m.DutchAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintListing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.FullPayToRoyalty {
 		i--
 		if m.FullPayToRoyalty {
@@ -264,19 +387,14 @@ func (m *Listing) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
-	if err1 != nil {
-		return 0, err1
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n1
-	i = encodeVarintListing(dAtA, i, uint64(n1))
+	i -= n3
+	i = encodeVarintListing(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x2a
-	if m.Price != 0 {
-		i = encodeVarintListing(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
-	}
 	if len(m.Seller) > 0 {
 		i -= len(m.Seller)
 		copy(dAtA[i:], m.Seller)
@@ -321,6 +439,28 @@ func (m *ListingStoreRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.DutchAuction != nil {
+		{
+			size, err := m.DutchAuction.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintListing(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintListing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.FullPayToRoyalty {
 		i--
 		if m.FullPayToRoyalty {
@@ -331,19 +471,14 @@ func (m *ListingStoreRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
-	if err2 != nil {
-		return 0, err2
+	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err6 != nil {
+		return 0, err6
 	}
-	i -= n2
-	i = encodeVarintListing(dAtA, i, uint64(n2))
+	i -= n6
+	i = encodeVarintListing(dAtA, i, uint64(n6))
 	i--
 	dAtA[i] = 0x2a
-	if m.Price != 0 {
-		i = encodeVarintListing(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
-	}
 	if len(m.Seller) > 0 {
 		i -= len(m.Seller)
 		copy(dAtA[i:], m.Seller)
@@ -368,6 +503,60 @@ func (m *ListingStoreRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DutchAuctionConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DutchAuctionConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DutchAuctionConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StepSeconds != 0 {
+		i = encodeVarintListing(dAtA, i, uint64(m.StepSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintListing(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x1a
+	n8, err8 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err8 != nil {
+		return 0, err8
+	}
+	i -= n8
+	i = encodeVarintListing(dAtA, i, uint64(n8))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FloorPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintListing(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintListing(dAtA []byte, offset int, v uint64) int {
 	offset -= sovListing(v)
 	base := offset
@@ -397,14 +586,17 @@ func (m *Listing) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovListing(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovListing(uint64(m.Price))
-	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovListing(uint64(l))
 	if m.FullPayToRoyalty {
 		n += 2
 	}
+	l = m.Price.Size()
+	n += 1 + l + sovListing(uint64(l))
+	if m.DutchAuction != nil {
+		l = m.DutchAuction.Size()
+		n += 1 + l + sovListing(uint64(l))
+	}
 	return n
 }
 
@@ -426,14 +618,35 @@ func (m *ListingStoreRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovListing(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovListing(uint64(m.Price))
-	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovListing(uint64(l))
 	if m.FullPayToRoyalty {
 		n += 2
 	}
+	l = m.Price.Size()
+	n += 1 + l + sovListing(uint64(l))
+	if m.DutchAuction != nil {
+		l = m.DutchAuction.Size()
+		n += 1 + l + sovListing(uint64(l))
+	}
+	return n
+}
+
+func (m *DutchAuctionConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FloorPrice.Size()
+	n += 1 + l + sovListing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovListing(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovListing(uint64(l))
+	if m.StepSeconds != 0 {
+		n += 1 + sovListing(uint64(m.StepSeconds))
+	}
 	return n
 }
 
@@ -568,11 +781,44 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 			}
 			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FullPayToRoyalty", wireType)
 			}
-			m.Price = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListing
@@ -582,14 +828,15 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			m.FullPayToRoyalty = bool(v != 0)
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -616,15 +863,15 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullPayToRoyalty", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListing
@@ -634,12 +881,28 @@ func (m *Listing) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FullPayToRoyalty = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DutchAuction == nil {
+				m.DutchAuction = &DutchAuctionConfig{}
+			}
+			if err := m.DutchAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipListing(dAtA[iNdEx:])
@@ -788,11 +1051,64 @@ func (m *ListingStoreRecord) Unmarshal(dAtA []byte) error {
 				m.Seller = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FullPayToRoyalty", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.FullPayToRoyalty = bool(v != 0)
+		case 7:
+			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			m.Price = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListing
@@ -802,14 +1118,28 @@ func (m *ListingStoreRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DutchAuction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -836,15 +1166,167 @@ func (m *ListingStoreRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if m.DutchAuction == nil {
+				m.DutchAuction = &DutchAuctionConfig{}
+			}
+			if err := m.DutchAuction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipListing(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthListing
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DutchAuctionConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowListing
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DutchAuctionConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DutchAuctionConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FloorPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FloorPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowListing
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthListing
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthListing
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FullPayToRoyalty", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StepSeconds", wireType)
 			}
-			var v int
+			m.StepSeconds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowListing
@@ -854,12 +1336,11 @@ func (m *ListingStoreRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.StepSeconds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.FullPayToRoyalty = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipListing(dAtA[iNdEx:])
@@ -9,7 +9,7 @@ const TypeMsgBuyNFT = "buy_nft"
 
 var _ sdk.Msg = &MsgBuyNFT{}
 
-func NewMsgBuyNFT(creator string, classId string, nftId string, seller string, price uint64) *MsgBuyNFT {
+func NewMsgBuyNFT(creator string, classId string, nftId string, seller string, price sdk.Coin) *MsgBuyNFT {
 	return &MsgBuyNFT{
 		Creator: creator,
 		ClassId: classId,
@@ -45,5 +45,8 @@ func (msg *MsgBuyNFT) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
 	return nil
 }
@@ -9,7 +9,7 @@ const TypeMsgSellNFT = "sell_nft"
 
 var _ sdk.Msg = &MsgSellNFT{}
 
-func NewMsgSellNFT(creator string, classId string, nftId string, buyer string, price uint64, fullPayToRoyalty bool) *MsgSellNFT {
+func NewMsgSellNFT(creator string, classId string, nftId string, buyer string, price sdk.Coin, fullPayToRoyalty bool, classOffer bool) *MsgSellNFT {
 	return &MsgSellNFT{
 		Creator:          creator,
 		ClassId:          classId,
@@ -17,6 +17,7 @@ func NewMsgSellNFT(creator string, classId string, nftId string, buyer string, p
 		Buyer:            buyer,
 		Price:            price,
 		FullPayToRoyalty: fullPayToRoyalty,
+		ClassOffer:       classOffer,
 	}
 }
 
@@ -46,5 +47,8 @@ func (msg *MsgSellNFT) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
 	return nil
 }
@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/likecoin/likecoin-chain/v4/testutil/sample"
 	"github.com/stretchr/testify/require"
@@ -24,7 +25,15 @@ func TestMsgSellNFT_ValidateBasic(t *testing.T) {
 			name: "valid address",
 			msg: MsgSellNFT{
 				Creator: sample.AccAddress(),
+				Price:   sdk.NewInt64Coin("nanolike", 1),
 			},
+		}, {
+			name: "invalid price",
+			msg: MsgSellNFT{
+				Creator: sample.AccAddress(),
+				Price:   sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+			},
+			err: ErrInvalidPrice,
 		},
 	}
 	for _, tt := range tests {
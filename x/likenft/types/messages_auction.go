@@ -0,0 +1,174 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateAuction = "create_auction"
+	TypeMsgCancelAuction = "cancel_auction"
+	TypeMsgPlaceBid      = "place_bid"
+)
+
+var _ sdk.Msg = &MsgCreateAuction{}
+
+func NewMsgCreateAuction(
+	creator string,
+	classId string,
+	nftId string,
+	reservePrice sdk.Coin,
+	minIncrement sdk.Coin,
+	endTime time.Time,
+	fullPayToRoyalty bool,
+
+) *MsgCreateAuction {
+	return &MsgCreateAuction{
+		Creator:          creator,
+		ClassId:          classId,
+		NftId:            nftId,
+		ReservePrice:     reservePrice,
+		MinIncrement:     minIncrement,
+		EndTime:          endTime,
+		FullPayToRoyalty: fullPayToRoyalty,
+	}
+}
+
+func (msg *MsgCreateAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateAuction) Type() string {
+	return TypeMsgCreateAuction
+}
+
+func (msg *MsgCreateAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidatePrice(msg.ReservePrice); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if err := ValidatePrice(msg.MinIncrement); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if !msg.MinIncrement.IsPositive() {
+		return ErrInvalidPrice.Wrapf("min increment must be positive")
+	}
+	if msg.MinIncrement.Denom != msg.ReservePrice.Denom {
+		return ErrInvalidPrice.Wrapf("min increment denom %s does not match reserve price denom %s", msg.MinIncrement.Denom, msg.ReservePrice.Denom)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgCancelAuction{}
+
+func NewMsgCancelAuction(
+	creator string,
+	classId string,
+	nftId string,
+
+) *MsgCancelAuction {
+	return &MsgCancelAuction{
+		Creator: creator,
+		ClassId: classId,
+		NftId:   nftId,
+	}
+}
+
+func (msg *MsgCancelAuction) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCancelAuction) Type() string {
+	return TypeMsgCancelAuction
+}
+
+func (msg *MsgCancelAuction) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCancelAuction) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCancelAuction) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgPlaceBid{}
+
+func NewMsgPlaceBid(
+	creator string,
+	classId string,
+	nftId string,
+	bid sdk.Coin,
+
+) *MsgPlaceBid {
+	return &MsgPlaceBid{
+		Creator: creator,
+		ClassId: classId,
+		NftId:   nftId,
+		Bid:     bid,
+	}
+}
+
+func (msg *MsgPlaceBid) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPlaceBid) Type() string {
+	return TypeMsgPlaceBid
+}
+
+func (msg *MsgPlaceBid) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgPlaceBid) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgPlaceBid) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidatePrice(msg.Bid); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if !msg.Bid.IsPositive() {
+		return ErrInvalidPrice.Wrapf("bid must be positive")
+	}
+	return nil
+}
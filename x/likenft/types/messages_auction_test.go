@@ -0,0 +1,144 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/likecoin/likecoin-chain/v4/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateAuction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateAuction
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateAuction{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCreateAuction{
+				Creator:      sample.AccAddress(),
+				ReservePrice: sdk.NewInt64Coin("nanolike", 0),
+				MinIncrement: sdk.NewInt64Coin("nanolike", 1),
+			},
+		}, {
+			name: "invalid reserve price",
+			msg: MsgCreateAuction{
+				Creator:      sample.AccAddress(),
+				ReservePrice: sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+				MinIncrement: sdk.NewInt64Coin("nanolike", 1),
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "zero min increment",
+			msg: MsgCreateAuction{
+				Creator:      sample.AccAddress(),
+				ReservePrice: sdk.NewInt64Coin("nanolike", 1),
+				MinIncrement: sdk.NewInt64Coin("nanolike", 0),
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "min increment denom mismatch",
+			msg: MsgCreateAuction{
+				Creator:      sample.AccAddress(),
+				ReservePrice: sdk.NewInt64Coin("nanolike", 1),
+				MinIncrement: sdk.NewInt64Coin("stake", 1),
+			},
+			err: ErrInvalidPrice,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgCancelAuction_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCancelAuction
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCancelAuction{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCancelAuction{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgPlaceBid_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgPlaceBid
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgPlaceBid{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgPlaceBid{
+				Creator: sample.AccAddress(),
+				Bid:     sdk.NewInt64Coin("nanolike", 1),
+			},
+		}, {
+			name: "invalid bid",
+			msg: MsgPlaceBid{
+				Creator: sample.AccAddress(),
+				Bid:     sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "zero bid",
+			msg: MsgPlaceBid{
+				Creator: sample.AccAddress(),
+				Bid:     sdk.NewInt64Coin("nanolike", 0),
+			},
+			err: ErrInvalidPrice,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
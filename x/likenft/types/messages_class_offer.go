@@ -0,0 +1,164 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+const (
+	TypeMsgCreateClassOffer = "create_class_offer"
+	TypeMsgUpdateClassOffer = "update_class_offer"
+	TypeMsgDeleteClassOffer = "delete_class_offer"
+)
+
+var _ sdk.Msg = &MsgCreateClassOffer{}
+
+func NewMsgCreateClassOffer(
+	creator string,
+	classId string,
+	price sdk.Coin,
+	quantity uint64,
+	expiration time.Time,
+
+) *MsgCreateClassOffer {
+	return &MsgCreateClassOffer{
+		Creator:    creator,
+		ClassId:    classId,
+		Price:      price,
+		Quantity:   quantity,
+		Expiration: expiration,
+	}
+}
+
+func (msg *MsgCreateClassOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgCreateClassOffer) Type() string {
+	return TypeMsgCreateClassOffer
+}
+
+func (msg *MsgCreateClassOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgCreateClassOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgCreateClassOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if msg.Quantity == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgUpdateClassOffer{}
+
+func NewMsgUpdateClassOffer(
+	creator string,
+	classId string,
+	price sdk.Coin,
+	quantity uint64,
+	expiration time.Time,
+
+) *MsgUpdateClassOffer {
+	return &MsgUpdateClassOffer{
+		Creator:    creator,
+		ClassId:    classId,
+		Price:      price,
+		Quantity:   quantity,
+		Expiration: expiration,
+	}
+}
+
+func (msg *MsgUpdateClassOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateClassOffer) Type() string {
+	return TypeMsgUpdateClassOffer
+}
+
+func (msg *MsgUpdateClassOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgUpdateClassOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgUpdateClassOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if msg.Quantity == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("quantity must be positive")
+	}
+	return nil
+}
+
+var _ sdk.Msg = &MsgDeleteClassOffer{}
+
+func NewMsgDeleteClassOffer(
+	creator string,
+	classId string,
+
+) *MsgDeleteClassOffer {
+	return &MsgDeleteClassOffer{
+		Creator: creator,
+		ClassId: classId,
+	}
+}
+
+func (msg *MsgDeleteClassOffer) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgDeleteClassOffer) Type() string {
+	return TypeMsgDeleteClassOffer
+}
+
+func (msg *MsgDeleteClassOffer) GetSigners() []sdk.AccAddress {
+	creator, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{creator}
+}
+
+func (msg *MsgDeleteClassOffer) GetSignBytes() []byte {
+	bz := ModuleCdc.MustMarshalJSON(msg)
+	return sdk.MustSortJSON(bz)
+}
+
+func (msg *MsgDeleteClassOffer) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Creator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
+	}
+	return nil
+}
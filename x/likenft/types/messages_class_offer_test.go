@@ -0,0 +1,139 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/likecoin/likecoin-chain/v4/testutil/sample"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgCreateClassOffer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgCreateClassOffer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgCreateClassOffer{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgCreateClassOffer{
+				Creator:  sample.AccAddress(),
+				Price:    sdk.NewInt64Coin("nanolike", 1),
+				Quantity: 10,
+			},
+		}, {
+			name: "invalid price",
+			msg: MsgCreateClassOffer{
+				Creator:  sample.AccAddress(),
+				Price:    sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+				Quantity: 10,
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "zero quantity",
+			msg: MsgCreateClassOffer{
+				Creator:  sample.AccAddress(),
+				Price:    sdk.NewInt64Coin("nanolike", 1),
+				Quantity: 0,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgUpdateClassOffer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgUpdateClassOffer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgUpdateClassOffer{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgUpdateClassOffer{
+				Creator:  sample.AccAddress(),
+				Price:    sdk.NewInt64Coin("nanolike", 1),
+				Quantity: 10,
+			},
+		}, {
+			name: "invalid price",
+			msg: MsgUpdateClassOffer{
+				Creator:  sample.AccAddress(),
+				Price:    sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+				Quantity: 10,
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "zero quantity",
+			msg: MsgUpdateClassOffer{
+				Creator:  sample.AccAddress(),
+				Price:    sdk.NewInt64Coin("nanolike", 1),
+				Quantity: 0,
+			},
+			err: sdkerrors.ErrInvalidRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgDeleteClassOffer_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name string
+		msg  MsgDeleteClassOffer
+		err  error
+	}{
+		{
+			name: "invalid address",
+			msg: MsgDeleteClassOffer{
+				Creator: "invalid_address",
+			},
+			err: sdkerrors.ErrInvalidAddress,
+		}, {
+			name: "valid address",
+			msg: MsgDeleteClassOffer{
+				Creator: sample.AccAddress(),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
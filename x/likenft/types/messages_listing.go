@@ -19,9 +19,11 @@ func NewMsgCreateListing(
 	creator string,
 	classId string,
 	nftId string,
-	price uint64,
+	price sdk.Coin,
 	expiration time.Time,
 	fullPayToRoyalty bool,
+	dutchAuction *DutchAuctionConfig,
+	autoMatch bool,
 
 ) *MsgCreateListing {
 	return &MsgCreateListing{
@@ -31,6 +33,8 @@ func NewMsgCreateListing(
 		Price:            price,
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
+		DutchAuction:     dutchAuction,
+		AutoMatch:        autoMatch,
 	}
 }
 
@@ -60,6 +64,12 @@ func (msg *MsgCreateListing) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if err := validateListingDutchAuction(msg.DutchAuction, msg.Price, msg.Expiration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -69,9 +79,10 @@ func NewMsgUpdateListing(
 	creator string,
 	classId string,
 	nftId string,
-	price uint64,
+	price sdk.Coin,
 	expiration time.Time,
 	fullPayToRoyalty bool,
+	dutchAuction *DutchAuctionConfig,
 
 ) *MsgUpdateListing {
 	return &MsgUpdateListing{
@@ -81,6 +92,7 @@ func NewMsgUpdateListing(
 		Price:            price,
 		Expiration:       expiration,
 		FullPayToRoyalty: fullPayToRoyalty,
+		DutchAuction:     dutchAuction,
 	}
 }
 
@@ -110,6 +122,12 @@ func (msg *MsgUpdateListing) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
+	if err := validateListingDutchAuction(msg.DutchAuction, msg.Price, msg.Expiration); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -154,3 +172,16 @@ func (msg *MsgDeleteListing) ValidateBasic() error {
 	}
 	return nil
 }
+
+func validateListingDutchAuction(dutchAuction *DutchAuctionConfig, price sdk.Coin, expiration time.Time) error {
+	if dutchAuction == nil {
+		return nil
+	}
+	if err := dutchAuction.Validate(price); err != nil {
+		return ErrInvalidPrice.Wrapf("invalid dutch auction: %s", err.Error())
+	}
+	if dutchAuction.EndTime.After(expiration) {
+		return ErrInvalidPrice.Wrapf("invalid dutch auction: end time is after listing expiration")
+	}
+	return nil
+}
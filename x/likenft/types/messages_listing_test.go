@@ -2,7 +2,9 @@ package types
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/likecoin/likecoin-chain/v4/testutil/sample"
 	"github.com/stretchr/testify/require"
@@ -24,7 +26,53 @@ func TestMsgCreateListing_ValidateBasic(t *testing.T) {
 			name: "valid address",
 			msg: MsgCreateListing{
 				Creator: sample.AccAddress(),
+				Price:   sdk.NewInt64Coin("nanolike", 1),
 			},
+		}, {
+			name: "invalid price",
+			msg: MsgCreateListing{
+				Creator: sample.AccAddress(),
+				Price:   sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "valid dutch auction",
+			msg: MsgCreateListing{
+				Creator:    sample.AccAddress(),
+				Price:      sdk.NewInt64Coin("nanolike", 1000),
+				Expiration: time.Unix(1700000000, 0),
+				DutchAuction: &DutchAuctionConfig{
+					FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+					StartTime:  time.Unix(1600000000, 0),
+					EndTime:    time.Unix(1700000000, 0),
+				},
+			},
+		}, {
+			name: "dutch auction floor price above price",
+			msg: MsgCreateListing{
+				Creator:    sample.AccAddress(),
+				Price:      sdk.NewInt64Coin("nanolike", 1000),
+				Expiration: time.Unix(1700000000, 0),
+				DutchAuction: &DutchAuctionConfig{
+					FloorPrice: sdk.NewInt64Coin("nanolike", 1001),
+					StartTime:  time.Unix(1600000000, 0),
+					EndTime:    time.Unix(1700000000, 0),
+				},
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "dutch auction ends after expiration",
+			msg: MsgCreateListing{
+				Creator:    sample.AccAddress(),
+				Price:      sdk.NewInt64Coin("nanolike", 1000),
+				Expiration: time.Unix(1700000000, 0),
+				DutchAuction: &DutchAuctionConfig{
+					FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+					StartTime:  time.Unix(1600000000, 0),
+					EndTime:    time.Unix(1700000001, 0),
+				},
+			},
+			err: ErrInvalidPrice,
 		},
 	}
 	for _, tt := range tests {
@@ -55,7 +103,53 @@ func TestMsgUpdateListing_ValidateBasic(t *testing.T) {
 			name: "valid address",
 			msg: MsgUpdateListing{
 				Creator: sample.AccAddress(),
+				Price:   sdk.NewInt64Coin("nanolike", 1),
+			},
+		}, {
+			name: "invalid price",
+			msg: MsgUpdateListing{
+				Creator: sample.AccAddress(),
+				Price:   sdk.Coin{Denom: "nanolike", Amount: sdk.NewInt(-1)},
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "valid dutch auction",
+			msg: MsgUpdateListing{
+				Creator:    sample.AccAddress(),
+				Price:      sdk.NewInt64Coin("nanolike", 1000),
+				Expiration: time.Unix(1700000000, 0),
+				DutchAuction: &DutchAuctionConfig{
+					FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+					StartTime:  time.Unix(1600000000, 0),
+					EndTime:    time.Unix(1700000000, 0),
+				},
+			},
+		}, {
+			name: "dutch auction floor price above price",
+			msg: MsgUpdateListing{
+				Creator:    sample.AccAddress(),
+				Price:      sdk.NewInt64Coin("nanolike", 1000),
+				Expiration: time.Unix(1700000000, 0),
+				DutchAuction: &DutchAuctionConfig{
+					FloorPrice: sdk.NewInt64Coin("nanolike", 1001),
+					StartTime:  time.Unix(1600000000, 0),
+					EndTime:    time.Unix(1700000000, 0),
+				},
+			},
+			err: ErrInvalidPrice,
+		}, {
+			name: "dutch auction ends after expiration",
+			msg: MsgUpdateListing{
+				Creator:    sample.AccAddress(),
+				Price:      sdk.NewInt64Coin("nanolike", 1000),
+				Expiration: time.Unix(1700000000, 0),
+				DutchAuction: &DutchAuctionConfig{
+					FloorPrice: sdk.NewInt64Coin("nanolike", 100),
+					StartTime:  time.Unix(1600000000, 0),
+					EndTime:    time.Unix(1700000001, 0),
+				},
 			},
+			err: ErrInvalidPrice,
 		},
 	}
 	for _, tt := range tests {
@@ -19,8 +19,9 @@ func NewMsgCreateOffer(
 	creator string,
 	classId string,
 	nftId string,
-	price uint64,
+	price sdk.Coin,
 	expiration time.Time,
+	autoMatch bool,
 
 ) *MsgCreateOffer {
 	return &MsgCreateOffer{
@@ -29,6 +30,7 @@ func NewMsgCreateOffer(
 		NftId:      nftId,
 		Price:      price,
 		Expiration: expiration,
+		AutoMatch:  autoMatch,
 	}
 }
 
@@ -58,6 +60,9 @@ func (msg *MsgCreateOffer) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
 	return nil
 }
 
@@ -67,7 +72,7 @@ func NewMsgUpdateOffer(
 	creator string,
 	classId string,
 	nftId string,
-	price uint64,
+	price sdk.Coin,
 	expiration time.Time,
 
 ) *MsgUpdateOffer {
@@ -106,6 +111,9 @@ func (msg *MsgUpdateOffer) ValidateBasic() error {
 	if err != nil {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address (%s)", err)
 	}
+	if err := ValidatePrice(msg.Price); err != nil {
+		return ErrInvalidPrice.Wrapf("%s", err.Error())
+	}
 	return nil
 }
 
@@ -6,6 +6,7 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
@@ -29,11 +30,11 @@ var _ = time.Kitchen
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Offer struct {
-	ClassId    string    `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
-	NftId      string    `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
-	Buyer      string    `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
-	Price      uint64    `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
-	Expiration time.Time `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	ClassId    string     `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId      string     `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
+	Buyer      string     `protobuf:"bytes,3,opt,name=buyer,proto3" json:"buyer,omitempty"`
+	Expiration time.Time  `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	Price      types.Coin `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
 }
 
 func (m *Offer) Reset()         { *m = Offer{} }
@@ -90,26 +91,26 @@ func (m *Offer) GetBuyer() string {
 	return ""
 }
 
-func (m *Offer) GetPrice() uint64 {
+func (m *Offer) GetExpiration() time.Time {
 	if m != nil {
-		return m.Price
+		return m.Expiration
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *Offer) GetExpiration() time.Time {
+func (m *Offer) GetPrice() types.Coin {
 	if m != nil {
-		return m.Expiration
+		return m.Price
 	}
-	return time.Time{}
+	return types.Coin{}
 }
 
 type OfferStoreRecord struct {
 	ClassId    string                                        `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
 	NftId      string                                        `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 	Buyer      github_com_cosmos_cosmos_sdk_types.AccAddress `protobuf:"bytes,3,opt,name=buyer,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"buyer,omitempty"`
-	Price      uint64                                        `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
 	Expiration time.Time                                     `protobuf:"bytes,5,opt,name=expiration,proto3,stdtime" json:"expiration"`
+	Price      types.Coin                                    `protobuf:"bytes,6,opt,name=price,proto3" json:"price"`
 }
 
 func (m *OfferStoreRecord) Reset()         { *m = OfferStoreRecord{} }
@@ -166,18 +167,18 @@ func (m *OfferStoreRecord) GetBuyer() github_com_cosmos_cosmos_sdk_types.AccAddr
 	return nil
 }
 
-func (m *OfferStoreRecord) GetPrice() uint64 {
+func (m *OfferStoreRecord) GetExpiration() time.Time {
 	if m != nil {
-		return m.Price
+		return m.Expiration
 	}
-	return 0
+	return time.Time{}
 }
 
-func (m *OfferStoreRecord) GetExpiration() time.Time {
+func (m *OfferStoreRecord) GetPrice() types.Coin {
 	if m != nil {
-		return m.Expiration
+		return m.Price
 	}
-	return time.Time{}
+	return types.Coin{}
 }
 
 func init() {
@@ -188,30 +189,32 @@ func init() {
 func init() { proto.RegisterFile("likechain/likenft/v1/offer.proto", fileDescriptor_ad0d5cfe801d650e) }
 
 var fileDescriptor_ad0d5cfe801d650e = []byte{
-	// 353 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0x3f, 0x6e, 0xc2, 0x30,
-	0x18, 0xc5, 0xe3, 0x96, 0x50, 0xea, 0x76, 0xa8, 0x22, 0x2a, 0xa5, 0x0c, 0x49, 0xc4, 0xc4, 0x82,
-	0x2d, 0xfa, 0xe7, 0x00, 0xa0, 0x4a, 0x15, 0x13, 0x52, 0xda, 0xa9, 0x4b, 0x95, 0x38, 0x4e, 0xb0,
-	0x20, 0x71, 0x64, 0x1b, 0x04, 0xb7, 0xe0, 0x1e, 0xbd, 0x08, 0x23, 0x63, 0x27, 0x5a, 0xc1, 0xd4,
-	0x2b, 0x74, 0xaa, 0xe2, 0x00, 0x62, 0xee, 0xd0, 0x29, 0xef, 0x7d, 0xdf, 0x8b, 0xfd, 0xb3, 0xf4,
-	0xa0, 0x37, 0x66, 0x23, 0x4a, 0x86, 0x01, 0xcb, 0x70, 0xa1, 0xb2, 0x58, 0xe1, 0x69, 0x07, 0xf3,
-	0x38, 0xa6, 0x02, 0xe5, 0x82, 0x2b, 0x6e, 0xd5, 0x0f, 0x09, 0xb4, 0x4b, 0xa0, 0x69, 0xa7, 0x51,
-	0x4f, 0x78, 0xc2, 0x75, 0x00, 0x17, 0xaa, 0xcc, 0x36, 0xdc, 0x84, 0xf3, 0x64, 0x4c, 0xb1, 0x76,
-	0xe1, 0x24, 0xc6, 0x8a, 0xa5, 0x54, 0xaa, 0x20, 0xcd, 0xcb, 0x40, 0xf3, 0x1d, 0x40, 0x73, 0x50,
-	0x1c, 0x6e, 0xdd, 0xc0, 0x1a, 0x19, 0x07, 0x52, 0xbe, 0xb1, 0xc8, 0x06, 0x1e, 0x68, 0x9d, 0xfb,
-	0x67, 0xda, 0xf7, 0x23, 0xeb, 0x1a, 0x56, 0xb3, 0x58, 0x15, 0x8b, 0x13, 0xbd, 0x30, 0xb3, 0x58,
-	0xf5, 0x23, 0xab, 0x0e, 0xcd, 0x70, 0x32, 0xa7, 0xc2, 0x3e, 0x2d, 0xa7, 0xda, 0x14, 0xd3, 0x5c,
-	0x30, 0x42, 0xed, 0x8a, 0x07, 0x5a, 0x15, 0xbf, 0x34, 0xd6, 0x23, 0x84, 0x74, 0x96, 0x33, 0x11,
-	0x28, 0xc6, 0x33, 0xdb, 0xf4, 0x40, 0xeb, 0xe2, 0xb6, 0x81, 0x4a, 0x3a, 0xb4, 0xa7, 0x43, 0x2f,
-	0x7b, 0xba, 0x5e, 0x6d, 0xb9, 0x76, 0x8d, 0xc5, 0xa7, 0x0b, 0xfc, 0xa3, 0xff, 0x9a, 0xdf, 0x00,
-	0x5e, 0x69, 0xda, 0x67, 0xc5, 0x05, 0xf5, 0x29, 0xe1, 0x22, 0xfa, 0x03, 0xf8, 0xd3, 0x31, 0xf8,
-	0x65, 0xaf, 0xf3, 0xb3, 0x76, 0xdb, 0x09, 0x53, 0xc3, 0x49, 0x88, 0x08, 0x4f, 0x31, 0xe1, 0x32,
-	0xe5, 0x72, 0xf7, 0x69, 0xcb, 0x68, 0x84, 0xd5, 0x3c, 0xa7, 0x12, 0x75, 0x09, 0xe9, 0x46, 0x91,
-	0xa0, 0x52, 0xfe, 0xc3, 0x5b, 0x7b, 0x83, 0xe5, 0xc6, 0x01, 0xab, 0x8d, 0x03, 0xbe, 0x36, 0x0e,
-	0x58, 0x6c, 0x1d, 0x63, 0xb5, 0x75, 0x8c, 0x8f, 0xad, 0x63, 0xbc, 0x3e, 0x1c, 0xb1, 0xea, 0x2e,
-	0xf0, 0x5d, 0x59, 0x0a, 0xd1, 0x2e, 0xbb, 0x33, 0xbd, 0xc7, 0xb3, 0x43, 0x81, 0x34, 0x7e, 0x58,
-	0xd5, 0x57, 0xdf, 0xfd, 0x06, 0x00, 0x00, 0xff, 0xff, 0x12, 0xc9, 0xf2, 0x0a, 0x62, 0x02, 0x00,
-	0x00,
+	// 392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x92, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0x86, 0xc5, 0xd4, 0x72, 0x5d, 0xb6, 0x43, 0x20, 0xb8, 0x80, 0xe2, 0x41, 0x32, 0x32, 0x65,
+	0x31, 0x09, 0xb5, 0xcd, 0x03, 0x44, 0x2d, 0x50, 0xa4, 0x4b, 0x00, 0xb5, 0x53, 0x97, 0x42, 0xa2,
+	0x28, 0x85, 0x88, 0xc5, 0x13, 0x48, 0x5a, 0x48, 0xde, 0xc2, 0x8f, 0x95, 0x31, 0xe8, 0xd4, 0xc9,
+	0x2d, 0xec, 0xb7, 0xe8, 0x54, 0x88, 0x94, 0x0d, 0xcf, 0x9d, 0x32, 0xe9, 0x3f, 0xde, 0x7f, 0xd2,
+	0xff, 0x89, 0x87, 0xe7, 0x4b, 0x71, 0xc7, 0xd9, 0x6d, 0x2e, 0x24, 0xed, 0x95, 0xac, 0x0c, 0xed,
+	0x12, 0x0a, 0x55, 0xc5, 0x15, 0x69, 0x15, 0x18, 0x08, 0xa6, 0x07, 0x07, 0x19, 0x1c, 0xa4, 0x4b,
+	0x66, 0x11, 0x03, 0xdd, 0x80, 0xa6, 0x45, 0xae, 0x39, 0xed, 0x92, 0x82, 0x9b, 0x3c, 0xa1, 0x0c,
+	0x84, 0x74, 0x53, 0xb3, 0x69, 0x0d, 0x35, 0x58, 0x49, 0x7b, 0x35, 0x9c, 0xc6, 0x35, 0x40, 0xbd,
+	0xe4, 0xd4, 0x56, 0xc5, 0xaa, 0xa2, 0x46, 0x34, 0x5c, 0x9b, 0xbc, 0x69, 0x9d, 0xe1, 0xfc, 0x27,
+	0xc2, 0xfe, 0x4d, 0xff, 0xf1, 0xe0, 0x0c, 0x4f, 0xd8, 0x32, 0xd7, 0xfa, 0x87, 0x28, 0x43, 0x34,
+	0x47, 0x17, 0xaf, 0xb2, 0x97, 0xb6, 0xbe, 0x2e, 0x83, 0xb7, 0x78, 0x2c, 0x2b, 0xd3, 0x37, 0x4e,
+	0x6c, 0xc3, 0x97, 0x95, 0xb9, 0x2e, 0x83, 0x29, 0xf6, 0x8b, 0xd5, 0x03, 0x57, 0xe1, 0x0b, 0x77,
+	0x6a, 0x8b, 0xe0, 0x13, 0xc6, 0xfc, 0xbe, 0x15, 0x2a, 0x37, 0x02, 0x64, 0xe8, 0xcf, 0xd1, 0xc5,
+	0xeb, 0x77, 0x33, 0xe2, 0x72, 0x90, 0x7d, 0x0e, 0xf2, 0x6d, 0x9f, 0x23, 0x9d, 0x3c, 0x6e, 0x62,
+	0x6f, 0xfd, 0x3b, 0x46, 0xd9, 0xd1, 0x5c, 0x70, 0x89, 0xfd, 0x56, 0x09, 0xc6, 0xc3, 0xb1, 0x7d,
+	0xc1, 0x19, 0x71, 0xf8, 0xa4, 0xc7, 0x27, 0x03, 0x3e, 0xf9, 0x08, 0x42, 0xa6, 0xa3, 0x7e, 0x3e,
+	0x73, 0xee, 0x2f, 0xa3, 0xc9, 0xe8, 0xd4, 0x3f, 0x5f, 0x9f, 0xe0, 0x53, 0x0b, 0xf5, 0xd5, 0x80,
+	0xe2, 0x19, 0x67, 0xa0, 0xca, 0xff, 0xe0, 0xfb, 0x7c, 0xcc, 0xf7, 0x26, 0x4d, 0xfe, 0x6e, 0xe2,
+	0x45, 0x2d, 0xcc, 0xed, 0xaa, 0x20, 0x0c, 0x1a, 0x3a, 0x5c, 0x88, 0x7b, 0x2c, 0x74, 0x79, 0x47,
+	0xcd, 0x43, 0xcb, 0x35, 0xb9, 0x62, 0xec, 0xaa, 0x2c, 0x15, 0xd7, 0xfa, 0xf9, 0xfc, 0x92, 0xf4,
+	0xe6, 0x71, 0x1b, 0xa1, 0xa7, 0x6d, 0x84, 0xfe, 0x6c, 0x23, 0xb4, 0xde, 0x45, 0xde, 0xd3, 0x2e,
+	0xf2, 0x7e, 0xed, 0x22, 0xef, 0xfb, 0xe5, 0x11, 0x92, 0xdd, 0x3c, 0x10, 0xf2, 0x20, 0x16, 0x6e,
+	0x53, 0xbb, 0x0f, 0xf4, 0xfe, 0xb0, 0xae, 0x96, 0xb2, 0x18, 0xdb, 0xdc, 0xef, 0xff, 0x0d, 0x00,
+	0xf1, 0xf2, 0x0b, 0x35, 0xd0, 0x02, 0x00, 0x00,
 }
 
 func (m *Offer) Marshal() (dAtA []byte, err error) {
@@ -234,19 +237,24 @@ func (m *Offer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
-	if err1 != nil {
-		return 0, err1
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOffer(dAtA, i, uint64(size))
 	}
-	i -= n1
-	i = encodeVarintOffer(dAtA, i, uint64(n1))
 	i--
-	dAtA[i] = 0x2a
-	if m.Price != 0 {
-		i = encodeVarintOffer(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
+	dAtA[i] = 0x32
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err2 != nil {
+		return 0, err2
 	}
+	i -= n2
+	i = encodeVarintOffer(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x2a
 	if len(m.Buyer) > 0 {
 		i -= len(m.Buyer)
 		copy(dAtA[i:], m.Buyer)
@@ -291,19 +299,24 @@ func (m *OfferStoreRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
-	if err2 != nil {
-		return 0, err2
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintOffer(dAtA, i, uint64(size))
 	}
-	i -= n2
-	i = encodeVarintOffer(dAtA, i, uint64(n2))
 	i--
-	dAtA[i] = 0x2a
-	if m.Price != 0 {
-		i = encodeVarintOffer(dAtA, i, uint64(m.Price))
-		i--
-		dAtA[i] = 0x20
+	dAtA[i] = 0x32
+	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Expiration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration):])
+	if err4 != nil {
+		return 0, err4
 	}
+	i -= n4
+	i = encodeVarintOffer(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x2a
 	if len(m.Buyer) > 0 {
 		i -= len(m.Buyer)
 		copy(dAtA[i:], m.Buyer)
@@ -357,11 +370,10 @@ func (m *Offer) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOffer(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovOffer(uint64(m.Price))
-	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovOffer(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOffer(uint64(l))
 	return n
 }
 
@@ -383,11 +395,10 @@ func (m *OfferStoreRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOffer(uint64(l))
 	}
-	if m.Price != 0 {
-		n += 1 + sovOffer(uint64(m.Price))
-	}
 	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Expiration)
 	n += 1 + l + sovOffer(uint64(l))
+	l = m.Price.Size()
+	n += 1 + l + sovOffer(uint64(l))
 	return n
 }
 
@@ -522,11 +533,11 @@ func (m *Offer) Unmarshal(dAtA []byte) error {
 			}
 			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			m.Price = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOffer
@@ -536,14 +547,28 @@ func (m *Offer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -570,7 +595,7 @@ func (m *Offer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -722,11 +747,11 @@ func (m *OfferStoreRecord) Unmarshal(dAtA []byte) error {
 				m.Buyer = []byte{}
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
 			}
-			m.Price = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOffer
@@ -736,14 +761,28 @@ func (m *OfferStoreRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Price |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			if msglen < 0 {
+				return ErrInvalidLengthOffer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOffer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -770,7 +809,7 @@ func (m *OfferStoreRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2,9 +2,12 @@ package types
 
 import (
 	"fmt"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	likefeegranttypes "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 )
 
 var (
@@ -25,6 +28,8 @@ var (
 	ParamKeyMaxOfferDurationDays   = []byte("MaxOfferDurationDays")
 	ParamKeyMaxListingDurationDays = []byte("MaxListingDurationDays")
 	ParamKeyMaxRoyaltyBasisPoints  = []byte("MaxRoyaltyBasisPoints")
+	ParamKeyFeeRouting             = []byte("FeeRouting")
+	ParamKeyAllowedPriceDenoms     = []byte("AllowedPriceDenoms")
 )
 
 var _ paramtypes.ParamSet = (*Params)(nil)
@@ -42,6 +47,8 @@ func DefaultParams() Params {
 		MaxOfferDurationDays:   DefaultMaxOfferDurationDays,
 		MaxListingDurationDays: DefaultMaxListingDurationDays,
 		MaxRoyaltyBasisPoints:  DefaultMaxRoyaltyBasisPoints,
+		FeeRouting:             likefeegranttypes.DefaultFeeRouting(),
+		AllowedPriceDenoms:     []string{},
 	}
 }
 
@@ -61,6 +68,25 @@ func validatePriceDenom(i interface{}) error {
 	return nil
 }
 
+// Validate allowed price denoms, which are accepted in addition to the price denom
+func validateAllowedPriceDenoms(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("Allowed price denoms has invalid type: %T", i)
+	}
+	seen := map[string]struct{}{}
+	for _, denom := range v {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("Invalid allowed price denom %s: %w", denom, err)
+		}
+		if _, ok := seen[denom]; ok {
+			return fmt.Errorf("Repeated allowed price denom %s", denom)
+		}
+		seen[denom] = struct{}{}
+	}
+	return nil
+}
+
 // Validate fee per byte
 func validateFeePerByte(i interface{}) error {
 	v, ok := i.(sdk.DecCoin)
@@ -112,6 +138,22 @@ func validateMaxRoyaltyBasisPoints(i interface{}) error {
 	return nil
 }
 
+// Validate fee routing, empty fee routing means all the fees go to the fee collector
+func validateFeeRouting(i interface{}) error {
+	v, ok := i.(likefeegranttypes.FeeRouting)
+	if !ok {
+		return fmt.Errorf("LikeNFT fee routing has invalid type: %T", i)
+	}
+	if v.IsEmpty() {
+		return nil
+	}
+	err := v.Validate()
+	if err != nil {
+		return fmt.Errorf("invalid LikeNFT fee routing: %w", err)
+	}
+	return nil
+}
+
 // ParamSetPairs get the params.ParamSet
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
@@ -120,6 +162,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(ParamKeyMaxOfferDurationDays, &p.MaxOfferDurationDays, validateMaxOfferDurationDays),
 		paramtypes.NewParamSetPair(ParamKeyMaxListingDurationDays, &p.MaxListingDurationDays, validateMaxListingDurationDays),
 		paramtypes.NewParamSetPair(ParamKeyMaxRoyaltyBasisPoints, &p.MaxRoyaltyBasisPoints, validateMaxRoyaltyBasisPoints),
+		paramtypes.NewParamSetPair(ParamKeyFeeRouting, &p.FeeRouting, validateFeeRouting),
+		paramtypes.NewParamSetPair(ParamKeyAllowedPriceDenoms, &p.AllowedPriceDenoms, validateAllowedPriceDenoms),
 	}
 }
 
@@ -146,6 +190,14 @@ func (p Params) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = validateFeeRouting(p.FeeRouting)
+	if err != nil {
+		return err
+	}
+	err = validateAllowedPriceDenoms(p.AllowedPriceDenoms)
+	if err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -156,11 +208,15 @@ func (p Params) String() string {
 	Fee per byte: %s
 	Max offer duration days: %d
 	Max listing duration days: %d
-	Max royalty basis points: %d`,
+	Max royalty basis points: %d
+	Fee routing: %s
+	Allowed price denoms: %s`,
 		p.PriceDenom,
 		p.FeePerByte,
 		p.MaxOfferDurationDays,
 		p.MaxListingDurationDays,
 		p.MaxRoyaltyBasisPoints,
+		p.FeeRouting.String(),
+		strings.Join(p.AllowedPriceDenoms, ", "),
 	)
 }
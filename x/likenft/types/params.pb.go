@@ -8,6 +8,7 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	types1 "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
@@ -26,11 +27,14 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	PriceDenom             string        `protobuf:"bytes,1,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
-	FeePerByte             types.DecCoin `protobuf:"bytes,2,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte"`
-	MaxOfferDurationDays   uint64        `protobuf:"varint,3,opt,name=max_offer_duration_days,json=maxOfferDurationDays,proto3" json:"max_offer_duration_days,omitempty"`
-	MaxListingDurationDays uint64        `protobuf:"varint,4,opt,name=max_listing_duration_days,json=maxListingDurationDays,proto3" json:"max_listing_duration_days,omitempty"`
-	MaxRoyaltyBasisPoints  uint64        `protobuf:"varint,5,opt,name=max_royalty_basis_points,json=maxRoyaltyBasisPoints,proto3" json:"max_royalty_basis_points,omitempty"`
+	PriceDenom             string            `protobuf:"bytes,1,opt,name=price_denom,json=priceDenom,proto3" json:"price_denom,omitempty"`
+	FeePerByte             types.DecCoin     `protobuf:"bytes,2,opt,name=fee_per_byte,json=feePerByte,proto3" json:"fee_per_byte"`
+	MaxOfferDurationDays   uint64            `protobuf:"varint,3,opt,name=max_offer_duration_days,json=maxOfferDurationDays,proto3" json:"max_offer_duration_days,omitempty"`
+	MaxListingDurationDays uint64            `protobuf:"varint,4,opt,name=max_listing_duration_days,json=maxListingDurationDays,proto3" json:"max_listing_duration_days,omitempty"`
+	MaxRoyaltyBasisPoints  uint64            `protobuf:"varint,5,opt,name=max_royalty_basis_points,json=maxRoyaltyBasisPoints,proto3" json:"max_royalty_basis_points,omitempty"`
+	FeeRouting             types1.FeeRouting `protobuf:"bytes,6,opt,name=fee_routing,json=feeRouting,proto3" json:"fee_routing"`
+	// Denoms accepted for prices in addition to `price_denom`, e.g. IBC vouchers
+	AllowedPriceDenoms []string `protobuf:"bytes,7,rep,name=allowed_price_denoms,json=allowedPriceDenoms,proto3" json:"allowed_price_denoms,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -100,6 +104,20 @@ func (m *Params) GetMaxRoyaltyBasisPoints() uint64 {
 	return 0
 }
 
+func (m *Params) GetFeeRouting() types1.FeeRouting {
+	if m != nil {
+		return m.FeeRouting
+	}
+	return types1.FeeRouting{}
+}
+
+func (m *Params) GetAllowedPriceDenoms() []string {
+	if m != nil {
+		return m.AllowedPriceDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "likechain.likenft.v1.Params")
 }
@@ -107,30 +125,35 @@ func init() {
 func init() { proto.RegisterFile("likechain/likenft/v1/params.proto", fileDescriptor_f9e0511dddc32499) }
 
 var fileDescriptor_f9e0511dddc32499 = []byte{
-	// 368 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0xc7, 0x93, 0x23, 0x9c, 0x84, 0x8f, 0x29, 0x2a, 0x10, 0x4e, 0x28, 0x2d, 0x4c, 0x5d, 0xb0,
-	0x15, 0xe0, 0x84, 0x60, 0x0c, 0x19, 0x91, 0xae, 0xca, 0xc8, 0x62, 0x39, 0xe9, 0x4b, 0xce, 0xa2,
-	0xb6, 0x23, 0xdb, 0x8d, 0x92, 0x6f, 0xc1, 0xc8, 0xd8, 0x8f, 0xd3, 0xb1, 0x23, 0x13, 0x42, 0xed,
-	0x17, 0x41, 0x76, 0xaa, 0x4a, 0xb0, 0xfd, 0xf5, 0x7e, 0xfe, 0xf9, 0x49, 0xef, 0x8f, 0x5e, 0x6f,
-	0xf8, 0x77, 0xa8, 0x1f, 0x18, 0x97, 0xc4, 0x25, 0xd9, 0x58, 0xd2, 0x67, 0xa4, 0x63, 0x9a, 0x09,
-	0x83, 0x3b, 0xad, 0xac, 0x8a, 0x67, 0x97, 0x27, 0xf8, 0xfc, 0x04, 0xf7, 0xd9, 0x6d, 0x5a, 0x2b,
-	0x23, 0x94, 0x21, 0x15, 0x33, 0x40, 0xfa, 0xac, 0x02, 0xcb, 0x32, 0x52, 0x2b, 0x2e, 0x27, 0xeb,
-	0x76, 0xd6, 0xaa, 0x56, 0xf9, 0x48, 0x5c, 0x9a, 0xa6, 0x6f, 0x76, 0x57, 0xe8, 0x7a, 0xe5, 0x3f,
-	0x8f, 0xe7, 0xe8, 0xa6, 0xd3, 0xbc, 0x06, 0xba, 0x06, 0xa9, 0x44, 0x12, 0x2e, 0xc2, 0xe5, 0x93,
-	0x12, 0xf9, 0x51, 0xe1, 0x26, 0x71, 0x81, 0x9e, 0x36, 0x00, 0xb4, 0x03, 0x4d, 0xab, 0xd1, 0x42,
-	0x72, 0xb5, 0x08, 0x97, 0x37, 0xef, 0x5e, 0xe1, 0x69, 0x31, 0x76, 0x8b, 0xf1, 0x79, 0x31, 0x2e,
-	0xa0, 0xfe, 0xa2, 0xb8, 0xcc, 0xa3, 0xfd, 0xef, 0x79, 0x50, 0xa2, 0x06, 0x60, 0x05, 0x3a, 0x1f,
-	0x2d, 0xc4, 0x77, 0xe8, 0x85, 0x60, 0x03, 0x55, 0x4d, 0x03, 0x9a, 0xae, 0xb7, 0x9a, 0x59, 0xae,
-	0x24, 0x5d, 0xb3, 0xd1, 0x24, 0x8f, 0x16, 0xe1, 0x32, 0x2a, 0x67, 0x82, 0x0d, 0xf7, 0x8e, 0x16,
-	0x67, 0x58, 0xb0, 0xd1, 0xc4, 0x9f, 0xd0, 0x4b, 0xa7, 0x6d, 0xb8, 0xb1, 0x5c, 0xb6, 0xff, 0x89,
-	0x91, 0x17, 0x9f, 0x0b, 0x36, 0x7c, 0x9d, 0xf8, 0x3f, 0xea, 0x47, 0x94, 0x38, 0x55, 0xab, 0x91,
-	0x6d, 0xec, 0x48, 0x2b, 0x66, 0xb8, 0xa1, 0x9d, 0xe2, 0xd2, 0x9a, 0xe4, 0xb1, 0x37, 0x9f, 0x09,
-	0x36, 0x94, 0x13, 0xce, 0x1d, 0x5d, 0x79, 0xf8, 0x39, 0xfa, 0xb9, 0x9b, 0x07, 0xf9, 0xfd, 0xfe,
-	0x98, 0x86, 0x87, 0x63, 0x1a, 0xfe, 0x39, 0xa6, 0xe1, 0x8f, 0x53, 0x1a, 0x1c, 0x4e, 0x69, 0xf0,
-	0xeb, 0x94, 0x06, 0xdf, 0xee, 0x5a, 0x6e, 0x1f, 0xb6, 0x15, 0xae, 0x95, 0xf0, 0x65, 0xb9, 0x6b,
-	0x5f, 0xc2, 0xdb, 0xa9, 0xc4, 0xfe, 0x03, 0x19, 0x2e, 0x4d, 0xda, 0xb1, 0x03, 0x53, 0x5d, 0xfb,
-	0xd3, 0xbf, 0xff, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x0d, 0x3f, 0x9f, 0x66, 0xeb, 0x01, 0x00, 0x00,
+	// 436 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x52, 0xc1, 0x8e, 0xd3, 0x30,
+	0x14, 0x6c, 0x68, 0x29, 0x5a, 0x97, 0x53, 0x54, 0x20, 0xac, 0x50, 0x5a, 0xf6, 0x94, 0x0b, 0x36,
+	0x05, 0x56, 0x08, 0x8e, 0x25, 0x42, 0x42, 0x42, 0xda, 0x2a, 0x47, 0x2e, 0x96, 0x93, 0xbe, 0x64,
+	0x2d, 0x12, 0x3b, 0xb2, 0xdd, 0x92, 0xfc, 0x05, 0x47, 0x2e, 0x48, 0x7c, 0xce, 0x1e, 0xf7, 0xc8,
+	0x09, 0xa1, 0xf6, 0x47, 0x90, 0x9d, 0x50, 0xb5, 0x7b, 0x7b, 0x7a, 0x33, 0x93, 0x8c, 0x67, 0x1e,
+	0x7a, 0x5e, 0xf2, 0xaf, 0x90, 0x5d, 0x33, 0x2e, 0x88, 0x9d, 0x44, 0x6e, 0xc8, 0x76, 0x41, 0x6a,
+	0xa6, 0x58, 0xa5, 0x71, 0xad, 0xa4, 0x91, 0xfe, 0xf4, 0x40, 0xc1, 0x3d, 0x05, 0x6f, 0x17, 0xe7,
+	0x61, 0x26, 0x75, 0x25, 0x35, 0x49, 0x99, 0x06, 0xb2, 0x5d, 0xa4, 0x60, 0xd8, 0x82, 0x64, 0x92,
+	0x8b, 0x4e, 0x75, 0x3e, 0x2d, 0x64, 0x21, 0xdd, 0x48, 0xec, 0xd4, 0x6f, 0xa3, 0xd3, 0xdf, 0xe5,
+	0x00, 0x85, 0x62, 0xc2, 0x90, 0x1c, 0x80, 0x2a, 0xb9, 0x31, 0x5c, 0x14, 0x1d, 0xf3, 0xe2, 0xe7,
+	0x10, 0x8d, 0x57, 0xce, 0x86, 0x3f, 0x43, 0x93, 0x5a, 0xf1, 0x0c, 0xe8, 0x1a, 0x84, 0xac, 0x02,
+	0x6f, 0xee, 0x45, 0x67, 0x09, 0x72, 0xab, 0xd8, 0x6e, 0xfc, 0x18, 0x3d, 0xb4, 0x1f, 0xa8, 0x41,
+	0xd1, 0xb4, 0x35, 0x10, 0xdc, 0x9b, 0x7b, 0xd1, 0xe4, 0xd5, 0x33, 0xdc, 0x59, 0xc4, 0xd6, 0x22,
+	0xee, 0x2d, 0xe2, 0x18, 0xb2, 0x0f, 0x92, 0x8b, 0xe5, 0xe8, 0xe6, 0xcf, 0x6c, 0x90, 0xa0, 0x1c,
+	0x60, 0x05, 0x6a, 0xd9, 0x1a, 0xf0, 0x2f, 0xd1, 0x93, 0x8a, 0x35, 0x54, 0xe6, 0x39, 0x28, 0xba,
+	0xde, 0x28, 0x66, 0xb8, 0x14, 0x74, 0xcd, 0x5a, 0x1d, 0x0c, 0xe7, 0x5e, 0x34, 0x4a, 0xa6, 0x15,
+	0x6b, 0xae, 0x2c, 0x1a, 0xf7, 0x60, 0xcc, 0x5a, 0xed, 0xbf, 0x43, 0x4f, 0xad, 0xac, 0xe4, 0xda,
+	0xba, 0xbf, 0x23, 0x1c, 0x39, 0xe1, 0xe3, 0x8a, 0x35, 0x9f, 0x3b, 0xfc, 0x44, 0xfa, 0x16, 0x05,
+	0x56, 0xaa, 0x64, 0xcb, 0x4a, 0xd3, 0xd2, 0x94, 0x69, 0xae, 0x69, 0x2d, 0xb9, 0x30, 0x3a, 0xb8,
+	0xef, 0x94, 0x8f, 0x2a, 0xd6, 0x24, 0x1d, 0xbc, 0xb4, 0xe8, 0xca, 0x81, 0xfe, 0x27, 0x34, 0x39,
+	0x4a, 0x2c, 0x18, 0xbb, 0xf7, 0x5e, 0xe0, 0xd3, 0xa2, 0xfe, 0x87, 0x8b, 0x3f, 0x02, 0x24, 0x1d,
+	0xf3, 0xe8, 0xd5, 0xfd, 0xc6, 0x7f, 0x89, 0xa6, 0xac, 0x2c, 0xe5, 0x37, 0x58, 0xd3, 0xa3, 0x90,
+	0x75, 0xf0, 0x60, 0x3e, 0x8c, 0xce, 0x12, 0xbf, 0xc7, 0x56, 0x87, 0xb0, 0xf5, 0xfb, 0xd1, 0x8f,
+	0x5f, 0xb3, 0xc1, 0xf2, 0xea, 0x66, 0x17, 0x7a, 0xb7, 0xbb, 0xd0, 0xfb, 0xbb, 0x0b, 0xbd, 0xef,
+	0xfb, 0x70, 0x70, 0xbb, 0x0f, 0x07, 0xbf, 0xf7, 0xe1, 0xe0, 0xcb, 0x65, 0xc1, 0xcd, 0xf5, 0x26,
+	0xc5, 0x99, 0xac, 0x5c, 0xc9, 0xf6, 0x28, 0x0e, 0xc3, 0x8b, 0xae, 0xfc, 0xed, 0x1b, 0xd2, 0x1c,
+	0x0e, 0xce, 0xb4, 0x35, 0xe8, 0x74, 0xec, 0x7a, 0x7f, 0xfd, 0x6f, 0x00, 0x71, 0xda, 0x35, 0xfb,
+	0x92, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -153,6 +176,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.AllowedPriceDenoms) > 0 {
+		for iNdEx := len(m.AllowedPriceDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPriceDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedPriceDenoms[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AllowedPriceDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.FeeRouting.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
 	if m.MaxRoyaltyBasisPoints != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxRoyaltyBasisPoints))
 		i--
@@ -220,6 +262,14 @@ func (m *Params) Size() (n int) {
 	if m.MaxRoyaltyBasisPoints != 0 {
 		n += 1 + sovParams(uint64(m.MaxRoyaltyBasisPoints))
 	}
+	l = m.FeeRouting.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.AllowedPriceDenoms) > 0 {
+		for _, s := range m.AllowedPriceDenoms {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -380,6 +430,71 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeRouting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeRouting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPriceDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPriceDenoms = append(m.AllowedPriceDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ValidatePrice checks that the price is a valid non-negative coin within the uint64 range used by the royalty
+// allocation. Whether the denom is accepted is checked against the parameters in the keeper.
+func ValidatePrice(price sdk.Coin) error {
+	if price.Amount.IsNil() {
+		return fmt.Errorf("price amount is empty")
+	}
+	if err := price.Validate(); err != nil {
+		return err
+	}
+	if !price.Amount.IsUint64() {
+		return fmt.Errorf("price amount %s is too large", price.Amount.String())
+	}
+	return nil
+}
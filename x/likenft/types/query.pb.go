@@ -6,12 +6,14 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	nft "github.com/cosmos/cosmos-sdk/x/nft"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
 	types "github.com/likecoin/likecoin-chain/v4/x/iscn/types"
+	types2 "github.com/likecoin/likecoin-chain/v4/x/likefeegrant/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -1505,6 +1507,8 @@ func (m *QueryListingRequest) GetSeller() string {
 
 type QueryListingResponse struct {
 	Listing Listing `protobuf:"bytes,1,opt,name=listing,proto3" json:"listing"`
+	// Price to buy the listing at the current block time, which declines over time for dutch auction listings
+	CurrentPrice types1.Coin `protobuf:"bytes,2,opt,name=current_price,json=currentPrice,proto3" json:"current_price"`
 }
 
 func (m *QueryListingResponse) Reset()         { *m = QueryListingResponse{} }
@@ -1547,6 +1551,13 @@ func (m *QueryListingResponse) GetListing() Listing {
 	return Listing{}
 }
 
+func (m *QueryListingResponse) GetCurrentPrice() types1.Coin {
+	if m != nil {
+		return m.CurrentPrice
+	}
+	return types1.Coin{}
+}
+
 type QueryListingIndexRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
@@ -2043,1823 +2054,1974 @@ func (m *QueryRoyaltyConfigIndexResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "likechain.likenft.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "likechain.likenft.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryClassesByISCNRequest)(nil), "likechain.likenft.v1.QueryClassesByISCNRequest")
-	proto.RegisterType((*QueryClassesByISCNResponse)(nil), "likechain.likenft.v1.QueryClassesByISCNResponse")
-	proto.RegisterType((*QueryClassesByISCNIndexRequest)(nil), "likechain.likenft.v1.QueryClassesByISCNIndexRequest")
-	proto.RegisterType((*QueryClassesByISCNIndexResponse)(nil), "likechain.likenft.v1.QueryClassesByISCNIndexResponse")
-	proto.RegisterType((*QueryISCNByClassRequest)(nil), "likechain.likenft.v1.QueryISCNByClassRequest")
-	proto.RegisterType((*QueryISCNByClassResponse)(nil), "likechain.likenft.v1.QueryISCNByClassResponse")
-	proto.RegisterType((*QueryClassesByAccountRequest)(nil), "likechain.likenft.v1.QueryClassesByAccountRequest")
-	proto.RegisterType((*QueryClassesByAccountResponse)(nil), "likechain.likenft.v1.QueryClassesByAccountResponse")
-	proto.RegisterType((*QueryClassesByAccountIndexRequest)(nil), "likechain.likenft.v1.QueryClassesByAccountIndexRequest")
-	proto.RegisterType((*QueryClassesByAccountIndexResponse)(nil), "likechain.likenft.v1.QueryClassesByAccountIndexResponse")
-	proto.RegisterType((*QueryAccountByClassRequest)(nil), "likechain.likenft.v1.QueryAccountByClassRequest")
-	proto.RegisterType((*QueryAccountByClassResponse)(nil), "likechain.likenft.v1.QueryAccountByClassResponse")
-	proto.RegisterType((*QueryBlindBoxContentRequest)(nil), "likechain.likenft.v1.QueryBlindBoxContentRequest")
-	proto.RegisterType((*QueryBlindBoxContentResponse)(nil), "likechain.likenft.v1.QueryBlindBoxContentResponse")
-	proto.RegisterType((*QueryBlindBoxContentIndexRequest)(nil), "likechain.likenft.v1.QueryBlindBoxContentIndexRequest")
-	proto.RegisterType((*QueryBlindBoxContentIndexResponse)(nil), "likechain.likenft.v1.QueryBlindBoxContentIndexResponse")
-	proto.RegisterType((*QueryBlindBoxContentsRequest)(nil), "likechain.likenft.v1.QueryBlindBoxContentsRequest")
-	proto.RegisterType((*QueryBlindBoxContentsResponse)(nil), "likechain.likenft.v1.QueryBlindBoxContentsResponse")
-	proto.RegisterType((*QueryOfferRequest)(nil), "likechain.likenft.v1.QueryOfferRequest")
-	proto.RegisterType((*QueryOfferResponse)(nil), "likechain.likenft.v1.QueryOfferResponse")
-	proto.RegisterType((*QueryOfferIndexRequest)(nil), "likechain.likenft.v1.QueryOfferIndexRequest")
-	proto.RegisterType((*QueryOfferIndexResponse)(nil), "likechain.likenft.v1.QueryOfferIndexResponse")
-	proto.RegisterType((*QueryOffersByClassRequest)(nil), "likechain.likenft.v1.QueryOffersByClassRequest")
-	proto.RegisterType((*QueryOffersByClassResponse)(nil), "likechain.likenft.v1.QueryOffersByClassResponse")
-	proto.RegisterType((*QueryOffersByNFTRequest)(nil), "likechain.likenft.v1.QueryOffersByNFTRequest")
-	proto.RegisterType((*QueryOffersByNFTResponse)(nil), "likechain.likenft.v1.QueryOffersByNFTResponse")
-	proto.RegisterType((*QueryListingRequest)(nil), "likechain.likenft.v1.QueryListingRequest")
-	proto.RegisterType((*QueryListingResponse)(nil), "likechain.likenft.v1.QueryListingResponse")
-	proto.RegisterType((*QueryListingIndexRequest)(nil), "likechain.likenft.v1.QueryListingIndexRequest")
-	proto.RegisterType((*QueryListingIndexResponse)(nil), "likechain.likenft.v1.QueryListingIndexResponse")
-	proto.RegisterType((*QueryListingsByClassRequest)(nil), "likechain.likenft.v1.QueryListingsByClassRequest")
-	proto.RegisterType((*QueryListingsByClassResponse)(nil), "likechain.likenft.v1.QueryListingsByClassResponse")
-	proto.RegisterType((*QueryListingsByNFTRequest)(nil), "likechain.likenft.v1.QueryListingsByNFTRequest")
-	proto.RegisterType((*QueryListingsByNFTResponse)(nil), "likechain.likenft.v1.QueryListingsByNFTResponse")
-	proto.RegisterType((*QueryRoyaltyConfigRequest)(nil), "likechain.likenft.v1.QueryRoyaltyConfigRequest")
-	proto.RegisterType((*QueryRoyaltyConfigResponse)(nil), "likechain.likenft.v1.QueryRoyaltyConfigResponse")
-	proto.RegisterType((*QueryRoyaltyConfigIndexRequest)(nil), "likechain.likenft.v1.QueryRoyaltyConfigIndexRequest")
-	proto.RegisterType((*QueryRoyaltyConfigIndexResponse)(nil), "likechain.likenft.v1.QueryRoyaltyConfigIndexResponse")
+type QueryCollectedFeesRequest struct {
 }
 
-func init() { proto.RegisterFile("likechain/likenft/v1/query.proto", fileDescriptor_14342af5346eedf4) }
-
-var fileDescriptor_14342af5346eedf4 = []byte{
-	// 1787 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6f, 0x14, 0x55,
-	0x14, 0xef, 0x2d, 0xb4, 0x85, 0x53, 0x5a, 0xca, 0xa5, 0x40, 0x19, 0xca, 0x52, 0x46, 0xa0, 0x1f,
-	0xd2, 0x9d, 0xb6, 0xb4, 0x94, 0x8f, 0x10, 0xb5, 0x4d, 0xd0, 0x26, 0x0a, 0x65, 0xf1, 0x23, 0x7e,
-	0x24, 0xeb, 0x7e, 0x4c, 0x97, 0x91, 0x65, 0x66, 0xd9, 0x99, 0x96, 0x6e, 0x9a, 0x1a, 0xd4, 0x27,
-	0x9f, 0x34, 0x21, 0xc6, 0x17, 0x35, 0x26, 0x04, 0x62, 0xe2, 0xe7, 0x83, 0x0f, 0x6a, 0x62, 0x7c,
-	0x30, 0x51, 0x12, 0x63, 0x42, 0xc2, 0x8b, 0x4f, 0xc6, 0x80, 0xff, 0x80, 0xff, 0x81, 0x99, 0x7b,
-	0xcf, 0x6c, 0xf7, 0xce, 0xde, 0x9d, 0xbd, 0xdb, 0x6c, 0x63, 0x7d, 0xdb, 0xbd, 0x7b, 0xce, 0x3d,
-	0xbf, 0xdf, 0xef, 0x9e, 0xfb, 0x71, 0x4e, 0x0b, 0x03, 0x79, 0xeb, 0xaa, 0x99, 0xb9, 0x92, 0xb2,
-	0x6c, 0xc3, 0xff, 0x64, 0x2f, 0x78, 0xc6, 0xd2, 0xb8, 0x71, 0x7d, 0xd1, 0x2c, 0x96, 0xe2, 0x85,
-	0xa2, 0xe3, 0x39, 0xb4, 0xb7, 0x6c, 0x11, 0x47, 0x8b, 0xf8, 0xd2, 0xb8, 0x36, 0x92, 0x71, 0xdc,
-	0x6b, 0x8e, 0x6b, 0xa4, 0x53, 0xae, 0xc9, 0xcd, 0x8d, 0xa5, 0xf1, 0xb4, 0xe9, 0xa5, 0xc6, 0x8d,
-	0x42, 0x2a, 0x67, 0xd9, 0x29, 0xcf, 0x72, 0x6c, 0x3e, 0x83, 0xd6, 0x8f, 0xb6, 0x7c, 0x72, 0x6e,
-	0xe4, 0x4f, 0xc3, 0x7f, 0xed, 0xcd, 0x39, 0x39, 0x87, 0x7d, 0x34, 0xfc, 0x4f, 0x81, 0x4f, 0xce,
-	0x71, 0x72, 0x79, 0xd3, 0x48, 0x15, 0x2c, 0x23, 0x65, 0xdb, 0x8e, 0xc7, 0x26, 0x74, 0xf1, 0x57,
-	0x6d, 0x0d, 0xb5, 0xe5, 0x66, 0xec, 0x4a, 0xbc, 0xda, 0x71, 0x29, 0xa3, 0x74, 0xde, 0xb2, 0xb3,
-	0xc9, 0xb4, 0xb3, 0x9c, 0xcc, 0x38, 0xb6, 0x67, 0xda, 0x41, 0xf4, 0x51, 0xa9, 0x75, 0x26, 0x9f,
-	0x72, 0x5d, 0xd3, 0x4d, 0xa6, 0x4b, 0xc9, 0x54, 0x26, 0xe3, 0x2c, 0x96, 0xcd, 0x47, 0xea, 0x99,
-	0xfb, 0x70, 0xd0, 0x56, 0x97, 0xda, 0xe6, 0x2d, 0xd7, 0xb3, 0xec, 0x1c, 0xda, 0xc8, 0xe5, 0x77,
-	0x16, 0x16, 0xcc, 0x22, 0x5a, 0x1c, 0x96, 0x5a, 0x14, 0x52, 0xc5, 0xd4, 0xb5, 0x40, 0x8d, 0x61,
-	0xa9, 0x49, 0xd1, 0x29, 0xa5, 0xf2, 0x5e, 0xc9, 0xe7, 0xbb, 0x60, 0x61, 0x3c, 0xbd, 0x17, 0xe8,
-	0x25, 0x5f, 0xab, 0x79, 0xe6, 0x9f, 0x30, 0xaf, 0x2f, 0x9a, 0xae, 0xa7, 0x5f, 0x82, 0xdd, 0xc2,
-	0xa8, 0x5b, 0x70, 0x6c, 0xd7, 0xa4, 0x67, 0xa0, 0x9d, 0xc7, 0xe9, 0x23, 0x03, 0x64, 0xa8, 0x73,
-	0xa2, 0x3f, 0x2e, 0x4b, 0x85, 0x38, 0xf7, 0x9a, 0xd9, 0x7a, 0xef, 0xcf, 0x43, 0x2d, 0x09, 0xf4,
-	0xd0, 0xdf, 0x25, 0xb0, 0x9f, 0xcd, 0x39, 0xcb, 0xb5, 0x99, 0x29, 0xcd, 0x5d, 0x9e, 0xbd, 0x80,
-	0x01, 0xe9, 0x11, 0xe8, 0xf6, 0x85, 0x4a, 0x5a, 0xd9, 0x64, 0xa1, 0x68, 0x2e, 0x58, 0xcb, 0x2c,
-	0xc2, 0xf6, 0xc4, 0x0e, 0x7f, 0x74, 0x2e, 0x3b, 0xcf, 0xc6, 0xe8, 0x79, 0x80, 0xb5, 0x5c, 0xea,
-	0x6b, 0x65, 0x18, 0x8e, 0xc5, 0x79, 0x32, 0xc5, 0xfd, 0xc4, 0x8b, 0xf3, 0x75, 0xc7, 0x9c, 0x8a,
-	0xcf, 0xa7, 0x72, 0x26, 0x46, 0x48, 0x54, 0x78, 0xea, 0xbf, 0x10, 0xd0, 0x64, 0x58, 0x90, 0xa6,
-	0x1a, 0x98, 0xd3, 0xd0, 0x81, 0xcb, 0xdc, 0xd7, 0x3a, 0xb0, 0x65, 0xa8, 0x73, 0x62, 0x7f, 0x80,
-	0x84, 0xcb, 0xc0, 0x21, 0xb0, 0x08, 0x28, 0x45, 0x60, 0x4f, 0x9f, 0x16, 0x78, 0x6c, 0x61, 0x3c,
-	0x06, 0xeb, 0xf2, 0xe0, 0xe8, 0x04, 0x22, 0x57, 0x20, 0x56, 0xcd, 0x63, 0xce, 0xce, 0x9a, 0xcb,
-	0x81, 0xb0, 0xa2, 0x64, 0x64, 0xdd, 0x92, 0xfd, 0x44, 0xe0, 0x50, 0xcd, 0x50, 0xa8, 0xdb, 0x65,
-	0xe8, 0x09, 0x25, 0xbe, 0x9f, 0x28, 0xbe, 0x34, 0x8f, 0xc9, 0x13, 0x45, 0x98, 0x0b, 0x45, 0xea,
-	0xce, 0x94, 0x07, 0xfd, 0x09, 0x42, 0x5a, 0xb5, 0xae, 0x5f, 0xab, 0x49, 0xd8, 0xc7, 0x08, 0xb0,
-	0x58, 0x9c, 0x46, 0x20, 0xd2, 0x7e, 0xd8, 0xc6, 0xa2, 0x26, 0xad, 0x2c, 0x2e, 0x35, 0x5f, 0xaa,
-	0xb9, 0xac, 0xfe, 0x3b, 0x81, 0xbe, 0x6a, 0xb7, 0x86, 0x12, 0xa5, 0x17, 0xda, 0x9c, 0x1b, 0xb6,
-	0x59, 0x64, 0xe0, 0xb7, 0x27, 0xf8, 0x17, 0x7a, 0x14, 0xba, 0xf3, 0x29, 0xcf, 0x74, 0xbd, 0xe4,
-	0x92, 0x59, 0x74, 0x83, 0x3c, 0xd8, 0x9a, 0xe8, 0xe2, 0xa3, 0x2f, 0xf2, 0x41, 0x7a, 0x01, 0x70,
-	0x20, 0x59, 0x34, 0x33, 0x4e, 0x31, 0xdb, 0xb7, 0x95, 0x29, 0x50, 0x29, 0x28, 0x3b, 0x61, 0x18,
-	0xc6, 0x32, 0x6f, 0x66, 0x8a, 0x82, 0xee, 0xe0, 0xfe, 0x7c, 0x4c, 0xbf, 0x49, 0xa0, 0x5f, 0x5c,
-	0xc7, 0xa7, 0xf8, 0x79, 0x16, 0x68, 0xd1, 0x07, 0x1d, 0x78, 0xc2, 0x05, 0x52, 0xe0, 0xd7, 0xa6,
-	0xed, 0xbe, 0x1f, 0x09, 0x1c, 0xac, 0x01, 0x01, 0x75, 0xad, 0x8d, 0x61, 0x33, 0x6c, 0xba, 0xab,
-	0x70, 0x58, 0x0a, 0x7f, 0x43, 0xf6, 0xdd, 0x6f, 0x04, 0xf4, 0xa8, 0x68, 0xa8, 0xd8, 0x6b, 0xb0,
-	0xbb, 0xfa, 0x8a, 0x0a, 0x76, 0xdf, 0xb1, 0x3a, 0xbb, 0x0f, 0x67, 0x44, 0xc1, 0x76, 0x65, 0x42,
-	0xe3, 0x4d, 0xdc, 0x83, 0xd3, 0x78, 0xee, 0x06, 0x11, 0x95, 0xb7, 0xe1, 0x34, 0x1c, 0x90, 0x3a,
-	0x56, 0x24, 0x4c, 0x36, 0x5b, 0x34, 0x5d, 0xb7, 0x9c, 0x30, 0xfc, 0xab, 0xfe, 0x0c, 0x3a, 0xce,
-	0xf8, 0xd7, 0xfd, 0x8c, 0xb3, 0x3c, 0xcb, 0x2f, 0xfb, 0xfa, 0x21, 0x69, 0x37, 0xb4, 0x5a, 0x59,
-	0xdc, 0xb3, 0xad, 0x56, 0x56, 0xbf, 0x81, 0x1b, 0xa7, 0x6a, 0x26, 0xc4, 0xf0, 0x12, 0xec, 0xaa,
-	0x7a, 0x53, 0xe0, 0xc2, 0x1f, 0x95, 0x2f, 0x40, 0x68, 0x26, 0xd4, 0x7f, 0x67, 0x5a, 0x1c, 0xd6,
-	0xdf, 0x80, 0x01, 0x59, 0xe0, 0x0d, 0x49, 0xb7, 0x5f, 0x09, 0x26, 0xb7, 0x3c, 0x18, 0x52, 0x7d,
-	0x19, 0x68, 0x15, 0xd5, 0x20, 0xd9, 0x1a, 0xe2, 0xda, 0x13, 0xe2, 0xda, 0xc4, 0x54, 0x7b, 0x8b,
-	0xc8, 0xd7, 0x4b, 0x21, 0xdb, 0x9a, 0x76, 0xd2, 0xfd, 0x1c, 0x9c, 0x74, 0xd5, 0x18, 0xfe, 0x47,
-	0x4a, 0xbe, 0x0a, 0xbb, 0x18, 0x89, 0x8b, 0xfe, 0x23, 0x54, 0x41, 0xbd, 0x3d, 0xd0, 0x6e, 0x2f,
-	0x78, 0xc9, 0xf2, 0xe6, 0x69, 0xb3, 0x17, 0xbc, 0xb9, 0xac, 0x7f, 0x0d, 0xa6, 0x17, 0x4b, 0x66,
-	0x91, 0x1d, 0xbd, 0xdb, 0x13, 0xfc, 0x8b, 0xfe, 0x1c, 0xbe, 0x3f, 0x71, 0x72, 0x94, 0x65, 0x1a,
-	0xda, 0xd8, 0x93, 0x17, 0x33, 0xf9, 0x80, 0x5c, 0x09, 0xe6, 0x83, 0xfc, 0xb9, 0xbd, 0xfe, 0x3a,
-	0xec, 0x5d, 0x9b, 0x6e, 0x43, 0x76, 0xc8, 0xc7, 0x04, 0xdf, 0x11, 0x95, 0x21, 0x10, 0xf6, 0x69,
-	0x68, 0x67, 0x30, 0x82, 0x15, 0x54, 0xc0, 0x8d, 0x0e, 0xcd, 0x5b, 0xad, 0x37, 0xf1, 0x99, 0xcd,
-	0x82, 0xb8, 0xca, 0x27, 0x6c, 0xd3, 0x72, 0xfe, 0xd3, 0xe0, 0x6d, 0x1d, 0x02, 0xb0, 0x89, 0x24,
-	0xba, 0x25, 0x2c, 0xa1, 0x3b, 0x53, 0xba, 0x70, 0xfe, 0xf9, 0xf5, 0xe7, 0xf5, 0x79, 0xc9, 0xbb,
-	0x62, 0x3d, 0xc2, 0x7d, 0x12, 0xbc, 0x34, 0x05, 0x54, 0x9b, 0x48, 0xb6, 0x24, 0x16, 0x85, 0xcf,
-	0xf2, 0x82, 0x75, 0xfd, 0x8a, 0xed, 0x85, 0x76, 0xd7, 0xcc, 0xe7, 0xcb, 0x47, 0x01, 0x7e, 0xd3,
-	0x5f, 0x80, 0x5e, 0x31, 0x00, 0x92, 0x3f, 0x07, 0x1d, 0x58, 0x24, 0xe3, 0xbe, 0x3d, 0x28, 0x67,
-	0x8f, 0x7e, 0xc1, 0xc3, 0x0f, 0x7d, 0xf4, 0x34, 0xea, 0x8a, 0x3f, 0x6f, 0xc8, 0xa9, 0x70, 0x27,
-	0xa8, 0x6e, 0xc5, 0x20, 0x48, 0xe0, 0x09, 0xd8, 0x86, 0x60, 0x82, 0xf5, 0x53, 0x62, 0x50, 0x76,
-	0x6a, 0xde, 0x1a, 0xde, 0x24, 0xf8, 0x1e, 0xc2, 0x48, 0xff, 0xc1, 0x01, 0xf1, 0x59, 0x70, 0x31,
-	0x57, 0x41, 0xd8, 0x74, 0x6a, 0x7d, 0x10, 0x5a, 0xd5, 0xcd, 0x72, 0x54, 0xdc, 0x0d, 0xce, 0xd8,
-	0x10, 0xae, 0x4d, 0x27, 0xe0, 0x49, 0xd4, 0x2f, 0xc1, 0x5b, 0x4f, 0xb3, 0xac, 0xf3, 0xa4, 0xf0,
-	0xdc, 0xb7, 0x91, 0x5f, 0xc8, 0x0f, 0xf9, 0xcd, 0x43, 0xb7, 0xd8, 0xcb, 0xc2, 0x8d, 0x5b, 0xa3,
-	0xcb, 0x20, 0x4c, 0x82, 0x5c, 0xbb, 0x8a, 0x95, 0x83, 0xe5, 0x3e, 0x8a, 0x60, 0xba, 0x21, 0x07,
-	0xc5, 0x83, 0xa0, 0x8f, 0x22, 0x0b, 0x85, 0xfc, 0x72, 0xb0, 0x4f, 0xe4, 0xe7, 0xd7, 0x74, 0x4c,
-	0x1a, 0x5c, 0xce, 0x11, 0x15, 0xa2, 0xa5, 0xca, 0x2a, 0xb8, 0xb7, 0x28, 0xf9, 0xad, 0x69, 0xeb,
-	0x3c, 0xf1, 0x8f, 0x06, 0x6d, 0x8c, 0x15, 0x7d, 0x87, 0x40, 0x3b, 0xef, 0xff, 0xd1, 0x21, 0x39,
-	0xca, 0xea, 0x76, 0xa3, 0x36, 0xac, 0x60, 0xc9, 0xa3, 0xea, 0x47, 0xde, 0x7e, 0xf0, 0xf7, 0xad,
-	0xd6, 0x18, 0xed, 0x37, 0x22, 0xda, 0xa0, 0xf4, 0x6b, 0x02, 0x5d, 0x42, 0x73, 0x89, 0x1a, 0x11,
-	0x21, 0x64, 0x1d, 0x49, 0x6d, 0x4c, 0xdd, 0x01, 0xa1, 0x9d, 0x65, 0xd0, 0xa6, 0xe8, 0x09, 0x39,
-	0x34, 0xd6, 0x29, 0xc2, 0xda, 0xda, 0x58, 0x11, 0xfb, 0x46, 0xab, 0xf4, 0x4b, 0x02, 0xb4, 0xba,
-	0xb5, 0x46, 0x27, 0x55, 0x51, 0x54, 0x26, 0xab, 0x36, 0xd5, 0xa0, 0x17, 0x12, 0x18, 0x61, 0x04,
-	0x8e, 0x50, 0xbd, 0x3e, 0x01, 0x7a, 0x9b, 0x40, 0x67, 0x45, 0x4b, 0x8c, 0x8e, 0x46, 0x84, 0xac,
-	0xee, 0xb8, 0x69, 0x71, 0x55, 0x73, 0x84, 0x36, 0xc5, 0xa0, 0x19, 0x74, 0xd4, 0x88, 0xea, 0xb7,
-	0x1b, 0x2b, 0xc1, 0x81, 0xb2, 0xca, 0xd0, 0xd2, 0x6f, 0x09, 0xf4, 0x84, 0xdb, 0x1c, 0x74, 0x42,
-	0x45, 0x1d, 0xb1, 0x2b, 0xa6, 0x9d, 0x68, 0xc8, 0x07, 0x41, 0x4f, 0x33, 0xd0, 0xe3, 0xd4, 0x90,
-	0x83, 0xc6, 0x2e, 0xcd, 0x5a, 0x4e, 0xe0, 0xc0, 0x2a, 0xfd, 0x9e, 0xc0, 0x1e, 0x69, 0xbf, 0x87,
-	0x4e, 0x37, 0x80, 0x43, 0x48, 0x89, 0x53, 0x8d, 0x3b, 0x22, 0x8b, 0x51, 0xc6, 0x62, 0x90, 0x1e,
-	0x55, 0x62, 0xe1, 0x6f, 0xbd, 0x6e, 0xb1, 0x4b, 0x43, 0xa3, 0xb6, 0x92, 0xb4, 0x13, 0xa4, 0x8d,
-	0x37, 0xe0, 0x81, 0x30, 0x4f, 0x31, 0x98, 0x13, 0x74, 0x4c, 0x39, 0x43, 0x82, 0x9e, 0xe2, 0x0f,
-	0x04, 0x76, 0x86, 0x8a, 0x6a, 0x1a, 0x05, 0x40, 0xde, 0x4a, 0xd2, 0x26, 0x1a, 0x71, 0x41, 0xd0,
-	0x4f, 0x32, 0xd0, 0x67, 0xe8, 0x29, 0x43, 0xed, 0x6f, 0x54, 0x02, 0xfe, 0x15, 0x2b, 0xcb, 0x52,
-	0xa5, 0x57, 0xd6, 0xab, 0xa1, 0x27, 0xd5, 0xe1, 0x08, 0x89, 0x32, 0xdd, 0xb0, 0x1f, 0x72, 0x19,
-	0x63, 0x5c, 0x46, 0xe8, 0x90, 0x2a, 0x17, 0xfa, 0x1d, 0x81, 0x9e, 0x70, 0x67, 0x84, 0x36, 0x20,
-	0xa3, 0xab, 0xb2, 0x3b, 0x6b, 0xb5, 0x5e, 0xea, 0x1d, 0xd7, 0x91, 0xda, 0xd3, 0x8f, 0x08, 0xb4,
-	0xb1, 0x62, 0x8b, 0x0e, 0x46, 0xc4, 0xae, 0xec, 0x98, 0x68, 0x43, 0xf5, 0x0d, 0xd5, 0xb2, 0x82,
-	0xd7, 0x75, 0x42, 0x26, 0xf0, 0xc7, 0xe6, 0xaa, 0xb1, 0xc2, 0x9a, 0x2a, 0xab, 0xf4, 0x3d, 0x02,
-	0xb0, 0xd6, 0x9f, 0xa0, 0xc7, 0xeb, 0x85, 0x16, 0x32, 0x60, 0x54, 0xd1, 0x5a, 0xed, 0x46, 0xc6,
-	0x2a, 0xf4, 0x36, 0x81, 0x2e, 0xa1, 0x23, 0x10, 0x79, 0x23, 0xcb, 0x9a, 0x17, 0x91, 0x37, 0xb2,
-	0xb4, 0xd9, 0xa0, 0x1b, 0x0c, 0xda, 0x30, 0x1d, 0x54, 0x14, 0x92, 0xde, 0x25, 0xd0, 0x59, 0x51,
-	0x7e, 0xd3, 0x51, 0x85, 0x90, 0x6b, 0x15, 0x41, 0xe4, 0xad, 0x26, 0xa9, 0xea, 0xeb, 0x9d, 0x59,
-	0xb5, 0x17, 0x9a, 0xde, 0x21, 0xd0, 0x81, 0xaf, 0x77, 0x1a, 0xf5, 0x7a, 0x12, 0x6b, 0x75, 0x6d,
-	0x44, 0xc5, 0x14, 0xc1, 0xcd, 0x32, 0x70, 0xe7, 0xe8, 0x59, 0x23, 0xea, 0xcf, 0xd6, 0x35, 0xf2,
-	0x90, 0x57, 0xf4, 0xab, 0xf4, 0x43, 0x02, 0x3b, 0x2a, 0x4b, 0x62, 0x1a, 0xaf, 0x8f, 0x40, 0x48,
-	0x46, 0x43, 0xd9, 0x1e, 0x61, 0x1f, 0x63, 0xb0, 0x07, 0x68, 0x2c, 0x1a, 0x36, 0xfd, 0x9c, 0xc0,
-	0xce, 0x50, 0x05, 0x1a, 0x79, 0xea, 0xcb, 0x0b, 0xe6, 0xc8, 0x53, 0xbf, 0x46, 0x81, 0xab, 0x8f,
-	0x33, 0x88, 0x8f, 0xd3, 0x61, 0x65, 0x65, 0xe9, 0x57, 0x04, 0xba, 0x84, 0x62, 0x8f, 0x1a, 0x4a,
-	0x81, 0x2b, 0x92, 0x73, 0x4c, 0xdd, 0x01, 0x71, 0x9e, 0x61, 0x38, 0x27, 0xe9, 0x44, 0xe3, 0x19,
-	0x40, 0xbf, 0x20, 0xd0, 0x25, 0xd4, 0x23, 0x91, 0x80, 0x65, 0xf5, 0x61, 0x24, 0x60, 0x69, 0x61,
-	0x58, 0x6f, 0x3f, 0x89, 0x45, 0x95, 0xa0, 0xef, 0x37, 0x04, 0x68, 0x75, 0x45, 0x16, 0xf9, 0xfc,
-	0xae, 0x59, 0x2b, 0x46, 0x3e, 0xbf, 0x6b, 0x97, 0x7d, 0xf5, 0x1e, 0x5a, 0x21, 0xf4, 0x33, 0x17,
-	0xef, 0x3d, 0x8c, 0x91, 0xfb, 0x0f, 0x63, 0xe4, 0xaf, 0x87, 0x31, 0xf2, 0xfe, 0xa3, 0x58, 0xcb,
-	0xfd, 0x47, 0xb1, 0x96, 0x3f, 0x1e, 0xc5, 0x5a, 0x5e, 0x99, 0xca, 0x59, 0xde, 0x95, 0xc5, 0x74,
-	0x3c, 0xe3, 0x5c, 0xe3, 0x53, 0x39, 0x38, 0x93, 0xff, 0x61, 0x94, 0x4f, 0xbc, 0x34, 0x69, 0x2c,
-	0x97, 0x67, 0xf7, 0x4a, 0x05, 0xd3, 0x4d, 0xb7, 0xb3, 0xff, 0x08, 0x39, 0xf1, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x44, 0x39, 0x8e, 0x04, 0x02, 0x24, 0x00, 0x00,
+func (m *QueryCollectedFeesRequest) Reset()         { *m = QueryCollectedFeesRequest{} }
+func (m *QueryCollectedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesRequest) ProtoMessage()    {}
+func (*QueryCollectedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{40}
+}
+func (m *QueryCollectedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCollectedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCollectedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesRequest.Merge(m, src)
+}
+func (m *QueryCollectedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryCollectedFeesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Queries a ClassesByISCN by index.
-	ClassesByISCN(ctx context.Context, in *QueryClassesByISCNRequest, opts ...grpc.CallOption) (*QueryClassesByISCNResponse, error)
-	// Queries a list of ClassesByISCN items.
-	ClassesByISCNIndex(ctx context.Context, in *QueryClassesByISCNIndexRequest, opts ...grpc.CallOption) (*QueryClassesByISCNIndexResponse, error)
-	// Queries a list of ISCNByClass items.
-	ISCNByClass(ctx context.Context, in *QueryISCNByClassRequest, opts ...grpc.CallOption) (*QueryISCNByClassResponse, error)
-	// Queries a ClassesByAccount by index.
-	ClassesByAccount(ctx context.Context, in *QueryClassesByAccountRequest, opts ...grpc.CallOption) (*QueryClassesByAccountResponse, error)
-	// Queries a list of ClassesByAccount items.
-	ClassesByAccountIndex(ctx context.Context, in *QueryClassesByAccountIndexRequest, opts ...grpc.CallOption) (*QueryClassesByAccountIndexResponse, error)
-	// Queries a list of AccountByClass items.
-	AccountByClass(ctx context.Context, in *QueryAccountByClassRequest, opts ...grpc.CallOption) (*QueryAccountByClassResponse, error)
-	// Queries a BlindBoxContent by index.
-	BlindBoxContent(ctx context.Context, in *QueryBlindBoxContentRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentResponse, error)
-	// Queries a list of BlindBoxContent items.
-	BlindBoxContentIndex(ctx context.Context, in *QueryBlindBoxContentIndexRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentIndexResponse, error)
-	// Queries a list of BlindBoxContents by class_id
-	BlindBoxContents(ctx context.Context, in *QueryBlindBoxContentsRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentsResponse, error)
-	// Queries a Offer by index.
-	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
-	// Queries a list of Offer items.
-	OfferIndex(ctx context.Context, in *QueryOfferIndexRequest, opts ...grpc.CallOption) (*QueryOfferIndexResponse, error)
-	// Queries a list of OffersByClass items.
-	OffersByClass(ctx context.Context, in *QueryOffersByClassRequest, opts ...grpc.CallOption) (*QueryOffersByClassResponse, error)
-	// Queries a list of OffersByNFT items.
-	OffersByNFT(ctx context.Context, in *QueryOffersByNFTRequest, opts ...grpc.CallOption) (*QueryOffersByNFTResponse, error)
-	// Queries a Listing by index.
-	Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error)
-	// Queries a list of Listing items.
-	ListingIndex(ctx context.Context, in *QueryListingIndexRequest, opts ...grpc.CallOption) (*QueryListingIndexResponse, error)
-	// Queries a list of Listing items by class
-	ListingsByClass(ctx context.Context, in *QueryListingsByClassRequest, opts ...grpc.CallOption) (*QueryListingsByClassResponse, error)
-	// Queries a list of Listing items by nft
-	ListingsByNFT(ctx context.Context, in *QueryListingsByNFTRequest, opts ...grpc.CallOption) (*QueryListingsByNFTResponse, error)
-	// Queries a RoyaltyConfig by class
-	RoyaltyConfig(ctx context.Context, in *QueryRoyaltyConfigRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigResponse, error)
-	// Queries index of RoyaltyConfig of all classes
-	RoyaltyConfigIndex(ctx context.Context, in *QueryRoyaltyConfigIndexRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigIndexResponse, error)
+type QueryCollectedFeesResponse struct {
+	CollectedFees types2.CollectedFees `protobuf:"bytes,1,opt,name=collected_fees,json=collectedFees,proto3" json:"collected_fees"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryCollectedFeesResponse) Reset()         { *m = QueryCollectedFeesResponse{} }
+func (m *QueryCollectedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCollectedFeesResponse) ProtoMessage()    {}
+func (*QueryCollectedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{41}
 }
-
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryCollectedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryCollectedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCollectedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *queryClient) ClassesByISCN(ctx context.Context, in *QueryClassesByISCNRequest, opts ...grpc.CallOption) (*QueryClassesByISCNResponse, error) {
-	out := new(QueryClassesByISCNResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByISCN", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCollectedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCollectedFeesResponse.Merge(m, src)
 }
-
-func (c *queryClient) ClassesByISCNIndex(ctx context.Context, in *QueryClassesByISCNIndexRequest, opts ...grpc.CallOption) (*QueryClassesByISCNIndexResponse, error) {
-	out := new(QueryClassesByISCNIndexResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByISCNIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryCollectedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCollectedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCollectedFeesResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ISCNByClass(ctx context.Context, in *QueryISCNByClassRequest, opts ...grpc.CallOption) (*QueryISCNByClassResponse, error) {
-	out := new(QueryISCNByClassResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ISCNByClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryCollectedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryCollectedFeesResponse) GetCollectedFees() types2.CollectedFees {
+	if m != nil {
+		return m.CollectedFees
 	}
-	return out, nil
+	return types2.CollectedFees{}
 }
 
-func (c *queryClient) ClassesByAccount(ctx context.Context, in *QueryClassesByAccountRequest, opts ...grpc.CallOption) (*QueryClassesByAccountResponse, error) {
-	out := new(QueryClassesByAccountResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByAccount", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAuctionRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	NftId   string `protobuf:"bytes,2,opt,name=nft_id,json=nftId,proto3" json:"nft_id,omitempty"`
 }
 
-func (c *queryClient) ClassesByAccountIndex(ctx context.Context, in *QueryClassesByAccountIndexRequest, opts ...grpc.CallOption) (*QueryClassesByAccountIndexResponse, error) {
-	out := new(QueryClassesByAccountIndexResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByAccountIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionRequest) Reset()         { *m = QueryAuctionRequest{} }
+func (m *QueryAuctionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRequest) ProtoMessage()    {}
+func (*QueryAuctionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{42}
+}
+func (m *QueryAuctionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuctionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRequest.Merge(m, src)
+}
+func (m *QueryAuctionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) AccountByClass(ctx context.Context, in *QueryAccountByClassRequest, opts ...grpc.CallOption) (*QueryAccountByClassResponse, error) {
-	out := new(QueryAccountByClassResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/AccountByClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BlindBoxContent(ctx context.Context, in *QueryBlindBoxContentRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentResponse, error) {
-	out := new(QueryBlindBoxContentResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/BlindBoxContent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionRequest) GetNftId() string {
+	if m != nil {
+		return m.NftId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) BlindBoxContentIndex(ctx context.Context, in *QueryBlindBoxContentIndexRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentIndexResponse, error) {
-	out := new(QueryBlindBoxContentIndexResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/BlindBoxContentIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAuctionResponse struct {
+	Auction Auction `protobuf:"bytes,1,opt,name=auction,proto3" json:"auction"`
 }
 
-func (c *queryClient) BlindBoxContents(ctx context.Context, in *QueryBlindBoxContentsRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentsResponse, error) {
-	out := new(QueryBlindBoxContentsResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/BlindBoxContents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionResponse) Reset()         { *m = QueryAuctionResponse{} }
+func (m *QueryAuctionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionResponse) ProtoMessage()    {}
+func (*QueryAuctionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{43}
+}
+func (m *QueryAuctionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuctionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionResponse.Merge(m, src)
+}
+func (m *QueryAuctionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
-	out := new(QueryOfferResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Offer", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionResponse) GetAuction() Auction {
+	if m != nil {
+		return m.Auction
 	}
-	return out, nil
+	return Auction{}
 }
 
-func (c *queryClient) OfferIndex(ctx context.Context, in *QueryOfferIndexRequest, opts ...grpc.CallOption) (*QueryOfferIndexResponse, error) {
-	out := new(QueryOfferIndexResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/OfferIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAuctionIndexRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) OffersByClass(ctx context.Context, in *QueryOffersByClassRequest, opts ...grpc.CallOption) (*QueryOffersByClassResponse, error) {
-	out := new(QueryOffersByClassResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/OffersByClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionIndexRequest) Reset()         { *m = QueryAuctionIndexRequest{} }
+func (m *QueryAuctionIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionIndexRequest) ProtoMessage()    {}
+func (*QueryAuctionIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{44}
+}
+func (m *QueryAuctionIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuctionIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionIndexRequest.Merge(m, src)
+}
+func (m *QueryAuctionIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionIndexRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) OffersByNFT(ctx context.Context, in *QueryOffersByNFTRequest, opts ...grpc.CallOption) (*QueryOffersByNFTResponse, error) {
-	out := new(QueryOffersByNFTResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/OffersByNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionIndexRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionIndexRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error) {
-	out := new(QueryListingResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Listing", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAuctionIndexResponse struct {
+	Auctions   []Auction           `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) ListingIndex(ctx context.Context, in *QueryListingIndexRequest, opts ...grpc.CallOption) (*QueryListingIndexResponse, error) {
-	out := new(QueryListingIndexResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ListingIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionIndexResponse) Reset()         { *m = QueryAuctionIndexResponse{} }
+func (m *QueryAuctionIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionIndexResponse) ProtoMessage()    {}
+func (*QueryAuctionIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{45}
+}
+func (m *QueryAuctionIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryAuctionIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionIndexResponse.Merge(m, src)
+}
+func (m *QueryAuctionIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionIndexResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListingsByClass(ctx context.Context, in *QueryListingsByClassRequest, opts ...grpc.CallOption) (*QueryListingsByClassResponse, error) {
-	out := new(QueryListingsByClassResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ListingsByClass", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAuctionIndexResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionIndexResponse) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) ListingsByNFT(ctx context.Context, in *QueryListingsByNFTRequest, opts ...grpc.CallOption) (*QueryListingsByNFTResponse, error) {
-	out := new(QueryListingsByNFTResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ListingsByNFT", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionIndexResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) RoyaltyConfig(ctx context.Context, in *QueryRoyaltyConfigRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigResponse, error) {
-	out := new(QueryRoyaltyConfigResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/RoyaltyConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type QueryAuctionsByClassRequest struct {
+	ClassId    string             `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) RoyaltyConfigIndex(ctx context.Context, in *QueryRoyaltyConfigIndexRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigIndexResponse, error) {
-	out := new(QueryRoyaltyConfigIndexResponse)
-	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/RoyaltyConfigIndex", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryAuctionsByClassRequest) Reset()         { *m = QueryAuctionsByClassRequest{} }
+func (m *QueryAuctionsByClassRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionsByClassRequest) ProtoMessage()    {}
+func (*QueryAuctionsByClassRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{46}
+}
+func (m *QueryAuctionsByClassRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionsByClassRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionsByClassRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Queries a ClassesByISCN by index.
-	ClassesByISCN(context.Context, *QueryClassesByISCNRequest) (*QueryClassesByISCNResponse, error)
-	// Queries a list of ClassesByISCN items.
-	ClassesByISCNIndex(context.Context, *QueryClassesByISCNIndexRequest) (*QueryClassesByISCNIndexResponse, error)
-	// Queries a list of ISCNByClass items.
-	ISCNByClass(context.Context, *QueryISCNByClassRequest) (*QueryISCNByClassResponse, error)
-	// Queries a ClassesByAccount by index.
-	ClassesByAccount(context.Context, *QueryClassesByAccountRequest) (*QueryClassesByAccountResponse, error)
-	// Queries a list of ClassesByAccount items.
-	ClassesByAccountIndex(context.Context, *QueryClassesByAccountIndexRequest) (*QueryClassesByAccountIndexResponse, error)
-	// Queries a list of AccountByClass items.
-	AccountByClass(context.Context, *QueryAccountByClassRequest) (*QueryAccountByClassResponse, error)
-	// Queries a BlindBoxContent by index.
-	BlindBoxContent(context.Context, *QueryBlindBoxContentRequest) (*QueryBlindBoxContentResponse, error)
-	// Queries a list of BlindBoxContent items.
-	BlindBoxContentIndex(context.Context, *QueryBlindBoxContentIndexRequest) (*QueryBlindBoxContentIndexResponse, error)
-	// Queries a list of BlindBoxContents by class_id
-	BlindBoxContents(context.Context, *QueryBlindBoxContentsRequest) (*QueryBlindBoxContentsResponse, error)
-	// Queries a Offer by index.
-	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
-	// Queries a list of Offer items.
-	OfferIndex(context.Context, *QueryOfferIndexRequest) (*QueryOfferIndexResponse, error)
-	// Queries a list of OffersByClass items.
-	OffersByClass(context.Context, *QueryOffersByClassRequest) (*QueryOffersByClassResponse, error)
-	// Queries a list of OffersByNFT items.
-	OffersByNFT(context.Context, *QueryOffersByNFTRequest) (*QueryOffersByNFTResponse, error)
-	// Queries a Listing by index.
-	Listing(context.Context, *QueryListingRequest) (*QueryListingResponse, error)
-	// Queries a list of Listing items.
-	ListingIndex(context.Context, *QueryListingIndexRequest) (*QueryListingIndexResponse, error)
-	// Queries a list of Listing items by class
-	ListingsByClass(context.Context, *QueryListingsByClassRequest) (*QueryListingsByClassResponse, error)
-	// Queries a list of Listing items by nft
-	ListingsByNFT(context.Context, *QueryListingsByNFTRequest) (*QueryListingsByNFTResponse, error)
-	// Queries a RoyaltyConfig by class
-	RoyaltyConfig(context.Context, *QueryRoyaltyConfigRequest) (*QueryRoyaltyConfigResponse, error)
-	// Queries index of RoyaltyConfig of all classes
-	RoyaltyConfigIndex(context.Context, *QueryRoyaltyConfigIndexRequest) (*QueryRoyaltyConfigIndexResponse, error)
+func (m *QueryAuctionsByClassRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionsByClassRequest.Merge(m, src)
 }
-
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAuctionsByClassRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionsByClassRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionsByClassRequest.DiscardUnknown(m)
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+var xxx_messageInfo_QueryAuctionsByClassRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionsByClassRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) ClassesByISCN(ctx context.Context, req *QueryClassesByISCNRequest) (*QueryClassesByISCNResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassesByISCN not implemented")
+
+func (m *QueryAuctionsByClassRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) ClassesByISCNIndex(ctx context.Context, req *QueryClassesByISCNIndexRequest) (*QueryClassesByISCNIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassesByISCNIndex not implemented")
+
+type QueryAuctionsByClassResponse struct {
+	Auctions   []Auction           `protobuf:"bytes,1,rep,name=auctions,proto3" json:"auctions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) ISCNByClass(ctx context.Context, req *QueryISCNByClassRequest) (*QueryISCNByClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ISCNByClass not implemented")
+
+func (m *QueryAuctionsByClassResponse) Reset()         { *m = QueryAuctionsByClassResponse{} }
+func (m *QueryAuctionsByClassResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionsByClassResponse) ProtoMessage()    {}
+func (*QueryAuctionsByClassResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{47}
 }
-func (*UnimplementedQueryServer) ClassesByAccount(ctx context.Context, req *QueryClassesByAccountRequest) (*QueryClassesByAccountResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAccount not implemented")
+func (m *QueryAuctionsByClassResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ClassesByAccountIndex(ctx context.Context, req *QueryClassesByAccountIndexRequest) (*QueryClassesByAccountIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAccountIndex not implemented")
+func (m *QueryAuctionsByClassResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionsByClassResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AccountByClass(ctx context.Context, req *QueryAccountByClassRequest) (*QueryAccountByClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AccountByClass not implemented")
+func (m *QueryAuctionsByClassResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionsByClassResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) BlindBoxContent(ctx context.Context, req *QueryBlindBoxContentRequest) (*QueryBlindBoxContentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlindBoxContent not implemented")
+func (m *QueryAuctionsByClassResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) BlindBoxContentIndex(ctx context.Context, req *QueryBlindBoxContentIndexRequest) (*QueryBlindBoxContentIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlindBoxContentIndex not implemented")
+func (m *QueryAuctionsByClassResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionsByClassResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) BlindBoxContents(ctx context.Context, req *QueryBlindBoxContentsRequest) (*QueryBlindBoxContentsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlindBoxContents not implemented")
+
+var xxx_messageInfo_QueryAuctionsByClassResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionsByClassResponse) GetAuctions() []Auction {
+	if m != nil {
+		return m.Auctions
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Offer(ctx context.Context, req *QueryOfferRequest) (*QueryOfferResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Offer not implemented")
+
+func (m *QueryAuctionsByClassResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) OfferIndex(ctx context.Context, req *QueryOfferIndexRequest) (*QueryOfferIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OfferIndex not implemented")
+
+type QueryClassOfferRequest struct {
+	ClassId string `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Buyer   string `protobuf:"bytes,2,opt,name=buyer,proto3" json:"buyer,omitempty"`
 }
-func (*UnimplementedQueryServer) OffersByClass(ctx context.Context, req *QueryOffersByClassRequest) (*QueryOffersByClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OffersByClass not implemented")
+
+func (m *QueryClassOfferRequest) Reset()         { *m = QueryClassOfferRequest{} }
+func (m *QueryClassOfferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassOfferRequest) ProtoMessage()    {}
+func (*QueryClassOfferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{48}
 }
-func (*UnimplementedQueryServer) OffersByNFT(ctx context.Context, req *QueryOffersByNFTRequest) (*QueryOffersByNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method OffersByNFT not implemented")
+func (m *QueryClassOfferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Listing(ctx context.Context, req *QueryListingRequest) (*QueryListingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Listing not implemented")
+func (m *QueryClassOfferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassOfferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListingIndex(ctx context.Context, req *QueryListingIndexRequest) (*QueryListingIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListingIndex not implemented")
+func (m *QueryClassOfferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassOfferRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) ListingsByClass(ctx context.Context, req *QueryListingsByClassRequest) (*QueryListingsByClassResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListingsByClass not implemented")
+func (m *QueryClassOfferRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) ListingsByNFT(ctx context.Context, req *QueryListingsByNFTRequest) (*QueryListingsByNFTResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListingsByNFT not implemented")
+func (m *QueryClassOfferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassOfferRequest.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) RoyaltyConfig(ctx context.Context, req *QueryRoyaltyConfigRequest) (*QueryRoyaltyConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyConfig not implemented")
+
+var xxx_messageInfo_QueryClassOfferRequest proto.InternalMessageInfo
+
+func (m *QueryClassOfferRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
+	}
+	return ""
 }
-func (*UnimplementedQueryServer) RoyaltyConfigIndex(ctx context.Context, req *QueryRoyaltyConfigIndexRequest) (*QueryRoyaltyConfigIndexResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyConfigIndex not implemented")
+
+func (m *QueryClassOfferRequest) GetBuyer() string {
+	if m != nil {
+		return m.Buyer
+	}
+	return ""
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+type QueryClassOfferResponse struct {
+	ClassOffer ClassOffer `protobuf:"bytes,1,opt,name=class_offer,json=classOffer,proto3" json:"class_offer"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryClassOfferResponse) Reset()         { *m = QueryClassOfferResponse{} }
+func (m *QueryClassOfferResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassOfferResponse) ProtoMessage()    {}
+func (*QueryClassOfferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{49}
+}
+func (m *QueryClassOfferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassOfferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassOfferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClassOfferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassOfferResponse.Merge(m, src)
+}
+func (m *QueryClassOfferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassOfferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassOfferResponse.DiscardUnknown(m)
 }
 
-func _Query_ClassesByISCN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesByISCNRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClassesByISCN(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ClassesByISCN",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassesByISCN(ctx, req.(*QueryClassesByISCNRequest))
+var xxx_messageInfo_QueryClassOfferResponse proto.InternalMessageInfo
+
+func (m *QueryClassOfferResponse) GetClassOffer() ClassOffer {
+	if m != nil {
+		return m.ClassOffer
 	}
-	return interceptor(ctx, in, info, handler)
+	return ClassOffer{}
 }
 
-func _Query_ClassesByISCNIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesByISCNIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClassesByISCNIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ClassesByISCNIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassesByISCNIndex(ctx, req.(*QueryClassesByISCNIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClassOfferIndexRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ISCNByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryISCNByClassRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ISCNByClass(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ISCNByClass",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ISCNByClass(ctx, req.(*QueryISCNByClassRequest))
+func (m *QueryClassOfferIndexRequest) Reset()         { *m = QueryClassOfferIndexRequest{} }
+func (m *QueryClassOfferIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassOfferIndexRequest) ProtoMessage()    {}
+func (*QueryClassOfferIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{50}
+}
+func (m *QueryClassOfferIndexRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassOfferIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassOfferIndexRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClassOfferIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassOfferIndexRequest.Merge(m, src)
+}
+func (m *QueryClassOfferIndexRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassOfferIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassOfferIndexRequest.DiscardUnknown(m)
 }
 
-func _Query_ClassesByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesByAccountRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClassesByAccount(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ClassesByAccount",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassesByAccount(ctx, req.(*QueryClassesByAccountRequest))
+var xxx_messageInfo_QueryClassOfferIndexRequest proto.InternalMessageInfo
+
+func (m *QueryClassOfferIndexRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_ClassesByAccountIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryClassesByAccountIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ClassesByAccountIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ClassesByAccountIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ClassesByAccountIndex(ctx, req.(*QueryClassesByAccountIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClassOfferIndexResponse struct {
+	ClassOffers []ClassOffer        `protobuf:"bytes,1,rep,name=class_offers,json=classOffers,proto3" json:"class_offers"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_AccountByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAccountByClassRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AccountByClass(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/AccountByClass",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AccountByClass(ctx, req.(*QueryAccountByClassRequest))
+func (m *QueryClassOfferIndexResponse) Reset()         { *m = QueryClassOfferIndexResponse{} }
+func (m *QueryClassOfferIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassOfferIndexResponse) ProtoMessage()    {}
+func (*QueryClassOfferIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{51}
+}
+func (m *QueryClassOfferIndexResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassOfferIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassOfferIndexResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClassOfferIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassOfferIndexResponse.Merge(m, src)
+}
+func (m *QueryClassOfferIndexResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassOfferIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassOfferIndexResponse.DiscardUnknown(m)
 }
 
-func _Query_BlindBoxContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlindBoxContentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlindBoxContent(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/BlindBoxContent",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlindBoxContent(ctx, req.(*QueryBlindBoxContentRequest))
+var xxx_messageInfo_QueryClassOfferIndexResponse proto.InternalMessageInfo
+
+func (m *QueryClassOfferIndexResponse) GetClassOffers() []ClassOffer {
+	if m != nil {
+		return m.ClassOffers
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlindBoxContentIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlindBoxContentIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlindBoxContentIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/BlindBoxContentIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlindBoxContentIndex(ctx, req.(*QueryBlindBoxContentIndexRequest))
+func (m *QueryClassOfferIndexResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_BlindBoxContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryBlindBoxContentsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).BlindBoxContents(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/BlindBoxContents",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).BlindBoxContents(ctx, req.(*QueryBlindBoxContentsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClassOffersRequest struct {
+	ClassId    string             `protobuf:"bytes,1,opt,name=class_id,json=classId,proto3" json:"class_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOfferRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Offer(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/Offer",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+func (m *QueryClassOffersRequest) Reset()         { *m = QueryClassOffersRequest{} }
+func (m *QueryClassOffersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryClassOffersRequest) ProtoMessage()    {}
+func (*QueryClassOffersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{52}
+}
+func (m *QueryClassOffersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassOffersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassOffersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryClassOffersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassOffersRequest.Merge(m, src)
+}
+func (m *QueryClassOffersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassOffersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassOffersRequest.DiscardUnknown(m)
 }
 
-func _Query_OfferIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOfferIndexRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryClassOffersRequest proto.InternalMessageInfo
+
+func (m *QueryClassOffersRequest) GetClassId() string {
+	if m != nil {
+		return m.ClassId
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).OfferIndex(ctx, in)
+	return ""
+}
+
+func (m *QueryClassOffersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/OfferIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OfferIndex(ctx, req.(*QueryOfferIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_OffersByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOffersByClassRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OffersByClass(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/OffersByClass",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OffersByClass(ctx, req.(*QueryOffersByClassRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+type QueryClassOffersResponse struct {
+	ClassOffers []ClassOffer        `protobuf:"bytes,1,rep,name=class_offers,json=classOffers,proto3" json:"class_offers"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_OffersByNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryOffersByNFTRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).OffersByNFT(ctx, in)
+func (m *QueryClassOffersResponse) Reset()         { *m = QueryClassOffersResponse{} }
+func (m *QueryClassOffersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryClassOffersResponse) ProtoMessage()    {}
+func (*QueryClassOffersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_14342af5346eedf4, []int{53}
+}
+func (m *QueryClassOffersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryClassOffersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryClassOffersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/OffersByNFT",
+}
+func (m *QueryClassOffersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryClassOffersResponse.Merge(m, src)
+}
+func (m *QueryClassOffersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryClassOffersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryClassOffersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryClassOffersResponse proto.InternalMessageInfo
+
+func (m *QueryClassOffersResponse) GetClassOffers() []ClassOffer {
+	if m != nil {
+		return m.ClassOffers
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).OffersByNFT(ctx, req.(*QueryOffersByNFTRequest))
+	return nil
+}
+
+func (m *QueryClassOffersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Listing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListingRequest)
-	if err := dec(in); err != nil {
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "likechain.likenft.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "likechain.likenft.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryClassesByISCNRequest)(nil), "likechain.likenft.v1.QueryClassesByISCNRequest")
+	proto.RegisterType((*QueryClassesByISCNResponse)(nil), "likechain.likenft.v1.QueryClassesByISCNResponse")
+	proto.RegisterType((*QueryClassesByISCNIndexRequest)(nil), "likechain.likenft.v1.QueryClassesByISCNIndexRequest")
+	proto.RegisterType((*QueryClassesByISCNIndexResponse)(nil), "likechain.likenft.v1.QueryClassesByISCNIndexResponse")
+	proto.RegisterType((*QueryISCNByClassRequest)(nil), "likechain.likenft.v1.QueryISCNByClassRequest")
+	proto.RegisterType((*QueryISCNByClassResponse)(nil), "likechain.likenft.v1.QueryISCNByClassResponse")
+	proto.RegisterType((*QueryClassesByAccountRequest)(nil), "likechain.likenft.v1.QueryClassesByAccountRequest")
+	proto.RegisterType((*QueryClassesByAccountResponse)(nil), "likechain.likenft.v1.QueryClassesByAccountResponse")
+	proto.RegisterType((*QueryClassesByAccountIndexRequest)(nil), "likechain.likenft.v1.QueryClassesByAccountIndexRequest")
+	proto.RegisterType((*QueryClassesByAccountIndexResponse)(nil), "likechain.likenft.v1.QueryClassesByAccountIndexResponse")
+	proto.RegisterType((*QueryAccountByClassRequest)(nil), "likechain.likenft.v1.QueryAccountByClassRequest")
+	proto.RegisterType((*QueryAccountByClassResponse)(nil), "likechain.likenft.v1.QueryAccountByClassResponse")
+	proto.RegisterType((*QueryBlindBoxContentRequest)(nil), "likechain.likenft.v1.QueryBlindBoxContentRequest")
+	proto.RegisterType((*QueryBlindBoxContentResponse)(nil), "likechain.likenft.v1.QueryBlindBoxContentResponse")
+	proto.RegisterType((*QueryBlindBoxContentIndexRequest)(nil), "likechain.likenft.v1.QueryBlindBoxContentIndexRequest")
+	proto.RegisterType((*QueryBlindBoxContentIndexResponse)(nil), "likechain.likenft.v1.QueryBlindBoxContentIndexResponse")
+	proto.RegisterType((*QueryBlindBoxContentsRequest)(nil), "likechain.likenft.v1.QueryBlindBoxContentsRequest")
+	proto.RegisterType((*QueryBlindBoxContentsResponse)(nil), "likechain.likenft.v1.QueryBlindBoxContentsResponse")
+	proto.RegisterType((*QueryOfferRequest)(nil), "likechain.likenft.v1.QueryOfferRequest")
+	proto.RegisterType((*QueryOfferResponse)(nil), "likechain.likenft.v1.QueryOfferResponse")
+	proto.RegisterType((*QueryOfferIndexRequest)(nil), "likechain.likenft.v1.QueryOfferIndexRequest")
+	proto.RegisterType((*QueryOfferIndexResponse)(nil), "likechain.likenft.v1.QueryOfferIndexResponse")
+	proto.RegisterType((*QueryOffersByClassRequest)(nil), "likechain.likenft.v1.QueryOffersByClassRequest")
+	proto.RegisterType((*QueryOffersByClassResponse)(nil), "likechain.likenft.v1.QueryOffersByClassResponse")
+	proto.RegisterType((*QueryOffersByNFTRequest)(nil), "likechain.likenft.v1.QueryOffersByNFTRequest")
+	proto.RegisterType((*QueryOffersByNFTResponse)(nil), "likechain.likenft.v1.QueryOffersByNFTResponse")
+	proto.RegisterType((*QueryListingRequest)(nil), "likechain.likenft.v1.QueryListingRequest")
+	proto.RegisterType((*QueryListingResponse)(nil), "likechain.likenft.v1.QueryListingResponse")
+	proto.RegisterType((*QueryListingIndexRequest)(nil), "likechain.likenft.v1.QueryListingIndexRequest")
+	proto.RegisterType((*QueryListingIndexResponse)(nil), "likechain.likenft.v1.QueryListingIndexResponse")
+	proto.RegisterType((*QueryListingsByClassRequest)(nil), "likechain.likenft.v1.QueryListingsByClassRequest")
+	proto.RegisterType((*QueryListingsByClassResponse)(nil), "likechain.likenft.v1.QueryListingsByClassResponse")
+	proto.RegisterType((*QueryListingsByNFTRequest)(nil), "likechain.likenft.v1.QueryListingsByNFTRequest")
+	proto.RegisterType((*QueryListingsByNFTResponse)(nil), "likechain.likenft.v1.QueryListingsByNFTResponse")
+	proto.RegisterType((*QueryRoyaltyConfigRequest)(nil), "likechain.likenft.v1.QueryRoyaltyConfigRequest")
+	proto.RegisterType((*QueryRoyaltyConfigResponse)(nil), "likechain.likenft.v1.QueryRoyaltyConfigResponse")
+	proto.RegisterType((*QueryRoyaltyConfigIndexRequest)(nil), "likechain.likenft.v1.QueryRoyaltyConfigIndexRequest")
+	proto.RegisterType((*QueryRoyaltyConfigIndexResponse)(nil), "likechain.likenft.v1.QueryRoyaltyConfigIndexResponse")
+	proto.RegisterType((*QueryCollectedFeesRequest)(nil), "likechain.likenft.v1.QueryCollectedFeesRequest")
+	proto.RegisterType((*QueryCollectedFeesResponse)(nil), "likechain.likenft.v1.QueryCollectedFeesResponse")
+	proto.RegisterType((*QueryAuctionRequest)(nil), "likechain.likenft.v1.QueryAuctionRequest")
+	proto.RegisterType((*QueryAuctionResponse)(nil), "likechain.likenft.v1.QueryAuctionResponse")
+	proto.RegisterType((*QueryAuctionIndexRequest)(nil), "likechain.likenft.v1.QueryAuctionIndexRequest")
+	proto.RegisterType((*QueryAuctionIndexResponse)(nil), "likechain.likenft.v1.QueryAuctionIndexResponse")
+	proto.RegisterType((*QueryAuctionsByClassRequest)(nil), "likechain.likenft.v1.QueryAuctionsByClassRequest")
+	proto.RegisterType((*QueryAuctionsByClassResponse)(nil), "likechain.likenft.v1.QueryAuctionsByClassResponse")
+	proto.RegisterType((*QueryClassOfferRequest)(nil), "likechain.likenft.v1.QueryClassOfferRequest")
+	proto.RegisterType((*QueryClassOfferResponse)(nil), "likechain.likenft.v1.QueryClassOfferResponse")
+	proto.RegisterType((*QueryClassOfferIndexRequest)(nil), "likechain.likenft.v1.QueryClassOfferIndexRequest")
+	proto.RegisterType((*QueryClassOfferIndexResponse)(nil), "likechain.likenft.v1.QueryClassOfferIndexResponse")
+	proto.RegisterType((*QueryClassOffersRequest)(nil), "likechain.likenft.v1.QueryClassOffersRequest")
+	proto.RegisterType((*QueryClassOffersResponse)(nil), "likechain.likenft.v1.QueryClassOffersResponse")
+}
+
+func init() { proto.RegisterFile("likechain/likenft/v1/query.proto", fileDescriptor_14342af5346eedf4) }
+
+var fileDescriptor_14342af5346eedf4 = []byte{
+	// 2214 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xdd, 0x6f, 0x1c, 0x57,
+	0x15, 0xcf, 0x75, 0x63, 0xbb, 0x39, 0x8e, 0x9d, 0xe4, 0xd6, 0x6d, 0x9c, 0x89, 0xbb, 0x75, 0xa6,
+	0x89, 0xe3, 0x98, 0x78, 0xc7, 0xde, 0xc4, 0x75, 0x9a, 0x2a, 0x82, 0xda, 0x28, 0xc1, 0x12, 0xa4,
+	0xee, 0x96, 0x0f, 0xf1, 0x21, 0x2d, 0xbb, 0xb3, 0xb3, 0x9b, 0xa1, 0x9b, 0x99, 0xed, 0xcc, 0xd8,
+	0xf5, 0xca, 0x18, 0x15, 0x78, 0xe2, 0x09, 0xa4, 0x0a, 0xf1, 0xc0, 0x87, 0x2a, 0x95, 0x56, 0x95,
+	0x80, 0x82, 0x10, 0x0f, 0x80, 0x84, 0x78, 0x40, 0x82, 0x4a, 0x08, 0xa9, 0x52, 0x5f, 0x78, 0x42,
+	0x28, 0xe1, 0x3f, 0xe0, 0x1f, 0x40, 0x73, 0xef, 0xb9, 0xbb, 0x73, 0x77, 0xee, 0xce, 0xde, 0xb5,
+	0xd6, 0xc4, 0x7d, 0xf3, 0xde, 0x39, 0xe7, 0x9e, 0xdf, 0xf9, 0xdd, 0x73, 0xcf, 0x3d, 0xf7, 0x5c,
+	0xc3, 0x5c, 0xc3, 0x7d, 0xd5, 0xb1, 0xef, 0x95, 0x5d, 0xcf, 0x8a, 0xff, 0xf2, 0x6a, 0x91, 0xb5,
+	0xb3, 0x62, 0xbd, 0xb6, 0xed, 0x04, 0xad, 0x7c, 0x33, 0xf0, 0x23, 0x9f, 0x4e, 0xb7, 0x25, 0xf2,
+	0x28, 0x91, 0xdf, 0x59, 0x31, 0x16, 0x6d, 0x3f, 0xbc, 0xef, 0x87, 0x56, 0xa5, 0x1c, 0x3a, 0x5c,
+	0xdc, 0xda, 0x59, 0xa9, 0x38, 0x51, 0x79, 0xc5, 0x6a, 0x96, 0xeb, 0xae, 0x57, 0x8e, 0x5c, 0xdf,
+	0xe3, 0x33, 0x18, 0xb9, 0xa4, 0xac, 0x90, 0xb2, 0x7d, 0x57, 0x7c, 0x9f, 0xc5, 0xef, 0xdc, 0x38,
+	0xff, 0x1c, 0x9b, 0xe1, 0x5f, 0xa7, 0xeb, 0x7e, 0xdd, 0x67, 0x7f, 0x5a, 0xf1, 0x5f, 0x42, 0xa7,
+	0xee, 0xfb, 0xf5, 0x86, 0x63, 0x95, 0x9b, 0xae, 0x55, 0xf6, 0x3c, 0x3f, 0x62, 0x06, 0x43, 0xfc,
+	0x6a, 0x74, 0xbc, 0x72, 0x43, 0xdb, 0x4b, 0xfa, 0x63, 0x2c, 0xc8, 0x1e, 0xd7, 0x1c, 0xa7, 0x1e,
+	0x94, 0xbd, 0xc8, 0xaa, 0x39, 0x4e, 0x29, 0xf0, 0xb7, 0x23, 0xd7, 0xab, 0xa3, 0xa4, 0xa9, 0xe4,
+	0xa6, 0xbc, 0x6d, 0x27, 0x7c, 0x9b, 0x57, 0xca, 0xd8, 0x8d, 0x72, 0x18, 0x96, 0xfc, 0x5a, 0xcd,
+	0x09, 0x50, 0xee, 0xaa, 0x52, 0xae, 0xd2, 0x70, 0xbd, 0x6a, 0xa9, 0xe2, 0xef, 0x96, 0x6c, 0xdf,
+	0x8b, 0x1c, 0x4f, 0xf8, 0xbc, 0xd4, 0x7b, 0x56, 0x27, 0x2c, 0x55, 0x5a, 0xa5, 0xb2, 0x6d, 0xfb,
+	0xdb, 0x6d, 0xf1, 0xc5, 0x7e, 0xe2, 0x31, 0x09, 0x99, 0x4e, 0x35, 0xdc, 0x30, 0xe1, 0xb8, 0x3a,
+	0x28, 0x92, 0xee, 0x5c, 0x50, 0x4a, 0x34, 0xcb, 0x41, 0xf9, 0xbe, 0x58, 0x83, 0x2b, 0x4a, 0x91,
+	0xc0, 0x6f, 0x95, 0x1b, 0x51, 0x2b, 0xf6, 0xb7, 0xe6, 0xa2, 0x3d, 0x73, 0x1a, 0xe8, 0xcb, 0xf1,
+	0x0a, 0x6d, 0x31, 0xfd, 0xa2, 0xf3, 0xda, 0xb6, 0x13, 0x46, 0xe6, 0xcb, 0xf0, 0x84, 0x34, 0x1a,
+	0x36, 0x7d, 0x2f, 0x74, 0xe8, 0x4d, 0x18, 0xe3, 0x76, 0x66, 0xc8, 0x1c, 0x59, 0x98, 0x28, 0xcc,
+	0xe6, 0x55, 0x01, 0x9a, 0xe7, 0x5a, 0xeb, 0xc7, 0x3f, 0xf8, 0xd7, 0x33, 0xc7, 0x8a, 0xa8, 0x61,
+	0x7e, 0x8f, 0xc0, 0x39, 0x36, 0xe7, 0x06, 0xe7, 0x66, 0xbd, 0xb5, 0xf9, 0xca, 0xc6, 0x5d, 0x34,
+	0x48, 0x2f, 0xc2, 0x54, 0x4c, 0x54, 0xc9, 0xad, 0x96, 0x9a, 0x81, 0x53, 0x73, 0x77, 0x99, 0x85,
+	0x13, 0xc5, 0x93, 0xf1, 0xe8, 0x66, 0x75, 0x8b, 0x8d, 0xd1, 0xdb, 0x00, 0x9d, 0x08, 0x9f, 0x19,
+	0x61, 0x18, 0xe6, 0xf3, 0x3c, 0x84, 0xf3, 0x71, 0x88, 0xe7, 0x79, 0xb4, 0x61, 0x24, 0xe7, 0xb7,
+	0xca, 0x75, 0x07, 0x2d, 0x14, 0x13, 0x9a, 0xe6, 0x5f, 0x09, 0x18, 0x2a, 0x2c, 0xe8, 0xa6, 0x1e,
+	0x98, 0xe7, 0x61, 0x1c, 0x97, 0x79, 0x66, 0x64, 0xee, 0xb1, 0x85, 0x89, 0xc2, 0x39, 0x81, 0x84,
+	0xd3, 0xc0, 0x21, 0x30, 0x0b, 0x48, 0x85, 0x90, 0xa7, 0x77, 0x24, 0x3f, 0x1e, 0x63, 0x7e, 0x5c,
+	0xee, 0xeb, 0x07, 0x47, 0x27, 0x39, 0x72, 0x0f, 0x72, 0x69, 0x3f, 0x36, 0xbd, 0xaa, 0xb3, 0x2b,
+	0x88, 0x95, 0x29, 0x23, 0x07, 0xa6, 0xec, 0xcf, 0x04, 0x9e, 0xe9, 0x69, 0x0a, 0x79, 0x7b, 0x05,
+	0x4e, 0x77, 0x05, 0x7e, 0x1c, 0x28, 0x31, 0x35, 0xcf, 0xaa, 0x03, 0x45, 0x9a, 0x0b, 0x49, 0x9a,
+	0xb2, 0xdb, 0x83, 0xf1, 0x04, 0xf4, 0x8e, 0x62, 0xcd, 0x0f, 0xc4, 0xd5, 0x75, 0x38, 0xcb, 0x1c,
+	0x60, 0xb6, 0xb8, 0x1b, 0x82, 0xa4, 0x73, 0xf0, 0x38, 0x4f, 0x1b, 0x6e, 0x15, 0x97, 0x9a, 0x2f,
+	0xd5, 0x66, 0xd5, 0xfc, 0x07, 0x81, 0x99, 0xb4, 0xda, 0x40, 0x81, 0x32, 0x0d, 0xa3, 0xfe, 0xeb,
+	0x9e, 0x13, 0x30, 0xf0, 0x27, 0x8a, 0xfc, 0x07, 0xbd, 0x04, 0x53, 0x8d, 0x72, 0xe4, 0x84, 0x51,
+	0x69, 0xc7, 0x09, 0x42, 0x11, 0x07, 0xc7, 0x8b, 0x93, 0x7c, 0xf4, 0x8b, 0x7c, 0x90, 0xde, 0x05,
+	0x1c, 0x28, 0x05, 0x8e, 0xed, 0x07, 0xd5, 0x99, 0xe3, 0x73, 0xa4, 0x8b, 0x50, 0x96, 0x61, 0x18,
+	0xc6, 0xb6, 0xdf, 0x4c, 0x14, 0x09, 0x3d, 0xc9, 0xf5, 0xf9, 0x98, 0xf9, 0x06, 0x81, 0x59, 0x79,
+	0x1d, 0x5f, 0xe4, 0xf9, 0x4c, 0x70, 0x31, 0x03, 0xe3, 0x98, 0xe1, 0x04, 0x15, 0xf8, 0x73, 0x68,
+	0xbb, 0xef, 0x4f, 0x04, 0x9e, 0xee, 0x01, 0x01, 0x79, 0xed, 0x8d, 0xe1, 0x28, 0x6c, 0xba, 0x57,
+	0xe1, 0x82, 0x12, 0xfe, 0xa1, 0xec, 0xbb, 0xbf, 0x13, 0x30, 0xb3, 0xac, 0x21, 0x63, 0x5f, 0x83,
+	0x27, 0xd2, 0x47, 0x94, 0xd8, 0x7d, 0xf3, 0x7d, 0x76, 0x1f, 0xce, 0x88, 0x84, 0x9d, 0xb1, 0xbb,
+	0xc6, 0x87, 0xb8, 0x07, 0xd7, 0x30, 0xef, 0x0a, 0x8b, 0xda, 0xdb, 0x70, 0x0d, 0xce, 0x2b, 0x15,
+	0x13, 0x01, 0x53, 0xad, 0x06, 0x4e, 0x18, 0x0a, 0x45, 0xfc, 0x69, 0x7e, 0x06, 0x15, 0xd7, 0xe3,
+	0xe3, 0x7e, 0xdd, 0xdf, 0xdd, 0xe0, 0x87, 0x7d, 0x7f, 0x93, 0x74, 0x0a, 0x46, 0xdc, 0x2a, 0xee,
+	0xd9, 0x11, 0xb7, 0x6a, 0xbe, 0x0e, 0xb3, 0xea, 0x99, 0x10, 0xc3, 0x97, 0xe0, 0x4c, 0xaa, 0xa6,
+	0xc0, 0x85, 0xbf, 0xa4, 0x5e, 0x80, 0xae, 0x99, 0x90, 0xff, 0x53, 0x15, 0x79, 0xd8, 0xfc, 0x06,
+	0xcc, 0xa9, 0x0c, 0x1f, 0x4a, 0xb8, 0xfd, 0x8d, 0xc0, 0x85, 0x0c, 0x63, 0xe8, 0xea, 0x97, 0x81,
+	0xa6, 0x5c, 0x15, 0xc1, 0x36, 0x90, 0xaf, 0xa7, 0xbb, 0x7c, 0x1d, 0x62, 0xa8, 0x7d, 0x9b, 0xa8,
+	0xd7, 0x4b, 0x23, 0xda, 0x86, 0x96, 0xe9, 0xfe, 0x22, 0x32, 0x5d, 0x1a, 0xc3, 0xc7, 0x88, 0xc9,
+	0xaf, 0xc2, 0x19, 0xe6, 0xc4, 0x4b, 0x71, 0x11, 0xaa, 0xc1, 0xde, 0x93, 0x30, 0xe6, 0xd5, 0xa2,
+	0x52, 0x7b, 0xf3, 0x8c, 0x7a, 0xb5, 0x68, 0xb3, 0x1a, 0x1f, 0x83, 0x95, 0xed, 0x96, 0x13, 0xb0,
+	0xd4, 0x7b, 0xa2, 0xc8, 0x7f, 0x98, 0x9f, 0x03, 0x9a, 0x9c, 0x1c, 0x69, 0x59, 0x83, 0x51, 0x56,
+	0xf2, 0x62, 0x24, 0x9f, 0x57, 0x33, 0xc1, 0x74, 0xd0, 0x7f, 0x2e, 0x6f, 0x7e, 0x1d, 0x9e, 0xea,
+	0x4c, 0x77, 0x28, 0x3b, 0xe4, 0xa7, 0x04, 0xce, 0xa6, 0x4c, 0x20, 0xec, 0xe7, 0x61, 0x8c, 0xc1,
+	0x10, 0x2b, 0xa8, 0x81, 0x1b, 0x15, 0x86, 0xb7, 0x5a, 0xdf, 0xc2, 0x32, 0x9b, 0x19, 0x09, 0xb5,
+	0x33, 0xec, 0xd0, 0x62, 0xfe, 0x2d, 0x51, 0x5b, 0x77, 0x01, 0x38, 0x42, 0x14, 0xbd, 0x29, 0x2d,
+	0x61, 0xb8, 0xde, 0xba, 0x7b, 0xfb, 0xf3, 0x07, 0x8f, 0xeb, 0xdb, 0x8a, 0xba, 0xe2, 0x20, 0xc4,
+	0xfd, 0x4c, 0x54, 0x9a, 0x12, 0xaa, 0x23, 0x44, 0x5b, 0x09, 0x2f, 0x85, 0x9f, 0xe5, 0x17, 0xd6,
+	0x83, 0x33, 0xf6, 0x14, 0x8c, 0x85, 0x4e, 0xa3, 0xd1, 0x4e, 0x05, 0xf8, 0xcb, 0xfc, 0x31, 0x81,
+	0x69, 0xd9, 0x02, 0x7a, 0x7f, 0x0b, 0xc6, 0xf1, 0x96, 0x8c, 0x1b, 0xf7, 0x69, 0xb5, 0xfb, 0xa8,
+	0x27, 0x2a, 0x3f, 0xd4, 0xa1, 0x9f, 0x86, 0x49, 0x7b, 0x3b, 0x08, 0x1c, 0x2f, 0x2a, 0x35, 0x03,
+	0xd7, 0x76, 0x90, 0x84, 0x73, 0x12, 0x09, 0xed, 0xda, 0xd1, 0x77, 0x3d, 0x51, 0x39, 0xa3, 0xd6,
+	0x56, 0xac, 0x64, 0x56, 0x70, 0x79, 0xd0, 0xc8, 0xa1, 0x24, 0x97, 0x77, 0xc4, 0x25, 0x59, 0x36,
+	0x82, 0x34, 0x7c, 0x12, 0x1e, 0x47, 0x97, 0x44, 0x18, 0x68, 0xf1, 0xd0, 0x56, 0x1a, 0x5e, 0x28,
+	0xbc, 0x41, 0xb0, 0xac, 0x42, 0x4b, 0x8f, 0x20, 0xcf, 0xbc, 0x27, 0xce, 0xf7, 0x14, 0x84, 0x23,
+	0xc7, 0xd6, 0x0f, 0xbb, 0x56, 0xf5, 0xa8, 0x64, 0x9c, 0x77, 0x45, 0xaa, 0xee, 0xc2, 0x75, 0xe4,
+	0x08, 0x7c, 0x0e, 0xf9, 0x2b, 0xf2, 0x0e, 0xd6, 0x06, 0x6b, 0x60, 0x69, 0xdc, 0x1a, 0x3c, 0x30,
+	0x54, 0x7a, 0xe8, 0xdf, 0x16, 0x4c, 0xc9, 0x2d, 0xb1, 0x19, 0x92, 0xba, 0x5b, 0x27, 0xbc, 0x94,
+	0x26, 0x41, 0x5f, 0x27, 0x83, 0xe4, 0x60, 0xbb, 0x1d, 0x23, 0x89, 0x1e, 0x4a, 0xa2, 0xf8, 0x48,
+	0xb4, 0x63, 0x54, 0xa6, 0xd0, 0xbf, 0x3a, 0x9c, 0x95, 0xfd, 0x8b, 0xaf, 0x86, 0x8c, 0x1a, 0x5c,
+	0xce, 0x45, 0x1d, 0x47, 0x5b, 0xc9, 0xcb, 0xf4, 0x74, 0xa0, 0xf8, 0x36, 0xbc, 0x75, 0x3e, 0x2f,
+	0x5a, 0x84, 0x7e, 0xa3, 0xe1, 0xd8, 0x91, 0x53, 0xbd, 0xed, 0x38, 0xed, 0x9e, 0x64, 0x13, 0x0c,
+	0xd5, 0x47, 0x74, 0xb6, 0x08, 0x53, 0xb6, 0xf8, 0x50, 0xaa, 0x39, 0x4e, 0xd8, 0xe3, 0xea, 0x25,
+	0x7a, 0xce, 0x79, 0x69, 0x1a, 0xb1, 0x9c, 0x76, 0x72, 0xd0, 0xbc, 0x83, 0x07, 0xde, 0x8b, 0xbc,
+	0xed, 0x7c, 0xe0, 0x0d, 0x6b, 0x7e, 0x01, 0xa6, 0xe5, 0x89, 0x3a, 0xe7, 0x1a, 0xb6, 0xb4, 0xb3,
+	0xcf, 0x35, 0xd4, 0x13, 0xe7, 0x1a, 0xea, 0xb4, 0x4f, 0x24, 0xfc, 0x7c, 0xb8, 0x27, 0x92, 0x6c,
+	0xa4, 0x93, 0x22, 0x10, 0x4c, 0x9f, 0x14, 0x21, 0x7b, 0xd0, 0x56, 0x3a, 0x84, 0x13, 0x09, 0x2d,
+	0x3d, 0xca, 0x13, 0x29, 0x05, 0xe1, 0xc8, 0xb1, 0xb5, 0x89, 0xd7, 0x24, 0x86, 0x4f, 0xf7, 0x5e,
+	0xd7, 0xbe, 0xc0, 0x8d, 0x24, 0x2f, 0x70, 0x15, 0x38, 0x9b, 0x9a, 0x0a, 0xfd, 0xbd, 0x03, 0x13,
+	0x89, 0xd7, 0x18, 0x0c, 0xc2, 0xb9, 0x8c, 0x66, 0x54, 0xb2, 0x7c, 0x05, 0xbb, 0x3d, 0x62, 0x3a,
+	0xb8, 0xb6, 0x1d, 0xa1, 0x43, 0x89, 0xf5, 0xdf, 0x4a, 0xbd, 0x51, 0xc5, 0xfd, 0x6e, 0x13, 0x4e,
+	0x26, 0x1c, 0x12, 0x8b, 0xa8, 0xeb, 0xd1, 0x44, 0xc7, 0xa3, 0x21, 0x2e, 0xe5, 0x37, 0x53, 0xfc,
+	0xff, 0x3f, 0x63, 0xfe, 0x7d, 0x71, 0x69, 0x91, 0xcc, 0x1f, 0x5d, 0xba, 0x0a, 0xff, 0x7d, 0x16,
+	0x46, 0x19, 0x60, 0xfa, 0x5d, 0x02, 0x63, 0xfc, 0xa5, 0x8a, 0x2e, 0xa8, 0x21, 0xa5, 0x1f, 0xc6,
+	0x8c, 0x2b, 0x1a, 0x92, 0xdc, 0xaa, 0x79, 0xf1, 0x3b, 0x1f, 0xfd, 0xe7, 0xcd, 0x91, 0x1c, 0x9d,
+	0xb5, 0x32, 0x1e, 0xec, 0xe8, 0xaf, 0x09, 0x4c, 0x4a, 0xcf, 0x20, 0xd4, 0xca, 0x30, 0xa1, 0x7a,
+	0x3b, 0x33, 0x96, 0xf5, 0x15, 0x10, 0xda, 0x0b, 0x0c, 0xda, 0x2a, 0xbd, 0xa6, 0x86, 0xc6, 0xde,
+	0x34, 0xb0, 0x0b, 0x6c, 0xed, 0xc9, 0x2f, 0x1c, 0xfb, 0xf4, 0x57, 0x04, 0x68, 0xfa, 0x11, 0x88,
+	0x5e, 0xd7, 0x45, 0x91, 0xdc, 0xba, 0xc6, 0xea, 0x80, 0x5a, 0xe8, 0xc0, 0x22, 0x73, 0xe0, 0x22,
+	0x35, 0xfb, 0x3b, 0x40, 0xdf, 0x26, 0x30, 0x91, 0x78, 0xbc, 0xa1, 0x4b, 0x19, 0x26, 0xd3, 0x6f,
+	0x43, 0x46, 0x5e, 0x57, 0x1c, 0xa1, 0xad, 0x32, 0x68, 0x16, 0x5d, 0xb2, 0xb2, 0x5e, 0x86, 0xad,
+	0x3d, 0xb1, 0x33, 0xf7, 0x19, 0x5a, 0xfa, 0x3b, 0x02, 0xa7, 0xbb, 0x1b, 0xf2, 0xb4, 0xa0, 0xc3,
+	0x8e, 0xfc, 0x7e, 0x63, 0x5c, 0x1b, 0x48, 0x07, 0x41, 0xaf, 0x31, 0xd0, 0x2b, 0xd4, 0x52, 0x83,
+	0xc6, 0xf7, 0x84, 0x4e, 0x4c, 0xe0, 0xc0, 0x3e, 0xfd, 0x03, 0x81, 0x27, 0x95, 0x2f, 0x13, 0x74,
+	0x6d, 0x00, 0x1c, 0x52, 0x48, 0xdc, 0x18, 0x5c, 0x11, 0xbd, 0x58, 0x62, 0x5e, 0x5c, 0xa6, 0x97,
+	0xb4, 0xbc, 0x88, 0xb7, 0xde, 0x94, 0xfc, 0x9e, 0x40, 0xb3, 0xb6, 0x92, 0xf2, 0xcd, 0xc2, 0x58,
+	0x19, 0x40, 0x03, 0x61, 0xde, 0x60, 0x30, 0x0b, 0x74, 0x59, 0x3b, 0x42, 0xc4, 0xeb, 0xd7, 0x1f,
+	0x09, 0x9c, 0xea, 0x6a, 0xff, 0xd2, 0x2c, 0x00, 0xea, 0x47, 0x0f, 0xa3, 0x30, 0x88, 0x0a, 0x82,
+	0xfe, 0x14, 0x03, 0x7d, 0x93, 0xde, 0xb0, 0xf4, 0xfe, 0x9b, 0x42, 0xc2, 0xbf, 0xe7, 0x56, 0x59,
+	0xa8, 0x4c, 0xab, 0x5e, 0x15, 0xe8, 0x73, 0xfa, 0x70, 0xa4, 0x40, 0x59, 0x1b, 0x58, 0x0f, 0x7d,
+	0x59, 0x66, 0xbe, 0x2c, 0xd2, 0x05, 0x5d, 0x5f, 0xe8, 0xef, 0x09, 0x9c, 0x5e, 0xef, 0x6e, 0xb0,
+	0x0f, 0x40, 0x63, 0xa8, 0xb3, 0x3b, 0x7b, 0x3d, 0x12, 0xf4, 0x4b, 0xd7, 0x99, 0xdc, 0xd3, 0x9f,
+	0x10, 0x18, 0x65, 0x87, 0x28, 0xbd, 0x9c, 0x61, 0x3b, 0x59, 0x03, 0x1a, 0x0b, 0xfd, 0x05, 0xf5,
+	0xa2, 0x82, 0x9f, 0xfb, 0x52, 0x24, 0xf0, 0xeb, 0xd1, 0xbe, 0xb5, 0xc7, 0xaa, 0xc7, 0x7d, 0xfa,
+	0x7d, 0x02, 0xd0, 0xa9, 0xb4, 0xe8, 0xd5, 0x7e, 0xa6, 0xa5, 0x08, 0x58, 0xd2, 0x94, 0xd6, 0x3b,
+	0x91, 0xb1, 0x5f, 0xfa, 0x36, 0x81, 0x49, 0xa9, 0x77, 0x9d, 0x79, 0x22, 0xab, 0xda, 0xec, 0xc6,
+	0xb2, 0xbe, 0x02, 0x42, 0xb3, 0x18, 0xb4, 0x2b, 0xf4, 0xb2, 0x26, 0x91, 0xf4, 0x5d, 0x02, 0x13,
+	0x89, 0x46, 0x31, 0x5d, 0xd2, 0x30, 0xd9, 0x69, 0x3a, 0x19, 0x79, 0x5d, 0x71, 0xbd, 0x9c, 0xd5,
+	0x7b, 0xa1, 0xe9, 0x7b, 0x04, 0xa0, 0x53, 0xdb, 0x65, 0x2e, 0x70, 0xea, 0x36, 0x62, 0x2c, 0x69,
+	0x4a, 0x23, 0xca, 0x5b, 0x0c, 0xe5, 0x1a, 0x5d, 0xb5, 0xfa, 0xfd, 0x6b, 0x98, 0x8c, 0x15, 0x63,
+	0xf1, 0xe7, 0x04, 0x4e, 0x75, 0x95, 0xfe, 0x99, 0xe9, 0x55, 0x7d, 0x1d, 0x31, 0x0a, 0x83, 0xa8,
+	0xe8, 0x15, 0x34, 0x49, 0xe4, 0xac, 0xa0, 0xd9, 0x48, 0xd4, 0xc6, 0x7a, 0x24, 0x69, 0x15, 0x34,
+	0x8a, 0x2a, 0x5e, 0xab, 0xa0, 0x49, 0x93, 0x4a, 0xdf, 0x21, 0x30, 0x8e, 0x8d, 0x41, 0x9a, 0x55,
+	0x35, 0xcb, 0xaf, 0x09, 0xc6, 0xa2, 0x8e, 0x28, 0x22, 0xdb, 0x60, 0xc8, 0x6e, 0xd1, 0x17, 0xac,
+	0xac, 0x7f, 0xac, 0xeb, 0x91, 0x7f, 0xf8, 0x9b, 0xc3, 0x3e, 0xfd, 0x11, 0x81, 0x93, 0xc9, 0x6e,
+	0x3b, 0xcd, 0xf7, 0x47, 0x20, 0x2d, 0xb7, 0xa5, 0x2d, 0x8f, 0xb0, 0xe7, 0x19, 0xec, 0x39, 0x9a,
+	0xcb, 0x86, 0x4d, 0x7f, 0x41, 0xe0, 0x54, 0x57, 0x73, 0x3b, 0x33, 0x1c, 0xd5, 0xbd, 0x78, 0xa3,
+	0x30, 0x88, 0x0a, 0x42, 0x5c, 0x61, 0x10, 0x3f, 0x41, 0xaf, 0x68, 0x33, 0x4b, 0xdf, 0x27, 0x30,
+	0x29, 0xf5, 0x91, 0xa9, 0xa5, 0x65, 0x38, 0x91, 0x94, 0x96, 0xf5, 0x15, 0x10, 0xe7, 0x4d, 0x86,
+	0xf3, 0x3a, 0x2d, 0x0c, 0x1e, 0x01, 0xf4, 0x97, 0x04, 0x26, 0xa5, 0x56, 0x67, 0x26, 0x60, 0x55,
+	0xeb, 0xd9, 0x58, 0xd6, 0x57, 0xd0, 0xcb, 0xa3, 0x72, 0xbf, 0x56, 0xe2, 0xf7, 0x37, 0x04, 0x68,
+	0xba, 0xd9, 0x9b, 0x79, 0xed, 0xea, 0xd9, 0x86, 0x36, 0x56, 0x07, 0xd4, 0xd2, 0x2b, 0xb0, 0xbb,
+	0xd0, 0xd3, 0xb7, 0xe2, 0xbb, 0x6d, 0xb2, 0xa3, 0x9a, 0x7d, 0xb7, 0x55, 0x34, 0x7d, 0x8d, 0x65,
+	0x7d, 0x05, 0xc4, 0x78, 0x95, 0x61, 0x9c, 0xa7, 0x17, 0x7b, 0xa4, 0x2b, 0xa9, 0x49, 0x1c, 0x57,
+	0x47, 0xe3, 0xd8, 0x6d, 0xcb, 0xcc, 0x52, 0x72, 0x0b, 0xd8, 0x58, 0xd4, 0x11, 0xd5, 0x8b, 0x51,
+	0xd1, 0xdc, 0x53, 0xc6, 0x68, 0x9c, 0x9c, 0x92, 0x8d, 0xd7, 0xcc, 0xe4, 0xa4, 0x68, 0x03, 0x1b,
+	0x96, 0xb6, 0xbc, 0x5e, 0x72, 0x6a, 0xb7, 0x22, 0xe3, 0xe4, 0xd4, 0xd5, 0xe7, 0xcc, 0x4c, 0x4e,
+	0xea, 0xb6, 0xac, 0x51, 0x18, 0x44, 0x45, 0x2f, 0x39, 0x29, 0x08, 0x5d, 0x7f, 0xe9, 0x83, 0x07,
+	0x39, 0xf2, 0xe1, 0x83, 0x1c, 0xf9, 0xf7, 0x83, 0x1c, 0xf9, 0xc1, 0xc3, 0xdc, 0xb1, 0x0f, 0x1f,
+	0xe6, 0x8e, 0xfd, 0xf3, 0x61, 0xee, 0xd8, 0x57, 0x56, 0xeb, 0x6e, 0x74, 0x6f, 0xbb, 0x92, 0xb7,
+	0xfd, 0xfb, 0x7c, 0x3a, 0xdf, 0xf5, 0xda, 0x7f, 0x2c, 0xf1, 0xc9, 0x77, 0xae, 0x5b, 0xbb, 0x6d,
+	0x0b, 0x51, 0xab, 0xe9, 0x84, 0x95, 0x31, 0xf6, 0xdf, 0xd3, 0xd7, 0xfe, 0x37, 0x00, 0xc5, 0x42,
+	0xd0, 0x0a, 0xc4, 0x2f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Queries a ClassesByISCN by index.
+	ClassesByISCN(ctx context.Context, in *QueryClassesByISCNRequest, opts ...grpc.CallOption) (*QueryClassesByISCNResponse, error)
+	// Queries a list of ClassesByISCN items.
+	ClassesByISCNIndex(ctx context.Context, in *QueryClassesByISCNIndexRequest, opts ...grpc.CallOption) (*QueryClassesByISCNIndexResponse, error)
+	// Queries a list of ISCNByClass items.
+	ISCNByClass(ctx context.Context, in *QueryISCNByClassRequest, opts ...grpc.CallOption) (*QueryISCNByClassResponse, error)
+	// Queries a ClassesByAccount by index.
+	ClassesByAccount(ctx context.Context, in *QueryClassesByAccountRequest, opts ...grpc.CallOption) (*QueryClassesByAccountResponse, error)
+	// Queries a list of ClassesByAccount items.
+	ClassesByAccountIndex(ctx context.Context, in *QueryClassesByAccountIndexRequest, opts ...grpc.CallOption) (*QueryClassesByAccountIndexResponse, error)
+	// Queries a list of AccountByClass items.
+	AccountByClass(ctx context.Context, in *QueryAccountByClassRequest, opts ...grpc.CallOption) (*QueryAccountByClassResponse, error)
+	// Queries a BlindBoxContent by index.
+	BlindBoxContent(ctx context.Context, in *QueryBlindBoxContentRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentResponse, error)
+	// Queries a list of BlindBoxContent items.
+	BlindBoxContentIndex(ctx context.Context, in *QueryBlindBoxContentIndexRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentIndexResponse, error)
+	// Queries a list of BlindBoxContents by class_id
+	BlindBoxContents(ctx context.Context, in *QueryBlindBoxContentsRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentsResponse, error)
+	// Queries a Offer by index.
+	Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error)
+	// Queries a list of Offer items.
+	OfferIndex(ctx context.Context, in *QueryOfferIndexRequest, opts ...grpc.CallOption) (*QueryOfferIndexResponse, error)
+	// Queries a list of OffersByClass items.
+	OffersByClass(ctx context.Context, in *QueryOffersByClassRequest, opts ...grpc.CallOption) (*QueryOffersByClassResponse, error)
+	// Queries a list of OffersByNFT items.
+	OffersByNFT(ctx context.Context, in *QueryOffersByNFTRequest, opts ...grpc.CallOption) (*QueryOffersByNFTResponse, error)
+	// Queries a ClassOffer by index.
+	ClassOffer(ctx context.Context, in *QueryClassOfferRequest, opts ...grpc.CallOption) (*QueryClassOfferResponse, error)
+	// Queries a list of ClassOffer items.
+	ClassOfferIndex(ctx context.Context, in *QueryClassOfferIndexRequest, opts ...grpc.CallOption) (*QueryClassOfferIndexResponse, error)
+	// Queries a list of ClassOffer items by class
+	ClassOffers(ctx context.Context, in *QueryClassOffersRequest, opts ...grpc.CallOption) (*QueryClassOffersResponse, error)
+	// Queries a Listing by index.
+	Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error)
+	// Queries a list of Listing items.
+	ListingIndex(ctx context.Context, in *QueryListingIndexRequest, opts ...grpc.CallOption) (*QueryListingIndexResponse, error)
+	// Queries a list of Listing items by class
+	ListingsByClass(ctx context.Context, in *QueryListingsByClassRequest, opts ...grpc.CallOption) (*QueryListingsByClassResponse, error)
+	// Queries a list of Listing items by nft
+	ListingsByNFT(ctx context.Context, in *QueryListingsByNFTRequest, opts ...grpc.CallOption) (*QueryListingsByNFTResponse, error)
+	// Queries a RoyaltyConfig by class
+	RoyaltyConfig(ctx context.Context, in *QueryRoyaltyConfigRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigResponse, error)
+	// Queries index of RoyaltyConfig of all classes
+	RoyaltyConfigIndex(ctx context.Context, in *QueryRoyaltyConfigIndexRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigIndexResponse, error)
+	// Queries the total per-byte fees routed to each destination
+	CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error)
+	// Queries an Auction by NFT
+	Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error)
+	// Queries a list of Auction items.
+	AuctionIndex(ctx context.Context, in *QueryAuctionIndexRequest, opts ...grpc.CallOption) (*QueryAuctionIndexResponse, error)
+	// Queries a list of Auction items by class
+	AuctionsByClass(ctx context.Context, in *QueryAuctionsByClassRequest, opts ...grpc.CallOption) (*QueryAuctionsByClassResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Params", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Listing(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/Listing",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Listing(ctx, req.(*QueryListingRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListingIndexRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ClassesByISCN(ctx context.Context, in *QueryClassesByISCNRequest, opts ...grpc.CallOption) (*QueryClassesByISCNResponse, error) {
+	out := new(QueryClassesByISCNResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByISCN", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListingIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ListingIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListingIndex(ctx, req.(*QueryListingIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListingsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListingsByClassRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ClassesByISCNIndex(ctx context.Context, in *QueryClassesByISCNIndexRequest, opts ...grpc.CallOption) (*QueryClassesByISCNIndexResponse, error) {
+	out := new(QueryClassesByISCNIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByISCNIndex", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListingsByClass(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ListingsByClass",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListingsByClass(ctx, req.(*QueryListingsByClassRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_ListingsByNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryListingsByNFTRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ISCNByClass(ctx context.Context, in *QueryISCNByClassRequest, opts ...grpc.CallOption) (*QueryISCNByClassResponse, error) {
+	out := new(QueryISCNByClassResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ISCNByClass", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListingsByNFT(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/ListingsByNFT",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListingsByNFT(ctx, req.(*QueryListingsByNFTRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RoyaltyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRoyaltyConfigRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ClassesByAccount(ctx context.Context, in *QueryClassesByAccountRequest, opts ...grpc.CallOption) (*QueryClassesByAccountResponse, error) {
+	out := new(QueryClassesByAccountResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByAccount", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RoyaltyConfig(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/RoyaltyConfig",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RoyaltyConfig(ctx, req.(*QueryRoyaltyConfigRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Query_RoyaltyConfigIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRoyaltyConfigIndexRequest)
-	if err := dec(in); err != nil {
+func (c *queryClient) ClassesByAccountIndex(ctx context.Context, in *QueryClassesByAccountIndexRequest, opts ...grpc.CallOption) (*QueryClassesByAccountIndexResponse, error) {
+	out := new(QueryClassesByAccountIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassesByAccountIndex", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).RoyaltyConfigIndex(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/likechain.likenft.v1.Query/RoyaltyConfigIndex",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RoyaltyConfigIndex(ctx, req.(*QueryRoyaltyConfigIndexRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "likechain.likenft.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "ClassesByISCN",
-			Handler:    _Query_ClassesByISCN_Handler,
-		},
-		{
-			MethodName: "ClassesByISCNIndex",
-			Handler:    _Query_ClassesByISCNIndex_Handler,
-		},
-		{
-			MethodName: "ISCNByClass",
-			Handler:    _Query_ISCNByClass_Handler,
-		},
-		{
-			MethodName: "ClassesByAccount",
-			Handler:    _Query_ClassesByAccount_Handler,
-		},
-		{
-			MethodName: "ClassesByAccountIndex",
-			Handler:    _Query_ClassesByAccountIndex_Handler,
-		},
-		{
-			MethodName: "AccountByClass",
-			Handler:    _Query_AccountByClass_Handler,
-		},
-		{
-			MethodName: "BlindBoxContent",
-			Handler:    _Query_BlindBoxContent_Handler,
-		},
-		{
-			MethodName: "BlindBoxContentIndex",
-			Handler:    _Query_BlindBoxContentIndex_Handler,
-		},
-		{
-			MethodName: "BlindBoxContents",
-			Handler:    _Query_BlindBoxContents_Handler,
-		},
-		{
-			MethodName: "Offer",
-			Handler:    _Query_Offer_Handler,
-		},
-		{
-			MethodName: "OfferIndex",
-			Handler:    _Query_OfferIndex_Handler,
-		},
-		{
-			MethodName: "OffersByClass",
-			Handler:    _Query_OffersByClass_Handler,
-		},
-		{
-			MethodName: "OffersByNFT",
-			Handler:    _Query_OffersByNFT_Handler,
-		},
-		{
-			MethodName: "Listing",
-			Handler:    _Query_Listing_Handler,
-		},
-		{
-			MethodName: "ListingIndex",
-			Handler:    _Query_ListingIndex_Handler,
-		},
-		{
-			MethodName: "ListingsByClass",
-			Handler:    _Query_ListingsByClass_Handler,
-		},
-		{
-			MethodName: "ListingsByNFT",
-			Handler:    _Query_ListingsByNFT_Handler,
-		},
-		{
-			MethodName: "RoyaltyConfig",
-			Handler:    _Query_RoyaltyConfig_Handler,
-		},
-		{
-			MethodName: "RoyaltyConfigIndex",
-			Handler:    _Query_RoyaltyConfigIndex_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "likechain/likenft/v1/query.proto",
+func (c *queryClient) AccountByClass(ctx context.Context, in *QueryAccountByClassRequest, opts ...grpc.CallOption) (*QueryAccountByClassResponse, error) {
+	out := new(QueryAccountByClassResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/AccountByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlindBoxContent(ctx context.Context, in *QueryBlindBoxContentRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentResponse, error) {
+	out := new(QueryBlindBoxContentResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/BlindBoxContent", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) BlindBoxContentIndex(ctx context.Context, in *QueryBlindBoxContentIndexRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentIndexResponse, error) {
+	out := new(QueryBlindBoxContentIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/BlindBoxContentIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *queryClient) BlindBoxContents(ctx context.Context, in *QueryBlindBoxContentsRequest, opts ...grpc.CallOption) (*QueryBlindBoxContentsResponse, error) {
+	out := new(QueryBlindBoxContentsResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/BlindBoxContents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Offer(ctx context.Context, in *QueryOfferRequest, opts ...grpc.CallOption) (*QueryOfferResponse, error) {
+	out := new(QueryOfferResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Offer", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OfferIndex(ctx context.Context, in *QueryOfferIndexRequest, opts ...grpc.CallOption) (*QueryOfferIndexResponse, error) {
+	out := new(QueryOfferIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/OfferIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (c *queryClient) OffersByClass(ctx context.Context, in *QueryOffersByClassRequest, opts ...grpc.CallOption) (*QueryOffersByClassResponse, error) {
+	out := new(QueryOffersByClassResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/OffersByClass", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesByISCNRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) OffersByNFT(ctx context.Context, in *QueryOffersByNFTRequest, opts ...grpc.CallOption) (*QueryOffersByNFTResponse, error) {
+	out := new(QueryOffersByNFTResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/OffersByNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryClassesByISCNRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClassOffer(ctx context.Context, in *QueryClassOfferRequest, opts ...grpc.CallOption) (*QueryClassOfferResponse, error) {
+	out := new(QueryClassOfferResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryClassesByISCNRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IscnIdPrefix) > 0 {
-		i -= len(m.IscnIdPrefix)
-		copy(dAtA[i:], m.IscnIdPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) ClassOfferIndex(ctx context.Context, in *QueryClassOfferIndexRequest, opts ...grpc.CallOption) (*QueryClassOfferIndexResponse, error) {
+	out := new(QueryClassOfferIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassOfferIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesByISCNResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ClassOffers(ctx context.Context, in *QueryClassOffersRequest, opts ...grpc.CallOption) (*QueryClassOffersResponse, error) {
+	out := new(QueryClassOffersResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ClassOffers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryClassesByISCNResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Listing(ctx context.Context, in *QueryListingRequest, opts ...grpc.CallOption) (*QueryListingResponse, error) {
+	out := new(QueryListingResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Listing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryClassesByISCNResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Classes) > 0 {
-		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.IscnIdPrefix) > 0 {
-		i -= len(m.IscnIdPrefix)
-		copy(dAtA[i:], m.IscnIdPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryClassesByISCNIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListingIndex(ctx context.Context, in *QueryListingIndexRequest, opts ...grpc.CallOption) (*QueryListingIndexResponse, error) {
+	out := new(QueryListingIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ListingIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClassesByISCNIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryClassesByISCNIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesByISCNIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListingsByClass(ctx context.Context, in *QueryListingsByClassRequest, opts ...grpc.CallOption) (*QueryListingsByClassResponse, error) {
+	out := new(QueryListingsByClassResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ListingsByClass", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClassesByISCNIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryClassesByISCNIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClassesByIscns) > 0 {
-		for iNdEx := len(m.ClassesByIscns) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClassesByIscns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
-	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryISCNByClassRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ListingsByNFT(ctx context.Context, in *QueryListingsByNFTRequest, opts ...grpc.CallOption) (*QueryListingsByNFTResponse, error) {
+	out := new(QueryListingsByNFTResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/ListingsByNFT", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryISCNByClassRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryISCNByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) RoyaltyConfig(ctx context.Context, in *QueryRoyaltyConfigRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigResponse, error) {
+	out := new(QueryRoyaltyConfigResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/RoyaltyConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryISCNByClassResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) RoyaltyConfigIndex(ctx context.Context, in *QueryRoyaltyConfigIndexRequest, opts ...grpc.CallOption) (*QueryRoyaltyConfigIndexResponse, error) {
+	out := new(QueryRoyaltyConfigIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/RoyaltyConfigIndex", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryISCNByClassResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryISCNByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.LatestRecord.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if m.LatestVersion != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.LatestVersion))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Owner) > 0 {
-		i -= len(m.Owner)
-		copy(dAtA[i:], m.Owner)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.IscnIdPrefix) > 0 {
-		i -= len(m.IscnIdPrefix)
-		copy(dAtA[i:], m.IscnIdPrefix)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) CollectedFees(ctx context.Context, in *QueryCollectedFeesRequest, opts ...grpc.CallOption) (*QueryCollectedFeesResponse, error) {
+	out := new(QueryCollectedFeesResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/CollectedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesByAccountRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Auction(ctx context.Context, in *QueryAuctionRequest, opts ...grpc.CallOption) (*QueryAuctionResponse, error) {
+	out := new(QueryAuctionResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/Auction", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClassesByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryClassesByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
+func (c *queryClient) AuctionIndex(ctx context.Context, in *QueryAuctionIndexRequest, opts ...grpc.CallOption) (*QueryAuctionIndexResponse, error) {
+	out := new(QueryAuctionIndexResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/AuctionIndex", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryClassesByAccountResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) AuctionsByClass(ctx context.Context, in *QueryAuctionsByClassRequest, opts ...grpc.CallOption) (*QueryAuctionsByClassResponse, error) {
+	out := new(QueryAuctionsByClassResponse)
+	err := c.cc.Invoke(ctx, "/likechain.likenft.v1.Query/AuctionsByClass", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryClassesByAccountResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *QueryClassesByAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Classes) > 0 {
-		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.Account) > 0 {
-		i -= len(m.Account)
-		copy(dAtA[i:], m.Account)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Queries a ClassesByISCN by index.
+	ClassesByISCN(context.Context, *QueryClassesByISCNRequest) (*QueryClassesByISCNResponse, error)
+	// Queries a list of ClassesByISCN items.
+	ClassesByISCNIndex(context.Context, *QueryClassesByISCNIndexRequest) (*QueryClassesByISCNIndexResponse, error)
+	// Queries a list of ISCNByClass items.
+	ISCNByClass(context.Context, *QueryISCNByClassRequest) (*QueryISCNByClassResponse, error)
+	// Queries a ClassesByAccount by index.
+	ClassesByAccount(context.Context, *QueryClassesByAccountRequest) (*QueryClassesByAccountResponse, error)
+	// Queries a list of ClassesByAccount items.
+	ClassesByAccountIndex(context.Context, *QueryClassesByAccountIndexRequest) (*QueryClassesByAccountIndexResponse, error)
+	// Queries a list of AccountByClass items.
+	AccountByClass(context.Context, *QueryAccountByClassRequest) (*QueryAccountByClassResponse, error)
+	// Queries a BlindBoxContent by index.
+	BlindBoxContent(context.Context, *QueryBlindBoxContentRequest) (*QueryBlindBoxContentResponse, error)
+	// Queries a list of BlindBoxContent items.
+	BlindBoxContentIndex(context.Context, *QueryBlindBoxContentIndexRequest) (*QueryBlindBoxContentIndexResponse, error)
+	// Queries a list of BlindBoxContents by class_id
+	BlindBoxContents(context.Context, *QueryBlindBoxContentsRequest) (*QueryBlindBoxContentsResponse, error)
+	// Queries a Offer by index.
+	Offer(context.Context, *QueryOfferRequest) (*QueryOfferResponse, error)
+	// Queries a list of Offer items.
+	OfferIndex(context.Context, *QueryOfferIndexRequest) (*QueryOfferIndexResponse, error)
+	// Queries a list of OffersByClass items.
+	OffersByClass(context.Context, *QueryOffersByClassRequest) (*QueryOffersByClassResponse, error)
+	// Queries a list of OffersByNFT items.
+	OffersByNFT(context.Context, *QueryOffersByNFTRequest) (*QueryOffersByNFTResponse, error)
+	// Queries a ClassOffer by index.
+	ClassOffer(context.Context, *QueryClassOfferRequest) (*QueryClassOfferResponse, error)
+	// Queries a list of ClassOffer items.
+	ClassOfferIndex(context.Context, *QueryClassOfferIndexRequest) (*QueryClassOfferIndexResponse, error)
+	// Queries a list of ClassOffer items by class
+	ClassOffers(context.Context, *QueryClassOffersRequest) (*QueryClassOffersResponse, error)
+	// Queries a Listing by index.
+	Listing(context.Context, *QueryListingRequest) (*QueryListingResponse, error)
+	// Queries a list of Listing items.
+	ListingIndex(context.Context, *QueryListingIndexRequest) (*QueryListingIndexResponse, error)
+	// Queries a list of Listing items by class
+	ListingsByClass(context.Context, *QueryListingsByClassRequest) (*QueryListingsByClassResponse, error)
+	// Queries a list of Listing items by nft
+	ListingsByNFT(context.Context, *QueryListingsByNFTRequest) (*QueryListingsByNFTResponse, error)
+	// Queries a RoyaltyConfig by class
+	RoyaltyConfig(context.Context, *QueryRoyaltyConfigRequest) (*QueryRoyaltyConfigResponse, error)
+	// Queries index of RoyaltyConfig of all classes
+	RoyaltyConfigIndex(context.Context, *QueryRoyaltyConfigIndexRequest) (*QueryRoyaltyConfigIndexResponse, error)
+	// Queries the total per-byte fees routed to each destination
+	CollectedFees(context.Context, *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error)
+	// Queries an Auction by NFT
+	Auction(context.Context, *QueryAuctionRequest) (*QueryAuctionResponse, error)
+	// Queries a list of Auction items.
+	AuctionIndex(context.Context, *QueryAuctionIndexRequest) (*QueryAuctionIndexResponse, error)
+	// Queries a list of Auction items by class
+	AuctionsByClass(context.Context, *QueryAuctionsByClassRequest) (*QueryAuctionsByClassResponse, error)
 }
 
-func (m *QueryClassesByAccountIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryClassesByAccountIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
-
-func (m *QueryClassesByAccountIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedQueryServer) ClassesByISCN(ctx context.Context, req *QueryClassesByISCNRequest) (*QueryClassesByISCNResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassesByISCN not implemented")
 }
-
-func (m *QueryClassesByAccountIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedQueryServer) ClassesByISCNIndex(ctx context.Context, req *QueryClassesByISCNIndexRequest) (*QueryClassesByISCNIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassesByISCNIndex not implemented")
+}
+func (*UnimplementedQueryServer) ISCNByClass(ctx context.Context, req *QueryISCNByClassRequest) (*QueryISCNByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ISCNByClass not implemented")
+}
+func (*UnimplementedQueryServer) ClassesByAccount(ctx context.Context, req *QueryClassesByAccountRequest) (*QueryClassesByAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAccount not implemented")
+}
+func (*UnimplementedQueryServer) ClassesByAccountIndex(ctx context.Context, req *QueryClassesByAccountIndexRequest) (*QueryClassesByAccountIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassesByAccountIndex not implemented")
+}
+func (*UnimplementedQueryServer) AccountByClass(ctx context.Context, req *QueryAccountByClassRequest) (*QueryAccountByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountByClass not implemented")
+}
+func (*UnimplementedQueryServer) BlindBoxContent(ctx context.Context, req *QueryBlindBoxContentRequest) (*QueryBlindBoxContentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlindBoxContent not implemented")
+}
+func (*UnimplementedQueryServer) BlindBoxContentIndex(ctx context.Context, req *QueryBlindBoxContentIndexRequest) (*QueryBlindBoxContentIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlindBoxContentIndex not implemented")
+}
+func (*UnimplementedQueryServer) BlindBoxContents(ctx context.Context, req *QueryBlindBoxContentsRequest) (*QueryBlindBoxContentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlindBoxContents not implemented")
+}
+func (*UnimplementedQueryServer) Offer(ctx context.Context, req *QueryOfferRequest) (*QueryOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Offer not implemented")
+}
+func (*UnimplementedQueryServer) OfferIndex(ctx context.Context, req *QueryOfferIndexRequest) (*QueryOfferIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OfferIndex not implemented")
+}
+func (*UnimplementedQueryServer) OffersByClass(ctx context.Context, req *QueryOffersByClassRequest) (*QueryOffersByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByClass not implemented")
+}
+func (*UnimplementedQueryServer) OffersByNFT(ctx context.Context, req *QueryOffersByNFTRequest) (*QueryOffersByNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OffersByNFT not implemented")
+}
+func (*UnimplementedQueryServer) ClassOffer(ctx context.Context, req *QueryClassOfferRequest) (*QueryClassOfferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassOffer not implemented")
+}
+func (*UnimplementedQueryServer) ClassOfferIndex(ctx context.Context, req *QueryClassOfferIndexRequest) (*QueryClassOfferIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassOfferIndex not implemented")
+}
+func (*UnimplementedQueryServer) ClassOffers(ctx context.Context, req *QueryClassOffersRequest) (*QueryClassOffersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClassOffers not implemented")
+}
+func (*UnimplementedQueryServer) Listing(ctx context.Context, req *QueryListingRequest) (*QueryListingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Listing not implemented")
+}
+func (*UnimplementedQueryServer) ListingIndex(ctx context.Context, req *QueryListingIndexRequest) (*QueryListingIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListingIndex not implemented")
+}
+func (*UnimplementedQueryServer) ListingsByClass(ctx context.Context, req *QueryListingsByClassRequest) (*QueryListingsByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListingsByClass not implemented")
+}
+func (*UnimplementedQueryServer) ListingsByNFT(ctx context.Context, req *QueryListingsByNFTRequest) (*QueryListingsByNFTResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListingsByNFT not implemented")
+}
+func (*UnimplementedQueryServer) RoyaltyConfig(ctx context.Context, req *QueryRoyaltyConfigRequest) (*QueryRoyaltyConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyConfig not implemented")
+}
+func (*UnimplementedQueryServer) RoyaltyConfigIndex(ctx context.Context, req *QueryRoyaltyConfigIndexRequest) (*QueryRoyaltyConfigIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoyaltyConfigIndex not implemented")
+}
+func (*UnimplementedQueryServer) CollectedFees(ctx context.Context, req *QueryCollectedFeesRequest) (*QueryCollectedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CollectedFees not implemented")
+}
+func (*UnimplementedQueryServer) Auction(ctx context.Context, req *QueryAuctionRequest) (*QueryAuctionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Auction not implemented")
+}
+func (*UnimplementedQueryServer) AuctionIndex(ctx context.Context, req *QueryAuctionIndexRequest) (*QueryAuctionIndexResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionIndex not implemented")
+}
+func (*UnimplementedQueryServer) AuctionsByClass(ctx context.Context, req *QueryAuctionsByClassRequest) (*QueryAuctionsByClassResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionsByClass not implemented")
 }
 
-func (m *QueryClassesByAccountIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
 }
 
-func (m *QueryClassesByAccountIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClassesByAccounts) > 0 {
-		for iNdEx := len(m.ClassesByAccounts) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClassesByAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountByClassRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClassesByISCN_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesByISCNRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryAccountByClassRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).ClassesByISCN(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassesByISCN",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassesByISCN(ctx, req.(*QueryClassesByISCNRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0xa
+func _Query_ClassesByISCNIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesByISCNIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClassesByISCNIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassesByISCNIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassesByISCNIndex(ctx, req.(*QueryClassesByISCNIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountByClassResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ISCNByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryISCNByClassRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ISCNByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ISCNByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ISCNByClass(ctx, req.(*QueryISCNByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountByClassResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ClassesByAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesByAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassesByAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassesByAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassesByAccount(ctx, req.(*QueryClassesByAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryAccountByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func _Query_ClassesByAccountIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassesByAccountIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClassesByAccountIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassesByAccountIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassesByAccountIndex(ctx, req.(*QueryClassesByAccountIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_AccountByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAccountByClassRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).AccountByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/AccountByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountByClass(ctx, req.(*QueryAccountByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_BlindBoxContent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlindBoxContentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlindBoxContent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/BlindBoxContent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlindBoxContent(ctx, req.(*QueryBlindBoxContentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
+func _Query_BlindBoxContentIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlindBoxContentIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).BlindBoxContentIndex(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/BlindBoxContentIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlindBoxContentIndex(ctx, req.(*QueryBlindBoxContentIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_BlindBoxContents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlindBoxContentsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).BlindBoxContents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/BlindBoxContents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlindBoxContents(ctx, req.(*QueryBlindBoxContentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_Offer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Offer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/Offer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Offer(ctx, req.(*QueryOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BlindBoxContent.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func _Query_OfferIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOfferIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OfferIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/OfferIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OfferIndex(ctx, req.(*QueryOfferIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentIndexRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_OffersByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByClassRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/OffersByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByClass(ctx, req.(*QueryOffersByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentIndexRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBlindBoxContentIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func _Query_OffersByNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOffersByNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(QueryServer).OffersByNFT(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/OffersByNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OffersByNFT(ctx, req.(*QueryOffersByNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentIndexResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClassOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassOfferRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryBlindBoxContentIndexResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryBlindBoxContentIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(QueryServer).ClassOffer(ctx, in)
 	}
-	if len(m.BlindBoxContents) > 0 {
-		for iNdEx := len(m.BlindBoxContents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlindBoxContents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassOffer",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassOffer(ctx, req.(*QueryClassOfferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ClassOfferIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassOfferIndexRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ClassOfferIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassOfferIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassOfferIndex(ctx, req.(*QueryClassOfferIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ClassOffers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClassOffersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClassOffers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ClassOffers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClassOffers(ctx, req.(*QueryClassOffersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_Listing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(QueryServer).Listing(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/Listing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Listing(ctx, req.(*QueryListingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_ListingIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingIndexRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).ListingIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ListingIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingIndex(ctx, req.(*QueryListingIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_ListingsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListingsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ListingsByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingsByClass(ctx, req.(*QueryListingsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryBlindBoxContentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
+func _Query_ListingsByNFT_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListingsByNFTRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.BlindBoxContents) > 0 {
-		for iNdEx := len(m.BlindBoxContents) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.BlindBoxContents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).ListingsByNFT(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/ListingsByNFT",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListingsByNFT(ctx, req.(*QueryListingsByNFTRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOfferRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_RoyaltyConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyConfigRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/RoyaltyConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyConfig(ctx, req.(*QueryRoyaltyConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOfferRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Query_RoyaltyConfigIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoyaltyConfigIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoyaltyConfigIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/RoyaltyConfigIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoyaltyConfigIndex(ctx, req.(*QueryRoyaltyConfigIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func _Query_CollectedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCollectedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CollectedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/CollectedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CollectedFees(ctx, req.(*QueryCollectedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Auction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Auction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/Auction",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Auction(ctx, req.(*QueryAuctionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionIndex_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionIndexRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionIndex(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/AuctionIndex",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionIndex(ctx, req.(*QueryAuctionIndexRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionsByClass_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionsByClassRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionsByClass(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/likechain.likenft.v1.Query/AuctionsByClass",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionsByClass(ctx, req.(*QueryAuctionsByClassRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "likechain.likenft.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "ClassesByISCN",
+			Handler:    _Query_ClassesByISCN_Handler,
+		},
+		{
+			MethodName: "ClassesByISCNIndex",
+			Handler:    _Query_ClassesByISCNIndex_Handler,
+		},
+		{
+			MethodName: "ISCNByClass",
+			Handler:    _Query_ISCNByClass_Handler,
+		},
+		{
+			MethodName: "ClassesByAccount",
+			Handler:    _Query_ClassesByAccount_Handler,
+		},
+		{
+			MethodName: "ClassesByAccountIndex",
+			Handler:    _Query_ClassesByAccountIndex_Handler,
+		},
+		{
+			MethodName: "AccountByClass",
+			Handler:    _Query_AccountByClass_Handler,
+		},
+		{
+			MethodName: "BlindBoxContent",
+			Handler:    _Query_BlindBoxContent_Handler,
+		},
+		{
+			MethodName: "BlindBoxContentIndex",
+			Handler:    _Query_BlindBoxContentIndex_Handler,
+		},
+		{
+			MethodName: "BlindBoxContents",
+			Handler:    _Query_BlindBoxContents_Handler,
+		},
+		{
+			MethodName: "Offer",
+			Handler:    _Query_Offer_Handler,
+		},
+		{
+			MethodName: "OfferIndex",
+			Handler:    _Query_OfferIndex_Handler,
+		},
+		{
+			MethodName: "OffersByClass",
+			Handler:    _Query_OffersByClass_Handler,
+		},
+		{
+			MethodName: "OffersByNFT",
+			Handler:    _Query_OffersByNFT_Handler,
+		},
+		{
+			MethodName: "ClassOffer",
+			Handler:    _Query_ClassOffer_Handler,
+		},
+		{
+			MethodName: "ClassOfferIndex",
+			Handler:    _Query_ClassOfferIndex_Handler,
+		},
+		{
+			MethodName: "ClassOffers",
+			Handler:    _Query_ClassOffers_Handler,
+		},
+		{
+			MethodName: "Listing",
+			Handler:    _Query_Listing_Handler,
+		},
+		{
+			MethodName: "ListingIndex",
+			Handler:    _Query_ListingIndex_Handler,
+		},
+		{
+			MethodName: "ListingsByClass",
+			Handler:    _Query_ListingsByClass_Handler,
+		},
+		{
+			MethodName: "ListingsByNFT",
+			Handler:    _Query_ListingsByNFT_Handler,
+		},
+		{
+			MethodName: "RoyaltyConfig",
+			Handler:    _Query_RoyaltyConfig_Handler,
+		},
+		{
+			MethodName: "RoyaltyConfigIndex",
+			Handler:    _Query_RoyaltyConfigIndex_Handler,
+		},
+		{
+			MethodName: "CollectedFees",
+			Handler:    _Query_CollectedFees_Handler,
+		},
+		{
+			MethodName: "Auction",
+			Handler:    _Query_Auction_Handler,
+		},
+		{
+			MethodName: "AuctionIndex",
+			Handler:    _Query_AuctionIndex_Handler,
+		},
+		{
+			MethodName: "AuctionsByClass",
+			Handler:    _Query_AuctionsByClass_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "likechain/likenft/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Buyer) > 0 {
-		i -= len(m.Buyer)
-		copy(dAtA[i:], m.Buyer)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NftId) > 0 {
-		i -= len(m.NftId)
-		copy(dAtA[i:], m.NftId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOfferResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3869,18 +4031,18 @@ func (m *QueryOfferResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3892,7 +4054,7 @@ func (m *QueryOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOfferIndexRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByISCNRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3902,12 +4064,12 @@ func (m *QueryOfferIndexRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOfferIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOfferIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3922,12 +4084,19 @@ func (m *QueryOfferIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOfferIndexResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByISCNResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3937,12 +4106,12 @@ func (m *QueryOfferIndexResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOfferIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOfferIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3957,12 +4126,12 @@ func (m *QueryOfferIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.Offers) > 0 {
-		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3970,13 +4139,20 @@ func (m *QueryOfferIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOffersByClassRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByISCNIndexRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3986,12 +4162,12 @@ func (m *QueryOffersByClassRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOffersByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNIndexRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOffersByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4006,19 +4182,12 @@ func (m *QueryOffersByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOffersByClassResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByISCNIndexResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4028,12 +4197,12 @@ func (m *QueryOffersByClassResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOffersByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNIndexResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOffersByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByISCNIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4050,10 +4219,10 @@ func (m *QueryOffersByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Offers) > 0 {
-		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ClassesByIscns) > 0 {
+		for iNdEx := len(m.ClassesByIscns) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ClassesByIscns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4067,7 +4236,7 @@ func (m *QueryOffersByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOffersByNFTRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryISCNByClassRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4077,35 +4246,16 @@ func (m *QueryOffersByNFTRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOffersByNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryISCNByClassRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOffersByNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryISCNByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NftId) > 0 {
-		i -= len(m.NftId)
-		copy(dAtA[i:], m.NftId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.ClassId) > 0 {
 		i -= len(m.ClassId)
 		copy(dAtA[i:], m.ClassId)
@@ -4116,7 +4266,7 @@ func (m *QueryOffersByNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryOffersByNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryISCNByClassResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4126,123 +4276,49 @@ func (m *QueryOffersByNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryOffersByNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryISCNByClassResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOffersByNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryISCNByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Offers) > 0 {
-		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+	{
+		size, err := m.LatestRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryListingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryListingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryListingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Seller) > 0 {
-		i -= len(m.Seller)
-		copy(dAtA[i:], m.Seller)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+	i--
+	dAtA[i] = 0x22
+	if m.LatestVersion != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LatestVersion))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	if len(m.NftId) > 0 {
-		i -= len(m.NftId)
-		copy(dAtA[i:], m.NftId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+	if len(m.IscnIdPrefix) > 0 {
+		i -= len(m.IscnIdPrefix)
+		copy(dAtA[i:], m.IscnIdPrefix)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.IscnIdPrefix)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryListingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryListingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Listing.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *QueryListingIndexRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByAccountRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4252,12 +4328,12 @@ func (m *QueryListingIndexRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListingIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4272,12 +4348,19 @@ func (m *QueryListingIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingIndexResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByAccountResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4287,12 +4370,12 @@ func (m *QueryListingIndexResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListingIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4307,12 +4390,12 @@ func (m *QueryListingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x1a
 	}
-	if len(m.Listings) > 0 {
-		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Classes) > 0 {
+		for iNdEx := len(m.Classes) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Classes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4320,13 +4403,20 @@ func (m *QueryListingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingsByClassRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByAccountIndexRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4336,12 +4426,12 @@ func (m *QueryListingsByClassRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListingsByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountIndexRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingsByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4356,19 +4446,12 @@ func (m *QueryListingsByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, er
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClassId) > 0 {
-		i -= len(m.ClassId)
-		copy(dAtA[i:], m.ClassId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
-		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingsByClassResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryClassesByAccountIndexResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4378,12 +4461,12 @@ func (m *QueryListingsByClassResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListingsByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountIndexResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingsByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryClassesByAccountIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4400,10 +4483,10 @@ func (m *QueryListingsByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Listings) > 0 {
-		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.ClassesByAccounts) > 0 {
+		for iNdEx := len(m.ClassesByAccounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.ClassesByAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4417,7 +4500,7 @@ func (m *QueryListingsByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingsByNFTRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountByClassRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4427,35 +4510,16 @@ func (m *QueryListingsByNFTRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListingsByNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountByClassRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingsByNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.NftId) > 0 {
-		i -= len(m.NftId)
-		copy(dAtA[i:], m.NftId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
-		i--
-		dAtA[i] = 0x12
-	}
 	if len(m.ClassId) > 0 {
 		i -= len(m.ClassId)
 		copy(dAtA[i:], m.ClassId)
@@ -4466,7 +4530,7 @@ func (m *QueryListingsByNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingsByNFTResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryAccountByClassResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4476,46 +4540,27 @@ func (m *QueryListingsByNFTResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryListingsByNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryAccountByClassResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingsByNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryAccountByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Listings) > 0 {
-		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRoyaltyConfigRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlindBoxContentRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4525,16 +4570,23 @@ func (m *QueryRoyaltyConfigRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRoyaltyConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRoyaltyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.ClassId) > 0 {
 		i -= len(m.ClassId)
 		copy(dAtA[i:], m.ClassId)
@@ -4545,7 +4597,7 @@ func (m *QueryRoyaltyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRoyaltyConfigResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlindBoxContentResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4555,18 +4607,18 @@ func (m *QueryRoyaltyConfigResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRoyaltyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRoyaltyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
 	{
-		size, err := m.RoyaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.BlindBoxContent.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4578,7 +4630,7 @@ func (m *QueryRoyaltyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRoyaltyConfigIndexRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlindBoxContentIndexRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4588,12 +4640,12 @@ func (m *QueryRoyaltyConfigIndexRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRoyaltyConfigIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentIndexRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRoyaltyConfigIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4613,7 +4665,7 @@ func (m *QueryRoyaltyConfigIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryRoyaltyConfigIndexResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryBlindBoxContentIndexResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4623,12 +4675,12 @@ func (m *QueryRoyaltyConfigIndexResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryRoyaltyConfigIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentIndexResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryRoyaltyConfigIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryBlindBoxContentIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4645,10 +4697,10 @@ func (m *QueryRoyaltyConfigIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.RoyaltyConfigByClass) > 0 {
-		for iNdEx := len(m.RoyaltyConfigByClass) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.BlindBoxContents) > 0 {
+		for iNdEx := len(m.BlindBoxContents) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.RoyaltyConfigByClass[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.BlindBoxContents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4662,681 +4714,3722 @@ func (m *QueryRoyaltyConfigIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlindBoxContentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryBlindBoxContentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryClassesByISCNRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlindBoxContentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.IscnIdPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryClassesByISCNResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.IscnIdPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassesByISCNIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryBlindBoxContentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryClassesByISCNIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryBlindBoxContentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlindBoxContentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClassesByIscns) > 0 {
-		for _, e := range m.ClassesByIscns {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.BlindBoxContents) > 0 {
+		for iNdEx := len(m.BlindBoxContents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlindBoxContents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryISCNByClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryISCNByClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.IscnIdPrefix)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Owner)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.LatestVersion != 0 {
-		n += 1 + sovQuery(uint64(m.LatestVersion))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.LatestRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassesByAccountRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryClassesByAccountResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Account)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if len(m.Classes) > 0 {
-		for _, e := range m.Classes {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.Offer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryClassesByAccountIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOfferIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryClassesByAccountIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOfferIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOfferIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.ClassesByAccounts) > 0 {
-		for _, e := range m.ClassesByAccounts {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryAccountByClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOfferIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryAccountByClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryOfferIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlindBoxContentRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOfferIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlindBoxContentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.BlindBoxContent.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlindBoxContentIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryOffersByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryBlindBoxContentIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOffersByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlindBoxContents) > 0 {
-		for _, e := range m.BlindBoxContents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryBlindBoxContentsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryOffersByClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryBlindBoxContentsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOffersByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.BlindBoxContents) > 0 {
-		for _, e := range m.BlindBoxContents {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOfferRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByNFTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOffersByNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOffersByNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.Buyer)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOfferResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryOffersByNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Offer.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOfferIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryOffersByNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOfferIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryOffersByNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Offers) > 0 {
-		for _, e := range m.Offers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Offers) > 0 {
+		for iNdEx := len(m.Offers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Offers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOffersByClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Seller) > 0 {
+		i -= len(m.Seller)
+		copy(dAtA[i:], m.Seller)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Seller)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryOffersByClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Offers) > 0 {
-		for _, e := range m.Offers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryOffersByNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+func (m *QueryListingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryOffersByNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Offers) > 0 {
-		for _, e := range m.Offers {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	{
+		size, err := m.CurrentPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Listing.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Seller)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListingIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Listing.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryListingIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryListingIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListingIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListingIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Listings) > 0 {
-		for _, e := range m.Listings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingsByClassRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryListingsByClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryListingsByClassResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryListingsByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Listings) > 0 {
-		for _, e := range m.Listings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryListingsByNFTRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListingsByClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.NftId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
 	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryListingsByNFTResponse) Size() (n int) {
-	if m == nil {
-		return 0
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Listings) > 0 {
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingsByNFTRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsByNFTRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsByNFTRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListingsByNFTResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListingsByNFTResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListingsByNFTResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Listings) > 0 {
-		for _, e := range m.Listings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+		for iNdEx := len(m.Listings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Listings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RoyaltyConfig.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyConfigIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyConfigIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyConfigIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoyaltyConfigIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoyaltyConfigIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoyaltyConfigIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.RoyaltyConfigByClass) > 0 {
+		for iNdEx := len(m.RoyaltyConfigByClass) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoyaltyConfigByClass[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCollectedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCollectedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCollectedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.CollectedFees.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NftId) > 0 {
+		i -= len(m.NftId)
+		copy(dAtA[i:], m.NftId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NftId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Auction.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionsByClassRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionsByClassRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionsByClassRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionsByClassResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionsByClassResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionsByClassResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Auctions) > 0 {
+		for iNdEx := len(m.Auctions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Auctions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassOfferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassOfferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassOfferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buyer) > 0 {
+		i -= len(m.Buyer)
+		copy(dAtA[i:], m.Buyer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Buyer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassOfferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassOfferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassOfferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClassOffer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassOfferIndexRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassOfferIndexRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassOfferIndexRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassOfferIndexResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassOfferIndexResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassOfferIndexResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassOffers) > 0 {
+		for iNdEx := len(m.ClassOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassOffersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassOffersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassOffersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassId) > 0 {
+		i -= len(m.ClassId)
+		copy(dAtA[i:], m.ClassId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClassId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryClassOffersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryClassOffersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryClassOffersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClassOffers) > 0 {
+		for iNdEx := len(m.ClassOffers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClassOffers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassesByISCNRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesByISCNResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesByISCNIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesByISCNIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClassesByIscns) > 0 {
+		for _, e := range m.ClassesByIscns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryISCNByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryISCNByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.IscnIdPrefix)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LatestVersion != 0 {
+		n += 1 + sovQuery(uint64(m.LatestVersion))
+	}
+	l = m.LatestRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassesByAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesByAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Classes) > 0 {
+		for _, e := range m.Classes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesByAccountIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassesByAccountIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClassesByAccounts) > 0 {
+		for _, e := range m.ClassesByAccounts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAccountByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlindBoxContentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlindBoxContentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BlindBoxContent.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBlindBoxContentIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlindBoxContentIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlindBoxContents) > 0 {
+		for _, e := range m.BlindBoxContents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlindBoxContentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlindBoxContentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlindBoxContents) > 0 {
+		for _, e := range m.BlindBoxContents {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Offer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOfferIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOfferIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOffersByNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Offers) > 0 {
+		for _, e := range m.Offers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Seller)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Listing.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListingIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingsByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingsByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingsByNFTRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListingsByNFTResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Listings) > 0 {
+		for _, e := range m.Listings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RoyaltyConfig.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRoyaltyConfigIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoyaltyConfigIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RoyaltyConfigByClass) > 0 {
+		for _, e := range m.RoyaltyConfigByClass {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCollectedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryCollectedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.CollectedFees.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.NftId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Auction.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAuctionIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionsByClassRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionsByClassResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Auctions) > 0 {
+		for _, e := range m.Auctions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassOfferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Buyer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassOfferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClassOffer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryClassOfferIndexRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassOfferIndexResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClassOffers) > 0 {
+		for _, e := range m.ClassOffers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassOffersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClassId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryClassOffersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClassOffers) > 0 {
+		for _, e := range m.ClassOffers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByISCNRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByISCNRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByISCNRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByISCNResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByISCNResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByISCNResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, nft.Class{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByISCNIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByISCNIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByISCNIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByISCNIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByISCNIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByISCNIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassesByIscns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassesByIscns = append(m.ClassesByIscns, ClassesByISCN{})
+			if err := m.ClassesByIscns[len(m.ClassesByIscns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryISCNByClassRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryISCNByClassRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryISCNByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryISCNByClassResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryISCNByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
+			}
+			m.LatestVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LatestRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Classes = append(m.Classes, nft.Class{})
+			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByAccountIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByAccountIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByAccountIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryClassesByAccountIndexResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryClassesByAccountIndexResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryClassesByAccountIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassesByAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassesByAccounts = append(m.ClassesByAccounts, ClassesByAccount{})
+			if err := m.ClassesByAccounts[len(m.ClassesByAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryRoyaltyConfigRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClassId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
-func (m *QueryRoyaltyConfigResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.RoyaltyConfig.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *QueryRoyaltyConfigIndexRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func (m *QueryRoyaltyConfigIndexResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.RoyaltyConfigByClass) > 0 {
-		for _, e := range m.RoyaltyConfigByClass {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountByClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5359,12 +8452,44 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountByClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5386,7 +8511,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAccountByClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5409,17 +8534,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAccountByClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAccountByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5429,24 +8554,23 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5469,7 +8593,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByISCNRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlindBoxContentRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5492,15 +8616,15 @@ func (m *QueryClassesByISCNRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByISCNRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlindBoxContentRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByISCNRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlindBoxContentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5528,13 +8652,13 @@ func (m *QueryClassesByISCNRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5544,27 +8668,23 @@ func (m *QueryClassesByISCNRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Id = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5587,7 +8707,7 @@ func (m *QueryClassesByISCNRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByISCNResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlindBoxContentResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5610,17 +8730,17 @@ func (m *QueryClassesByISCNResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByISCNResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlindBoxContentResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByISCNResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlindBoxContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlindBoxContent", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5630,59 +8750,76 @@ func (m *QueryClassesByISCNResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+			if err := m.BlindBoxContent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBlindBoxContentIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, nft.Class{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBlindBoxContentIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBlindBoxContentIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5712,7 +8849,7 @@ func (m *QueryClassesByISCNResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5739,7 +8876,7 @@ func (m *QueryClassesByISCNResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByISCNIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlindBoxContentIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5762,13 +8899,47 @@ func (m *QueryClassesByISCNIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByISCNIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlindBoxContentIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByISCNIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlindBoxContentIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlindBoxContents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlindBoxContents = append(m.BlindBoxContents, BlindBoxContent{})
+			if err := m.BlindBoxContents[len(m.BlindBoxContents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5798,7 +8969,7 @@ func (m *QueryClassesByISCNIndexRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5825,7 +8996,7 @@ func (m *QueryClassesByISCNIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByISCNIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlindBoxContentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5848,17 +9019,17 @@ func (m *QueryClassesByISCNIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByISCNIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlindBoxContentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByISCNIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlindBoxContentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassesByIscns", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5868,25 +9039,23 @@ func (m *QueryClassesByISCNIndexResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassesByIscns = append(m.ClassesByIscns, ClassesByISCN{})
-			if err := m.ClassesByIscns[len(m.ClassesByIscns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
@@ -5918,7 +9087,7 @@ func (m *QueryClassesByISCNIndexResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -5945,7 +9114,7 @@ func (m *QueryClassesByISCNIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryISCNByClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlindBoxContentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5968,17 +9137,17 @@ func (m *QueryISCNByClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryISCNByClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlindBoxContentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryISCNByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlindBoxContentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlindBoxContents", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5988,23 +9157,61 @@ func (m *QueryISCNByClassRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.BlindBoxContents = append(m.BlindBoxContents, BlindBoxContent{})
+			if err := m.BlindBoxContents[len(m.BlindBoxContents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6027,7 +9234,7 @@ func (m *QueryISCNByClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOfferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6050,15 +9257,15 @@ func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryISCNByClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOfferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryISCNByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IscnIdPrefix", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6086,11 +9293,11 @@ func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IscnIdPrefix = string(dAtA[iNdEx:postIndex])
+			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6118,32 +9325,13 @@ func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Owner = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestVersion", wireType)
-			}
-			m.LatestVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.LatestVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6153,24 +9341,23 @@ func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LatestRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6193,7 +9380,7 @@ func (m *QueryISCNByClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByAccountRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6216,17 +9403,17 @@ func (m *QueryClassesByAccountRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByAccountRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6236,25 +9423,76 @@ func (m *QueryClassesByAccountRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Account = string(dAtA[iNdEx:postIndex])
+			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOfferIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOfferIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOfferIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6311,7 +9549,7 @@ func (m *QueryClassesByAccountRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByAccountResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOfferIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6334,47 +9572,15 @@ func (m *QueryClassesByAccountResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByAccountResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOfferIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOfferIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Account = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Classes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6401,12 +9607,12 @@ func (m *QueryClassesByAccountResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Classes = append(m.Classes, nft.Class{})
-			if err := m.Classes[len(m.Classes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6463,7 +9669,7 @@ func (m *QueryClassesByAccountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByAccountIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOffersByClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6486,13 +9692,45 @@ func (m *QueryClassesByAccountIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByAccountIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffersByClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByAccountIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffersByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -6549,7 +9787,7 @@ func (m *QueryClassesByAccountIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryClassesByAccountIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOffersByClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6572,15 +9810,15 @@ func (m *QueryClassesByAccountIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryClassesByAccountIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffersByClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryClassesByAccountIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffersByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassesByAccounts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6607,8 +9845,8 @@ func (m *QueryClassesByAccountIndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassesByAccounts = append(m.ClassesByAccounts, ClassesByAccount{})
-			if err := m.ClassesByAccounts[len(m.ClassesByAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6669,7 +9907,7 @@ func (m *QueryClassesByAccountIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountByClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOffersByNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6692,10 +9930,10 @@ func (m *QueryAccountByClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountByClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffersByNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffersByNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6730,6 +9968,74 @@ func (m *QueryAccountByClassRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -6751,7 +10057,7 @@ func (m *QueryAccountByClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAccountByClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOffersByNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6774,17 +10080,17 @@ func (m *QueryAccountByClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAccountByClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOffersByNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAccountByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOffersByNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -6794,23 +10100,61 @@ func (m *QueryAccountByClassResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Offers = append(m.Offers, Offer{})
+			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6833,7 +10177,7 @@ func (m *QueryAccountByClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlindBoxContentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6856,15 +10200,47 @@ func (m *QueryBlindBoxContentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlindBoxContentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlindBoxContentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6892,11 +10268,11 @@ func (m *QueryBlindBoxContentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6924,7 +10300,7 @@ func (m *QueryBlindBoxContentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			m.Seller = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6947,7 +10323,7 @@ func (m *QueryBlindBoxContentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlindBoxContentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6970,15 +10346,15 @@ func (m *QueryBlindBoxContentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlindBoxContentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlindBoxContentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlindBoxContent", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listing", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7005,7 +10381,40 @@ func (m *QueryBlindBoxContentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BlindBoxContent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Listing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7030,7 +10439,7 @@ func (m *QueryBlindBoxContentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlindBoxContentIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7053,10 +10462,10 @@ func (m *QueryBlindBoxContentIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlindBoxContentIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlindBoxContentIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7116,7 +10525,7 @@ func (m *QueryBlindBoxContentIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlindBoxContentIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7139,15 +10548,15 @@ func (m *QueryBlindBoxContentIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlindBoxContentIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlindBoxContentIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlindBoxContents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7174,8 +10583,8 @@ func (m *QueryBlindBoxContentIndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlindBoxContents = append(m.BlindBoxContents, BlindBoxContent{})
-			if err := m.BlindBoxContents[len(m.BlindBoxContents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7236,7 +10645,7 @@ func (m *QueryBlindBoxContentIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlindBoxContentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsByClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7259,10 +10668,10 @@ func (m *QueryBlindBoxContentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlindBoxContentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsByClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlindBoxContentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7354,7 +10763,7 @@ func (m *QueryBlindBoxContentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryBlindBoxContentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsByClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7377,15 +10786,15 @@ func (m *QueryBlindBoxContentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryBlindBoxContentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsByClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryBlindBoxContentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlindBoxContents", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7412,8 +10821,8 @@ func (m *QueryBlindBoxContentsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlindBoxContents = append(m.BlindBoxContents, BlindBoxContent{})
-			if err := m.BlindBoxContents[len(m.BlindBoxContents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7474,7 +10883,7 @@ func (m *QueryBlindBoxContentsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOfferRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsByNFTRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7497,10 +10906,10 @@ func (m *QueryOfferRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOfferRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsByNFTRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsByNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7565,174 +10974,9 @@ func (m *QueryOfferRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Buyer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOfferResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOfferResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offer", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Offer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryOfferIndexRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOfferIndexRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOfferIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.NftId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -7789,7 +11033,7 @@ func (m *QueryOfferIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOfferIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryListingsByNFTResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7812,15 +11056,15 @@ func (m *QueryOfferIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOfferIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListingsByNFTResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOfferIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListingsByNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7847,8 +11091,8 @@ func (m *QueryOfferIndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Offers = append(m.Offers, Offer{})
-			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Listings = append(m.Listings, Listing{})
+			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7909,7 +11153,7 @@ func (m *QueryOfferIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOffersByClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyConfigRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7932,10 +11176,10 @@ func (m *QueryOffersByClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOffersByClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyConfigRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOffersByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7970,42 +11214,6 @@ func (m *QueryOffersByClassRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.ClassId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8027,7 +11235,7 @@ func (m *QueryOffersByClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOffersByClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8050,15 +11258,15 @@ func (m *QueryOffersByClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOffersByClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOffersByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyConfig", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8085,12 +11293,61 @@ func (m *QueryOffersByClassResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Offers = append(m.Offers, Offer{})
-			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.RoyaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoyaltyConfigIndexRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoyaltyConfigIndexRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoyaltyConfigIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -8120,7 +11377,7 @@ func (m *QueryOffersByClassResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8147,7 +11404,7 @@ func (m *QueryOffersByClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOffersByNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryRoyaltyConfigIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8170,17 +11427,17 @@ func (m *QueryOffersByNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOffersByNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRoyaltyConfigIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOffersByNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRoyaltyConfigIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyConfigByClass", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8190,57 +11447,27 @@ func (m *QueryOffersByNFTRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RoyaltyConfigByClass = append(m.RoyaltyConfigByClass, RoyaltyConfigByClass{})
+			if err := m.RoyaltyConfigByClass[len(m.RoyaltyConfigByClass)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -8270,7 +11497,7 @@ func (m *QueryOffersByNFTRequest) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+				m.Pagination = &query.PageResponse{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -8297,7 +11524,7 @@ func (m *QueryOffersByNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryOffersByNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryCollectedFeesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8320,49 +11547,65 @@ func (m *QueryOffersByNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryOffersByNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryOffersByNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryCollectedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Offers", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if msglen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCollectedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Offers = append(m.Offers, Offer{})
-			if err := m.Offers[len(m.Offers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCollectedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CollectedFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8389,10 +11632,7 @@ func (m *QueryOffersByNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.CollectedFees.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8417,7 +11657,7 @@ func (m *QueryOffersByNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8440,10 +11680,10 @@ func (m *QueryListingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8510,38 +11750,6 @@ func (m *QueryListingRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.NftId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seller", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Seller = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -8563,7 +11771,7 @@ func (m *QueryListingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8586,15 +11794,15 @@ func (m *QueryListingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listing", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auction", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8621,7 +11829,7 @@ func (m *QueryListingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Listing.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Auction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8646,7 +11854,7 @@ func (m *QueryListingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8669,10 +11877,10 @@ func (m *QueryListingIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8732,7 +11940,7 @@ func (m *QueryListingIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8755,15 +11963,15 @@ func (m *QueryListingIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8790,8 +11998,8 @@ func (m *QueryListingIndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Listings = append(m.Listings, Listing{})
-			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8852,7 +12060,7 @@ func (m *QueryListingIndexResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingsByClassRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionsByClassRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8875,10 +12083,10 @@ func (m *QueryListingsByClassRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingsByClassRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionsByClassRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingsByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionsByClassRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8970,7 +12178,7 @@ func (m *QueryListingsByClassRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingsByClassResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionsByClassResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8993,15 +12201,15 @@ func (m *QueryListingsByClassResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingsByClassResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionsByClassResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingsByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionsByClassResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Auctions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9028,8 +12236,8 @@ func (m *QueryListingsByClassResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Listings = append(m.Listings, Listing{})
-			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Auctions = append(m.Auctions, Auction{})
+			if err := m.Auctions[len(m.Auctions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9090,7 +12298,7 @@ func (m *QueryListingsByClassResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingsByNFTRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassOfferRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9113,10 +12321,10 @@ func (m *QueryListingsByNFTRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingsByNFTRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassOfferRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingsByNFTRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassOfferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9153,7 +12361,7 @@ func (m *QueryListingsByNFTRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NftId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Buyer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9181,43 +12389,7 @@ func (m *QueryListingsByNFTRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NftId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Buyer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9240,7 +12412,7 @@ func (m *QueryListingsByNFTRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryListingsByNFTResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassOfferResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9263,49 +12435,15 @@ func (m *QueryListingsByNFTResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryListingsByNFTResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassOfferResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryListingsByNFTResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassOfferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Listings", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Listings = append(m.Listings, Listing{})
-			if err := m.Listings[len(m.Listings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassOffer", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9332,10 +12470,7 @@ func (m *QueryListingsByNFTResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ClassOffer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9360,7 +12495,7 @@ func (m *QueryListingsByNFTResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRoyaltyConfigRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassOfferIndexRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9383,17 +12518,17 @@ func (m *QueryRoyaltyConfigRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassOfferIndexRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassOfferIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9403,23 +12538,27 @@ func (m *QueryRoyaltyConfigRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClassId = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9442,7 +12581,7 @@ func (m *QueryRoyaltyConfigRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRoyaltyConfigResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassOfferIndexResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9465,15 +12604,15 @@ func (m *QueryRoyaltyConfigResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassOfferIndexResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassOfferIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassOffers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9500,7 +12639,44 @@ func (m *QueryRoyaltyConfigResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoyaltyConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClassOffers = append(m.ClassOffers, ClassOffer{})
+			if err := m.ClassOffers[len(m.ClassOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9525,7 +12701,7 @@ func (m *QueryRoyaltyConfigResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRoyaltyConfigIndexRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryClassOffersRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9548,13 +12724,45 @@ func (m *QueryRoyaltyConfigIndexRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigIndexRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassOffersRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigIndexRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassOffersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClassId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -9611,7 +12819,7 @@ func (m *QueryRoyaltyConfigIndexRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryRoyaltyConfigIndexResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryClassOffersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9634,15 +12842,15 @@ func (m *QueryRoyaltyConfigIndexResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigIndexResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryClassOffersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryRoyaltyConfigIndexResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryClassOffersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoyaltyConfigByClass", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ClassOffers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9669,8 +12877,8 @@ func (m *QueryRoyaltyConfigIndexResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoyaltyConfigByClass = append(m.RoyaltyConfigByClass, RoyaltyConfigByClass{})
-			if err := m.RoyaltyConfigByClass[len(m.RoyaltyConfigByClass)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ClassOffers = append(m.ClassOffers, ClassOffer{})
+			if err := m.ClassOffers[len(m.ClassOffers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex